@@ -16,6 +16,7 @@ var services = mapOf(
         "authorization" to "Authorization",
         "automanage" to "Automanage",
         "automation" to "Automation",
+        "azurefleet" to "Azure Fleet",
         "azurestackhci" to "Azure Stack HCI",
         "batch" to "Batch",
         "billing" to "Billing",
@@ -45,6 +46,7 @@ var services = mapOf(
         "datadog" to "Datadog",
         "desktopvirtualization" to "Desktop Virtualization",
         "devcenter" to "Dev Center",
+        "deviceregistry" to "Device Registry",
         "devtestlabs" to "Dev Test",
         "digitaltwins" to "Digital Twins",
         "domainservices" to "DomainServices",
@@ -104,6 +106,7 @@ var services = mapOf(
         "privatednsresolver" to "Private DNS Resolver",
         "purview" to "Purview",
         "qumulo" to "Qumulo",
+        "quota" to "Quota",
         "recoveryservices" to "Recovery Services",
         "redhatopenshift" to "Red Hat OpenShift",
         "redis" to "Redis",
@@ -3,7 +3,10 @@
 
 package tf
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // todo this should be moved to internal somewhere?
 func ImportAsExistsError(resourceName, id string) error {
@@ -15,3 +18,8 @@ func ImportAsExistsAssociationError(resourceName, parentId, childId string) erro
 	msg := "an association between %q and %q already exists - to be managed via Terraform this association needs to be imported into the State. Please see the resource documentation for %q for more information"
 	return fmt.Errorf(msg, parentId, childId, resourceName)
 }
+
+func AdoptExistingMismatchError(resourceName, id string, arguments []string) error {
+	msg := "a resource with the ID %q already exists but can't be adopted, since the existing values for %s don't match the configuration and can't be updated - to be managed via Terraform either the configuration needs to be updated to match or this resource needs to be imported into the State. Please see the resource documentation for %q for more information"
+	return fmt.Errorf(msg, id, "`"+strings.Join(arguments, "`, `")+"`", resourceName)
+}
@@ -30,8 +30,8 @@ type ResourceManagerAccount struct {
 	RegisteredResourceProviders      resourceproviders.ResourceProviders
 }
 
-func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) (*ResourceManagerAccount, error) {
-	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, config, config.Environment.MicrosoftGraph)
+func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, options AuthOptions, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) (*ResourceManagerAccount, error) {
+	authorizer, err := NewAuthorizer(ctx, config, options, config.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
 	}
@@ -0,0 +1,307 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+)
+
+const (
+	managedIdentityDefaultApiVersion = "2018-02-01"
+	managedIdentityDefaultEndpoint   = "http://169.254.169.254/metadata/identity/oauth2/token"
+	managedIdentityDefaultMaxRetries = 5
+	managedIdentityRetryWaitMin      = 2 * time.Second
+	managedIdentityRetryWaitMax      = 60 * time.Second
+
+	// Azure Arc-enabled Servers expose a Hybrid Instance Metadata Service, which requires a challenge/response
+	azureArcApiVersion = "2020-06-01"
+
+	// azureArcSecretMaxSize is the maximum size of the challenge secret file, matching the Azure SDK
+	azureArcSecretMaxSize = 4096
+)
+
+// azureArcTokenDirectory returns the directory in which the Connected Machine Agent writes the challenge secret
+// files, which is a variable so that it can be overridden in tests
+var azureArcTokenDirectory = func() (string, error) {
+	switch runtime.GOOS {
+	case "linux":
+		return "/var/opt/azcmagent/tokens", nil
+	case "windows":
+		programData := os.Getenv("ProgramData")
+		if programData == "" {
+			return "", fmt.Errorf("the `ProgramData` environment variable is not set")
+		}
+		return filepath.Join(programData, "AzureConnectedMachineAgent", "Tokens"), nil
+	}
+
+	return "", fmt.Errorf("authenticating using a Managed Identity on Azure Arc is not supported on %q", runtime.GOOS)
+}
+
+type managedIdentityAuthorizerOptions struct {
+	// Api describes the Azure API being used
+	Api environments.Api
+
+	// ClientId is the Client ID of the User Assigned Identity to authenticate as
+	ClientId string
+
+	// ResourceId is the Resource ID of the User Assigned Identity to authenticate as
+	ResourceId string
+
+	// CustomEndpoint is an optional endpoint from which to obtain an access token
+	CustomEndpoint string
+
+	// CustomApiVersion is an optional API version to use when requesting an access token
+	CustomApiVersion string
+
+	// MaxRetries is the maximum number of times a request should be retried
+	MaxRetries int
+}
+
+var _ auth.Authorizer = &managedIdentityAuthorizer{}
+
+// managedIdentityAuthorizer is an Authorizer which obtains access tokens from the Instance Metadata Service
+// - or the Hybrid Instance Metadata Service on Azure Arc-enabled Servers. Unlike the Managed Identity
+// Authorizer within go-azure-sdk this supports authenticating using the Resource ID of a User Assigned
+// Identity and a configurable number of retries, since the metadata service on Arc-enabled Servers can
+// take some time to become available.
+type managedIdentityAuthorizer struct {
+	client     *http.Client
+	resource   string
+	clientId   string
+	resourceId string
+	endpoint   string
+	apiVersion string
+	azureArc   bool
+}
+
+func newManagedIdentityAuthorizer(options managedIdentityAuthorizerOptions) (auth.Authorizer, error) {
+	resource, err := environments.Resource(options.Api)
+	if err != nil {
+		return nil, fmt.Errorf("determining resource for api %q: %+v", options.Api.Name(), err)
+	}
+
+	if options.ClientId != "" && options.ResourceId != "" {
+		return nil, fmt.Errorf("only one of the Client ID or the Resource ID of the Managed Identity can be specified")
+	}
+
+	a := &managedIdentityAuthorizer{
+		resource:   *resource,
+		clientId:   options.ClientId,
+		resourceId: options.ResourceId,
+		endpoint:   managedIdentityDefaultEndpoint,
+		apiVersion: managedIdentityDefaultApiVersion,
+	}
+
+	if endpoint := azureArcIdentityEndpoint(); endpoint != "" && options.CustomEndpoint == "" {
+		log.Printf("[DEBUG] Detected the Azure Arc Hybrid Instance Metadata Service at %q", endpoint)
+		a.endpoint = endpoint
+		a.apiVersion = azureArcApiVersion
+		a.azureArc = true
+	}
+
+	if options.CustomEndpoint != "" {
+		a.endpoint = options.CustomEndpoint
+	}
+	if options.CustomApiVersion != "" {
+		a.apiVersion = options.CustomApiVersion
+	}
+
+	maxRetries := managedIdentityDefaultMaxRetries
+	if options.MaxRetries > 0 {
+		maxRetries = options.MaxRetries
+	}
+	a.client = managedIdentityHttpClient(maxRetries, managedIdentityRetryWaitMin, managedIdentityRetryWaitMax)
+
+	return auth.NewCachedAuthorizer(a)
+}
+
+// azureArcIdentityEndpoint returns the endpoint of the Hybrid Instance Metadata Service when running
+// on an Azure Arc-enabled Server, which is exposed by the Connected Machine Agent via environment variables.
+func azureArcIdentityEndpoint() string {
+	if os.Getenv("IMDS_ENDPOINT") == "" {
+		return ""
+	}
+
+	return os.Getenv("IDENTITY_ENDPOINT")
+}
+
+func managedIdentityHttpClient(maxRetries int, retryWaitMin, retryWaitMax time.Duration) *http.Client {
+	r := retryablehttp.NewClient()
+	r.Logger = log.Default()
+	r.RetryMax = maxRetries
+	r.RetryWaitMin = retryWaitMin
+	r.RetryWaitMax = retryWaitMax
+	r.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		// the Hybrid Instance Metadata Service returns a 401 as part of the challenge, which shouldn't be retried
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return false, nil
+		}
+
+		// the Instance Metadata Service returns a 410 whilst it's being updated, and a 404 whilst the identity is being assigned
+		// see https://learn.microsoft.com/en-us/entra/identity/managed-identities-azure-resources/how-to-use-vm-token#retry-guidance
+		if resp != nil && (resp.StatusCode == http.StatusGone || resp.StatusCode == http.StatusNotFound) {
+			return true, nil
+		}
+
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	// the metadata service must be accessed directly, rather than via a proxy
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	r.HTTPClient.Transport = transport
+
+	return r.StandardClient()
+}
+
+func (a *managedIdentityAuthorizer) Token(ctx context.Context, _ *http.Request) (*oauth2.Token, error) {
+	query := url.Values{
+		"api-version": []string{a.apiVersion},
+		"resource":    []string{a.resource},
+	}
+	if a.clientId != "" {
+		query["client_id"] = []string{a.clientId}
+	}
+	if a.resourceId != "" {
+		query["mi_res_id"] = []string{a.resourceId}
+	}
+	u := fmt.Sprintf("%s?%s", a.endpoint, query.Encode())
+
+	resp, body, err := a.request(ctx, u, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && a.azureArc {
+		secret, err := azureArcChallengeSecret(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp, body, err = a.request(ctx, u, secret); err != nil {
+			return nil, err
+		}
+	}
+
+	if c := resp.StatusCode; c < 200 || c > 299 {
+		return nil, fmt.Errorf("managedIdentityAuthorizer: received HTTP status %d from the metadata endpoint with body: %s", c, body)
+	}
+
+	var tokenRes struct {
+		AccessToken string      `json:"access_token"`
+		TokenType   string      `json:"token_type"`
+		ExpiresIn   json.Number `json:"expires_in"`
+		ExpiresOn   json.Number `json:"expires_on"`
+	}
+	if err := json.Unmarshal(body, &tokenRes); err != nil {
+		return nil, fmt.Errorf("managedIdentityAuthorizer: unmarshalling token: %+v", err)
+	}
+
+	token := &oauth2.Token{
+		AccessToken: tokenRes.AccessToken,
+		TokenType:   tokenRes.TokenType,
+	}
+	if v, err := strconv.Atoi(tokenRes.ExpiresIn.String()); err == nil && v > 0 {
+		token.Expiry = time.Now().Add(time.Duration(v) * time.Second)
+	} else if v, err := strconv.ParseInt(tokenRes.ExpiresOn.String(), 10, 64); err == nil && v > 0 {
+		token.Expiry = time.Unix(v, 0)
+	}
+
+	return token, nil
+}
+
+func (a *managedIdentityAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	// auxiliary tokens are not supported with Managed Identity authentication
+	return []*oauth2.Token{}, nil
+}
+
+func (a *managedIdentityAuthorizer) request(ctx context.Context, u string, secret string) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("managedIdentityAuthorizer: building request: %+v", err)
+	}
+	req.Header.Set("Metadata", "true")
+	if secret != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Basic %s", secret))
+	}
+
+	log.Printf("[DEBUG] Performing %s Request to %q", req.Method, u)
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("managedIdentityAuthorizer: requesting token from the metadata endpoint: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("managedIdentityAuthorizer: reading response from the metadata endpoint: %+v", err)
+	}
+
+	return resp, body, nil
+}
+
+// azureArcChallengeSecret returns the secret referenced by the challenge from the Hybrid Instance Metadata
+// Service, which is the contents of a file only readable by privileged users on the Arc-enabled Server.
+func azureArcChallengeSecret(resp *http.Response) (string, error) {
+	header := resp.Header.Get("WWW-Authenticate")
+	_, path, ok := strings.Cut(header, "Basic realm=")
+	if !ok || path == "" {
+		return "", fmt.Errorf("managedIdentityAuthorizer: the Azure Arc challenge didn't contain a path to the secret file, got %q", header)
+	}
+
+	// the Connected Machine Agent always writes the secret to a `.key` file within its token directory, so
+	// anything else is unexpected and isn't read, since the path is supplied by the metadata endpoint
+	path = filepath.Clean(path)
+	tokenDirectory, err := azureArcTokenDirectory()
+	if err != nil {
+		return "", fmt.Errorf("managedIdentityAuthorizer: determining the Azure Arc token directory: %+v", err)
+	}
+	if !azureArcPathIsWithinDirectory(path, tokenDirectory) || filepath.Ext(path) != ".key" {
+		return "", fmt.Errorf("managedIdentityAuthorizer: the Azure Arc challenge referenced an unexpected file %q", path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("managedIdentityAuthorizer: reading the Azure Arc challenge secret from %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	secret, err := io.ReadAll(io.LimitReader(file, azureArcSecretMaxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("managedIdentityAuthorizer: reading the Azure Arc challenge secret from %q: %+v", path, err)
+	}
+	if len(secret) > azureArcSecretMaxSize {
+		return "", fmt.Errorf("managedIdentityAuthorizer: the Azure Arc challenge secret %q is larger than %d bytes", path, azureArcSecretMaxSize)
+	}
+
+	return strings.TrimSpace(string(secret)), nil
+}
+
+// azureArcPathIsWithinDirectory returns whether the file at path is directly within the directory
+func azureArcPathIsWithinDirectory(path string, directory string) bool {
+	parent := filepath.Dir(path)
+	directory = filepath.Clean(directory)
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(parent, directory)
+	}
+
+	return parent == directory
+}
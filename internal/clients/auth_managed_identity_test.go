@@ -0,0 +1,415 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func testManagedIdentityAuthorizer(endpoint string, maxRetries int) *managedIdentityAuthorizer {
+	return &managedIdentityAuthorizer{
+		client:     managedIdentityHttpClient(maxRetries, time.Millisecond, 10*time.Millisecond),
+		resource:   "https://management.azure.com/",
+		endpoint:   endpoint,
+		apiVersion: managedIdentityDefaultApiVersion,
+	}
+}
+
+// testAzureArcTokenDirectory overrides the Azure Arc token directory for the duration of the test
+func testAzureArcTokenDirectory(t *testing.T, directory string) {
+	original := azureArcTokenDirectory
+	azureArcTokenDirectory = func() (string, error) {
+		return directory, nil
+	}
+	t.Cleanup(func() {
+		azureArcTokenDirectory = original
+	})
+}
+
+func TestManagedIdentityAuthorizer_Token(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" {
+			t.Errorf("expected the `Metadata` header to be `true` but got %q", r.Header.Get("Metadata"))
+		}
+
+		query := r.URL.Query()
+		if v := query.Get("api-version"); v != managedIdentityDefaultApiVersion {
+			t.Errorf("expected the `api-version` to be %q but got %q", managedIdentityDefaultApiVersion, v)
+		}
+		if v := query.Get("resource"); v != "https://management.azure.com/" {
+			t.Errorf("expected the `resource` to be %q but got %q", "https://management.azure.com/", v)
+		}
+		if v := query.Get("mi_res_id"); v != "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ManagedIdentity/userAssignedIdentities/example" {
+			t.Errorf("expected `mi_res_id` to be the Resource ID of the identity but got %q", v)
+		}
+		if query.Has("client_id") {
+			t.Errorf("expected `client_id` not to be specified")
+		}
+
+		fmt.Fprint(w, `{"access_token": "example-token", "token_type": "Bearer", "expires_in": "3599", "expires_on": "1700000000"}`)
+	}))
+	defer server.Close()
+
+	a := testManagedIdentityAuthorizer(server.URL, 1)
+	a.resourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ManagedIdentity/userAssignedIdentities/example"
+
+	token, err := a.Token(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if token.AccessToken != "example-token" {
+		t.Fatalf("expected the access token to be %q but got %q", "example-token", token.AccessToken)
+	}
+	if token.TokenType != "Bearer" {
+		t.Fatalf("expected the token type to be %q but got %q", "Bearer", token.TokenType)
+	}
+
+	// `expires_in` takes precedence over `expires_on`
+	if remaining := time.Until(token.Expiry); remaining < 3500*time.Second || remaining > 3600*time.Second {
+		t.Fatalf("expected the token to expire in around 3599s but got %s", remaining)
+	}
+}
+
+func TestManagedIdentityAuthorizer_TokenExpiresOn(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.URL.Query().Get("client_id"); v != "11111111-1111-1111-1111-111111111111" {
+			t.Errorf("expected `client_id` to be the Client ID of the identity but got %q", v)
+		}
+
+		fmt.Fprint(w, `{"access_token": "example-token", "token_type": "Bearer", "expires_on": 1700000000}`)
+	}))
+	defer server.Close()
+
+	a := testManagedIdentityAuthorizer(server.URL, 1)
+	a.clientId = "11111111-1111-1111-1111-111111111111"
+
+	token, err := a.Token(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if expected := time.Unix(1700000000, 0); !token.Expiry.Equal(expected) {
+		t.Fatalf("expected the token to expire at %s but got %s", expected, token.Expiry)
+	}
+}
+
+func TestManagedIdentityAuthorizer_TokenInvalidResponse(t *testing.T) {
+	testData := map[string]struct {
+		statusCode int
+		body       string
+		expected   string
+	}{
+		"bad request": {
+			statusCode: http.StatusBadRequest,
+			body:       `{"error": "invalid_request"}`,
+			expected:   "received HTTP status 400",
+		},
+		"invalid json": {
+			statusCode: http.StatusOK,
+			body:       `not json`,
+			expected:   "unmarshalling token",
+		},
+	}
+
+	for name, v := range testData {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(v.statusCode)
+				fmt.Fprint(w, v.body)
+			}))
+			defer server.Close()
+
+			_, err := testManagedIdentityAuthorizer(server.URL, 1).Token(context.Background(), nil)
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !strings.Contains(err.Error(), v.expected) {
+				t.Fatalf("expected the error to contain %q but got: %+v", v.expected, err)
+			}
+		})
+	}
+}
+
+func TestManagedIdentityAuthorizer_Retries(t *testing.T) {
+	testData := map[string]struct {
+		statusCodes      []int
+		maxRetries       int
+		expectedRequests int32
+		expectError      bool
+	}{
+		"identity being assigned": {
+			statusCodes:      []int{http.StatusNotFound, http.StatusNotFound, http.StatusOK},
+			maxRetries:       5,
+			expectedRequests: 3,
+		},
+		"metadata service being updated": {
+			statusCodes:      []int{http.StatusGone, http.StatusOK},
+			maxRetries:       5,
+			expectedRequests: 2,
+		},
+		"throttled": {
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusOK},
+			maxRetries:       5,
+			expectedRequests: 3,
+		},
+		"retries exhausted": {
+			statusCodes:      []int{http.StatusGone, http.StatusGone, http.StatusGone, http.StatusOK},
+			maxRetries:       2,
+			expectedRequests: 3,
+			expectError:      true,
+		},
+		"bad request isn't retried": {
+			statusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			maxRetries:       5,
+			expectedRequests: 1,
+			expectError:      true,
+		},
+	}
+
+	for name, v := range testData {
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := atomic.AddInt32(&requests, 1) - 1
+				if statusCode := v.statusCodes[i]; statusCode != http.StatusOK {
+					w.WriteHeader(statusCode)
+					return
+				}
+
+				fmt.Fprint(w, `{"access_token": "example-token", "token_type": "Bearer", "expires_in": 3599}`)
+			}))
+			defer server.Close()
+
+			token, err := testManagedIdentityAuthorizer(server.URL, v.maxRetries).Token(context.Background(), nil)
+			if v.expectError {
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+				if token.AccessToken != "example-token" {
+					t.Fatalf("expected the access token to be %q but got %q", "example-token", token.AccessToken)
+				}
+			}
+
+			if actual := atomic.LoadInt32(&requests); actual != v.expectedRequests {
+				t.Fatalf("expected %d requests but got %d", v.expectedRequests, actual)
+			}
+		})
+	}
+}
+
+func TestManagedIdentityAuthorizer_AzureArcChallenge(t *testing.T) {
+	dir := t.TempDir()
+	testAzureArcTokenDirectory(t, dir)
+	secretPath := filepath.Join(dir, "example.key")
+	if err := os.WriteFile(secretPath, []byte("example-secret\n"), 0o600); err != nil {
+		t.Fatalf("writing the secret file: %+v", err)
+	}
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		if v := r.URL.Query().Get("api-version"); v != azureArcApiVersion {
+			t.Errorf("expected the `api-version` to be %q but got %q", azureArcApiVersion, v)
+		}
+
+		authorization := r.Header.Get("Authorization")
+		if authorization == "" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf("Basic realm=%s", secretPath))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if authorization != "Basic example-secret" {
+			t.Errorf("expected the `Authorization` header to contain the secret but got %q", authorization)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		fmt.Fprint(w, `{"access_token": "example-token", "token_type": "Bearer", "expires_in": "3599"}`)
+	}))
+	defer server.Close()
+
+	t.Setenv("IMDS_ENDPOINT", "http://localhost:40342")
+	t.Setenv("IDENTITY_ENDPOINT", server.URL)
+
+	authorizer, err := newManagedIdentityAuthorizer(managedIdentityAuthorizerOptions{
+		Api:        environments.AzurePublic().ResourceManager,
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatalf("building the authorizer: %+v", err)
+	}
+
+	token, err := authorizer.Token(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if token.AccessToken != "example-token" {
+		t.Fatalf("expected the access token to be %q but got %q", "example-token", token.AccessToken)
+	}
+
+	// the challenge response shouldn't be retried, only answered
+	if actual := atomic.LoadInt32(&requests); actual != 2 {
+		t.Fatalf("expected 2 requests but got %d", actual)
+	}
+}
+
+func TestManagedIdentityAuthorizer_AzureArcChallengeWithoutArc(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("WWW-Authenticate", "Basic realm=/var/opt/azcmagent/tokens/example.key")
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	// a challenge is only answered when the Hybrid Instance Metadata Service was detected
+	_, err := testManagedIdentityAuthorizer(server.URL, 1).Token(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "received HTTP status 401") {
+		t.Fatalf("expected an error containing the 401 status but got: %+v", err)
+	}
+}
+
+func TestAzureArcChallengeSecret(t *testing.T) {
+	dir := t.TempDir()
+	testAzureArcTokenDirectory(t, dir)
+	secretPath := filepath.Join(dir, "example.key")
+	if err := os.WriteFile(secretPath, []byte("  example-secret \n"), 0o600); err != nil {
+		t.Fatalf("writing the secret file: %+v", err)
+	}
+	otherPath := filepath.Join(dir, "example.txt")
+	if err := os.WriteFile(otherPath, []byte("example-secret"), 0o600); err != nil {
+		t.Fatalf("writing the file: %+v", err)
+	}
+	largePath := filepath.Join(dir, "large.key")
+	if err := os.WriteFile(largePath, []byte(strings.Repeat("a", azureArcSecretMaxSize+1)), 0o600); err != nil {
+		t.Fatalf("writing the file: %+v", err)
+	}
+	outsideDir := t.TempDir()
+	outsidePath := filepath.Join(outsideDir, "example.key")
+	if err := os.WriteFile(outsidePath, []byte("example-secret"), 0o600); err != nil {
+		t.Fatalf("writing the file: %+v", err)
+	}
+	nestedDir := filepath.Join(dir, "nested")
+	if err := os.Mkdir(nestedDir, 0o700); err != nil {
+		t.Fatalf("creating the directory: %+v", err)
+	}
+	nestedPath := filepath.Join(nestedDir, "example.key")
+	if err := os.WriteFile(nestedPath, []byte("example-secret"), 0o600); err != nil {
+		t.Fatalf("writing the file: %+v", err)
+	}
+
+	testData := map[string]struct {
+		header      string
+		expected    string
+		expectError string
+	}{
+		"valid": {
+			header:   fmt.Sprintf("Basic realm=%s", secretPath),
+			expected: "example-secret",
+		},
+		"missing header": {
+			header:      "",
+			expectError: "didn't contain a path",
+		},
+		"missing path": {
+			header:      "Basic realm=",
+			expectError: "didn't contain a path",
+		},
+		"unexpected extension": {
+			header:      fmt.Sprintf("Basic realm=%s", otherPath),
+			expectError: "unexpected file",
+		},
+		"path traversal to an unexpected file": {
+			header:      fmt.Sprintf("Basic realm=%s/../%s", filepath.Join(dir, "tokens"), filepath.Base(otherPath)),
+			expectError: "unexpected file",
+		},
+		"outside of the token directory": {
+			header:      fmt.Sprintf("Basic realm=%s", outsidePath),
+			expectError: "unexpected file",
+		},
+		"path traversal outside of the token directory": {
+			header:      fmt.Sprintf("Basic realm=%s/../%s/%s", dir, filepath.Base(outsideDir), filepath.Base(outsidePath)),
+			expectError: "unexpected file",
+		},
+		"nested within the token directory": {
+			header:      fmt.Sprintf("Basic realm=%s", nestedPath),
+			expectError: "unexpected file",
+		},
+		"too large": {
+			header:      fmt.Sprintf("Basic realm=%s", largePath),
+			expectError: "is larger than 4096 bytes",
+		},
+		"missing file": {
+			header:      fmt.Sprintf("Basic realm=%s", filepath.Join(dir, "missing.key")),
+			expectError: "reading the Azure Arc challenge secret",
+		},
+	}
+
+	for name, v := range testData {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{
+				Header: http.Header{},
+			}
+			if v.header != "" {
+				resp.Header.Set("WWW-Authenticate", v.header)
+			}
+
+			actual, err := azureArcChallengeSecret(resp)
+			if v.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), v.expectError) {
+					t.Fatalf("expected an error containing %q but got: %+v", v.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if actual != v.expected {
+				t.Fatalf("expected the secret to be %q but got %q", v.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewManagedIdentityAuthorizer_ClientIdAndResourceId(t *testing.T) {
+	_, err := newManagedIdentityAuthorizer(managedIdentityAuthorizerOptions{
+		Api:        environments.AzurePublic().ResourceManager,
+		ClientId:   "11111111-1111-1111-1111-111111111111",
+		ResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ManagedIdentity/userAssignedIdentities/example",
+	})
+	if err == nil {
+		t.Fatalf("expected an error when both the Client ID and Resource ID are specified")
+	}
+}
+
+func TestAzureArcIdentityEndpoint(t *testing.T) {
+	t.Setenv("IMDS_ENDPOINT", "")
+	t.Setenv("IDENTITY_ENDPOINT", "http://localhost:40342/metadata/identity/oauth2/token")
+	if actual := azureArcIdentityEndpoint(); actual != "" {
+		t.Fatalf("expected no endpoint when `IMDS_ENDPOINT` isn't set but got %q", actual)
+	}
+
+	t.Setenv("IMDS_ENDPOINT", "http://localhost:40342")
+	if actual := azureArcIdentityEndpoint(); actual != "http://localhost:40342/metadata/identity/oauth2/token" {
+		t.Fatalf("expected the `IDENTITY_ENDPOINT` to be returned but got %q", actual)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"golang.org/x/oauth2"
+)
+
+var _ auth.CachingAuthorizer = &oidcTokenFileAuthorizer{}
+
+// oidcTokenFileAuthorizer is an Authorizer which re-reads the Federated Token from a file each time an
+// access token is requested. Projected tokens (such as those used by AKS Workload Identity) are rotated
+// on disk and are typically valid for around an hour, which is shorter than many applies - as such the
+// OIDC Authorizer is rebuilt using the new Federated Token whenever the contents of the file change.
+type oidcTokenFileAuthorizer struct {
+	ctx     context.Context
+	options auth.OIDCAuthorizerOptions
+	path    string
+
+	mutex      *sync.Mutex
+	assertion  string
+	authorizer auth.Authorizer
+}
+
+func newOIDCTokenFileAuthorizer(ctx context.Context, options auth.OIDCAuthorizerOptions, path string) (auth.Authorizer, error) {
+	a := &oidcTokenFileAuthorizer{
+		ctx:     ctx,
+		options: options,
+		path:    path,
+		mutex:   &sync.Mutex{},
+	}
+
+	if _, err := a.current(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// current returns the OIDC Authorizer for the Federated Token currently within the file, rebuilding it
+// when the Federated Token has been rotated.
+func (a *oidcTokenFileAuthorizer) current() (auth.Authorizer, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	raw, err := os.ReadFile(a.path)
+	if err != nil {
+		if a.authorizer != nil {
+			// the file can be briefly unavailable whilst it's being rotated, so continue using the existing token
+			log.Printf("[DEBUG] Unable to re-read the OIDC Token from file %q, using the existing token: %+v", a.path, err)
+			return a.authorizer, nil
+		}
+		return nil, fmt.Errorf("reading OIDC Token from file %q: %+v", a.path, err)
+	}
+
+	assertion := strings.TrimSpace(string(raw))
+	if assertion == "" {
+		return nil, fmt.Errorf("the OIDC Token file %q was empty", a.path)
+	}
+
+	if a.authorizer != nil && assertion == a.assertion {
+		return a.authorizer, nil
+	}
+
+	if a.authorizer != nil {
+		log.Printf("[DEBUG] The OIDC Token within file %q has been rotated, rebuilding the OIDC Authorizer", a.path)
+	}
+
+	options := a.options
+	options.FederatedAssertion = assertion
+	authorizer, err := auth.NewOIDCAuthorizer(a.ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("building OIDC Authorizer using the token from file %q: %+v", a.path, err)
+	}
+
+	a.assertion = assertion
+	a.authorizer = authorizer
+
+	return authorizer, nil
+}
+
+func (a *oidcTokenFileAuthorizer) Token(ctx context.Context, request *http.Request) (*oauth2.Token, error) {
+	authorizer, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+
+	return authorizer.Token(ctx, request)
+}
+
+func (a *oidcTokenFileAuthorizer) AuxiliaryTokens(ctx context.Context, request *http.Request) ([]*oauth2.Token, error) {
+	authorizer, err := a.current()
+	if err != nil {
+		return nil, err
+	}
+
+	return authorizer.AuxiliaryTokens(ctx, request)
+}
+
+func (a *oidcTokenFileAuthorizer) InvalidateCachedTokens() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if cache, ok := a.authorizer.(auth.CachingAuthorizer); ok {
+		return cache.InvalidateCachedTokens()
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// testOIDCTokenServer returns a token endpoint which issues an access token containing the Federated Token
+// it was requested with, and the list of Federated Tokens which have been received
+func testOIDCTokenServer(t *testing.T) (*httptest.Server, func() []string) {
+	mutex := &sync.Mutex{}
+	assertions := make([]string, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing the token request: %+v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		assertion := r.PostForm.Get("client_assertion")
+		mutex.Lock()
+		assertions = append(assertions, assertion)
+		mutex.Unlock()
+
+		fmt.Fprintf(w, `{"access_token": "token-for-%s", "token_type": "Bearer", "expires_in": 3599}`, assertion)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string{}, assertions...)
+	}
+}
+
+func testOIDCAuthorizerOptions(loginEndpoint string) auth.OIDCAuthorizerOptions {
+	environment := environments.AzurePublic()
+	environment.Authorization.LoginEndpoint = loginEndpoint
+
+	return auth.OIDCAuthorizerOptions{
+		Environment: *environment,
+		Api:         environment.ResourceManager,
+		TenantId:    "00000000-0000-0000-0000-000000000000",
+		ClientId:    "11111111-1111-1111-1111-111111111111",
+	}
+}
+
+func writeOIDCTokenFile(t *testing.T, path string, contents string) {
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing the OIDC Token file: %+v", err)
+	}
+}
+
+func TestOIDCTokenFileAuthorizer_RereadsRotatedToken(t *testing.T) {
+	server, assertions := testOIDCTokenServer(t)
+	path := filepath.Join(t.TempDir(), "token")
+	writeOIDCTokenFile(t, path, "first\n")
+
+	authorizer, err := newOIDCTokenFileAuthorizer(context.Background(), testOIDCAuthorizerOptions(server.URL), path)
+	if err != nil {
+		t.Fatalf("building the authorizer: %+v", err)
+	}
+
+	token, err := authorizer.Token(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if token.AccessToken != "token-for-first" {
+		t.Fatalf("expected the access token to be %q but got %q", "token-for-first", token.AccessToken)
+	}
+
+	// whilst the Federated Token is unchanged the cached access token is used
+	if _, err := authorizer.Token(context.Background(), nil); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual := len(assertions()); actual != 1 {
+		t.Fatalf("expected 1 token request but got %d", actual)
+	}
+
+	writeOIDCTokenFile(t, path, "second\n")
+
+	token, err = authorizer.Token(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if token.AccessToken != "token-for-second" {
+		t.Fatalf("expected the access token to be %q but got %q", "token-for-second", token.AccessToken)
+	}
+
+	actual := assertions()
+	if len(actual) != 2 || actual[0] != "first" || actual[1] != "second" {
+		t.Fatalf("expected the token requests to use the Federated Tokens [first second] but got %v", actual)
+	}
+}
+
+func TestOIDCTokenFileAuthorizer_RotationInProgress(t *testing.T) {
+	server, _ := testOIDCTokenServer(t)
+	path := filepath.Join(t.TempDir(), "token")
+	writeOIDCTokenFile(t, path, "first")
+
+	authorizer, err := newOIDCTokenFileAuthorizer(context.Background(), testOIDCAuthorizerOptions(server.URL), path)
+	if err != nil {
+		t.Fatalf("building the authorizer: %+v", err)
+	}
+
+	// the file can be briefly missing whilst it's being rotated, in which case the existing token is used
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("removing the OIDC Token file: %+v", err)
+	}
+
+	token, err := authorizer.Token(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if token.AccessToken != "token-for-first" {
+		t.Fatalf("expected the access token to be %q but got %q", "token-for-first", token.AccessToken)
+	}
+}
+
+func TestOIDCTokenFileAuthorizer_InvalidFile(t *testing.T) {
+	dir := t.TempDir()
+	emptyPath := filepath.Join(dir, "empty")
+	writeOIDCTokenFile(t, emptyPath, " \n")
+
+	testData := map[string]string{
+		"missing": filepath.Join(dir, "missing"),
+		"empty":   emptyPath,
+	}
+
+	for name, path := range testData {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newOIDCTokenFileAuthorizer(context.Background(), testOIDCAuthorizerOptions("https://login.microsoftonline.com"), path); err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+		})
+	}
+}
+
+func TestOIDCTokenFileAuthorizer_EmptiedFile(t *testing.T) {
+	server, _ := testOIDCTokenServer(t)
+	path := filepath.Join(t.TempDir(), "token")
+	writeOIDCTokenFile(t, path, "first")
+
+	authorizer, err := newOIDCTokenFileAuthorizer(context.Background(), testOIDCAuthorizerOptions(server.URL), path)
+	if err != nil {
+		t.Fatalf("building the authorizer: %+v", err)
+	}
+
+	writeOIDCTokenFile(t, path, "")
+	if _, err := authorizer.Token(context.Background(), nil); err == nil {
+		t.Fatalf("expected an error when the OIDC Token file is empty but didn't get one")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// AuthOptions contains authentication settings which are handled within the Provider, rather
+// than by the Credentials within go-azure-sdk.
+type AuthOptions struct {
+	// OIDCTokenFilePath is the path to a file containing a Federated Token, which is re-read whenever
+	// a new access token is required since projected tokens are rotated on disk.
+	OIDCTokenFilePath string
+
+	// ManagedIdentityResourceID is the Resource ID of the User Assigned Identity to authenticate as
+	// when using Managed Identity, as an alternative to specifying the Client ID.
+	ManagedIdentityResourceID string
+
+	// ManagedIdentityMaxRetries is the maximum number of times a request to the Managed Identity
+	// endpoint should be retried, when zero the default number of retries is used.
+	ManagedIdentityMaxRetries int
+}
+
+// NewAuthorizer returns an Authorizer for the specified API, using the Authorizers within the Provider
+// where the AuthOptions require it and falling back to those within go-azure-sdk otherwise.
+func NewAuthorizer(ctx context.Context, credentials auth.Credentials, options AuthOptions, api environments.Api) (auth.Authorizer, error) {
+	// go-azure-sdk gives precedence to Client Certificates and Client Secrets, then OIDC, then Managed Identity - so the same applies here
+	hasClientCertificate := len(credentials.ClientCertificateData) > 0 || strings.TrimSpace(credentials.ClientCertificatePath) != ""
+	hasClientSecret := strings.TrimSpace(credentials.ClientSecret) != ""
+	hasClientCredentials := (credentials.EnableAuthenticatingUsingClientCertificate && hasClientCertificate) || (credentials.EnableAuthenticatingUsingClientSecret && hasClientSecret)
+
+	if !hasClientCredentials && credentials.EnableAuthenticationUsingOIDC && options.OIDCTokenFilePath != "" {
+		return newOIDCTokenFileAuthorizer(ctx, auth.OIDCAuthorizerOptions{
+			Environment:        credentials.Environment,
+			Api:                api,
+			TenantId:           credentials.TenantID,
+			AuxiliaryTenantIds: credentials.AuxiliaryTenantIDs,
+			ClientId:           credentials.ClientID,
+		}, options.OIDCTokenFilePath)
+	}
+
+	hasOIDCCredentials := credentials.EnableAuthenticationUsingOIDC && (strings.TrimSpace(credentials.OIDCAssertionToken) != "" || strings.TrimSpace(credentials.OIDCTokenRequestURL) != "")
+	if !hasClientCredentials && !hasOIDCCredentials && credentials.EnableAuthenticatingUsingManagedIdentity && (options.ManagedIdentityResourceID != "" || options.ManagedIdentityMaxRetries > 0 || azureArcIdentityEndpoint() != "") {
+		return newManagedIdentityAuthorizer(managedIdentityAuthorizerOptions{
+			Api:              api,
+			ClientId:         credentials.ClientID,
+			ResourceId:       options.ManagedIdentityResourceID,
+			CustomEndpoint:   credentials.CustomManagedIdentityEndpoint,
+			CustomApiVersion: credentials.CustomManagedIdentityAPIVersion,
+			MaxRetries:       options.ManagedIdentityMaxRetries,
+		})
+	}
+
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, credentials, api)
+	if err != nil {
+		return nil, fmt.Errorf("building authorizer for API %q: %+v", api.Name(), err)
+	}
+
+	return authorizer, nil
+}
@@ -19,12 +19,15 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/vcr"
 )
 
 type ClientBuilder struct {
-	AuthConfig *auth.Credentials
-	Features   features.UserFeatures
+	AuthConfig      *auth.Credentials
+	AuthOptions     AuthOptions
+	DefaultTimeouts timeouts.DefaultTimeouts
+	Features        features.UserFeatures
 
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
@@ -53,23 +56,23 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = NewAuthorizer(ctx, *builder.AuthConfig, builder.AuthOptions, builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = NewAuthorizer(ctx, *builder.AuthConfig, builder.AuthOptions, builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = NewAuthorizer(ctx, *builder.AuthConfig, builder.AuthOptions, builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = NewAuthorizer(ctx, *builder.AuthConfig, builder.AuthOptions, builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -78,7 +81,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = NewAuthorizer(ctx, *builder.AuthConfig, builder.AuthOptions, builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -88,7 +91,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := NewAuthorizer(ctx, *builder.AuthConfig, builder.AuthOptions, api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -96,14 +99,14 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.AuthOptions, builder.SubscriptionID, builder.RegisteredResourceProviders)
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = NewAuthorizer(ctx, *builder.AuthConfig, builder.AuthOptions, builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -117,7 +120,8 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	client := Client{
-		Account: account,
+		Account:         account,
+		DefaultTimeouts: builder.DefaultTimeouts,
 	}
 
 	o := &common.ClientOptions{
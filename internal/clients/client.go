@@ -20,8 +20,8 @@ import (
 	servicenetworking_2025_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01"
 	storagecache_2023_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01"
 	storagecache_2024_07_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01"
+	storagecache_2025_07_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2025-07-01"
 	systemcentervirtualmachinemanager_2023_10_07 "github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07"
-	workloads_v2024_09_01 "github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2024-09-01"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
@@ -37,6 +37,7 @@ import (
 	authorization "github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/client"
 	automanage "github.com/hashicorp/terraform-provider-azurerm/internal/services/automanage/client"
 	automation "github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/client"
+	azurefleet "github.com/hashicorp/terraform-provider-azurerm/internal/services/azurefleet/client"
 	azureStackHCI "github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/client"
 	batch "github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/client"
 	blueprints "github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/client"
@@ -63,6 +64,7 @@ import (
 	dataprotection "github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/client"
 	datashare "github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/client"
 	desktopvirtualization "github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/client"
+	deviceregistry "github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry/client"
 	devtestlabs "github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/client"
 	digitaltwins "github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/client"
 	dns "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/client"
@@ -121,6 +123,7 @@ import (
 	dnsresolver "github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/client"
 	purview "github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/client"
 	qumulo "github.com/hashicorp/terraform-provider-azurerm/internal/services/qumulo/client"
+	quota "github.com/hashicorp/terraform-provider-azurerm/internal/services/quota/client"
 	recoveryServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/client"
 	redhatopenshift "github.com/hashicorp/terraform-provider-azurerm/internal/services/redhatopenshift/client"
 	redis "github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/client"
@@ -150,6 +153,7 @@ import (
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type Client struct {
@@ -161,6 +165,9 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// DefaultTimeouts are the overrides for the default timeouts of resources from the Provider block
+	DefaultTimeouts timeouts.DefaultTimeouts
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -175,6 +182,7 @@ type Client struct {
 	Authorization                     *authorization.Client
 	Automanage                        *automanage.Client
 	Automation                        *automation.Client
+	AzureFleet                        *azurefleet.Client
 	AzureStackHCI                     *azurestackhci_v2024_01_01.Client
 	Batch                             *batch.Client
 	Blueprints                        *blueprints.Client
@@ -201,6 +209,7 @@ type Client struct {
 	DataProtection                    *dataprotection.Client
 	DataShare                         *datashare.Client
 	DesktopVirtualization             *desktopvirtualization.Client
+	DeviceRegistry                    *deviceregistry.Client
 	DevTestLabs                       *devtestlabs.Client
 	DigitalTwins                      *digitaltwins.Client
 	Dns                               *dns_v2018_05_01.Client
@@ -259,6 +268,7 @@ type Client struct {
 	PrivateDnsResolver                *dnsresolver.Client
 	Purview                           *purview.Client
 	Qumulo                            *qumulo.Client
+	Quota                             *quota.Client
 	RecoveryServices                  *recoveryServices.Client
 	RedHatOpenShift                   *redhatopenshift.Client
 	Redis                             *redis.Client
@@ -277,6 +287,7 @@ type Client struct {
 	Storage                           *storage.Client
 	StorageCache                      *storagecache_2024_07_01.Client
 	StorageCache_2023_05_01           *storagecache_2023_05_01.Client
+	StorageCache_2025_07_01           *storagecache_2025_07_01.Client
 	StorageMover                      *storageMover.Client
 	StreamAnalytics                   *streamAnalytics.Client
 	Subscription                      *subscription.Client
@@ -287,7 +298,7 @@ type Client struct {
 	Vmware                            *vmware.Client
 	VoiceServices                     *voiceServices.Client
 	Web                               *web.Client
-	Workloads                         *workloads_v2024_09_01.Client
+	Workloads                         *workloads.Client
 }
 
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
@@ -348,6 +359,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Automation, err = automation.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Automation: %+v", err)
 	}
+	if client.AzureFleet, err = azurefleet.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for AzureFleet: %+v", err)
+	}
 	if client.AzureStackHCI, err = azureStackHCI.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for AzureStackHCI: %+v", err)
 	}
@@ -426,6 +440,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.DesktopVirtualization, err = desktopvirtualization.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for DesktopVirtualization: %+v", err)
 	}
+	if client.DeviceRegistry, err = deviceregistry.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for DeviceRegistry: %+v", err)
+	}
 	if client.DevTestLabs, err = devtestlabs.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for DevTestLabs: %+v", err)
 	}
@@ -601,6 +618,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Qumulo, err = qumulo.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Qumulo: %+v", err)
 	}
+	if client.Quota, err = quota.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Quota: %+v", err)
+	}
 	if client.RedHatOpenShift, err = redhatopenshift.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for RedHatOpenShift: %+v", err)
 	}
@@ -652,6 +672,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.StorageCache_2023_05_01, err = storageCache.NewClient_2023_05_01(o); err != nil {
 		return fmt.Errorf("building clients for Storage Cache 2023-05-01: %+v", err)
 	}
+	if client.StorageCache_2025_07_01, err = storageCache.NewClient_2025_07_01(o); err != nil {
+		return fmt.Errorf("building clients for Storage Cache 2025-07-01: %+v", err)
+	}
 	if client.StorageMover, err = storageMover.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for StorageMover: %+v", err)
 	}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// EnvironmentOptions defines the sources from which the Cloud Environment can be determined,
+// in order of precedence: an Environment File, a Metadata Host and then a built-in Environment Name.
+type EnvironmentOptions struct {
+	// EnvironmentFilePath is the path to a file containing the response from the ARM Metadata Service,
+	// used in disconnected environments (such as Azure Stack Hub) where the Metadata Service isn't reachable
+	EnvironmentFilePath string
+
+	// MetadataHost is the hostname (or URL) of the ARM Metadata Service to discover the Environment from
+	MetadataHost string
+
+	// Name is the name of a built-in Environment, e.g. `public`, `usgovernment` or `china`
+	Name string
+}
+
+// NewEnvironment returns the Cloud Environment described by the specified options.
+func NewEnvironment(ctx context.Context, options EnvironmentOptions) (*environments.Environment, error) {
+	if options.EnvironmentFilePath != "" && options.MetadataHost != "" {
+		return nil, fmt.Errorf("only one of `environment_file` or `metadata_host` can be specified")
+	}
+
+	if options.EnvironmentFilePath != "" {
+		log.Printf("[DEBUG] Configuring cloud environment from the Environment File at %q", options.EnvironmentFilePath)
+		return EnvironmentFromFile(ctx, options.EnvironmentFilePath)
+	}
+
+	if options.MetadataHost != "" {
+		log.Printf("[DEBUG] Configuring cloud environment from Metadata Service at %q", options.MetadataHost)
+		env, err := environments.FromEndpoint(ctx, metadataEndpoint(options.MetadataHost))
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the Cloud Environment from the Metadata Host %q: %+v", options.MetadataHost, err)
+		}
+		return env, nil
+	}
+
+	log.Printf("[DEBUG] Configuring built-in cloud environment by name: %q", options.Name)
+	return environments.FromName(options.Name)
+}
+
+// metadataEndpoint returns the URI of the Metadata Service, since the `metadata_host` can be specified
+// either as a hostname (e.g. `management.local.azurestack.external`) or as a URL.
+func metadataEndpoint(host string) string {
+	host = strings.TrimSuffix(host, "/")
+	if strings.HasPrefix(host, "https://") || strings.HasPrefix(host, "http://") {
+		return host
+	}
+
+	return fmt.Sprintf("https://%s", host)
+}
+
+// EnvironmentFromFile returns the Cloud Environment described by a file containing the response from the
+// ARM Metadata Service (`/metadata/endpoints?api-version=2022-09-01`), which allows the Provider to be used
+// where the Metadata Service can't be reached at plan/apply time.
+func EnvironmentFromFile(ctx context.Context, path string) (*environments.Environment, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading the Environment File %q: %+v", path, err)
+	}
+
+	contents = bytes.TrimPrefix(contents, []byte("\xef\xbb\xbf"))
+	if !json.Valid(contents) {
+		return nil, fmt.Errorf("the Environment File %q doesn't contain valid JSON", path)
+	}
+
+	// go-azure-sdk only supports parsing the metadata from an endpoint, so rather than duplicating the mapping
+	// (which would then drift) the file is served from a short-lived listener bound to the loopback interface
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("serving the Environment File %q: %+v", path, err)
+	}
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/metadata/endpoints" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(contents)
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("[DEBUG] serving the Environment File %q: %+v", path, err)
+		}
+	}()
+	defer server.Close()
+
+	env, err := environments.FromEndpoint(ctx, fmt.Sprintf("http://%s", listener.Addr().String()))
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Environment File %q: %+v", path, err)
+	}
+
+	return env, nil
+}
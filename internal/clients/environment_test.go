@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testEnvironmentFile = `{
+  "portal": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://adfs.local.azurestack.external/adfs",
+    "audiences": ["https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000"],
+    "tenant": "adfs",
+    "identityProvider": "ADFS"
+  },
+  "graph": "https://graph.local.azurestack.external/",
+  "name": "AzureStack-User-00000000-0000-0000-0000-000000000000",
+  "suffixes": {
+    "keyVaultDns": "vault.local.azurestack.external",
+    "storage": "local.azurestack.external"
+  },
+  "resourceManager": "https://management.local.azurestack.external/",
+  "microsoftGraphResourceId": "https://graph.local.azurestack.external/"
+}`
+
+func TestEnvironmentFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "environment.json")
+	if err := os.WriteFile(path, []byte(testEnvironmentFile), 0o600); err != nil {
+		t.Fatalf("writing environment file: %+v", err)
+	}
+
+	env, err := NewEnvironment(context.Background(), EnvironmentOptions{
+		EnvironmentFilePath: path,
+	})
+	if err != nil {
+		t.Fatalf("loading environment: %+v", err)
+	}
+
+	if env.Name != "AzureStack-User-00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("expected the environment name to be populated from the file, got %q", env.Name)
+	}
+
+	endpoint, ok := env.ResourceManager.Endpoint()
+	if !ok || *endpoint != "https://management.local.azurestack.external" {
+		t.Fatalf("expected the resource manager endpoint to be populated from the file, got %v", endpoint)
+	}
+
+	if !env.IsAzureStack() {
+		t.Fatalf("expected the environment to be detected as Azure Stack")
+	}
+}
+
+func TestEnvironmentFromFile_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "environment.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o600); err != nil {
+		t.Fatalf("writing environment file: %+v", err)
+	}
+
+	if _, err := EnvironmentFromFile(context.Background(), path); err == nil {
+		t.Fatalf("expected an error for an invalid environment file")
+	}
+}
+
+func TestNewEnvironment_Conflicts(t *testing.T) {
+	_, err := NewEnvironment(context.Background(), EnvironmentOptions{
+		EnvironmentFilePath: "environment.json",
+		MetadataHost:        "management.local.azurestack.external",
+	})
+	if err == nil {
+		t.Fatalf("expected an error when both an environment file and metadata host are specified")
+	}
+}
+
+func TestMetadataEndpoint(t *testing.T) {
+	cases := map[string]string{
+		"management.azure.com":                   "https://management.azure.com",
+		"https://management.azure.com/":          "https://management.azure.com",
+		"http://management.local.azurestack.com": "http://management.local.azurestack.com",
+	}
+	for input, expected := range cases {
+		if actual := metadataEndpoint(input); actual != expected {
+			t.Fatalf("expected %q for %q, got %q", expected, input, actual)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deletionprotection
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// LockName is the name of the CanNotDelete Management Lock which is created as a child of the protected resource
+const LockName = "terraform-deletion-protection"
+
+func Schema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// IsEnabled returns whether the Deletion Protection lock exists for the resource with the specified ID. The
+// returned bool `forbidden` is true when the caller doesn't have permission to read Management Locks, in which case
+// the caller decides how this should be handled
+func IsEnabled(ctx context.Context, client *managementlocks.ManagementLocksClient, resourceId string) (enabled bool, forbidden bool, err error) {
+	id := managementlocks.NewScopedLockID(resourceId, LockName)
+	resp, err := client.GetByScope(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, false, nil
+		}
+		return false, response.WasForbidden(resp.HttpResponse), fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return resp.Model != nil && resp.Model.Properties.Level == managementlocks.LockLevelCanNotDelete, false, nil
+}
+
+// Set creates or removes the Deletion Protection lock for the resource with the specified ID
+func Set(ctx context.Context, client *managementlocks.ManagementLocksClient, resourceId string, enabled bool) error {
+	id := managementlocks.NewScopedLockID(resourceId, LockName)
+
+	if !enabled {
+		if resp, err := client.DeleteByScope(ctx, id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("removing Deletion Protection %s: %+v", id, err)
+		}
+
+		return nil
+	}
+
+	payload := managementlocks.ManagementLockObject{
+		Properties: managementlocks.ManagementLockProperties{
+			Level: managementlocks.LockLevelCanNotDelete,
+			Notes: pointer.To("Managed by Terraform via `deletion_protection_enabled`"),
+		},
+	}
+	if _, err := client.CreateOrUpdateByScope(ctx, id, payload); err != nil {
+		return fmt.Errorf("enabling Deletion Protection %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// FlattenAndSet sets `deletion_protection_enabled` based on the presence of the lock. So that refreshing these
+// resources doesn't require permission to read Management Locks, the lock is only looked up when Deletion Protection
+// is enabled in the state - when importing the lock is looked up by Import instead.
+//
+// Should the caller not have permission to read the lock the value in the state is retained rather than failing the
+// refresh, since the lock is more likely to still exist than not.
+func FlattenAndSet(ctx context.Context, d *pluginsdk.ResourceData, client *managementlocks.ManagementLocksClient, resourceId string) error {
+	if !d.Get("deletion_protection_enabled").(bool) {
+		return d.Set("deletion_protection_enabled", false)
+	}
+
+	enabled, forbidden, err := IsEnabled(ctx, client, resourceId)
+	if err != nil {
+		if forbidden {
+			log.Printf("[WARN] insufficient permissions to retrieve the Deletion Protection lock for %s - retaining `deletion_protection_enabled` from the state: %+v", resourceId, err)
+			return nil
+		}
+		return err
+	}
+
+	return d.Set("deletion_protection_enabled", enabled)
+}
+
+// Import is an ImporterFunc which looks up the lock for the resource being imported, since there's no prior state for
+// FlattenAndSet to use - a caller without permission to read Management Locks is treated as the lock not existing
+func Import(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	enabled, forbidden, err := IsEnabled(ctx, meta.(*clients.Client).Resource.LocksClient, d.Id())
+	if err != nil {
+		if !forbidden {
+			return []*pluginsdk.ResourceData{d}, err
+		}
+		log.Printf("[DEBUG] insufficient permissions to retrieve the Deletion Protection lock for %s - assuming it's not enabled: %+v", d.Id(), err)
+	}
+
+	if err := d.Set("deletion_protection_enabled", enabled); err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("setting `deletion_protection_enabled`: %+v", err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// EnsureDeletable returns an error when Deletion Protection is enabled for the resource being destroyed
+func EnsureDeletable(d *pluginsdk.ResourceData, resourceId string) error {
+	if d.Get("deletion_protection_enabled").(bool) {
+		return fmt.Errorf("cannot delete %s since `deletion_protection_enabled` is set to `true` - this must be set to `false` and applied before the resource can be deleted", resourceId)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deletionprotection
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"golang.org/x/oauth2"
+)
+
+const testResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1"
+
+type testAuthorizer struct{}
+
+func (testAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: "token", TokenType: "Bearer"}, nil
+}
+
+func (testAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+// testForbiddenLocksClient returns a Management Locks client whose requests are all rejected with a 403, and the
+// number of requests which have been made
+func testForbiddenLocksClient(t *testing.T) (*managementlocks.ManagementLocksClient, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": "AuthorizationFailed", "message": "does not have authorization to perform action 'Microsoft.Authorization/locks/read'"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := managementlocks.NewManagementLocksClientWithBaseURI(environments.NewApiEndpoint("ResourceManager", server.URL, nil))
+	if err != nil {
+		t.Fatalf("building the client: %+v", err)
+	}
+	client.Client.SetAuthorizer(testAuthorizer{})
+
+	return client, &requests
+}
+
+func testResourceData(t *testing.T, raw map[string]interface{}) *pluginsdk.ResourceData {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"deletion_protection_enabled": Schema(),
+	}, raw)
+	d.SetId(testResourceId)
+	return d
+}
+
+func TestFlattenAndSet_Forbidden(t *testing.T) {
+	testData := []struct {
+		name             string
+		enabled          bool
+		expectedRequests int
+	}{
+		{
+			// the lock isn't looked up, so permission to read it isn't required
+			name:             "disabled",
+			enabled:          false,
+			expectedRequests: 0,
+		},
+		{
+			// the lock is looked up, and the value in the state is retained
+			name:             "enabled",
+			enabled:          true,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			client, requests := testForbiddenLocksClient(t)
+			d := testResourceData(t, map[string]interface{}{
+				"deletion_protection_enabled": v.enabled,
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			if err := FlattenAndSet(ctx, d, client, testResourceId); err != nil {
+				t.Fatalf("expected a 403 not to fail the read but got: %+v", err)
+			}
+
+			if actual := d.Get("deletion_protection_enabled").(bool); actual != v.enabled {
+				t.Fatalf("expected `deletion_protection_enabled` to be %t but got %t", v.enabled, actual)
+			}
+			if *requests != v.expectedRequests {
+				t.Fatalf("expected %d requests but got %d", v.expectedRequests, *requests)
+			}
+		})
+	}
+}
+
+func TestImport_Forbidden(t *testing.T) {
+	client, requests := testForbiddenLocksClient(t)
+	meta := &clients.Client{
+		Resource: &resourceClient.Client{
+			LocksClient: client,
+		},
+	}
+	d := testResourceData(t, map[string]interface{}{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := Import(ctx, d, meta); err != nil {
+		t.Fatalf("expected a 403 not to fail the import but got: %+v", err)
+	}
+
+	if d.Get("deletion_protection_enabled").(bool) {
+		t.Fatalf("expected `deletion_protection_enabled` to be false")
+	}
+	if *requests != 1 {
+		t.Fatalf("expected 1 request but got %d", *requests)
+	}
+}
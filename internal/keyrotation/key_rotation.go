@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyrotation
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// The key types are shared by the `RegenerateAccessKeyParameters` of the Event Hub, Relay and Service Bus APIs
+const (
+	KeyTypePrimary   = "PrimaryKey"
+	KeyTypeSecondary = "SecondaryKey"
+)
+
+// TriggerSchema returns the schema for `rotation_trigger`, an arbitrary map of values which regenerates the key
+// specified in `regenerate_key` whenever it changes
+func TriggerSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+// KeyTypeSchema returns the schema for `regenerate_key`, the key which is regenerated when `rotation_trigger` changes.
+// This intentionally has no Default so that imported resources match those which were created - an empty value means
+// the primary key is regenerated
+func KeyTypeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			KeyTypePrimary,
+			KeyTypeSecondary,
+		}, false),
+	}
+}
+
+// RegenerateFunc regenerates the key of the specified type, which is one of KeyTypePrimary or KeyTypeSecondary
+type RegenerateFunc func(keyType string) error
+
+// RegenerateIfTriggered calls regenerate with the value of `regenerate_key` when `rotation_trigger` has changed for an
+// existing resource - new resources already have freshly generated keys so nothing is regenerated during creation
+func RegenerateIfTriggered(d *pluginsdk.ResourceData, regenerate RegenerateFunc) error {
+	if d.IsNewResource() || !d.HasChange("rotation_trigger") {
+		return nil
+	}
+
+	keyType := d.Get("regenerate_key").(string)
+	if keyType == "" {
+		keyType = KeyTypePrimary
+	}
+
+	return regenerate(keyType)
+}
+
+// SetNewComputedIfTriggered marks the attributes derived from the key which will be regenerated as unknown when
+// `rotation_trigger` has changed, so that references to them are updated in the same apply. The suffixes are the
+// attribute names without the `primary_`/`secondary_` prefix, e.g. `key` and `connection_string`
+func SetNewComputedIfTriggered(d *pluginsdk.ResourceDiff, suffixes ...string) error {
+	if d.Id() == "" || !d.HasChange("rotation_trigger") {
+		return nil
+	}
+
+	prefix := "primary"
+	if d.Get("regenerate_key").(string) == KeyTypeSecondary {
+		prefix = "secondary"
+	}
+
+	for _, suffix := range suffixes {
+		if err := d.SetNewComputed(fmt.Sprintf("%s_%s", prefix, suffix)); err != nil {
+			return fmt.Errorf("setting `%s_%s` to computed: %+v", prefix, suffix, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package propagation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	statePropagating = "Propagating"
+	statePropagated  = "Propagated"
+)
+
+// Wait is the configuration of the `propagation_wait` block
+type Wait struct {
+	// Timeout is the upper bound on the time spent waiting for the change to propagate
+	Timeout time.Duration
+
+	// Interval is the time between each verification call
+	Interval time.Duration
+
+	// ConsecutiveSuccesses is the number of verification calls which must succeed in a row
+	ConsecutiveSuccesses int
+}
+
+// CheckFunc performs a verification call, returning true once the change is visible. Errors which indicate the change
+// hasn't propagated yet (such as a 403 or 404) should return false rather than an error, which stops the wait.
+type CheckFunc func(ctx context.Context) (bool, error)
+
+func Schema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"timeout": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "5m",
+					ValidateFunc: validateDuration,
+				},
+
+				"interval": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "10s",
+					ValidateFunc: validateDuration,
+				},
+
+				"consecutive_successes": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      3,
+					ValidateFunc: validation.IntBetween(1, 20),
+				},
+			},
+		},
+	}
+}
+
+// Expand returns the configuration of the `propagation_wait` block, or nil when it isn't specified
+func Expand(input []interface{}) (*Wait, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	timeout, err := time.ParseDuration(raw["timeout"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `timeout` within the `propagation_wait` block: %+v", err)
+	}
+
+	interval, err := time.ParseDuration(raw["interval"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `interval` within the `propagation_wait` block: %+v", err)
+	}
+
+	return &Wait{
+		Timeout:              timeout,
+		Interval:             interval,
+		ConsecutiveSuccesses: raw["consecutive_successes"].(int),
+	}, nil
+}
+
+// WaitFor polls the verification call until it has succeeded the configured number of times in a row. Since the
+// resource itself has already been provisioned, reaching the timeout is logged rather than returned as an error.
+func WaitFor(ctx context.Context, config *Wait, description string, check CheckFunc) error {
+	if config == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting up to %s for %s to propagate..", config.Timeout, description)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{statePropagating},
+		Target:  []string{statePropagated},
+		Refresh: func() (interface{}, string, error) {
+			propagated, err := check(ctx)
+			if err != nil {
+				return nil, "", err
+			}
+			if !propagated {
+				return statePropagating, statePropagating, nil
+			}
+			return statePropagated, statePropagated, nil
+		},
+		PollInterval:              config.Interval,
+		ContinuousTargetOccurence: config.ConsecutiveSuccesses,
+		Timeout:                   config.Timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if _, ok := err.(*retry.TimeoutError); ok {
+			log.Printf("[WARN] %s hadn't propagated after %s, continuing", description, config.Timeout)
+			return nil
+		}
+
+		return fmt.Errorf("waiting for %s to propagate: %+v", description, err)
+	}
+
+	return nil
+}
+
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	value, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q cannot be parsed as a duration: %+v", k, err)}
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return warnings, errors
+}
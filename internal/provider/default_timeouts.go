@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func schemaDefaultTimeouts() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Description: "Overrides the default timeouts of resources. A `timeouts` block within a resource takes precedence.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_types": {
+					Type:        pluginsdk.TypeList,
+					Optional:    true,
+					Description: "The resource types these timeouts apply to. When omitted, the timeouts apply to all resources.",
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^azurerm_[a-z0-9_]+$`), "must be the name of an AzureRM resource type, for example `azurerm_kubernetes_cluster`"),
+					},
+				},
+
+				"create": {
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+					Description: "The default timeout used when creating a resource, for example `2h`.",
+				},
+
+				"read": {
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+					Description: "The default timeout used when reading a resource or data source, for example `10m`.",
+				},
+
+				"update": {
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+					Description: "The default timeout used when updating a resource, for example `2h`.",
+				},
+
+				"delete": {
+					Type:        pluginsdk.TypeString,
+					Optional:    true,
+					Description: "The default timeout used when deleting a resource, for example `2h`.",
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTimeouts(input []interface{}) (*timeouts.DefaultTimeouts, error) {
+	config := make([]timeouts.DefaultTimeoutsConfig, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		block := timeouts.DefaultTimeoutsConfig{
+			Create: raw["create"].(string),
+			Read:   raw["read"].(string),
+			Update: raw["update"].(string),
+			Delete: raw["delete"].(string),
+		}
+		for _, resourceType := range raw["resource_types"].([]interface{}) {
+			block.ResourceTypes = append(block.ResourceTypes, resourceType.(string))
+		}
+
+		config = append(config, block)
+	}
+
+	return timeouts.ExpandDefaultTimeouts(config)
+}
+
+// withDefaultTimeouts wraps the functions of a Plugin SDK resource or data source so that the overrides from the
+// `default_timeouts` blocks are resolved from the client handling each request. The schema is shared by every
+// provider configuration within this process, so the overrides can't be stored on the resource itself.
+func withDefaultTimeouts(resourceType string, resource *schema.Resource) {
+	if resource.Timeouts == nil {
+		return
+	}
+	resourceTimeouts := *resource.Timeouts
+
+	withClient := func(meta interface{}) interface{} {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil {
+			return meta
+		}
+
+		overrides := client.DefaultTimeouts.For(resourceType)
+		if overrides == (timeouts.Overrides{}) {
+			return meta
+		}
+
+		c := *client
+		c.StopContext = timeouts.WithDefaults(client.StopContext, overrides, resourceTimeouts)
+		return &c
+	}
+
+	// the typed resources use the context from the Plugin SDK, which has already been given the resource's default
+	// timeout as its deadline - so when an override is configured the timeout is determined the same way as for the
+	// untyped resources, and applied to the context from the Plugin SDK without that deadline
+	withContext := func(in func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, determine func(context.Context, *schema.ResourceData) (context.Context, context.CancelFunc)) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta = withClient(meta)
+			if client, ok := meta.(*clients.Client); ok && client != nil && timeouts.HasDefaults(client.StopContext) {
+				parent, stop := withoutDeadline(ctx, client.StopContext)
+				defer stop()
+
+				var cancel context.CancelFunc
+				ctx, cancel = determine(timeouts.WithDefaults(parent, client.DefaultTimeouts.For(resourceType), resourceTimeouts), d)
+				defer cancel()
+			}
+			return in(ctx, d, meta)
+		}
+	}
+
+	// the untyped resources still use the functions without a context
+	if f := resource.Create; f != nil { //nolint:staticcheck
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return f(d, withClient(meta))
+		}
+	}
+	if f := resource.Read; f != nil { //nolint:staticcheck
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return f(d, withClient(meta))
+		}
+	}
+	if f := resource.Update; f != nil { //nolint:staticcheck
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return f(d, withClient(meta))
+		}
+	}
+	if f := resource.Delete; f != nil { //nolint:staticcheck
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return f(d, withClient(meta))
+		}
+	}
+
+	if f := resource.CreateContext; f != nil {
+		resource.CreateContext = withContext(f, timeouts.ForCreate)
+	}
+	if f := resource.ReadContext; f != nil {
+		resource.ReadContext = withContext(f, timeouts.ForRead)
+	}
+	if f := resource.UpdateContext; f != nil {
+		resource.UpdateContext = withContext(f, timeouts.ForUpdate)
+	}
+	if f := resource.DeleteContext; f != nil {
+		resource.DeleteContext = withContext(f, timeouts.ForDelete)
+	}
+}
+
+// withoutDeadline returns a copy of the context without its deadline. This is cancelled when the context is cancelled
+// for any other reason, or when the Provider is stopped - which still applies once the deadline has been exceeded.
+func withoutDeadline(ctx context.Context, stopContext context.Context) (context.Context, context.CancelFunc) {
+	out, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stopParent := context.AfterFunc(ctx, func() {
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			cancel()
+		}
+	})
+	stopProvider := context.AfterFunc(stopContext, cancel)
+
+	return out, func() {
+		stopParent()
+		stopProvider()
+		cancel()
+	}
+}
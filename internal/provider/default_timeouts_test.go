@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       []interface{}
+		ExpectError bool
+	}{
+		{
+			Name:  "Empty Block",
+			Input: []interface{}{},
+		},
+		{
+			Name: "All Resources",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_types": []interface{}{},
+					"create":         "2h",
+					"read":           "",
+					"update":         "",
+					"delete":         "",
+				},
+			},
+		},
+		{
+			Name: "Invalid Duration",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_types": []interface{}{},
+					"create":         "two hours",
+					"read":           "",
+					"update":         "",
+					"delete":         "",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Zero Duration",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_types": []interface{}{},
+					"create":         "0s",
+					"read":           "",
+					"update":         "",
+					"delete":         "",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Multiple Blocks For All Resources",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_types": []interface{}{},
+					"create":         "2h",
+					"read":           "",
+					"update":         "",
+					"delete":         "",
+				},
+				map[string]interface{}{
+					"resource_types": []interface{}{},
+					"create":         "",
+					"read":           "",
+					"update":         "",
+					"delete":         "3h",
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Resource Type In Multiple Blocks",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_types": []interface{}{"azurerm_kubernetes_cluster"},
+					"create":         "2h",
+					"read":           "",
+					"update":         "",
+					"delete":         "",
+				},
+				map[string]interface{}{
+					"resource_types": []interface{}{"azurerm_kubernetes_cluster", "azurerm_resource_group"},
+					"create":         "",
+					"read":           "",
+					"update":         "",
+					"delete":         "3h",
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		_, err := expandDefaultTimeouts(testCase.Input)
+		if testCase.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !testCase.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestWithDefaultTimeouts(t *testing.T) {
+	defaultTimeouts, err := expandDefaultTimeouts([]interface{}{
+		map[string]interface{}{
+			"resource_types": []interface{}{},
+			"create":         "2h",
+			"read":           "10m",
+			"update":         "",
+			"delete":         "",
+		},
+		map[string]interface{}{
+			"resource_types": []interface{}{"azurerm_resource_group"},
+			"create":         "3h",
+			"read":           "",
+			"update":         "2h",
+			"delete":         "",
+		},
+	})
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	var actual time.Duration
+	newResource := func() *pluginsdk.Resource {
+		return &pluginsdk.Resource{
+			Create: func(d *pluginsdk.ResourceData, meta interface{}) error {
+				ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+				defer cancel()
+
+				deadline, _ := ctx.Deadline()
+				actual = time.Until(deadline).Round(time.Minute)
+				return nil
+			},
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+			},
+			Timeouts: &pluginsdk.ResourceTimeout{
+				Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+				Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+				Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+			},
+		}
+	}
+
+	testData := []struct {
+		Name             string
+		ResourceType     string
+		DefaultTimeouts  *timeouts.DefaultTimeouts
+		ConfiguredCreate string
+		Expected         time.Duration
+	}{
+		{
+			Name:         "No Default Timeouts",
+			ResourceType: "azurerm_virtual_network",
+			Expected:     30 * time.Minute,
+		},
+		{
+			Name:            "All Resources",
+			ResourceType:    "azurerm_virtual_network",
+			DefaultTimeouts: defaultTimeouts,
+			Expected:        2 * time.Hour,
+		},
+		{
+			Name:            "Resource Type",
+			ResourceType:    "azurerm_resource_group",
+			DefaultTimeouts: defaultTimeouts,
+			Expected:        3 * time.Hour,
+		},
+		{
+			Name:             "Timeouts Block Takes Precedence",
+			ResourceType:     "azurerm_resource_group",
+			DefaultTimeouts:  defaultTimeouts,
+			ConfiguredCreate: "45m",
+			Expected:         45 * time.Minute,
+		},
+		{
+			Name:             "Timeouts Block Equal To The Resource Default Takes Precedence",
+			ResourceType:     "azurerm_resource_group",
+			DefaultTimeouts:  defaultTimeouts,
+			ConfiguredCreate: "30m",
+			Expected:         30 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		resource := newResource()
+		withDefaultTimeouts(testCase.ResourceType, resource)
+
+		// the timeouts are resolved when the resource is planned, which falls back to the resource's defaults
+		planned := *resource.Timeouts
+		timeoutsBlock := cty.NullVal(cty.Object(map[string]cty.Type{
+			"create": cty.String,
+			"read":   cty.String,
+			"delete": cty.String,
+		}))
+		if testCase.ConfiguredCreate != "" {
+			create, err := time.ParseDuration(testCase.ConfiguredCreate)
+			if err != nil {
+				t.Fatalf("parsing: %+v", err)
+			}
+			planned.Create = pluginsdk.DefaultTimeout(create)
+			timeoutsBlock = cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal(testCase.ConfiguredCreate),
+				"read":   cty.NullVal(cty.String),
+				"delete": cty.NullVal(cty.String),
+			})
+		}
+		diff := &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"name": {
+					New: "example",
+				},
+			},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"id":       cty.NullVal(cty.String),
+				"name":     cty.StringVal("example"),
+				"timeouts": timeoutsBlock,
+			}),
+		}
+		if err := planned.DiffEncode(diff); err != nil {
+			t.Fatalf("encoding timeouts: %+v", err)
+		}
+
+		client := &clients.Client{
+			StopContext: context.Background(),
+		}
+		if testCase.DefaultTimeouts != nil {
+			client.DefaultTimeouts = *testCase.DefaultTimeouts
+		}
+
+		if _, diags := resource.Apply(context.Background(), nil, diff, client); diags.HasError() {
+			t.Fatalf("applying: %+v", diags)
+		}
+		if actual != testCase.Expected {
+			t.Fatalf("expected the create timeout to be %s but got %s", testCase.Expected, actual)
+		}
+
+		// the schema is shared by every provider configuration, so the overrides mustn't be stored on it
+		if *resource.Timeouts.Create != 30*time.Minute {
+			t.Fatalf("expected the default create timeout of the resource to be unchanged but got %s", *resource.Timeouts.Create)
+		}
+	}
+}
+
+func TestWithoutDeadline(t *testing.T) {
+	t.Run("Cancelled", func(t *testing.T) {
+		parent, cancelParent := context.WithCancel(context.Background())
+		withDeadline, cancelDeadline := context.WithTimeout(parent, time.Hour)
+		defer cancelDeadline()
+
+		ctx, stop := withoutDeadline(withDeadline, context.Background())
+		defer stop()
+
+		if _, ok := ctx.Deadline(); ok {
+			t.Fatalf("expected the context to have no deadline")
+		}
+
+		cancelParent()
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("expected the context to be cancelled alongside the parent context")
+		}
+	})
+
+	t.Run("Deadline Exceeded", func(t *testing.T) {
+		stopContext, stopProvider := context.WithCancel(context.Background())
+		withDeadline, cancelDeadline := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancelDeadline()
+
+		ctx, stop := withoutDeadline(withDeadline, stopContext)
+		defer stop()
+
+		<-withDeadline.Done()
+		time.Sleep(10 * time.Millisecond)
+		if err := ctx.Err(); err != nil {
+			t.Fatalf("expected the context not to be cancelled when the deadline is exceeded but got: %+v", err)
+		}
+
+		stopProvider()
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("expected the context to be cancelled when the Provider is stopped")
+		}
+	})
+}
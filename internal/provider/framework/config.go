@@ -10,12 +10,12 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
-	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type ProviderConfig struct {
@@ -25,29 +25,20 @@ type ProviderConfig struct {
 
 // Load handles the heavy lifting of configuring the provider and handling defaults
 func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersion string, diags *diag.Diagnostics) {
-	env := &environments.Environment{}
-	var err error
-
 	subscriptionId := getEnvStringOrDefault(data.SubscriptionId, "ARM_SUBSCRIPTION_ID", "")
 	if subscriptionId == "" {
 		diags.Append(diag.NewErrorDiagnostic("Configuring subscription", "`subscription_id` is a required provider property when performing a plan/apply operation"))
 		return
 	}
 
-	if metadataHost := getEnvStringOrDefault(data.MetaDataHost, "ARM_METADATA_HOSTNAME", ""); metadataHost != "" {
-		env, err = environments.FromEndpoint(ctx, metadataHost)
-		if err != nil {
-			diags.Append(diag.NewErrorDiagnostic("Configuring metadata host", err.Error()))
-			return
-		}
-	} else {
-		if v := getEnvStringOrDefault(data.Environment, "ARM_ENVIRONMENT", "public"); v != "" {
-			env, err = environments.FromName(v)
-			if err != nil {
-				diags.Append(diag.NewErrorDiagnostic("Configuring metadata host", err.Error()))
-				return
-			}
-		}
+	env, err := clients.NewEnvironment(ctx, clients.EnvironmentOptions{
+		EnvironmentFilePath: getEnvStringOrDefault(data.EnvironmentFile, "ARM_ENVIRONMENT_FILE", ""),
+		MetadataHost:        getEnvStringOrDefault(data.MetaDataHost, "ARM_METADATA_HOSTNAME", ""),
+		Name:                getEnvStringOrDefault(data.Environment, "ARM_ENVIRONMENT", "public"),
+	})
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Configuring cloud environment", err.Error()))
+		return
 	}
 
 	var clientCertificateData []byte
@@ -115,6 +106,13 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		EnableAuthenticatingUsingManagedIdentity:   getEnvBoolOrDefault(data.UseMSI, "ARM_USE_MSI", false),
 	}
 
+	authOptions, err := getAuthOptions(data)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("configuring authentication", err.Error()))
+		return
+	}
+	p.clientBuilder.AuthOptions = *authOptions
+
 	p.clientBuilder.SubscriptionID = getEnvStringIfValueAbsent(data.SubscriptionId, "ARM_SUBSCRIPTION_ID")
 
 	partnerId := getEnvStringIfValueAbsent(data.PartnerId, "ARM_PARTNER_ID")
@@ -157,6 +155,38 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		}
 	}
 
+	if !data.DefaultTimeouts.IsNull() && !data.DefaultTimeouts.IsUnknown() {
+		var blocks []DefaultTimeoutsModel
+		diags.Append(data.DefaultTimeouts.ElementsAs(ctx, &blocks, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		config := make([]timeouts.DefaultTimeoutsConfig, 0)
+		for _, block := range blocks {
+			item := timeouts.DefaultTimeoutsConfig{
+				Create: block.Create.ValueString(),
+				Read:   block.Read.ValueString(),
+				Update: block.Update.ValueString(),
+				Delete: block.Delete.ValueString(),
+			}
+			if !block.ResourceTypes.IsNull() && !block.ResourceTypes.IsUnknown() {
+				diags.Append(block.ResourceTypes.ElementsAs(ctx, &item.ResourceTypes, true)...)
+				if diags.HasError() {
+					return
+				}
+			}
+			config = append(config, item)
+		}
+
+		defaultTimeouts, err := timeouts.ExpandDefaultTimeouts(config)
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic("expanding `default_timeouts`", err.Error()))
+			return
+		}
+		p.clientBuilder.DefaultTimeouts = *defaultTimeouts
+	}
+
 	f := providerfeatures.UserFeatures{}
 
 	// features is required, but we'll play safe here
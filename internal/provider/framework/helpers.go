@@ -8,9 +8,11 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 )
 
 func decodeCertificate(clientCertificate string) ([]byte, error) {
@@ -84,6 +86,37 @@ func getOidcToken(d *ProviderModel) (*string, error) {
 	return &idToken, nil
 }
 
+// getAuthOptions returns the authentication settings which are handled within the Provider rather than by go-azure-sdk
+func getAuthOptions(d *ProviderModel) (*clients.AuthOptions, error) {
+	options := clients.AuthOptions{
+		OIDCTokenFilePath:         getEnvStringOrDefault(d.OIDCTokenFilePath, "ARM_OIDC_TOKEN_FILE_PATH", ""),
+		ManagedIdentityResourceID: getEnvStringOrDefault(d.MSIResourceID, "ARM_MSI_RESOURCE_ID", ""),
+	}
+
+	if options.OIDCTokenFilePath == "" && getEnvBoolIfValueAbsent(d.UseAKSWorkloadIdentity, "ARM_USE_AKS_WORKLOAD_IDENTITY") {
+		options.OIDCTokenFilePath = os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	}
+
+	maxRetriesSpecified := false
+	if !d.MSIMaxRetries.IsNull() && !d.MSIMaxRetries.IsUnknown() {
+		options.ManagedIdentityMaxRetries = int(d.MSIMaxRetries.ValueInt64())
+		maxRetriesSpecified = true
+	} else if v := os.Getenv("ARM_MSI_MAX_RETRIES"); v != "" {
+		retries, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `ARM_MSI_MAX_RETRIES` %q as an integer: %+v", v, err)
+		}
+		options.ManagedIdentityMaxRetries = retries
+		maxRetriesSpecified = true
+	}
+
+	if maxRetriesSpecified && (options.ManagedIdentityMaxRetries < 1 || options.ManagedIdentityMaxRetries > 50) {
+		return nil, fmt.Errorf("`msi_max_retries` must be between 1 and 50, got %d", options.ManagedIdentityMaxRetries)
+	}
+
+	return &options, nil
+}
+
 func getClientId(d *ProviderModel) (*string, error) {
 	clientId := getEnvStringOrDefault(d.ClientId, "ARM_CLIENT_ID", "")
 
@@ -289,3 +289,75 @@ func Test_decodeCertificateExpectError(t *testing.T) {
 		t.Fatalf("did not get expected error, got '%v'", err)
 	}
 }
+
+func Test_getAuthOptionsMSIMaxRetries(t *testing.T) {
+	testData := []struct {
+		name     string
+		value    basetypes.Int64Value
+		env      string
+		expected int
+		error    bool
+	}{
+		{
+			name:     "not specified",
+			value:    basetypes.NewInt64Null(),
+			expected: 0,
+		},
+		{
+			name:     "specified",
+			value:    basetypes.NewInt64Value(10),
+			expected: 10,
+		},
+		{
+			name:     "specified from environment",
+			value:    basetypes.NewInt64Null(),
+			env:      "20",
+			expected: 20,
+		},
+		{
+			name:  "zero",
+			value: basetypes.NewInt64Value(0),
+			error: true,
+		},
+		{
+			name:  "zero from environment",
+			value: basetypes.NewInt64Null(),
+			env:   "0",
+			error: true,
+		},
+		{
+			name:  "too many",
+			value: basetypes.NewInt64Value(51),
+			error: true,
+		},
+		{
+			name:  "not an integer from environment",
+			value: basetypes.NewInt64Null(),
+			env:   "five",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			t.Setenv("ARM_MSI_MAX_RETRIES", v.env)
+
+			result, err := getAuthOptions(&ProviderModel{
+				MSIMaxRetries: v.value,
+			})
+			if v.error {
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getAuthOptions returned unexpected error %v", err)
+			}
+
+			if result.ManagedIdentityMaxRetries != v.expected {
+				t.Fatalf("expected `ManagedIdentityMaxRetries` to be %d but got %d", v.expected, result.ManagedIdentityMaxRetries)
+			}
+		})
+	}
+}
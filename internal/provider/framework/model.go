@@ -16,6 +16,7 @@ type ProviderModel struct {
 	AuxiliaryTenantIds             types.List   `tfsdk:"auxiliary_tenant_ids"`
 	Environment                    types.String `tfsdk:"environment"`
 	MetaDataHost                   types.String `tfsdk:"metadata_host"`
+	EnvironmentFile                types.String `tfsdk:"environment_file"`
 	ClientCertificate              types.String `tfsdk:"client_certificate"`
 	ClientCertificatePath          types.String `tfsdk:"client_certificate_path"`
 	ClientCertificatePassword      types.String `tfsdk:"client_certificate_password"`
@@ -30,12 +31,15 @@ type ProviderModel struct {
 	UseMSI                         types.Bool   `tfsdk:"use_msi"`
 	MSIEndpoint                    types.String `tfsdk:"msi_endpoint"`
 	MSIAPIVersion                  types.String `tfsdk:"msi_api_version"`
+	MSIResourceID                  types.String `tfsdk:"msi_resource_id"`
+	MSIMaxRetries                  types.Int64  `tfsdk:"msi_max_retries"`
 	UseCLI                         types.Bool   `tfsdk:"use_cli"`
 	UseAKSWorkloadIdentity         types.Bool   `tfsdk:"use_aks_workload_identity"`
 	PartnerId                      types.String `tfsdk:"partner_id"`
 	DisableCorrelationRequestId    types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
+	DefaultTimeouts                types.List   `tfsdk:"default_timeouts"`
 	EnhancedValidation             types.List   `tfsdk:"enhanced_validation"`
 	Features                       types.List   `tfsdk:"features"`
 	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
@@ -283,6 +287,14 @@ var DatabricksWorkspaceAttributes = map[string]attr.Type{
 	"force_delete": types.BoolType,
 }
 
+type DefaultTimeoutsModel struct {
+	ResourceTypes types.List   `tfsdk:"resource_types"`
+	Create        types.String `tfsdk:"create"`
+	Read          types.String `tfsdk:"read"`
+	Update        types.String `tfsdk:"update"`
+	Delete        types.String `tfsdk:"delete"`
+}
+
 type EnhancedValidationModel struct {
 	Locations         types.Bool `tfsdk:"locations"`
 	ResourceProviders types.Bool `tfsdk:"resource_providers"`
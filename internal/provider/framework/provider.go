@@ -96,6 +96,11 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "The Hostname which should be used for the Azure Metadata Service.",
 			},
 
+			"environment_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a file containing the response from the Azure Metadata Service, used to define the Cloud Environment where the Metadata Service isn't reachable. Conflicts with `metadata_host`.",
+			},
+
 			// Client Certificate specific fields
 			"client_certificate": schema.StringAttribute{
 				Optional:    true,
@@ -170,6 +175,16 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "The API version to use for Managed Service Identity (IMDS) - for cases where the default API version is not supported by the endpoint. e.g. for Azure Container Apps.",
 			},
 
+			"msi_resource_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Resource ID of the User Assigned Identity to use for Managed Service Identity, as an alternative to specifying the `client_id`.",
+			},
+
+			"msi_max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of times a request to the Managed Service Identity endpoint should be retried, for cases where the endpoint is slow to become available. e.g. on Azure Arc-enabled Servers.",
+			},
+
 			// Azure CLI specific fields
 			"use_cli": schema.BoolAttribute{
 				Optional:    true,
@@ -239,6 +254,34 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Overrides the default timeouts of resources. A `timeouts` block within a resource takes precedence.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The resource types these timeouts apply to. When omitted, the timeouts apply to all resources.",
+						},
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when creating a resource, for example `2h`.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when reading a resource or data source, for example `10m`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when updating a resource, for example `2h`.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when deleting a resource, for example `2h`.",
+						},
+					},
+				},
+			},
 			"enhanced_validation": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
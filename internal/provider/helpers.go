@@ -10,6 +10,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -76,6 +77,21 @@ func getOidcToken(d *pluginsdk.ResourceData) (*string, error) {
 	return &idToken, nil
 }
 
+// getAuthOptions returns the authentication settings which are handled within the Provider rather than by go-azure-sdk
+func getAuthOptions(d *pluginsdk.ResourceData) clients.AuthOptions {
+	options := clients.AuthOptions{
+		OIDCTokenFilePath:         d.Get("oidc_token_file_path").(string),
+		ManagedIdentityResourceID: d.Get("msi_resource_id").(string),
+		ManagedIdentityMaxRetries: d.Get("msi_max_retries").(int),
+	}
+
+	if options.OIDCTokenFilePath == "" && d.Get("use_aks_workload_identity").(bool) {
+		options.OIDCTokenFilePath = os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	}
+
+	return options
+}
+
 func getClientId(d *pluginsdk.ResourceData) (*string, error) {
 	clientId := strings.TrimSpace(d.Get("client_id").(string))
 
@@ -12,7 +12,6 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
-	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -145,6 +144,13 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 		}
 	}
 
+	for resourceType, dataSource := range dataSources {
+		withDefaultTimeouts(resourceType, dataSource)
+	}
+	for resourceType, resource := range resources {
+		withDefaultTimeouts(resourceType, resource)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -184,6 +190,13 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 				Description: "The Hostname which should be used for the Azure Metadata Service.",
 			},
 
+			"environment_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT_FILE", nil),
+				Description: "The path to a file containing the response from the Azure Metadata Service, used to define the Cloud Environment where the Metadata Service isn't reachable. Conflicts with `metadata_host`.",
+			},
+
 			"client_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -300,6 +313,21 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 				Description: "The API version to use for Managed Service Identity (IMDS) - for cases where the default API version is not supported by the endpoint. e.g. for Azure Container Apps.",
 			},
 
+			"msi_resource_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_RESOURCE_ID", nil),
+				Description: "The Resource ID of the User Assigned Identity to use for Managed Service Identity, as an alternative to specifying the `client_id`.",
+			},
+
+			"msi_max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MSI_MAX_RETRIES", nil),
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "The maximum number of times a request to the Managed Service Identity endpoint should be retried, for cases where the endpoint is slow to become available. e.g. on Azure Arc-enabled Servers.",
+			},
+
 			// Azure CLI specific fields
 			"use_cli": {
 				Type:        schema.TypeBool,
@@ -342,6 +370,8 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_timeouts": schemaDefaultTimeouts(),
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -471,23 +501,13 @@ func providerConfigure(p *schema.Provider, testName string) schema.ConfigureCont
 			return nil, diag.FromErr(err)
 		}
 
-		var (
-			env *environments.Environment
-
-			envName      = d.Get("environment").(string)
-			metadataHost = d.Get("metadata_host").(string)
-		)
-
-		if metadataHost != "" {
-			logEntry("[DEBUG] Configuring cloud environment from Metadata Service at %q", metadataHost)
-			if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
-				return nil, diag.FromErr(err)
-			}
-		} else {
-			logEntry("[DEBUG] Configuring built-in cloud environment by name: %q", envName)
-			if env, err = environments.FromName(envName); err != nil {
-				return nil, diag.FromErr(err)
-			}
+		env, err := clients.NewEnvironment(ctx, clients.EnvironmentOptions{
+			EnvironmentFilePath: d.Get("environment_file").(string),
+			MetadataHost:        d.Get("metadata_host").(string),
+			Name:                d.Get("environment").(string),
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
 		}
 
 		var (
@@ -583,8 +603,15 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	features.EnhancedValidation.Locations = enhancedValidationLocations
 	features.EnhancedValidation.ResourceProviders = enhancedValidationResourceProviders
 
+	defaultTimeouts, err := expandDefaultTimeouts(d.Get("default_timeouts").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
+		AuthOptions:                 getAuthOptions(d),
+		DefaultTimeouts:             *defaultTimeouts,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    features,
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automanage"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurefleet"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/billing"
@@ -47,6 +48,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/deviceregistry"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns"
@@ -107,6 +109,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/qumulo"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/quota"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redhatopenshift"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis"
@@ -153,6 +156,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		automanage.Registration{},
 		automation.Registration{},
 		advisor.Registration{},
+		azurefleet.Registration{},
 		azurestackhci.Registration{},
 		batch.Registration{},
 		bot.Registration{},
@@ -171,6 +175,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		datafactory.Registration{},
 		dataprotection.Registration{},
 		desktopvirtualization.Registration{},
+		deviceregistry.Registration{},
 		digitaltwins.Registration{},
 		dns.Registration{},
 		domainservices.Registration{},
@@ -187,6 +192,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		iothub.Registration{},
 		keyvault.Registration{},
 		kusto.Registration{},
+		lighthouse.Registration{},
 		loadbalancer.Registration{},
 		loadtestservice.Registration{},
 		loganalytics.Registration{},
@@ -212,7 +218,9 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		policy.Registration{},
 		postgres.Registration{},
 		privatednsresolver.Registration{},
+		purview.Registration{},
 		qumulo.Registration{},
+		quota.Registration{},
 		recoveryservices.Registration{},
 		redhatopenshift.Registration{},
 		redis.Registration{},
@@ -401,6 +409,7 @@ func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
 		datashare.Registration{},
 		desktopvirtualization.Registration{},
 		devcenter.Registration{},
+		deviceregistry.Registration{},
 		devtestlabs.Registration{},
 		digitaltwins.Registration{},
 		dns.Registration{},
@@ -448,6 +457,7 @@ func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
 		privatednsresolver.Registration{},
 		purview.Registration{},
 		qumulo.Registration{},
+		quota.Registration{},
 		recoveryservices.Registration{},
 		redhatopenshift.Registration{},
 		redis.Registration{},
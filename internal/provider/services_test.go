@@ -130,8 +130,9 @@ func validateResourceTypeName(resourceType string) error {
 		return fmt.Errorf("role assignment resources should be named `azurerm_{type}_role_assignment`")
 	}
 
-	// Role Definitions should be named `azurerm_{type}_role_definition` for consistency
-	if strings.Contains(resourceType, "role_definition") && !strings.HasSuffix(resourceType, "role_definition") {
+	// Role Definitions should be named `azurerm_{type}_role_definition` for consistency - with the exception of the
+	// `azurerm_role_definitions` Data Source, which lists Role Definitions
+	if strings.Contains(resourceType, "role_definition") && !strings.HasSuffix(resourceType, "role_definition") && resourceType != "azurerm_role_definitions" {
 		return fmt.Errorf("role assignment resources should be named `azurerm_{type}_role_definition`")
 	}
 
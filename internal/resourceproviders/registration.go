@@ -104,3 +104,14 @@ func registerWithSubscription(ctx context.Context, client *providers.ProvidersCl
 
 	return nil
 }
+
+// RegisterForSubscription registers the specified Resource Providers in the specified Subscription, waiting for each
+// of them to finish registering. Unlike EnsureRegistered this doesn't use the cache of the Subscription the Provider
+// is configured for, and so can be used for a Subscription which has just been created.
+func RegisterForSubscription(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providersToRegister []string) error {
+	if len(providersToRegister) == 0 {
+		return nil
+	}
+
+	return registerForSubscription(ctx, client, subscriptionId, providersToRegister)
+}
@@ -36,6 +36,7 @@ func (d *FrameworkDataSourceWrapper) Schema(ctx context.Context, request datasou
 
 func (d *FrameworkDataSourceWrapper) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
 	d.DefaultsDataSource(request, response)
+	d.applyDefaultTimeouts(d.FrameworkWrappedDataSource.ResourceType())
 
 	d.Model = d.ModelObject()
 	if _, ok := d.FrameworkWrappedDataSource.(FrameworkWrappedDataSourceWithConfigure); ok {
@@ -67,6 +67,28 @@ func (r *ResourceMetadata) Defaults(req resource.ConfigureRequest, resp *resourc
 	r.TimeoutDelete = 30 * time.Minute
 }
 
+// applyDefaultTimeouts overrides the default timeouts with those configured in the `default_timeouts` blocks within
+// the Provider block, a `timeouts` block within the resource still takes precedence over these
+func (r *ResourceMetadata) applyDefaultTimeouts(resourceType string) {
+	if r.Client == nil {
+		return
+	}
+
+	overrides := r.Client.DefaultTimeouts.For(resourceType)
+	if overrides.Create != nil {
+		r.TimeoutCreate = *overrides.Create
+	}
+	if overrides.Read != nil {
+		r.TimeoutRead = *overrides.Read
+	}
+	if overrides.Update != nil && r.TimeoutUpdate != nil {
+		r.TimeoutUpdate = pointer.To(*overrides.Update)
+	}
+	if overrides.Delete != nil {
+		r.TimeoutDelete = *overrides.Delete
+	}
+}
+
 // DefaultsDataSource configures the Resource Metadata for client access, Provider Features, and subscriptionId.
 func (r *ResourceMetadata) DefaultsDataSource(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
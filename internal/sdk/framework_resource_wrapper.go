@@ -203,6 +203,7 @@ func (r *FrameworkResourceWrapper) Delete(ctx context.Context, request resource.
 
 func (r *FrameworkResourceWrapper) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	r.Defaults(request, response)
+	r.applyDefaultTimeouts(r.FrameworkWrappedResource.ResourceType())
 
 	r.Model = r.ModelObject()
 	if f, ok := r.FrameworkWrappedResource.(FrameworkWrappedResourceWithConfigure); ok {
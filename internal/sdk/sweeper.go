@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// Sweeper is implemented by types which are able to find and remove resources leaked by
+// the Acceptance Tests, for example Resource Groups which failed to be destroyed.
+type Sweeper interface {
+	// Name is a human-readable name for this Sweeper, used in log output
+	Name() string
+
+	// Sweep removes any resources matching the specified filter, returning the IDs of the
+	// resources which were removed - or which would have been removed in Dry Run mode.
+	Sweep(ctx context.Context, client *clients.Client, filter SweepFilter) ([]string, error)
+}
+
+// ServiceRegistrationWithSweepers is an optional interface which can be implemented by both
+// Typed and Untyped Service Registrations to expose the Sweepers for that Service Package.
+type ServiceRegistrationWithSweepers interface {
+	// Sweepers returns a list of Sweepers supported by this Service
+	Sweepers() []Sweeper
+}
+
+// SweepFilter defines which resources are eligible to be removed by a Sweeper.
+type SweepFilter struct {
+	// Prefixes is a list of (case-insensitive) name prefixes, one of which the resource name must start with
+	Prefixes []string
+
+	// TagKey is the key of a Tag which must be present on the resource, when specified
+	TagKey string
+
+	// TagValue is the value which the Tag specified in TagKey must have, when specified
+	TagValue string
+
+	// OlderThan is the minimum age of a resource before it's eligible to be removed
+	OlderThan time.Duration
+
+	// DryRun specifies that resources should be logged rather than removed
+	DryRun bool
+
+	// IncludeTenantResources specifies that resources which are scoped to the Tenant rather than the
+	// Subscription (for example Management Groups) are eligible to be removed
+	IncludeTenantResources bool
+
+	// Now is the point in time which the age of a resource is compared against
+	Now time.Time
+}
+
+// MatchesName returns whether the specified name starts with one of the configured Prefixes.
+func (f SweepFilter) MatchesName(name string) bool {
+	for _, prefix := range f.Prefixes {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchesTags returns whether the specified tags contain the configured TagKey/TagValue,
+// or true when no tag filter is configured.
+func (f SweepFilter) MatchesTags(tags map[string]string) bool {
+	if f.TagKey == "" {
+		return true
+	}
+
+	v, ok := tags[f.TagKey]
+	if !ok {
+		return false
+	}
+
+	return f.TagValue == "" || v == f.TagValue
+}
+
+// MatchesAge returns whether a resource created at the specified time is old enough to be removed.
+// Resources where the creation time is unknown are only eligible when no minimum age is configured,
+// since these may belong to Acceptance Tests which are still running.
+func (f SweepFilter) MatchesAge(createdAt *time.Time) bool {
+	if f.OlderThan == 0 {
+		return true
+	}
+
+	if createdAt == nil {
+		return false
+	}
+
+	return f.Now.Sub(*createdAt) >= f.OlderThan
+}
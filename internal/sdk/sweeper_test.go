@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestSweepFilter_MatchesName(t *testing.T) {
+	filter := SweepFilter{
+		Prefixes: []string{"acctest", "tf-"},
+	}
+
+	testData := map[string]bool{
+		"acctestRG-1234": true,
+		"ACCTESTrg-1234": true,
+		"tf-example":     true,
+		"example":        false,
+		"rg-acctest":     false,
+	}
+	for input, expected := range testData {
+		if actual := filter.MatchesName(input); actual != expected {
+			t.Fatalf("expected %q to be %t but got %t", input, expected, actual)
+		}
+	}
+}
+
+func TestSweepFilter_MatchesTags(t *testing.T) {
+	testData := []struct {
+		filter   SweepFilter
+		tags     map[string]string
+		expected bool
+	}{
+		{
+			filter:   SweepFilter{},
+			tags:     nil,
+			expected: true,
+		},
+		{
+			filter:   SweepFilter{TagKey: "owner"},
+			tags:     map[string]string{"owner": "ci"},
+			expected: true,
+		},
+		{
+			filter:   SweepFilter{TagKey: "owner"},
+			tags:     map[string]string{"environment": "ci"},
+			expected: false,
+		},
+		{
+			filter:   SweepFilter{TagKey: "owner", TagValue: "ci"},
+			tags:     map[string]string{"owner": "ci"},
+			expected: true,
+		},
+		{
+			filter:   SweepFilter{TagKey: "owner", TagValue: "ci"},
+			tags:     map[string]string{"owner": "someone"},
+			expected: false,
+		},
+	}
+	for _, v := range testData {
+		if actual := v.filter.MatchesTags(v.tags); actual != v.expected {
+			t.Fatalf("expected %+v with tags %+v to be %t but got %t", v.filter, v.tags, v.expected, actual)
+		}
+	}
+}
+
+func TestSweepFilter_MatchesAge(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	filter := SweepFilter{
+		OlderThan: 24 * time.Hour,
+		Now:       now,
+	}
+
+	testData := []struct {
+		createdAt *time.Time
+		expected  bool
+	}{
+		{
+			createdAt: nil,
+			expected:  false,
+		},
+		{
+			createdAt: pointer.To(now.Add(-48 * time.Hour)),
+			expected:  true,
+		},
+		{
+			createdAt: pointer.To(now.Add(-1 * time.Hour)),
+			expected:  false,
+		},
+	}
+	for _, v := range testData {
+		if actual := filter.MatchesAge(v.createdAt); actual != v.expected {
+			t.Fatalf("expected %v to be %t but got %t", v.createdAt, v.expected, actual)
+		}
+	}
+}
+
+func TestSweepFilter_MatchesAgeWithoutMinimumAge(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	filter := SweepFilter{
+		Now: now,
+	}
+
+	for _, createdAt := range []*time.Time{nil, pointer.To(now.Add(-1 * time.Hour))} {
+		if !filter.MatchesAge(createdAt) {
+			t.Fatalf("expected %v to match when no minimum age is configured", createdAt)
+		}
+	}
+}
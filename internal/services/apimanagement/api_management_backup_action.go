@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storageaccounts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type ApiManagementBackupAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ApiManagementBackupAction{}
+
+func newApiManagementBackupAction() action.Action {
+	return &ApiManagementBackupAction{}
+}
+
+// ApiManagementBackupRestoreActionModel is shared by the backup and restore actions since both take the same parameters
+type ApiManagementBackupRestoreActionModel struct {
+	ApiManagementId  types.String `tfsdk:"api_management_id"`
+	StorageAccountId types.String `tfsdk:"storage_account_id"`
+	ContainerName    types.String `tfsdk:"container_name"`
+	BackupName       types.String `tfsdk:"backup_name"`
+	AccessType       types.String `tfsdk:"access_type"`
+	ClientId         types.String `tfsdk:"client_id"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+func apiManagementBackupRestoreActionSchema(operation string) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_management_id": schema.StringAttribute{
+				Required:            true,
+				Description:         fmt.Sprintf("The ID of the API Management Service to %s.", operation),
+				MarkdownDescription: fmt.Sprintf("The ID of the API Management Service to %s.", operation),
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: apimanagementservice.ValidateServiceID,
+					},
+				},
+			},
+
+			"storage_account_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Storage Account containing the backup.",
+				MarkdownDescription: "The ID of the Storage Account containing the backup.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"container_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Storage Container containing the backup.",
+				MarkdownDescription: "The name of the Storage Container containing the backup.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"backup_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the backup blob.",
+				MarkdownDescription: "The name of the backup blob.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"access_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How the API Management Service authenticates to the Storage Account. Possible values are `AccessKey`, `SystemAssignedManagedIdentity` and `UserAssignedManagedIdentity`. Defaults to `AccessKey`.",
+				MarkdownDescription: "How the API Management Service authenticates to the Storage Account. Possible values are `AccessKey`, `SystemAssignedManagedIdentity` and `UserAssignedManagedIdentity`. Defaults to `AccessKey`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(apimanagementservice.PossibleValuesForAccessType()...),
+				},
+			},
+
+			"client_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The Client ID of the User Assigned Managed Identity used to authenticate to the Storage Account. Required when `access_type` is `UserAssignedManagedIdentity`.",
+				MarkdownDescription: "The Client ID of the User Assigned Managed Identity used to authenticate to the Storage Account. Required when `access_type` is `UserAssignedManagedIdentity`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `120m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `120m`.",
+			},
+		},
+	}
+}
+
+// expandApiManagementBackupRestoreParameters builds the payload for a backup or restore - when using `AccessKey`
+// authentication the key is looked up from the Storage Account so that it doesn't need to be specified in the config
+func expandApiManagementBackupRestoreParameters(ctx context.Context, client *clients.Client, model ApiManagementBackupRestoreActionModel) (*apimanagementservice.ApiManagementServiceBackupRestoreParameters, error) {
+	storageAccountId, err := commonids.ParseStorageAccountID(model.StorageAccountId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	accessType := apimanagementservice.AccessTypeAccessKey
+	if v := model.AccessType; !v.IsNull() && v.ValueString() != "" {
+		accessType = apimanagementservice.AccessType(v.ValueString())
+	}
+
+	payload := apimanagementservice.ApiManagementServiceBackupRestoreParameters{
+		AccessType:     pointer.To(accessType),
+		BackupName:     model.BackupName.ValueString(),
+		ContainerName:  model.ContainerName.ValueString(),
+		StorageAccount: storageAccountId.StorageAccountName,
+	}
+
+	switch accessType {
+	case apimanagementservice.AccessTypeAccessKey:
+		resp, err := client.Storage.ResourceManager.StorageAccounts.ListKeys(ctx, *storageAccountId, storageaccounts.DefaultListKeysOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing Keys for %s: %+v", storageAccountId, err)
+		}
+
+		if model := resp.Model; model != nil && model.Keys != nil {
+			for _, key := range *model.Keys {
+				if pointer.From(key.Permissions) == storageaccounts.KeyPermissionFull && key.Value != nil {
+					payload.AccessKey = key.Value
+					break
+				}
+			}
+		}
+
+		if payload.AccessKey == nil {
+			return nil, fmt.Errorf("unable to determine the Write Key for %s", storageAccountId)
+		}
+
+	case apimanagementservice.AccessTypeUserAssignedManagedIdentity:
+		if model.ClientId.IsNull() || model.ClientId.ValueString() == "" {
+			return nil, fmt.Errorf("`client_id` must be specified when `access_type` is %q", accessType)
+		}
+		payload.ClientId = pointer.To(model.ClientId.ValueString())
+	}
+
+	return &payload, nil
+}
+
+func apiManagementBackupRestoreActionTimeout(model ApiManagementBackupRestoreActionModel) (time.Duration, error) {
+	timeout := 120 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			return 0, err
+		}
+		timeout = duration
+	}
+
+	return timeout, nil
+}
+
+func (a *ApiManagementBackupAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = apiManagementBackupRestoreActionSchema("back up")
+}
+
+func (a *ApiManagementBackupAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_api_management_backup"
+}
+
+func (a *ApiManagementBackupAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.ApiManagement.ServiceClient
+
+	model := ApiManagementBackupRestoreActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := apiManagementBackupRestoreActionTimeout(model)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := apimanagementservice.ParseServiceID(model.ApiManagementId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	payload, err := expandApiManagementBackupRestoreParameters(ctx, a.Client, model)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("building the backup parameters for %s", id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("backing up %s to %q in container %q", id, payload.BackupName, payload.ContainerName),
+	})
+
+	if err := client.BackupThenPoll(ctx, *id, *payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("backing up %s", id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("backup of %s to %q completed", id, payload.BackupName),
+	})
+}
+
+func (a *ApiManagementBackupAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
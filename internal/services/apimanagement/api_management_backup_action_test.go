@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ApiManagementBackupAction struct{}
+
+func TestAccApiManagementBackupAction_accessKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backup", "test")
+	a := ApiManagementBackupAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.accessKey(data),
+			},
+		},
+	})
+}
+
+func TestAccApiManagementBackupAction_systemAssignedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backup", "test")
+	a := ApiManagementBackupAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.systemAssignedIdentity(data),
+			},
+		},
+	})
+}
+
+func (a ApiManagementBackupAction) accessKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_storage_container.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_api_management_backup.test]
+    }
+  }
+}
+
+action "azurerm_api_management_backup" "test" {
+  config {
+    api_management_id  = azurerm_api_management.test.id
+    storage_account_id = azurerm_storage_account.test.id
+    container_name     = azurerm_storage_container.test.name
+    backup_name        = "acctest-backup"
+  }
+}
+`, a.template(data, "None"))
+}
+
+func (a ApiManagementBackupAction) systemAssignedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_api_management.test.identity[0].principal_id
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_role_assignment.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_api_management_backup.test]
+    }
+  }
+}
+
+action "azurerm_api_management_backup" "test" {
+  config {
+    api_management_id  = azurerm_api_management.test.id
+    storage_account_id = azurerm_storage_account.test.id
+    container_name     = azurerm_storage_container.test.name
+    backup_name        = "acctest-backup"
+    access_type        = "SystemAssignedManagedIdentity"
+  }
+}
+`, a.template(data, "SystemAssigned"))
+}
+
+func (ApiManagementBackupAction) template(data acceptance.TestData, identityType string) string {
+	identity := ""
+	if identityType != "None" {
+		identity = fmt.Sprintf(`
+  identity {
+    type = "%s"
+  }
+`, identityType)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku_name = "Developer_1"
+%[4]s
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name               = "backups"
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, identity)
+}
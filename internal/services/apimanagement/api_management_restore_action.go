@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type ApiManagementRestoreAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ApiManagementRestoreAction{}
+
+func newApiManagementRestoreAction() action.Action {
+	return &ApiManagementRestoreAction{}
+}
+
+func (a *ApiManagementRestoreAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = apiManagementBackupRestoreActionSchema("restore the backup to")
+}
+
+func (a *ApiManagementRestoreAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_api_management_restore"
+}
+
+func (a *ApiManagementRestoreAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.ApiManagement.ServiceClient
+
+	model := ApiManagementBackupRestoreActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := apiManagementBackupRestoreActionTimeout(model)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := apimanagementservice.ParseServiceID(model.ApiManagementId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	payload, err := expandApiManagementBackupRestoreParameters(ctx, a.Client, model)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("building the restore parameters for %s", id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("restoring %s from %q in container %q", id, payload.BackupName, payload.ContainerName),
+	})
+
+	if err := client.RestoreThenPoll(ctx, *id, *payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("restoring %s", id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("restore of %s from %q completed", id, payload.BackupName),
+	})
+}
+
+func (a *ApiManagementRestoreAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
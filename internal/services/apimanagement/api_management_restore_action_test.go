@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ApiManagementRestoreAction struct{}
+
+func TestAccApiManagementRestoreAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_restore", "test")
+	a := ApiManagementRestoreAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: ApiManagementBackupAction{}.accessKey(data),
+			},
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func (a ApiManagementRestoreAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "restore" {
+  input = azurerm_storage_container.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_api_management_restore.test]
+    }
+  }
+}
+
+action "azurerm_api_management_restore" "test" {
+  config {
+    api_management_id  = azurerm_api_management.test.id
+    storage_account_id = azurerm_storage_account.test.id
+    container_name     = azurerm_storage_container.test.name
+    backup_name        = "acctest-backup"
+  }
+}
+`, ApiManagementBackupAction{}.accessKey(data))
+}
@@ -122,7 +122,10 @@ func (r Registration) Resources() []sdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newApiManagementBackupAction,
+		newApiManagementRestoreAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
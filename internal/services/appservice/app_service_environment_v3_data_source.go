@@ -23,25 +23,26 @@ type AppServiceEnvironmentV3DataSource struct{}
 var _ sdk.DataSource = AppServiceEnvironmentV3DataSource{}
 
 type AppServiceEnvironmentV3DataSourceModel struct {
-	Name                               string                            `tfschema:"name"`
-	ResourceGroup                      string                            `tfschema:"resource_group_name"`
-	SubnetId                           string                            `tfschema:"subnet_id"`
-	AllowNewPrivateEndpointConnections bool                              `tfschema:"allow_new_private_endpoint_connections"`
-	ClusterSetting                     []ClusterSettingModel             `tfschema:"cluster_setting"`
-	DedicatedHostCount                 int64                             `tfschema:"dedicated_host_count"`
-	InternalLoadBalancingMode          string                            `tfschema:"internal_load_balancing_mode"`
-	RemoteDebuggingEnabled             bool                              `tfschema:"remote_debugging_enabled"`
-	ZoneRedundant                      bool                              `tfschema:"zone_redundant"`
-	Tags                               map[string]string                 `tfschema:"tags"`
-	DnsSuffix                          string                            `tfschema:"dns_suffix"`
-	ExternalInboundIPAddresses         []string                          `tfschema:"external_inbound_ip_addresses"`
-	InboundNetworkDependencies         []AppServiceV3InboundDependencies `tfschema:"inbound_network_dependencies"`
-	InternalInboundIPAddresses         []string                          `tfschema:"internal_inbound_ip_addresses"`
-	IpSSLAddressCount                  int64                             `tfschema:"ip_ssl_address_count"`
-	LinuxOutboundIPAddresses           []string                          `tfschema:"linux_outbound_ip_addresses"`
-	Location                           string                            `tfschema:"location"`
-	PricingTier                        string                            `tfschema:"pricing_tier"`
-	WindowsOutboundIPAddresses         []string                          `tfschema:"windows_outbound_ip_addresses"`
+	Name                               string                             `tfschema:"name"`
+	ResourceGroup                      string                             `tfschema:"resource_group_name"`
+	SubnetId                           string                             `tfschema:"subnet_id"`
+	AllowNewPrivateEndpointConnections bool                               `tfschema:"allow_new_private_endpoint_connections"`
+	ClusterSetting                     []ClusterSettingModel              `tfschema:"cluster_setting"`
+	DedicatedHostCount                 int64                              `tfschema:"dedicated_host_count"`
+	InternalLoadBalancingMode          string                             `tfschema:"internal_load_balancing_mode"`
+	RemoteDebuggingEnabled             bool                               `tfschema:"remote_debugging_enabled"`
+	ZoneRedundant                      bool                               `tfschema:"zone_redundant"`
+	Tags                               map[string]string                  `tfschema:"tags"`
+	DnsSuffix                          string                             `tfschema:"dns_suffix"`
+	ExternalInboundIPAddresses         []string                           `tfschema:"external_inbound_ip_addresses"`
+	InboundNetworkDependencies         []AppServiceV3InboundDependencies  `tfschema:"inbound_network_dependencies"`
+	InternalInboundIPAddresses         []string                           `tfschema:"internal_inbound_ip_addresses"`
+	IpSSLAddressCount                  int64                              `tfschema:"ip_ssl_address_count"`
+	LinuxOutboundIPAddresses           []string                           `tfschema:"linux_outbound_ip_addresses"`
+	Location                           string                             `tfschema:"location"`
+	OutboundNetworkDependencies        []AppServiceV3OutboundDependencies `tfschema:"outbound_network_dependencies"`
+	PricingTier                        string                             `tfschema:"pricing_tier"`
+	WindowsOutboundIPAddresses         []string                           `tfschema:"windows_outbound_ip_addresses"`
 }
 
 func (r AppServiceEnvironmentV3DataSource) Arguments() map[string]*pluginsdk.Schema {
@@ -154,6 +155,34 @@ func (r AppServiceEnvironmentV3DataSource) Attributes() map[string]*pluginsdk.Sc
 			Computed: true,
 		},
 
+		"outbound_network_dependencies": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"domain_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"port": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+
 		"pricing_tier": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
@@ -257,6 +286,12 @@ func (r AppServiceEnvironmentV3DataSource) Read() sdk.ResourceFunc {
 				}
 				state.InboundNetworkDependencies = *inboundNetworkDependencies
 
+				outboundNetworkDependencies, err := flattenOutboundNetworkDependencies(ctx, client, &id)
+				if err != nil {
+					return err
+				}
+				state.OutboundNetworkDependencies = *outboundNetworkDependencies
+
 				state.Tags = pointer.From(model.Tags)
 			}
 
@@ -25,6 +25,7 @@ func TestAccAppServiceEnvironmentV3DataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("ip_ssl_address_count").HasValue("0"),
 				check.That(data.ResourceName).Key("inbound_network_dependencies.#").HasValue("3"),
 				check.That(data.ResourceName).Key("location").HasValue(data.Locations.Primary),
+				check.That(data.ResourceName).Key("outbound_network_dependencies.0.domain_name").IsNotEmpty(),
 				check.That(data.ResourceName).Key("windows_outbound_ip_addresses.#").HasValue("1"),
 			),
 		},
@@ -56,6 +56,13 @@ type AppServiceV3InboundDependencies struct {
 	Ports       []string `tfschema:"ports"`
 }
 
+type AppServiceV3OutboundDependencies struct {
+	Category   string `tfschema:"category"`
+	DomainName string `tfschema:"domain_name"`
+	IPAddress  string `tfschema:"ip_address"`
+	Port       int64  `tfschema:"port"`
+}
+
 // (@jackofallops) - Important property missing from the SDK / Swagger that will need to be added later: `upgrade_preference` https://docs.microsoft.com/en-us/azure/app-service/environment/using#upgrade-preference
 
 type AppServiceEnvironmentV3Resource struct{}
@@ -575,6 +582,40 @@ func flattenInboundNetworkDependencies(ctx context.Context, client *appserviceen
 	return &results, nil
 }
 
+func flattenOutboundNetworkDependencies(ctx context.Context, client *appserviceenvironments.AppServiceEnvironmentsClient, id *commonids.AppServiceEnvironmentId) (*[]AppServiceV3OutboundDependencies, error) {
+	outboundNetworking, err := client.GetOutboundNetworkDependenciesEndpointsComplete(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading paged results for Outbound Network Dependencies for %s: %+v", id, err)
+	}
+
+	results := make([]AppServiceV3OutboundDependencies, 0)
+	for _, v := range outboundNetworking.Items {
+		if v.Endpoints == nil {
+			continue
+		}
+
+		for _, endpoint := range *v.Endpoints {
+			result := AppServiceV3OutboundDependencies{
+				Category:   pointer.From(v.Category),
+				DomainName: pointer.From(endpoint.DomainName),
+			}
+
+			if endpoint.EndpointDetails == nil || len(*endpoint.EndpointDetails) == 0 {
+				results = append(results, result)
+				continue
+			}
+
+			for _, detail := range *endpoint.EndpointDetails {
+				result.IPAddress = pointer.From(detail.IPAddress)
+				result.Port = pointer.From(detail.Port)
+				results = append(results, result)
+			}
+		}
+	}
+
+	return &results, nil
+}
+
 func checkNetworkConfigUpdate(ctx context.Context, client *appserviceenvironments.AppServiceEnvironmentsClient, id commonids.AppServiceEnvironmentId, values appserviceenvironments.AseV3NetworkingConfigurationProperties) pluginsdk.StateRefreshFunc {
 	return func() (result interface{}, state string, err error) {
 		resp, err := client.GetAseV3NetworkingConfiguration(ctx, id)
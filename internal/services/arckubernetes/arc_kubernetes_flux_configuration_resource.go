@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	arckubernetes "github.com/hashicorp/go-azure-sdk/resource-manager/hybridkubernetes/2024-01-01/connectedclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2025-04-01/fluxconfiguration"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -27,6 +29,9 @@ const (
 	FluxGitCommit       string = "commit"
 	FluxGitReferenceTag string = "tag"
 	FluxGitSemverRange  string = "semver"
+
+	SubstituteFromKindConfigMap string = "ConfigMap"
+	SubstituteFromKindSecret    string = "Secret"
 )
 
 type ArcKubernetesFluxConfigurationModel struct {
@@ -39,6 +44,9 @@ type ArcKubernetesFluxConfigurationModel struct {
 	Namespace                       string                         `tfschema:"namespace"`
 	Scope                           string                         `tfschema:"scope"`
 	ContinuousReconciliationEnabled bool                           `tfschema:"continuous_reconciliation_enabled"`
+	WaitForReconciliationEnabled    bool                           `tfschema:"wait_for_reconciliation_enabled"`
+	ReconciliationWaitDuration      string                         `tfschema:"reconciliation_wait_duration"`
+	ComplianceState                 string                         `tfschema:"compliance_state"`
 }
 
 type AzureBlobDefinitionModel struct {
@@ -86,14 +94,27 @@ type GitRepositoryDefinitionModel struct {
 }
 
 type KustomizationDefinitionModel struct {
-	Name                   string   `tfschema:"name"`
-	Path                   string   `tfschema:"path"`
-	TimeoutInSeconds       int64    `tfschema:"timeout_in_seconds"`
-	SyncIntervalInSeconds  int64    `tfschema:"sync_interval_in_seconds"`
-	RetryIntervalInSeconds int64    `tfschema:"retry_interval_in_seconds"`
-	Force                  bool     `tfschema:"recreating_enabled"`
-	Prune                  bool     `tfschema:"garbage_collection_enabled"`
-	DependsOn              []string `tfschema:"depends_on"`
+	Name                   string                     `tfschema:"name"`
+	Path                   string                     `tfschema:"path"`
+	TimeoutInSeconds       int64                      `tfschema:"timeout_in_seconds"`
+	SyncIntervalInSeconds  int64                      `tfschema:"sync_interval_in_seconds"`
+	RetryIntervalInSeconds int64                      `tfschema:"retry_interval_in_seconds"`
+	Force                  bool                       `tfschema:"recreating_enabled"`
+	Prune                  bool                       `tfschema:"garbage_collection_enabled"`
+	DependsOn              []string                   `tfschema:"depends_on"`
+	PostBuild              []PostBuildDefinitionModel `tfschema:"post_build"`
+	Wait                   bool                       `tfschema:"wait"`
+}
+
+type PostBuildDefinitionModel struct {
+	Substitute     map[string]string               `tfschema:"substitute"`
+	SubstituteFrom []SubstituteFromDefinitionModel `tfschema:"substitute_from"`
+}
+
+type SubstituteFromDefinitionModel struct {
+	Kind     string `tfschema:"kind"`
+	Name     string `tfschema:"name"`
+	Optional bool   `tfschema:"optional"`
 }
 
 type ArcKubernetesFluxConfigurationResource struct{}
@@ -212,6 +233,59 @@ func (r ArcKubernetesFluxConfigurationResource) Arguments() map[string]*pluginsd
 							Type: pluginsdk.TypeString,
 						},
 					},
+
+					"post_build": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"substitute": {
+									Type:     pluginsdk.TypeMap,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"substitute_from": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"kind": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													SubstituteFromKindConfigMap,
+													SubstituteFromKindSecret,
+												}, false),
+											},
+
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"optional": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												Default:  false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"wait": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
 				},
 			},
 		},
@@ -506,11 +580,29 @@ func (r ArcKubernetesFluxConfigurationResource) Arguments() map[string]*pluginsd
 			Optional: true,
 			Default:  true,
 		},
+
+		"wait_for_reconciliation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"reconciliation_wait_duration": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "PT1H",
+			ValidateFunc: azValidate.ISO8601DurationBetween("PT1M", "PT24H"),
+		},
 	}
 }
 
 func (r ArcKubernetesFluxConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"compliance_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r ArcKubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
@@ -547,9 +639,11 @@ func (r ArcKubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
 
 			properties := &fluxconfiguration.FluxConfiguration{
 				Properties: &fluxconfiguration.FluxConfigurationProperties{
-					Kustomizations: expandKustomizationDefinitionModel(model.Kustomizations),
-					Scope:          pointer.To(fluxconfiguration.ScopeType(model.Scope)),
-					Suspend:        pointer.To(!model.ContinuousReconciliationEnabled),
+					Kustomizations:             expandKustomizationDefinitionModel(model.Kustomizations),
+					ReconciliationWaitDuration: pointer.To(model.ReconciliationWaitDuration),
+					Scope:                      pointer.To(fluxconfiguration.ScopeType(model.Scope)),
+					Suspend:                    pointer.To(!model.ContinuousReconciliationEnabled),
+					WaitForReconciliation:      pointer.To(model.WaitForReconciliationEnabled),
 				},
 			}
 
@@ -584,6 +678,13 @@ func (r ArcKubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if model.WaitForReconciliationEnabled {
+				if err := checkArcKubernetesFluxConfigurationCompliance(ctx, client, id); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -662,6 +763,14 @@ func (r ArcKubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
 				properties.Properties.Suspend = pointer.To(!model.ContinuousReconciliationEnabled)
 			}
 
+			if metadata.ResourceData.HasChange("wait_for_reconciliation_enabled") {
+				properties.Properties.WaitForReconciliation = pointer.To(model.WaitForReconciliationEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("reconciliation_wait_duration") {
+				properties.Properties.ReconciliationWaitDuration = pointer.To(model.ReconciliationWaitDuration)
+			}
+
 			if properties.Properties.ConfigurationProtectedSettings == nil {
 				if err := setConfigurationProtectedSettings(metadata, model, properties); err != nil {
 					return err
@@ -672,6 +781,12 @@ func (r ArcKubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if model.WaitForReconciliationEnabled {
+				if err := checkArcKubernetesFluxConfigurationCompliance(ctx, client, *id); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -731,6 +846,13 @@ func (r ArcKubernetesFluxConfigurationResource) Read() sdk.ResourceFunc {
 					state.Namespace = pointer.From(properties.Namespace)
 					state.Scope = string(pointer.From(properties.Scope))
 					state.ContinuousReconciliationEnabled = !pointer.From(properties.Suspend)
+					state.WaitForReconciliationEnabled = pointer.From(properties.WaitForReconciliation)
+					state.ComplianceState = pointer.FromEnum(properties.ComplianceState)
+
+					state.ReconciliationWaitDuration = "PT1H"
+					if v := pointer.From(properties.ReconciliationWaitDuration); v != "" {
+						state.ReconciliationWaitDuration = v
+					}
 				}
 			}
 
@@ -808,9 +930,11 @@ func expandKustomizationDefinitionModel(inputList []KustomizationDefinitionModel
 			Force:                  &input.Force,
 			Name:                   &input.Name,
 			Prune:                  &input.Prune,
+			PostBuild:              expandPostBuildDefinitionModel(input.PostBuild),
 			RetryIntervalInSeconds: &input.RetryIntervalInSeconds,
 			SyncIntervalInSeconds:  &input.SyncIntervalInSeconds,
 			TimeoutInSeconds:       &input.TimeoutInSeconds,
+			Wait:                   &input.Wait,
 		}
 
 		if input.Path != "" {
@@ -1010,10 +1134,12 @@ func flattenKustomizationDefinitionModel(inputList *map[string]fluxconfiguration
 			Force:                  pointer.From(input.Force),
 			Name:                   pointer.From(input.Name),
 			Path:                   pointer.From(input.Path),
+			PostBuild:              flattenPostBuildDefinitionModel(input.PostBuild),
 			Prune:                  pointer.From(input.Prune),
 			RetryIntervalInSeconds: pointer.From(input.RetryIntervalInSeconds),
 			SyncIntervalInSeconds:  pointer.From(input.SyncIntervalInSeconds),
 			TimeoutInSeconds:       pointer.From(input.TimeoutInSeconds),
+			Wait:                   pointer.From(input.Wait),
 		}
 
 		outputList = append(outputList, output)
@@ -1150,3 +1276,105 @@ func setConfigurationProtectedSettings(metadata sdk.ResourceMetaData, model ArcK
 	}
 	return nil
 }
+
+func expandPostBuildDefinitionModel(inputList []PostBuildDefinitionModel) *fluxconfiguration.PostBuildDefinition {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	input := inputList[0]
+	output := fluxconfiguration.PostBuildDefinition{
+		SubstituteFrom: expandSubstituteFromDefinitionModel(input.SubstituteFrom),
+	}
+
+	if len(input.Substitute) > 0 {
+		output.Substitute = pointer.To(input.Substitute)
+	}
+
+	return &output
+}
+
+func expandSubstituteFromDefinitionModel(inputList []SubstituteFromDefinitionModel) *[]fluxconfiguration.SubstituteFromDefinition {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	outputList := make([]fluxconfiguration.SubstituteFromDefinition, 0)
+	for _, input := range inputList {
+		outputList = append(outputList, fluxconfiguration.SubstituteFromDefinition{
+			Kind:     pointer.To(input.Kind),
+			Name:     pointer.To(input.Name),
+			Optional: pointer.To(input.Optional),
+		})
+	}
+
+	return &outputList
+}
+
+func flattenPostBuildDefinitionModel(input *fluxconfiguration.PostBuildDefinition) []PostBuildDefinitionModel {
+	outputList := make([]PostBuildDefinitionModel, 0)
+	if input == nil {
+		return outputList
+	}
+
+	return append(outputList, PostBuildDefinitionModel{
+		Substitute:     pointer.From(input.Substitute),
+		SubstituteFrom: flattenSubstituteFromDefinitionModel(input.SubstituteFrom),
+	})
+}
+
+func flattenSubstituteFromDefinitionModel(inputList *[]fluxconfiguration.SubstituteFromDefinition) []SubstituteFromDefinitionModel {
+	outputList := make([]SubstituteFromDefinitionModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		outputList = append(outputList, SubstituteFromDefinitionModel{
+			Kind:     pointer.From(input.Kind),
+			Name:     pointer.From(input.Name),
+			Optional: pointer.From(input.Optional),
+		})
+	}
+
+	return outputList
+}
+
+// checkArcKubernetesFluxConfigurationCompliance returns an error when the Flux Configuration isn't Compliant once the
+// service has finished waiting for it to reconcile, detailing the objects which failed to reconcile
+func checkArcKubernetesFluxConfigurationCompliance(ctx context.Context, client *fluxconfiguration.FluxConfigurationClient, id fluxconfiguration.ScopedFluxConfigurationId) error {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	props := resp.Model.Properties
+
+	complianceState := pointer.From(props.ComplianceState)
+	if complianceState == fluxconfiguration.FluxComplianceStateCompliant {
+		return nil
+	}
+
+	details := make([]string, 0)
+	if v := pointer.From(props.ErrorMessage); v != "" {
+		details = append(details, v)
+	}
+	for _, status := range pointer.From(props.Statuses) {
+		if pointer.From(status.ComplianceState) == fluxconfiguration.FluxComplianceStateCompliant {
+			continue
+		}
+
+		messages := make([]string, 0)
+		for _, condition := range pointer.From(status.StatusConditions) {
+			if v := pointer.From(condition.Message); v != "" {
+				messages = append(messages, v)
+			}
+		}
+		details = append(details, fmt.Sprintf("%s %q (%s): %s", pointer.From(status.Kind), pointer.From(status.Name), pointer.FromEnum(status.ComplianceState), strings.Join(messages, "; ")))
+	}
+
+	return fmt.Errorf("waiting for %s to reconcile: the compliance state was %q rather than %q:\n%s", id, complianceState, fluxconfiguration.FluxComplianceStateCompliant, strings.Join(details, "\n"))
+}
@@ -92,6 +92,22 @@ func TestAccArcKubernetesFluxConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccArcKubernetesFluxConfiguration_waitForReconciliation(t *testing.T) {
+	credential, privateKey, publicKey := ArcKubernetesClusterResource{}.getCredentials(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_kubernetes_flux_configuration", "test")
+	r := ArcKubernetesFluxConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.waitForReconciliation(data, credential, privateKey, publicKey),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("compliance_state").HasValue("Compliant"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccArcKubernetesFluxConfiguration_privateRepositoryWithSshKey(t *testing.T) {
 	const FluxUrl = "ARM_K8S_FLUX_CONFIG_SSH_URL" // git@github.com:Azure/arc-k8s-demo.git
 	const PrivateSshKey = "ARM_K8S_FLUX_CONFIG_SSH_KEY"
@@ -321,6 +337,55 @@ resource "azurerm_arc_kubernetes_flux_configuration" "test" {
   kustomizations {
     name       = "kustomization-2"
     depends_on = ["kustomization-1"]
+    wait       = false
+
+    post_build {
+      substitute = {
+        cluster_env = "test"
+      }
+
+      substitute_from {
+        kind     = "ConfigMap"
+        name     = "cluster-config"
+        optional = true
+      }
+    }
+  }
+
+  depends_on = [
+    azurerm_arc_kubernetes_cluster_extension.test
+  ]
+}
+`, template, data.RandomInteger)
+}
+
+func (r ArcKubernetesFluxConfigurationResource) waitForReconciliation(data acceptance.TestData, credential string, privateKey string, publicKey string) string {
+	template := r.template(data, credential, privateKey, publicKey)
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_arc_kubernetes_flux_configuration" "test" {
+  name                            = "acctest-fc-%d"
+  cluster_id                      = azurerm_arc_kubernetes_cluster.test.id
+  namespace                       = "flux"
+  wait_for_reconciliation_enabled = true
+  reconciliation_wait_duration    = "PT30M"
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+    wait = true
+
+    post_build {
+      substitute = {
+        cluster_env = "test"
+      }
+    }
   }
 
   depends_on = [
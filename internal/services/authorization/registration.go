@@ -16,6 +16,7 @@ var (
 	_ sdk.FrameworkServiceRegistration               = Registration{}
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.ServiceRegistrationWithSweepers            = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -52,6 +53,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		RoleAssignmentsDataSource{},
 		RoleDefinitionDataSource{},
+		RoleDefinitionsDataSource{},
 		RoleManagementPolicyDataSource{},
 	}
 }
@@ -67,6 +69,13 @@ func (r Registration) Resources() []sdk.Resource {
 	return resources
 }
 
+// Sweepers returns a list of Sweepers supported by this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		RoleDefinitionSweeper{},
+	}
+}
+
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{}
 }
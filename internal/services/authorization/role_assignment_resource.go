@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/propagation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -37,6 +38,7 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmRoleAssignmentCreate,
 		Read:   resourceArmRoleAssignmentRead,
+		Update: resourceArmRoleAssignmentUpdate,
 		Delete: resourceArmRoleAssignmentDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -47,6 +49,7 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -153,6 +156,8 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 					"2.0",
 				}, false),
 			},
+
+			"propagation_wait": propagation.Schema(),
 		},
 	}
 }
@@ -273,6 +278,14 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 
 	d.SetId(id.ID())
 
+	propagationWait, err := propagation.Expand(d.Get("propagation_wait").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if err := propagation.WaitFor(ctx, propagationWait, id.String(), roleAssignmentPropagationCheckFunc(roleAssignmentsClient, id, principalId)); err != nil {
+		return err
+	}
+
 	return resourceArmRoleAssignmentRead(d, meta)
 }
 
@@ -341,6 +354,11 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceArmRoleAssignmentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	// only `propagation_wait` and `skip_service_principal_aad_check` can be updated, neither of which are sent to the API
+	return resourceArmRoleAssignmentRead(d, meta)
+}
+
 func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Authorization.ScopedRoleAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -430,6 +448,35 @@ func roleAssignmentCreateStateRefreshFunc(ctx context.Context, client *roleassig
 	}
 }
 
+// roleAssignmentPropagationCheckFunc lists the Role Assignments for the Principal at the scope, rather than retrieving
+// the Role Assignment by ID, since this is the lookup used when authorizing requests made by the Principal
+func roleAssignmentPropagationCheckFunc(client *roleassignments.RoleAssignmentsClient, id parse.ScopedRoleAssignmentId, principalId string) propagation.CheckFunc {
+	return func(ctx context.Context) (bool, error) {
+		options := roleassignments.ListForScopeOperationOptions{
+			Filter: pointer.To(fmt.Sprintf("principalId eq '%s'", principalId)),
+		}
+		if id.TenantId != "" {
+			options.TenantId = pointer.To(id.TenantId)
+		}
+
+		resp, err := client.ListForScopeComplete(ctx, commonids.NewScopeID(id.ScopedId.Scope), options)
+		if err != nil {
+			if response.WasForbidden(resp.LatestHttpResponse) || response.WasNotFound(resp.LatestHttpResponse) {
+				return false, nil
+			}
+			return false, fmt.Errorf("listing Role Assignments for Principal %q at %q: %+v", principalId, id.ScopedId.Scope, err)
+		}
+
+		for _, item := range resp.Items {
+			if strings.EqualFold(pointer.From(item.Name), id.ScopedId.RoleAssignmentName) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
+
 func getTenantIdBySubscriptionId(ctx context.Context, client *subscriptions.SubscriptionsClient, subscriptionId string) (string, error) {
 	id := commonids.NewSubscriptionID(subscriptionId)
 	resp, err := client.Get(ctx, id)
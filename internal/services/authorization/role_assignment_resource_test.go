@@ -77,6 +77,32 @@ func TestAccRoleAssignment_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccRoleAssignment_propagationWait(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
+	id := uuid.New().String()
+
+	r := RoleAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.propagationWaitConfig(id, "5m"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("skip_service_principal_aad_check", "propagation_wait"),
+		{
+			// updating the wait doesn't recreate the Role Assignment
+			Config: r.propagationWaitConfig(id, "10m"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("propagation_wait.0.timeout").HasValue("10m"),
+			),
+		},
+		data.ImportStep("skip_service_principal_aad_check", "propagation_wait"),
+	})
+}
+
 func TestAccRoleAssignment_dataActions(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
 	id := uuid.New().String()
@@ -356,6 +382,33 @@ resource "azurerm_role_assignment" "test" {
 `, id)
 }
 
+func (RoleAssignmentResource) propagationWaitConfig(id string, timeout string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                 = "%s"
+  scope                = data.azurerm_subscription.primary.id
+  role_definition_name = "Log Analytics Reader"
+  principal_id         = data.azurerm_client_config.test.object_id
+
+  propagation_wait {
+    timeout               = "%s"
+    interval              = "5s"
+    consecutive_successes = 3
+  }
+}
+`, id, timeout)
+}
+
 func (RoleAssignmentResource) roleResourceScoped(data acceptance.TestData, id string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
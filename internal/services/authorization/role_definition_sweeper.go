@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-05-01-preview/roledefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.Sweeper = RoleDefinitionSweeper{}
+
+// RoleDefinitionSweeper removes Custom Role Definitions which have been leaked by the Acceptance Tests. These are
+// created at the Subscription scope, so aren't removed along with a Resource Group.
+//
+// NOTE: a Role Definition can't be deleted whilst it's assigned, as such any Role Assignments for the Role
+// Definition within the Subscription are removed first. The name of a Role Definition is a UUID, so the Role
+// Name is matched against the prefixes instead.
+type RoleDefinitionSweeper struct{}
+
+func (RoleDefinitionSweeper) Name() string {
+	return "Role Definitions"
+}
+
+func (RoleDefinitionSweeper) Sweep(ctx context.Context, client *clients.Client, filter sdk.SweepFilter) ([]string, error) {
+	if !filter.MatchesTags(nil) {
+		log.Printf("[DEBUG] Skipping Role Definitions since these can't be filtered by Tags")
+		return nil, nil
+	}
+
+	definitionsClient := client.Authorization.ScopedRoleDefinitionsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	options := roledefinitions.ListOperationOptions{
+		Filter: pointer.To("type eq 'CustomRole'"),
+	}
+	resp, err := definitionsClient.ListComplete(ctx, commonids.NewScopeID(subscriptionId.ID()), options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Custom Role Definitions within %s: %+v", subscriptionId, err)
+	}
+
+	swept := make([]string, 0)
+	for _, definition := range resp.Items {
+		props := definition.Properties
+		if props == nil || !filter.MatchesName(pointer.From(props.RoleName)) {
+			continue
+		}
+
+		// Role Definitions created at a Management Group are also returned, but are left for the Management
+		// Group to be swept
+		if !strings.HasPrefix(strings.ToLower(pointer.From(definition.Id)), strings.ToLower(subscriptionId.ID()+"/")) {
+			continue
+		}
+
+		createdAt, err := props.GetCreatedOnAsTime()
+		if err != nil {
+			return swept, fmt.Errorf("parsing `createdOn` for %s: %+v", pointer.From(definition.Id), err)
+		}
+		if !filter.MatchesAge(createdAt) {
+			continue
+		}
+
+		id := roledefinitions.NewScopedRoleDefinitionID(subscriptionId.ID(), pointer.From(definition.Name))
+		swept = append(swept, id.ID())
+		if filter.DryRun {
+			log.Printf("[DEBUG] Dry Run: would delete %s", id)
+			continue
+		}
+
+		if err := deleteRoleAssignmentsForRoleDefinition(ctx, client.Authorization.ScopedRoleAssignmentsClient, subscriptionId, pointer.From(definition.Id)); err != nil {
+			return swept, err
+		}
+
+		log.Printf("[DEBUG] Deleting %s..", id)
+		if _, err := definitionsClient.Delete(ctx, id); err != nil {
+			return swept, fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return swept, nil
+}
+
+// deleteRoleAssignmentsForRoleDefinition removes the Role Assignments within the Subscription which assign the
+// specified Role Definition, since these would otherwise block deleting it.
+func deleteRoleAssignmentsForRoleDefinition(ctx context.Context, client *roleassignments.RoleAssignmentsClient, subscriptionId commonids.SubscriptionId, roleDefinitionId string) error {
+	resp, err := client.ListForSubscriptionComplete(ctx, subscriptionId, roleassignments.DefaultListForSubscriptionOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Role Assignments within %s: %+v", subscriptionId, err)
+	}
+
+	for _, assignment := range resp.Items {
+		if assignment.Properties == nil || !strings.EqualFold(assignment.Properties.RoleDefinitionId, roleDefinitionId) {
+			continue
+		}
+
+		id := commonids.NewScopeID(pointer.From(assignment.Id))
+		log.Printf("[DEBUG] Deleting Role Assignment %q..", id.Scope)
+		if _, err := client.DeleteById(ctx, id, roleassignments.DefaultDeleteByIdOperationOptions()); err != nil {
+			return fmt.Errorf("deleting Role Assignment %q: %+v", id.Scope, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-05-01-preview/roledefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type RoleDefinitionsDataSource struct{}
+
+var _ sdk.DataSource = RoleDefinitionsDataSource{}
+
+type RoleDefinitionsDataSourceModel struct {
+	Scope           string                 `tfschema:"scope"`
+	Type            string                 `tfschema:"type"`
+	Action          string                 `tfschema:"action"`
+	DataAction      string                 `tfschema:"data_action"`
+	RoleDefinitions []RoleDefinitionsModel `tfschema:"role_definitions"`
+}
+
+type RoleDefinitionsModel struct {
+	Id               string   `tfschema:"id"`
+	RoleDefinitionId string   `tfschema:"role_definition_id"`
+	Name             string   `tfschema:"name"`
+	Type             string   `tfschema:"type"`
+	Description      string   `tfschema:"description"`
+	AssignableScopes []string `tfschema:"assignable_scopes"`
+}
+
+func (a RoleDefinitionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateScopeID,
+		},
+
+		"type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"BuiltInRole",
+				"CustomRole",
+			}, false),
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"data_action": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (a RoleDefinitionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"role_definitions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"role_definition_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"assignable_scopes": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a RoleDefinitionsDataSource) ModelObject() interface{} {
+	return &RoleDefinitionsDataSourceModel{}
+}
+
+func (a RoleDefinitionsDataSource) ResourceType() string {
+	return "azurerm_role_definitions"
+}
+
+func (a RoleDefinitionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.ScopedRoleDefinitionsClient
+
+			var state RoleDefinitionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := roledefinitions.DefaultListOperationOptions()
+			if state.Type != "" {
+				options.Filter = pointer.To(fmt.Sprintf("type eq '%s'", state.Type))
+			}
+
+			resp, err := client.ListComplete(ctx, commonids.NewScopeID(state.Scope), options)
+			if err != nil {
+				return fmt.Errorf("listing Role Definitions for scope %q: %+v", state.Scope, err)
+			}
+
+			state.RoleDefinitions = make([]RoleDefinitionsModel, 0)
+			for _, item := range resp.Items {
+				props := item.Properties
+				if props == nil {
+					continue
+				}
+
+				// `type` is checked again here rather than relying solely on the API filter
+				if state.Type != "" && !strings.EqualFold(pointer.From(props.Type), state.Type) {
+					continue
+				}
+
+				if state.Action != "" && !roleDefinitionAllows(props.Permissions, state.Action, false) {
+					continue
+				}
+
+				if state.DataAction != "" && !roleDefinitionAllows(props.Permissions, state.DataAction, true) {
+					continue
+				}
+
+				state.RoleDefinitions = append(state.RoleDefinitions, RoleDefinitionsModel{
+					Id:               pointer.From(item.Id),
+					RoleDefinitionId: pointer.From(item.Name),
+					Name:             pointer.From(props.RoleName),
+					Type:             pointer.From(props.Type),
+					Description:      pointer.From(props.Description),
+					AssignableScopes: pointer.From(props.AssignableScopes),
+				})
+			}
+
+			// the ID is the Role Definitions collection at the scope, since the scope can be empty (the tenant) this is
+			// set directly rather than via a Resource ID type
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.Authorization/roleDefinitions", strings.TrimSuffix(state.Scope, "/")))
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// roleDefinitionAllows returns whether any of the permissions grants the specified action (or data action) without it
+// being excluded by the corresponding `notActions` (or `notDataActions`) of the same permission
+func roleDefinitionAllows(input *[]roledefinitions.Permission, operation string, dataAction bool) bool {
+	if input == nil {
+		return false
+	}
+
+	for _, permission := range *input {
+		allowed, denied := permission.Actions, permission.NotActions
+		if dataAction {
+			allowed, denied = permission.DataActions, permission.NotDataActions
+		}
+
+		if roleDefinitionOperationMatches(pointer.From(allowed), operation) && !roleDefinitionOperationMatches(pointer.From(denied), operation) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// roleDefinitionOperationMatches returns whether the operation matches any of the patterns, which are case-insensitive
+// and can contain `*` wildcards, e.g. `Microsoft.KeyVault/*` or `*/read`
+func roleDefinitionOperationMatches(patterns []string, operation string) bool {
+	for _, pattern := range patterns {
+		expression := "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+		if matched, err := regexp.MatchString(expression, operation); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
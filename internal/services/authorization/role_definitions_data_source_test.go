@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RoleDefinitionsDataSource struct{}
+
+func TestAccRoleDefinitionsDataSource_builtIn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_role_definitions", "test")
+	d := RoleDefinitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.builtIn(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_definitions.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("role_definitions.0.type").HasValue("BuiltInRole"),
+			),
+		},
+	})
+}
+
+func TestAccRoleDefinitionsDataSource_dataAction(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_role_definitions", "test")
+	d := RoleDefinitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.dataAction(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_definitions.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("role_definitions.0.role_definition_id").IsUUID(),
+			),
+		},
+	})
+}
+
+func TestAccRoleDefinitionsDataSource_custom(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_role_definitions", "test")
+	d := RoleDefinitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.custom(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_definitions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("role_definitions.0.name").HasValue(fmt.Sprintf("acctestrd-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("role_definitions.0.type").HasValue("CustomRole"),
+			),
+		},
+	})
+}
+
+func (RoleDefinitionsDataSource) builtIn() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_role_definitions" "test" {
+  scope  = data.azurerm_subscription.primary.id
+  type   = "BuiltInRole"
+  action = "Microsoft.KeyVault/vaults/secrets/read"
+}
+`
+}
+
+func (RoleDefinitionsDataSource) dataAction() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_role_definitions" "test" {
+  scope       = data.azurerm_subscription.primary.id
+  data_action = "Microsoft.KeyVault/vaults/secrets/getSecret/action"
+}
+`
+}
+
+func (RoleDefinitionsDataSource) custom(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_role_definition" "test" {
+  name  = "acctestrd-%[1]d"
+  scope = azurerm_resource_group.test.id
+
+  permissions {
+    actions     = ["Microsoft.Acctest%[1]d/*"]
+    not_actions = ["Microsoft.Acctest%[1]d/things/delete"]
+  }
+
+  assignable_scopes = [azurerm_resource_group.test.id]
+}
+
+data "azurerm_role_definitions" "test" {
+  scope  = azurerm_resource_group.test.id
+  type   = "CustomRole"
+  action = "Microsoft.Acctest%[1]d/things/read"
+
+  depends_on = [azurerm_role_definition.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -5,6 +5,8 @@ package automation
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func contentLinkSchema(isDraft bool) *pluginsdk.Schema {
@@ -98,6 +101,22 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+				// runtime environments (e.g. PowerShell 7.4 or Python 3.10) are only supported by the generic runbook types
+				runbookType := d.Get("runbook_type").(string)
+				if d.Get("runtime_environment_name").(string) != "" && runbookType != string(runbook.RunbookTypeEnumPowerShell) && runbookType != string(runbook.RunbookTypeEnumPython) {
+					return fmt.Errorf("`runtime_environment_name` can only be specified when `runbook_type` is `%s` or `%s`", runbook.RunbookTypeEnumPowerShell, runbook.RunbookTypeEnumPython)
+				}
+
+				if !d.Get("publish_enabled").(bool) && d.GetRawConfig().AsValueMap()["content"].IsNull() {
+					return fmt.Errorf("`content` must be specified when `publish_enabled` is `false`")
+				}
+
+				return nil
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -160,6 +179,22 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"publish_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"content_sha256": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"job_schedule": {
 				Type:       pluginsdk.TypeSet,
 				Optional:   true,
@@ -355,8 +390,11 @@ func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta inter
 				return fmt.Errorf("setting the draft for %s: %+v", id, err)
 			}
 
-			if err := autoCli.Runbook.PublishThenPoll(ctx, id); err != nil {
-				return fmt.Errorf("publishing the updated %s: %+v", id, err)
+			// when publishing is disabled the content is left as a Draft, so that it can be tested before it's published
+			if d.Get("publish_enabled").(bool) {
+				if err := autoCli.Runbook.PublishThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("publishing the updated %s: %+v", id, err)
+				}
 			}
 		}
 
@@ -417,19 +455,40 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("description", props.Description)
 		d.Set("log_activity_trace_level", props.LogActivityTrace)
 		d.Set("runtime_environment_name", pointer.From(props.RuntimeEnvironment))
+		d.Set("state", string(pointer.From(props.State)))
 	}
 
-	// GetContent need to use preview version client RunbookClientHack
-	// move to stable Runbook once this issue fixed: https://github.com/Azure/azure-sdk-for-go/issues/17591#issuecomment-1233676539
-	contentResp, err := autoCli.Runbook.GetContent(ctx, *id)
-	if err != nil {
-		if response.WasNotFound(contentResp.HttpResponse) {
-			d.Set("content", "")
-		} else {
+	publishEnabled := true
+	if v, ok := d.GetOkExists("publish_enabled"); ok {
+		publishEnabled = v.(bool)
+	}
+	d.Set("publish_enabled", publishEnabled)
+
+	var content string
+	if publishEnabled {
+		// GetContent need to use preview version client RunbookClientHack
+		// move to stable Runbook once this issue fixed: https://github.com/Azure/azure-sdk-for-go/issues/17591#issuecomment-1233676539
+		contentResp, err := autoCli.Runbook.GetContent(ctx, *id)
+		if err != nil && !response.WasNotFound(contentResp.HttpResponse) {
 			return fmt.Errorf("retrieving content for Automation Runbook %s: %+v", id, err)
 		}
+		content = string(pointer.From(contentResp.Model))
+	} else {
+		draftRunbookID := runbookdraft.NewRunbookID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName, id.RunbookName)
+		draftContentResp, err := autoCli.RunbookDraft.GetContent(ctx, draftRunbookID)
+		if err != nil && !response.WasNotFound(draftContentResp.HttpResponse) {
+			return fmt.Errorf("retrieving draft content for Automation Runbook %s: %+v", id, err)
+		}
+		content = string(pointer.From(draftContentResp.Model))
+	}
+	d.Set("content", content)
+
+	contentSha256 := ""
+	if content != "" {
+		hash := sha256.Sum256([]byte(content))
+		contentSha256 = hex.EncodeToString(hash[:])
 	}
-	d.Set("content", string(pointer.From(contentResp.Model)))
+	d.Set("content_sha256", contentSha256)
 
 	jsMap := make(map[uuid.UUID]jobschedule.JobScheduleProperties)
 	automationAccountId := jobschedule.NewAutomationAccountID(id.SubscriptionId, id.ResourceGroupName, id.AutomationAccountName)
@@ -439,6 +498,8 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("loading Automation Account %q Job Schedule List: %+v", id.AutomationAccountName, err)
 	}
+	jsIds := make([]uuid.UUID, 0)
+	jobscheduleIDs := make([]jobschedule.JobScheduleId, 0)
 	for _, item := range pointer.From(jsIterator.Model) {
 		if itemProps := item.Properties; itemProps != nil {
 			if itemProps.JobScheduleId == nil || *itemProps.JobScheduleId == "" {
@@ -448,18 +509,32 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 			if err != nil {
 				return fmt.Errorf("parsing job schedule Id listed by Automation Account %q Job Schedule List: %v", id.AutomationAccountName, err)
 			}
-			// get job schedule from GET API, `ListByAutomationAccountComplete` lost parameters
 			jobscheduleID, err := jobschedule.ParseJobScheduleID(pointer.From(item.Id))
 			if err != nil {
 				return fmt.Errorf("parsing job schedule Id listed by Automation Account %q Job Schedule List: %v", id.AutomationAccountName, err)
 			}
-			jsResult, err := jsClient.Get(ctx, *jobscheduleID)
-			if err != nil {
-				return fmt.Errorf("retrieving job schedule by %s: %v", *jobscheduleID, err)
-			}
-			if jsResult.Model != nil && jsResult.Model.Properties != nil {
-				jsMap[jsId] = *jsResult.Model.Properties
-			}
+			jsIds = append(jsIds, jsId)
+			jobscheduleIDs = append(jobscheduleIDs, *jobscheduleID)
+		}
+	}
+
+	// get job schedule from GET API, `ListByAutomationAccountComplete` lost parameters
+	jsResults, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, jobscheduleIDs, func(ctx context.Context, jobscheduleID jobschedule.JobScheduleId) (*jobschedule.JobScheduleProperties, error) {
+		jsResult, err := jsClient.Get(ctx, jobscheduleID)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving job schedule by %s: %v", jobscheduleID, err)
+		}
+		if jsResult.Model != nil {
+			return jsResult.Model.Properties, nil
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	for i, props := range jsResults {
+		if props != nil {
+			jsMap[jsIds[i]] = *props
 		}
 	}
 
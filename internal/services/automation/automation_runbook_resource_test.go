@@ -111,6 +111,33 @@ func TestAccAutomationRunbook_PSWithContent(t *testing.T) {
 	})
 }
 
+func TestAccAutomationRunbook_publishEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runbook", "test")
+	r := AutomationRunbookResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.publishEnabled(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("New"),
+				check.That(data.ResourceName).Key("content").HasValue("# Some draft content\n"),
+				check.That(data.ResourceName).Key("content_sha256").Exists(),
+			),
+		},
+		data.ImportStep("publish_content_link", "publish_enabled", "content"),
+		{
+			Config: r.publishEnabled(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Published"),
+				check.That(data.ResourceName).Key("content").HasValue("# Some draft content\n"),
+			),
+		},
+		data.ImportStep("publish_content_link"),
+	})
+}
+
 func TestAccAutomationRunbook_PSWorkflowWithoutUri(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_automation_runbook", "test")
 	r := AutomationRunbookResource{}
@@ -422,6 +449,40 @@ CONTENT
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (AutomationRunbookResource) publishEnabled(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                    = "Get-AzureVMTutorial"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+
+  log_verbose  = "true"
+  log_progress = "true"
+  runbook_type = "PowerShell"
+
+  content         = "# Some draft content\n"
+  publish_enabled = %[3]t
+}
+`, data.RandomInteger, data.Locations.Primary, enabled)
+}
+
 func (AutomationRunbookResource) PSWorkflowWithoutUri(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
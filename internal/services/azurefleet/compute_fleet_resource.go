@@ -0,0 +1,1180 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azurefleet
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurefleet/2024-11-01/fleets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networksecuritygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = ComputeFleetResource{}
+	_ sdk.ResourceWithCustomizeDiff = ComputeFleetResource{}
+)
+
+type ComputeFleetResource struct{}
+
+type ComputeFleetModel struct {
+	Name                     string                        `tfschema:"name"`
+	ResourceGroupName        string                        `tfschema:"resource_group_name"`
+	Location                 string                        `tfschema:"location"`
+	PlatformFaultDomainCount int64                         `tfschema:"platform_fault_domain_count"`
+	RegularPriorityProfile   []RegularPriorityProfileModel `tfschema:"regular_priority_profile"`
+	SpotPriorityProfile      []SpotPriorityProfileModel    `tfschema:"spot_priority_profile"`
+	VirtualMachineProfile    []VirtualMachineProfileModel  `tfschema:"virtual_machine_profile"`
+	VirtualMachineSize       []VirtualMachineSizeModel     `tfschema:"vm_size"`
+	Zones                    zones.Schema                  `tfschema:"zones"`
+	Tags                     map[string]string             `tfschema:"tags"`
+	UniqueId                 string                        `tfschema:"unique_id"`
+}
+
+type RegularPriorityProfileModel struct {
+	AllocationStrategy string `tfschema:"allocation_strategy"`
+	Capacity           int64  `tfschema:"capacity"`
+	MinimumCapacity    int64  `tfschema:"minimum_capacity"`
+}
+
+type SpotPriorityProfileModel struct {
+	AllocationStrategy string  `tfschema:"allocation_strategy"`
+	Capacity           int64   `tfschema:"capacity"`
+	EvictionPolicy     string  `tfschema:"eviction_policy"`
+	MaintainEnabled    bool    `tfschema:"maintain_enabled"`
+	MaximumHourlyPrice float64 `tfschema:"maximum_hourly_price_per_virtual_machine"`
+	MinimumCapacity    int64   `tfschema:"minimum_capacity"`
+}
+
+type VirtualMachineSizeModel struct {
+	Name string `tfschema:"name"`
+	Rank int64  `tfschema:"rank"`
+}
+
+type VirtualMachineProfileModel struct {
+	NetworkInterface     []NetworkInterfaceModel     `tfschema:"network_interface"`
+	OsDisk               []OsDiskModel               `tfschema:"os_disk"`
+	OsProfile            []OsProfileModel            `tfschema:"os_profile"`
+	SourceImageId        string                      `tfschema:"source_image_id"`
+	SourceImageReference []SourceImageReferenceModel `tfschema:"source_image_reference"`
+}
+
+type NetworkInterfaceModel struct {
+	Name                         string                 `tfschema:"name"`
+	AcceleratedNetworkingEnabled bool                   `tfschema:"accelerated_networking_enabled"`
+	IPConfiguration              []IPConfigurationModel `tfschema:"ip_configuration"`
+	IPForwardingEnabled          bool                   `tfschema:"ip_forwarding_enabled"`
+	NetworkSecurityGroupId       string                 `tfschema:"network_security_group_id"`
+	Primary                      bool                   `tfschema:"primary"`
+}
+
+type IPConfigurationModel struct {
+	Name                              string   `tfschema:"name"`
+	LoadBalancerBackendAddressPoolIds []string `tfschema:"load_balancer_backend_address_pool_ids"`
+	Primary                           bool     `tfschema:"primary"`
+	SubnetId                          string   `tfschema:"subnet_id"`
+}
+
+type OsDiskModel struct {
+	Caching            string `tfschema:"caching"`
+	DiskSizeInGB       int64  `tfschema:"disk_size_in_gb"`
+	StorageAccountType string `tfschema:"storage_account_type"`
+}
+
+type OsProfileModel struct {
+	LinuxConfiguration   []LinuxConfigurationModel   `tfschema:"linux_configuration"`
+	WindowsConfiguration []WindowsConfigurationModel `tfschema:"windows_configuration"`
+}
+
+type LinuxConfigurationModel struct {
+	AdminPassword                 string   `tfschema:"admin_password"`
+	AdminSSHKeys                  []string `tfschema:"admin_ssh_keys"`
+	AdminUsername                 string   `tfschema:"admin_username"`
+	ComputerNamePrefix            string   `tfschema:"computer_name_prefix"`
+	PasswordAuthenticationEnabled bool     `tfschema:"password_authentication_enabled"`
+}
+
+type WindowsConfigurationModel struct {
+	AdminPassword      string `tfschema:"admin_password"`
+	AdminUsername      string `tfschema:"admin_username"`
+	ComputerNamePrefix string `tfschema:"computer_name_prefix"`
+}
+
+type SourceImageReferenceModel struct {
+	Offer     string `tfschema:"offer"`
+	Publisher string `tfschema:"publisher"`
+	Sku       string `tfschema:"sku"`
+	Version   string `tfschema:"version"`
+}
+
+func (r ComputeFleetResource) ResourceType() string {
+	return "azurerm_compute_fleet"
+}
+
+func (r ComputeFleetResource) ModelObject() interface{} {
+	return &ComputeFleetModel{}
+}
+
+func (r ComputeFleetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleets.ValidateFleetID
+}
+
+func (r ComputeFleetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,62}[a-zA-Z0-9_]$`),
+				"`name` must be between 1 and 64 characters, start with an alphanumeric character, end with an alphanumeric character or underscore and can only contain alphanumeric characters, underscores, periods and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"virtual_machine_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_interface": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"ip_configuration": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									ForceNew: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"subnet_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.SubnetId{}),
+
+											"load_balancer_backend_address_pool_ids": {
+												Type:     pluginsdk.TypeList,
+												Optional: true,
+												ForceNew: true,
+												Elem: &pluginsdk.Schema{
+													Type:         pluginsdk.TypeString,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
+
+											"primary": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  false,
+											},
+										},
+									},
+								},
+
+								"accelerated_networking_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"ip_forwarding_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"network_security_group_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: networksecuritygroups.ValidateNetworkSecurityGroupID,
+								},
+
+								"primary": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+							},
+						},
+					},
+
+					"os_disk": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"caching": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForCachingTypes(), false),
+								},
+
+								"storage_account_type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForStorageAccountTypes(), false),
+								},
+
+								"disk_size_in_gb": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ForceNew:     true,
+									Computed:     true,
+									ValidateFunc: validation.IntBetween(0, 4095),
+								},
+							},
+						},
+					},
+
+					"os_profile": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"linux_configuration": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									ForceNew: true,
+									MaxItems: 1,
+									ExactlyOneOf: []string{
+										"virtual_machine_profile.0.os_profile.0.linux_configuration",
+										"virtual_machine_profile.0.os_profile.0.windows_configuration",
+									},
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"admin_username": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"computer_name_prefix": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"admin_password": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ForceNew:     true,
+												Sensitive:    true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"admin_ssh_keys": {
+												Type:     pluginsdk.TypeList,
+												Optional: true,
+												ForceNew: true,
+												Elem: &pluginsdk.Schema{
+													Type:         pluginsdk.TypeString,
+													ValidateFunc: computeValidate.SSHKey,
+												},
+											},
+
+											"password_authentication_enabled": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  false,
+											},
+										},
+									},
+								},
+
+								"windows_configuration": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									ForceNew: true,
+									MaxItems: 1,
+									ExactlyOneOf: []string{
+										"virtual_machine_profile.0.os_profile.0.linux_configuration",
+										"virtual_machine_profile.0.os_profile.0.windows_configuration",
+									},
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"admin_username": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"admin_password": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												Sensitive:    true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"computer_name_prefix": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringLenBetween(1, 9),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"source_image_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{
+							"virtual_machine_profile.0.source_image_id",
+							"virtual_machine_profile.0.source_image_reference",
+						},
+					},
+
+					"source_image_reference": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						ExactlyOneOf: []string{
+							"virtual_machine_profile.0.source_image_id",
+							"virtual_machine_profile.0.source_image_reference",
+						},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"publisher": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"offer": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"sku": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"version": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"vm_size": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 15,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"rank": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+				},
+			},
+		},
+
+		"platform_fault_domain_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 3),
+		},
+
+		"regular_priority_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			AtLeastOneOf: []string{
+				"regular_priority_profile",
+				"spot_priority_profile",
+			},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allocation_strategy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(fleets.RegularPriorityAllocationStrategyLowestPrice),
+						ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForRegularPriorityAllocationStrategy(), false),
+					},
+
+					"capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+
+					"minimum_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+				},
+			},
+		},
+
+		"spot_priority_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			AtLeastOneOf: []string{
+				"regular_priority_profile",
+				"spot_priority_profile",
+			},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allocation_strategy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(fleets.SpotAllocationStrategyPriceCapacityOptimized),
+						ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForSpotAllocationStrategy(), false),
+					},
+
+					"capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+
+					"eviction_policy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(fleets.EvictionPolicyDelete),
+						ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForEvictionPolicy(), false),
+					},
+
+					"maintain_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"maximum_hourly_price_per_virtual_machine": {
+						Type:         pluginsdk.TypeFloat,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      -1,
+						ValidateFunc: computeValidate.SpotMaxPrice,
+					},
+
+					"minimum_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+				},
+			},
+		},
+
+		"zones": commonschema.ZonesMultipleOptionalForceNew(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ComputeFleetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"unique_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ComputeFleetResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ComputeFleetModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, v := range model.SpotPriorityProfile {
+				if v.MinimumCapacity > v.Capacity {
+					return fmt.Errorf("`spot_priority_profile.0.minimum_capacity` must be less than or equal to `spot_priority_profile.0.capacity`")
+				}
+			}
+
+			for _, v := range model.RegularPriorityProfile {
+				if v.MinimumCapacity > v.Capacity {
+					return fmt.Errorf("`regular_priority_profile.0.minimum_capacity` must be less than or equal to `regular_priority_profile.0.capacity`")
+				}
+			}
+
+			for _, profile := range model.VirtualMachineProfile {
+				for _, osProfile := range profile.OsProfile {
+					for _, linux := range osProfile.LinuxConfiguration {
+						if linux.PasswordAuthenticationEnabled && linux.AdminPassword == "" {
+							return fmt.Errorf("`admin_password` must be specified when `password_authentication_enabled` is set to `true`")
+						}
+						if !linux.PasswordAuthenticationEnabled && len(linux.AdminSSHKeys) == 0 {
+							return fmt.Errorf("at least one `admin_ssh_keys` must be specified when `password_authentication_enabled` is set to `false`")
+						}
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ComputeFleetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureFleet.FleetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config ComputeFleetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fleets.NewFleetID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := fleets.Fleet{
+				Location: location.Normalize(config.Location),
+				Properties: &fleets.FleetProperties{
+					ComputeProfile: fleets.ComputeProfile{
+						BaseVirtualMachineProfile: expandBaseVirtualMachineProfileModel(config.VirtualMachineProfile),
+						PlatformFaultDomainCount:  pointer.To(config.PlatformFaultDomainCount),
+					},
+					RegularPriorityProfile: expandRegularPriorityProfileModel(config.RegularPriorityProfile),
+					SpotPriorityProfile:    expandSpotPriorityProfileModel(config.SpotPriorityProfile),
+					VMSizesProfile:         expandVirtualMachineSizeModel(config.VirtualMachineSize),
+				},
+				Tags: pointer.To(config.Tags),
+			}
+
+			if len(config.Zones) > 0 {
+				payload.Zones = pointer.To(config.Zones)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ComputeFleetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureFleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the admin passwords aren't returned by the API, so these are pulled from the config
+			var config ComputeFleetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ComputeFleetModel{
+				Name:              id.FleetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+				state.Zones = pointer.From(model.Zones)
+
+				if props := model.Properties; props != nil {
+					state.PlatformFaultDomainCount = pointer.From(props.ComputeProfile.PlatformFaultDomainCount)
+					state.RegularPriorityProfile = flattenRegularPriorityProfileModel(props.RegularPriorityProfile)
+					state.SpotPriorityProfile = flattenSpotPriorityProfileModel(props.SpotPriorityProfile)
+					state.UniqueId = pointer.From(props.UniqueId)
+					state.VirtualMachineProfile = flattenBaseVirtualMachineProfileModel(props.ComputeProfile.BaseVirtualMachineProfile, config.VirtualMachineProfile)
+					state.VirtualMachineSize = flattenVirtualMachineSizeModel(props.VMSizesProfile)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ComputeFleetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureFleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ComputeFleetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := existing.Model
+
+			// the admin passwords aren't returned by the API, as such the Virtual Machine profile is rebuilt from the config
+			payload.Properties.ComputeProfile.BaseVirtualMachineProfile = expandBaseVirtualMachineProfileModel(config.VirtualMachineProfile)
+
+			if metadata.ResourceData.HasChange("regular_priority_profile") {
+				payload.Properties.RegularPriorityProfile = expandRegularPriorityProfileModel(config.RegularPriorityProfile)
+			}
+
+			if metadata.ResourceData.HasChange("spot_priority_profile") {
+				payload.Properties.SpotPriorityProfile = expandSpotPriorityProfileModel(config.SpotPriorityProfile)
+			}
+
+			if metadata.ResourceData.HasChange("vm_size") {
+				payload.Properties.VMSizesProfile = expandVirtualMachineSizeModel(config.VirtualMachineSize)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ComputeFleetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureFleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandRegularPriorityProfileModel(input []RegularPriorityProfileModel) *fleets.RegularPriorityProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return &fleets.RegularPriorityProfile{
+		AllocationStrategy: pointer.To(fleets.RegularPriorityAllocationStrategy(v.AllocationStrategy)),
+		Capacity:           pointer.To(v.Capacity),
+		MinCapacity:        pointer.To(v.MinimumCapacity),
+	}
+}
+
+func flattenRegularPriorityProfileModel(input *fleets.RegularPriorityProfile) []RegularPriorityProfileModel {
+	if input == nil {
+		return []RegularPriorityProfileModel{}
+	}
+
+	return []RegularPriorityProfileModel{
+		{
+			AllocationStrategy: string(pointer.From(input.AllocationStrategy)),
+			Capacity:           pointer.From(input.Capacity),
+			MinimumCapacity:    pointer.From(input.MinCapacity),
+		},
+	}
+}
+
+func expandSpotPriorityProfileModel(input []SpotPriorityProfileModel) *fleets.SpotPriorityProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return &fleets.SpotPriorityProfile{
+		AllocationStrategy: pointer.To(fleets.SpotAllocationStrategy(v.AllocationStrategy)),
+		Capacity:           pointer.To(v.Capacity),
+		EvictionPolicy:     pointer.To(fleets.EvictionPolicy(v.EvictionPolicy)),
+		Maintain:           pointer.To(v.MaintainEnabled),
+		MaxPricePerVM:      pointer.To(v.MaximumHourlyPrice),
+		MinCapacity:        pointer.To(v.MinimumCapacity),
+	}
+}
+
+func flattenSpotPriorityProfileModel(input *fleets.SpotPriorityProfile) []SpotPriorityProfileModel {
+	if input == nil {
+		return []SpotPriorityProfileModel{}
+	}
+
+	maxPrice := float64(-1)
+	if input.MaxPricePerVM != nil {
+		maxPrice = *input.MaxPricePerVM
+	}
+
+	return []SpotPriorityProfileModel{
+		{
+			AllocationStrategy: string(pointer.From(input.AllocationStrategy)),
+			Capacity:           pointer.From(input.Capacity),
+			EvictionPolicy:     string(pointer.From(input.EvictionPolicy)),
+			MaintainEnabled:    pointer.From(input.Maintain),
+			MaximumHourlyPrice: maxPrice,
+			MinimumCapacity:    pointer.From(input.MinCapacity),
+		},
+	}
+}
+
+func expandVirtualMachineSizeModel(input []VirtualMachineSizeModel) []fleets.VMSizeProfile {
+	output := make([]fleets.VMSizeProfile, 0)
+	for _, v := range input {
+		size := fleets.VMSizeProfile{
+			Name: v.Name,
+		}
+		if v.Rank > 0 {
+			size.Rank = pointer.To(v.Rank)
+		}
+		output = append(output, size)
+	}
+
+	return output
+}
+
+func flattenVirtualMachineSizeModel(input []fleets.VMSizeProfile) []VirtualMachineSizeModel {
+	output := make([]VirtualMachineSizeModel, 0)
+	for _, v := range input {
+		output = append(output, VirtualMachineSizeModel{
+			Name: v.Name,
+			Rank: pointer.From(v.Rank),
+		})
+	}
+
+	return output
+}
+
+func expandBaseVirtualMachineProfileModel(input []VirtualMachineProfileModel) fleets.BaseVirtualMachineProfile {
+	if len(input) == 0 {
+		return fleets.BaseVirtualMachineProfile{}
+	}
+
+	v := input[0]
+	output := fleets.BaseVirtualMachineProfile{
+		NetworkProfile: &fleets.VirtualMachineScaleSetNetworkProfile{
+			// Compute Fleet uses Flexible Virtual Machine Scale Sets, which require the Network API Version to be specified
+			NetworkApiVersion:              pointer.To(fleets.NetworkApiVersionTwoZeroTwoZeroNegativeOneOneNegativeZeroOne),
+			NetworkInterfaceConfigurations: expandNetworkInterfaceModel(v.NetworkInterface),
+		},
+		OsProfile: expandOsProfileModel(v.OsProfile),
+		StorageProfile: &fleets.VirtualMachineScaleSetStorageProfile{
+			ImageReference: expandSourceImageReferenceModel(v.SourceImageId, v.SourceImageReference),
+			OsDisk:         expandOsDiskModel(v.OsDisk),
+		},
+	}
+
+	return output
+}
+
+func flattenBaseVirtualMachineProfileModel(input fleets.BaseVirtualMachineProfile, config []VirtualMachineProfileModel) []VirtualMachineProfileModel {
+	output := VirtualMachineProfileModel{}
+
+	if networkProfile := input.NetworkProfile; networkProfile != nil {
+		output.NetworkInterface = flattenNetworkInterfaceModel(networkProfile.NetworkInterfaceConfigurations)
+	}
+
+	var configOsProfile []OsProfileModel
+	if len(config) > 0 {
+		configOsProfile = config[0].OsProfile
+	}
+	output.OsProfile = flattenOsProfileModel(input.OsProfile, configOsProfile)
+
+	if storageProfile := input.StorageProfile; storageProfile != nil {
+		output.OsDisk = flattenOsDiskModel(storageProfile.OsDisk)
+		output.SourceImageId, output.SourceImageReference = flattenSourceImageReferenceModel(storageProfile.ImageReference)
+	}
+
+	return []VirtualMachineProfileModel{output}
+}
+
+func expandNetworkInterfaceModel(input []NetworkInterfaceModel) *[]fleets.VirtualMachineScaleSetNetworkConfiguration {
+	output := make([]fleets.VirtualMachineScaleSetNetworkConfiguration, 0)
+	for _, v := range input {
+		ipConfigurations := make([]fleets.VirtualMachineScaleSetIPConfiguration, 0)
+		for _, ipConfig := range v.IPConfiguration {
+			backendAddressPools := make([]fleets.SubResource, 0)
+			for _, poolId := range ipConfig.LoadBalancerBackendAddressPoolIds {
+				backendAddressPools = append(backendAddressPools, fleets.SubResource{
+					Id: pointer.To(poolId),
+				})
+			}
+
+			ipConfigurations = append(ipConfigurations, fleets.VirtualMachineScaleSetIPConfiguration{
+				Name: ipConfig.Name,
+				Properties: &fleets.VirtualMachineScaleSetIPConfigurationProperties{
+					LoadBalancerBackendAddressPools: pointer.To(backendAddressPools),
+					Primary:                         pointer.To(ipConfig.Primary),
+					Subnet: &fleets.ApiEntityReference{
+						Id: pointer.To(ipConfig.SubnetId),
+					},
+				},
+			})
+		}
+
+		networkInterface := fleets.VirtualMachineScaleSetNetworkConfiguration{
+			Name: v.Name,
+			Properties: &fleets.VirtualMachineScaleSetNetworkConfigurationProperties{
+				EnableAcceleratedNetworking: pointer.To(v.AcceleratedNetworkingEnabled),
+				EnableIPForwarding:          pointer.To(v.IPForwardingEnabled),
+				IPConfigurations:            ipConfigurations,
+				Primary:                     pointer.To(v.Primary),
+			},
+		}
+
+		if v.NetworkSecurityGroupId != "" {
+			networkInterface.Properties.NetworkSecurityGroup = &fleets.SubResource{
+				Id: pointer.To(v.NetworkSecurityGroupId),
+			}
+		}
+
+		output = append(output, networkInterface)
+	}
+
+	return &output
+}
+
+func flattenNetworkInterfaceModel(input *[]fleets.VirtualMachineScaleSetNetworkConfiguration) []NetworkInterfaceModel {
+	output := make([]NetworkInterfaceModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		networkInterface := NetworkInterfaceModel{
+			Name: v.Name,
+		}
+
+		if props := v.Properties; props != nil {
+			networkInterface.AcceleratedNetworkingEnabled = pointer.From(props.EnableAcceleratedNetworking)
+			networkInterface.IPForwardingEnabled = pointer.From(props.EnableIPForwarding)
+			networkInterface.Primary = pointer.From(props.Primary)
+
+			if props.NetworkSecurityGroup != nil {
+				networkInterface.NetworkSecurityGroupId = pointer.From(props.NetworkSecurityGroup.Id)
+			}
+
+			ipConfigurations := make([]IPConfigurationModel, 0)
+			for _, ipConfig := range props.IPConfigurations {
+				ipConfiguration := IPConfigurationModel{
+					Name:                              ipConfig.Name,
+					LoadBalancerBackendAddressPoolIds: make([]string, 0),
+				}
+
+				if ipProps := ipConfig.Properties; ipProps != nil {
+					ipConfiguration.Primary = pointer.From(ipProps.Primary)
+
+					if ipProps.Subnet != nil {
+						ipConfiguration.SubnetId = pointer.From(ipProps.Subnet.Id)
+					}
+
+					if ipProps.LoadBalancerBackendAddressPools != nil {
+						for _, pool := range *ipProps.LoadBalancerBackendAddressPools {
+							ipConfiguration.LoadBalancerBackendAddressPoolIds = append(ipConfiguration.LoadBalancerBackendAddressPoolIds, pointer.From(pool.Id))
+						}
+					}
+				}
+
+				ipConfigurations = append(ipConfigurations, ipConfiguration)
+			}
+			networkInterface.IPConfiguration = ipConfigurations
+		}
+
+		output = append(output, networkInterface)
+	}
+
+	return output
+}
+
+func expandOsDiskModel(input []OsDiskModel) *fleets.VirtualMachineScaleSetOSDisk {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &fleets.VirtualMachineScaleSetOSDisk{
+		Caching:      pointer.To(fleets.CachingTypes(v.Caching)),
+		CreateOption: fleets.DiskCreateOptionTypesFromImage,
+		ManagedDisk: &fleets.VirtualMachineScaleSetManagedDiskParameters{
+			StorageAccountType: pointer.To(fleets.StorageAccountTypes(v.StorageAccountType)),
+		},
+	}
+
+	if v.DiskSizeInGB > 0 {
+		output.DiskSizeGB = pointer.To(v.DiskSizeInGB)
+	}
+
+	return output
+}
+
+func flattenOsDiskModel(input *fleets.VirtualMachineScaleSetOSDisk) []OsDiskModel {
+	if input == nil {
+		return []OsDiskModel{}
+	}
+
+	output := OsDiskModel{
+		Caching:      string(pointer.From(input.Caching)),
+		DiskSizeInGB: pointer.From(input.DiskSizeGB),
+	}
+
+	if input.ManagedDisk != nil {
+		output.StorageAccountType = string(pointer.From(input.ManagedDisk.StorageAccountType))
+	}
+
+	return []OsDiskModel{output}
+}
+
+func expandOsProfileModel(input []OsProfileModel) *fleets.VirtualMachineScaleSetOSProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	if len(v.LinuxConfiguration) > 0 {
+		linux := v.LinuxConfiguration[0]
+		output := &fleets.VirtualMachineScaleSetOSProfile{
+			AdminUsername:      pointer.To(linux.AdminUsername),
+			ComputerNamePrefix: pointer.To(linux.ComputerNamePrefix),
+			LinuxConfiguration: &fleets.LinuxConfiguration{
+				DisablePasswordAuthentication: pointer.To(!linux.PasswordAuthenticationEnabled),
+			},
+		}
+
+		if linux.AdminPassword != "" {
+			output.AdminPassword = pointer.To(linux.AdminPassword)
+		}
+
+		if len(linux.AdminSSHKeys) > 0 {
+			publicKeys := make([]fleets.SshPublicKey, 0)
+			for _, key := range linux.AdminSSHKeys {
+				publicKeys = append(publicKeys, fleets.SshPublicKey{
+					KeyData: pointer.To(key),
+					Path:    pointer.To(fmt.Sprintf("/home/%s/.ssh/authorized_keys", linux.AdminUsername)),
+				})
+			}
+			output.LinuxConfiguration.Ssh = &fleets.SshConfiguration{
+				PublicKeys: &publicKeys,
+			}
+		}
+
+		return output
+	}
+
+	if len(v.WindowsConfiguration) > 0 {
+		windows := v.WindowsConfiguration[0]
+		return &fleets.VirtualMachineScaleSetOSProfile{
+			AdminPassword:        pointer.To(windows.AdminPassword),
+			AdminUsername:        pointer.To(windows.AdminUsername),
+			ComputerNamePrefix:   pointer.To(windows.ComputerNamePrefix),
+			WindowsConfiguration: &fleets.WindowsConfiguration{},
+		}
+	}
+
+	return nil
+}
+
+func flattenOsProfileModel(input *fleets.VirtualMachineScaleSetOSProfile, config []OsProfileModel) []OsProfileModel {
+	if input == nil {
+		return []OsProfileModel{}
+	}
+
+	output := OsProfileModel{}
+
+	if linux := input.LinuxConfiguration; linux != nil {
+		linuxConfiguration := LinuxConfigurationModel{
+			AdminSSHKeys:                  make([]string, 0),
+			AdminUsername:                 pointer.From(input.AdminUsername),
+			ComputerNamePrefix:            pointer.From(input.ComputerNamePrefix),
+			PasswordAuthenticationEnabled: !pointer.From(linux.DisablePasswordAuthentication),
+		}
+
+		if len(config) > 0 && len(config[0].LinuxConfiguration) > 0 {
+			linuxConfiguration.AdminPassword = config[0].LinuxConfiguration[0].AdminPassword
+		}
+
+		if linux.Ssh != nil && linux.Ssh.PublicKeys != nil {
+			for _, key := range *linux.Ssh.PublicKeys {
+				linuxConfiguration.AdminSSHKeys = append(linuxConfiguration.AdminSSHKeys, pointer.From(key.KeyData))
+			}
+		}
+
+		output.LinuxConfiguration = []LinuxConfigurationModel{linuxConfiguration}
+	}
+
+	if input.WindowsConfiguration != nil {
+		windowsConfiguration := WindowsConfigurationModel{
+			AdminUsername:      pointer.From(input.AdminUsername),
+			ComputerNamePrefix: pointer.From(input.ComputerNamePrefix),
+		}
+
+		if len(config) > 0 && len(config[0].WindowsConfiguration) > 0 {
+			windowsConfiguration.AdminPassword = config[0].WindowsConfiguration[0].AdminPassword
+		}
+
+		output.WindowsConfiguration = []WindowsConfigurationModel{windowsConfiguration}
+	}
+
+	return []OsProfileModel{output}
+}
+
+func expandSourceImageReferenceModel(sourceImageId string, input []SourceImageReferenceModel) *fleets.ImageReference {
+	if sourceImageId != "" {
+		return &fleets.ImageReference{
+			Id: pointer.To(sourceImageId),
+		}
+	}
+
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return &fleets.ImageReference{
+		Offer:     pointer.To(v.Offer),
+		Publisher: pointer.To(v.Publisher),
+		Sku:       pointer.To(v.Sku),
+		Version:   pointer.To(v.Version),
+	}
+}
+
+func flattenSourceImageReferenceModel(input *fleets.ImageReference) (string, []SourceImageReferenceModel) {
+	if input == nil {
+		return "", []SourceImageReferenceModel{}
+	}
+
+	if input.Id != nil && *input.Id != "" {
+		return *input.Id, []SourceImageReferenceModel{}
+	}
+
+	return "", []SourceImageReferenceModel{
+		{
+			Offer:     pointer.From(input.Offer),
+			Publisher: pointer.From(input.Publisher),
+			Sku:       pointer.From(input.Sku),
+			Version:   pointer.From(input.Version),
+		},
+	}
+}
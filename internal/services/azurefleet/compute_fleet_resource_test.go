@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azurefleet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurefleet/2024-11-01/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ComputeFleetResource struct{}
+
+func TestAccComputeFleet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.linux_configuration.0.admin_password"),
+	})
+}
+
+func TestAccComputeFleet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccComputeFleet_mixedPriority(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.mixedPriority(data, 1, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.linux_configuration.0.admin_password"),
+		{
+			Config: r.mixedPriority(data, 2, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("regular_priority_profile.0.capacity").HasValue("2"),
+				check.That(data.ResourceName).Key("spot_priority_profile.0.capacity").HasValue("3"),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.linux_configuration.0.admin_password"),
+	})
+}
+
+func (ComputeFleetResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := fleets.ParseFleetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureFleet.FleetsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ComputeFleetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_fleet" "test" {
+  name                = "acctest-fleet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  spot_priority_profile {
+    capacity = 1
+  }
+
+  vm_size {
+    name = "Standard_D2s_v3"
+  }
+
+  virtual_machine_profile {
+    %s
+  }
+}
+`, r.template(data), data.RandomInteger, r.virtualMachineProfile())
+}
+
+func (r ComputeFleetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_fleet" "import" {
+  name                = azurerm_compute_fleet.test.name
+  resource_group_name = azurerm_compute_fleet.test.resource_group_name
+  location            = azurerm_compute_fleet.test.location
+
+  spot_priority_profile {
+    capacity = 1
+  }
+
+  vm_size {
+    name = "Standard_D2s_v3"
+  }
+
+  virtual_machine_profile {
+    %s
+  }
+}
+`, r.basic(data), r.virtualMachineProfile())
+}
+
+func (r ComputeFleetResource) mixedPriority(data acceptance.TestData, regularCapacity, spotCapacity int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_fleet" "test" {
+  name                = "acctest-fleet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  regular_priority_profile {
+    allocation_strategy = "Prioritized"
+    capacity            = %d
+    minimum_capacity    = 1
+  }
+
+  spot_priority_profile {
+    allocation_strategy = "CapacityOptimized"
+    capacity            = %d
+    eviction_policy     = "Deallocate"
+    maintain_enabled    = false
+  }
+
+  vm_size {
+    name = "Standard_D2s_v3"
+    rank = 1
+  }
+
+  vm_size {
+    name = "Standard_D2as_v4"
+    rank = 2
+  }
+
+  virtual_machine_profile {
+    %s
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, regularCapacity, spotCapacity, r.virtualMachineProfile())
+}
+
+func (ComputeFleetResource) virtualMachineProfile() string {
+	return `
+    network_interface {
+      name    = "networkInterface"
+      primary = true
+
+      ip_configuration {
+        name      = "ipConfiguration"
+        primary   = true
+        subnet_id = azurerm_subnet.test.id
+      }
+    }
+
+    os_disk {
+      caching              = "ReadWrite"
+      storage_account_type = "Standard_LRS"
+    }
+
+    os_profile {
+      linux_configuration {
+        computer_name_prefix            = "prefix"
+        admin_username                  = "testadmin1234"
+        admin_password                  = "Password1234!"
+        password_authentication_enabled = true
+      }
+    }
+
+    source_image_reference {
+      publisher = "Canonical"
+      offer     = "0001-com-ubuntu-server-jammy"
+      sku       = "22_04-lts"
+      version   = "latest"
+    }
+`
+}
+
+func (ComputeFleetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fleet-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
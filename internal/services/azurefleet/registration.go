@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azurefleet
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ComputeFleetResource{},
+	}
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Azure Fleet"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Compute",
+	}
+}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/azure-fleet"
+}
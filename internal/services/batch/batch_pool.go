@@ -808,6 +808,10 @@ func expandBatchPoolVirtualMachineConfig(d *pluginsdk.ResourceData) (*pool.Virtu
 		result.OsDisk = expandBatchPoolOSDisk(v)
 	}
 
+	if v, ok := d.GetOk("os_disk"); ok {
+		result.OsDisk = expandBatchPoolOSDiskSettings(v.([]interface{}), result.OsDisk)
+	}
+
 	if v, ok := d.GetOk("security_profile"); ok {
 		result.SecurityProfile = expandBatchPoolSecurityProfile(v.([]interface{}))
 	}
@@ -860,6 +864,165 @@ func expandBatchPoolOSDisk(ref interface{}) *pool.OSDisk {
 	}
 }
 
+// expandBatchPoolOSDiskSettings merges the `os_disk` block into the OS Disk, which may already contain the ephemeral
+// placement configured via `os_disk_placement`
+func expandBatchPoolOSDiskSettings(input []interface{}, osDisk *pool.OSDisk) *pool.OSDisk {
+	if len(input) == 0 || input[0] == nil {
+		return osDisk
+	}
+
+	if osDisk == nil {
+		osDisk = &pool.OSDisk{}
+	}
+
+	item := input[0].(map[string]interface{})
+
+	if v := item["caching"].(string); v != "" {
+		osDisk.Caching = pointer.To(pool.CachingType(v))
+	}
+
+	if v := item["disk_size_gb"].(int); v > 0 {
+		osDisk.DiskSizeGB = pointer.To(int64(v))
+	}
+
+	if v := item["write_accelerator_enabled"].(bool); v {
+		osDisk.WriteAcceleratorEnabled = pointer.To(v)
+	}
+
+	storageAccountType := item["storage_account_type"].(string)
+	securityEncryptionType := item["security_encryption_type"].(string)
+	if storageAccountType != "" || securityEncryptionType != "" {
+		osDisk.ManagedDisk = &pool.ManagedDisk{}
+		if storageAccountType != "" {
+			osDisk.ManagedDisk.StorageAccountType = pointer.To(pool.StorageAccountType(storageAccountType))
+		}
+		if securityEncryptionType != "" {
+			osDisk.ManagedDisk.SecurityProfile = &pool.VMDiskSecurityProfile{
+				SecurityEncryptionType: pointer.To(pool.SecurityEncryptionTypes(securityEncryptionType)),
+			}
+		}
+	}
+
+	return osDisk
+}
+
+func flattenBatchPoolOSDisk(input *pool.OSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	storageAccountType := ""
+	securityEncryptionType := ""
+	if input.ManagedDisk != nil {
+		storageAccountType = string(pointer.From(input.ManagedDisk.StorageAccountType))
+		if input.ManagedDisk.SecurityProfile != nil {
+			securityEncryptionType = string(pointer.From(input.ManagedDisk.SecurityProfile.SecurityEncryptionType))
+		}
+	}
+
+	// the API returns an OS Disk when only `os_disk_placement` is specified, so omit the block when nothing within it is set
+	if input.Caching == nil && input.DiskSizeGB == nil && !pointer.From(input.WriteAcceleratorEnabled) && storageAccountType == "" && securityEncryptionType == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(pointer.From(input.Caching)),
+			"disk_size_gb":              int(pointer.From(input.DiskSizeGB)),
+			"security_encryption_type":  securityEncryptionType,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": pointer.From(input.WriteAcceleratorEnabled),
+		},
+	}
+}
+
+func expandBatchPoolUpgradePolicy(input []interface{}) *pool.UpgradePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	item := input[0].(map[string]interface{})
+	result := &pool.UpgradePolicy{
+		Mode: pool.UpgradeMode(item["mode"].(string)),
+	}
+
+	if v := item["automatic_os_upgrade_policy"].([]interface{}); len(v) > 0 && v[0] != nil {
+		policy := v[0].(map[string]interface{})
+		result.AutomaticOSUpgradePolicy = &pool.AutomaticOSUpgradePolicy{
+			DisableAutomaticRollback: pointer.To(!policy["automatic_rollback_enabled"].(bool)),
+			EnableAutomaticOSUpgrade: pointer.To(policy["automatic_os_upgrade_enabled"].(bool)),
+			OsRollingUpgradeDeferral: pointer.To(policy["os_rolling_upgrade_deferral_enabled"].(bool)),
+			UseRollingUpgradePolicy:  pointer.To(policy["rolling_upgrade_policy_enabled"].(bool)),
+		}
+	}
+
+	if v := item["rolling_upgrade_policy"].([]interface{}); len(v) > 0 && v[0] != nil {
+		policy := v[0].(map[string]interface{})
+		rollingUpgradePolicy := &pool.RollingUpgradePolicy{
+			EnableCrossZoneUpgrade:                pointer.To(policy["cross_zone_upgrade_enabled"].(bool)),
+			PrioritizeUnhealthyInstances:          pointer.To(policy["prioritize_unhealthy_instances_enabled"].(bool)),
+			RollbackFailedInstancesOnPolicyBreach: pointer.To(policy["rollback_failed_instances_on_policy_breach_enabled"].(bool)),
+		}
+
+		if v := policy["maximum_batch_instance_percent"].(int); v > 0 {
+			rollingUpgradePolicy.MaxBatchInstancePercent = pointer.To(int64(v))
+		}
+
+		if v := policy["maximum_unhealthy_instance_percent"].(int); v > 0 {
+			rollingUpgradePolicy.MaxUnhealthyInstancePercent = pointer.To(int64(v))
+		}
+
+		if v := policy["maximum_unhealthy_upgraded_instance_percent"].(int); v > 0 {
+			rollingUpgradePolicy.MaxUnhealthyUpgradedInstancePercent = pointer.To(int64(v))
+		}
+
+		if v := policy["pause_time_between_batches"].(string); v != "" {
+			rollingUpgradePolicy.PauseTimeBetweenBatches = pointer.To(v)
+		}
+
+		result.RollingUpgradePolicy = rollingUpgradePolicy
+	}
+
+	return result
+}
+
+func flattenBatchPoolUpgradePolicy(input *pool.UpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	automaticOSUpgradePolicy := make([]interface{}, 0)
+	if policy := input.AutomaticOSUpgradePolicy; policy != nil {
+		automaticOSUpgradePolicy = append(automaticOSUpgradePolicy, map[string]interface{}{
+			"automatic_os_upgrade_enabled":        pointer.From(policy.EnableAutomaticOSUpgrade),
+			"automatic_rollback_enabled":          !pointer.From(policy.DisableAutomaticRollback),
+			"os_rolling_upgrade_deferral_enabled": pointer.From(policy.OsRollingUpgradeDeferral),
+			"rolling_upgrade_policy_enabled":      pointer.From(policy.UseRollingUpgradePolicy),
+		})
+	}
+
+	rollingUpgradePolicy := make([]interface{}, 0)
+	if policy := input.RollingUpgradePolicy; policy != nil {
+		rollingUpgradePolicy = append(rollingUpgradePolicy, map[string]interface{}{
+			"cross_zone_upgrade_enabled":                         pointer.From(policy.EnableCrossZoneUpgrade),
+			"maximum_batch_instance_percent":                     int(pointer.From(policy.MaxBatchInstancePercent)),
+			"maximum_unhealthy_instance_percent":                 int(pointer.From(policy.MaxUnhealthyInstancePercent)),
+			"maximum_unhealthy_upgraded_instance_percent":        int(pointer.From(policy.MaxUnhealthyUpgradedInstancePercent)),
+			"pause_time_between_batches":                         pointer.From(policy.PauseTimeBetweenBatches),
+			"prioritize_unhealthy_instances_enabled":             pointer.From(policy.PrioritizeUnhealthyInstances),
+			"rollback_failed_instances_on_policy_breach_enabled": pointer.From(policy.RollbackFailedInstancesOnPolicyBreach),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                        string(input.Mode),
+			"automatic_os_upgrade_policy": automaticOSUpgradePolicy,
+			"rolling_upgrade_policy":      rollingUpgradePolicy,
+		},
+	}
+}
+
 func expandBatchPoolNodeReplacementConfig(list []interface{}) *pool.NodePlacementConfiguration {
 	if len(list) == 0 || list[0] == nil {
 		return nil
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
@@ -671,6 +672,45 @@ func resourceBatchPool() *pluginsdk.Resource {
 						string(pool.DiffDiskPlacementCacheDisk),
 					}, false),
 			},
+			"os_disk": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"caching": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForCachingType(), false),
+						},
+						"disk_size_gb": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"security_encryption_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForSecurityEncryptionTypes(), false),
+						},
+						"storage_account_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForStorageAccountType(), false),
+						},
+						"write_accelerator_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"inter_node_communication": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -721,6 +761,108 @@ func resourceBatchPool() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(pool.PossibleValuesForNodeCommunicationMode(), false),
 			},
 
+			"upgrade_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForUpgradeMode(), false),
+						},
+
+						"automatic_os_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"automatic_os_upgrade_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"automatic_rollback_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  true,
+									},
+									"os_rolling_upgrade_deferral_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"rolling_upgrade_policy_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+
+						"rolling_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"cross_zone_upgrade_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"maximum_batch_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"maximum_unhealthy_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"maximum_unhealthy_upgraded_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"pause_time_between_batches": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+									},
+									"prioritize_unhealthy_instances_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"rollback_failed_instances_on_policy_breach_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"task_scheduling_policy": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -984,6 +1126,10 @@ func resourceBatchCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		parameters.Properties.TargetNodeCommunicationMode = pointer.To(pool.NodeCommunicationMode(v.(string)))
 	}
 
+	if v, ok := d.GetOk("upgrade_policy"); ok {
+		parameters.Properties.UpgradePolicy = expandBatchPoolUpgradePolicy(v.([]interface{}))
+	}
+
 	if _, err = client.Create(ctx, id, parameters, pool.CreateOperationOptions{}); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -1310,6 +1456,10 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 					}
 					d.Set("os_disk_placement", osDiskPlacement)
 
+					if err := d.Set("os_disk", flattenBatchPoolOSDisk(config.OsDisk)); err != nil {
+						return fmt.Errorf("setting `os_disk`: %+v", err)
+					}
+
 					if config.SecurityProfile != nil {
 						d.Set("security_profile", flattenBatchPoolSecurityProfile(config.SecurityProfile))
 					}
@@ -1348,6 +1498,10 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 			d.Set("target_node_communication_mode", targetNodeCommunicationMode)
 
+			if err := d.Set("upgrade_policy", flattenBatchPoolUpgradePolicy(props.UpgradePolicy)); err != nil {
+				return fmt.Errorf("setting `upgrade_policy`: %+v", err)
+			}
+
 			if err := d.Set("network_configuration", flattenBatchPoolNetworkConfiguration(props.NetworkConfiguration)); err != nil {
 				return fmt.Errorf("setting `network_configuration`: %v", err)
 			}
@@ -734,6 +734,39 @@ func TestAccBatchPool_securityProfileWithUEFISettings(t *testing.T) {
 	})
 }
 
+func TestAccBatchPool_securityProfileConfidentialVM(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
+	r := BatchPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.securityProfileConfidentialVM(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_profile.0.security_type").HasValue("confidentialVM"),
+				check.That(data.ResourceName).Key("os_disk.0.security_encryption_type").HasValue("VMGuestStateOnly"),
+			),
+		},
+		data.ImportStep("stop_pending_resize_operation"),
+	})
+}
+
+func TestAccBatchPool_upgradePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
+	r := BatchPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.upgradePolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("upgrade_policy.0.mode").HasValue("rolling"),
+				check.That(data.ResourceName).Key("upgrade_policy.0.automatic_os_upgrade_policy.0.automatic_os_upgrade_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("upgrade_policy.0.rolling_upgrade_policy.0.maximum_batch_instance_percent").HasValue("20"),
+			),
+		},
+		data.ImportStep("stop_pending_resize_operation"),
+	})
+}
+
 func TestAccBatchPool_linuxUserAccounts(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
 	r := BatchPoolResource{}
@@ -2672,3 +2705,84 @@ resource "azurerm_batch_pool" "test" {
 }
 `, template, data.RandomString, data.RandomString)
 }
+
+func (BatchPoolResource) securityProfileConfidentialVM(data acceptance.TestData) string {
+	template := BatchPoolResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_batch_account" "test" {
+  name                = "acctestbatch%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_batch_pool" "test" {
+  name                = "acctestpool%s"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_batch_account.test.name
+  node_agent_sku_id   = "batch.node.ubuntu 22.04"
+  vm_size             = "Standard_DC2as_v5"
+  fixed_scale {
+    target_dedicated_nodes = 1
+  }
+  os_disk {
+    security_encryption_type = "VMGuestStateOnly"
+  }
+  security_profile {
+    security_type       = "confidentialVM"
+    secure_boot_enabled = true
+    vtpm_enabled        = true
+  }
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-confidential-vm-jammy"
+    sku       = "22_04-lts-cvm"
+    version   = "latest"
+  }
+}
+`, template, data.RandomString, data.RandomString)
+}
+
+func (BatchPoolResource) upgradePolicy(data acceptance.TestData) string {
+	template := BatchPoolResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_batch_account" "test" {
+  name                = "acctestbatch%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_batch_pool" "test" {
+  name                = "acctestpool%s"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_batch_account.test.name
+  node_agent_sku_id   = "batch.node.ubuntu 22.04"
+  vm_size             = "STANDARD_A1_V2"
+  fixed_scale {
+    target_dedicated_nodes = 1
+  }
+  node_placement {
+    policy = "Zonal"
+  }
+  upgrade_policy {
+    mode = "rolling"
+    automatic_os_upgrade_policy {
+      automatic_os_upgrade_enabled   = true
+      rolling_upgrade_policy_enabled = true
+    }
+    rolling_upgrade_policy {
+      cross_zone_upgrade_enabled                  = true
+      maximum_batch_instance_percent              = 20
+      maximum_unhealthy_instance_percent          = 20
+      maximum_unhealthy_upgraded_instance_percent = 20
+      pause_time_between_batches                  = "PT0S"
+    }
+  }
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, template, data.RandomString, data.RandomString)
+}
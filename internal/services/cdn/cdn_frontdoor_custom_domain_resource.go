@@ -4,6 +4,7 @@
 package cdn
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -43,6 +44,16 @@ func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
 			return err
 		}),
 
+		// when the `_dnsauth` TXT record is missing or contains an outdated token (e.g. as the token has been
+		// regenerated) a diff is raised, so that the record is recreated/refreshed during the next apply
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if diff.Id() != "" && diff.Get("dns_validation_record_enabled").(bool) && !diff.Get("dns_validation_record_up_to_date").(bool) {
+				return diff.SetNew("dns_validation_record_up_to_date", true)
+			}
+
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -65,6 +76,13 @@ func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
 				ValidateFunc: dnsValidate.ValidateDnsZoneID,
 			},
 
+			"dns_validation_record_enabled": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"dns_zone_id"},
+			},
+
 			"host_name": {
 				Type:     pluginsdk.TypeString,
 				ForceNew: true,
@@ -117,6 +135,16 @@ func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"domain_validation_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"dns_validation_record_up_to_date": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 
@@ -190,6 +218,12 @@ func resourceCdnFrontDoorCustomDomainCreate(d *pluginsdk.ResourceData, meta inte
 
 	d.SetId(id.ID())
 
+	if d.Get("dns_validation_record_enabled").(bool) {
+		if err := createFrontDoorCustomDomainValidationRecord(ctx, meta, id, dnsZone, d.Get("host_name").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceCdnFrontDoorCustomDomainRead(d, meta)
 }
 
@@ -237,10 +271,22 @@ func resourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta interf
 			return fmt.Errorf("setting `tls`: %+v", err)
 		}
 
+		validationToken := ""
 		if validationProps := props.ValidationProperties; validationProps != nil {
 			d.Set("expiration_date", validationProps.ExpirationDate)
 			d.Set("validation_token", validationProps.ValidationToken)
+			validationToken = pointer.From(validationProps.ValidationToken)
 		}
+		d.Set("domain_validation_state", string(props.DomainValidationState))
+
+		upToDate := true
+		if d.Get("dns_validation_record_enabled").(bool) && props.DomainValidationState != cdn.DomainValidationStateApproved {
+			upToDate, err = frontDoorCustomDomainValidationRecordIsUpToDate(ctx, meta, d.Get("dns_zone_id").(string), pointer.From(props.HostName), validationToken)
+			if err != nil {
+				return err
+			}
+		}
+		d.Set("dns_validation_record_up_to_date", upToDate)
 	}
 
 	return nil
@@ -309,6 +355,24 @@ func resourceCdnFrontDoorCustomDomainUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
 	}
 
+	if d.HasChanges("dns_validation_record_enabled", "dns_validation_record_up_to_date", "dns_zone_id") {
+		oldEnabled, newEnabled := d.GetChange("dns_validation_record_enabled")
+		oldDnsZone, newDnsZone := d.GetChange("dns_zone_id")
+		hostName := d.Get("host_name").(string)
+
+		if oldEnabled.(bool) && oldDnsZone.(string) != "" && (!newEnabled.(bool) || oldDnsZone.(string) != newDnsZone.(string)) {
+			if err := deleteFrontDoorCustomDomainValidationRecord(ctx, meta, oldDnsZone.(string), hostName); err != nil {
+				return err
+			}
+		}
+
+		if newEnabled.(bool) {
+			if err := createFrontDoorCustomDomainValidationRecord(ctx, meta, *id, newDnsZone.(string), hostName); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceCdnFrontDoorCustomDomainRead(d, meta)
 }
 
@@ -332,6 +396,12 @@ func resourceCdnFrontDoorCustomDomainDelete(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
 	}
 
+	if d.Get("dns_validation_record_enabled").(bool) {
+		if err := deleteFrontDoorCustomDomainValidationRecord(ctx, meta, d.Get("dns_zone_id").(string), d.Get("host_name").(string)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
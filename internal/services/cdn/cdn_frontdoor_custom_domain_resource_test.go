@@ -90,6 +90,29 @@ func TestAccCdnFrontDoorCustomDomain_complete(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorCustomDomain_dnsValidationRecord(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_custom_domain", "test")
+	r := CdnFrontDoorCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dnsValidationRecord(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domain_validation_state").HasValue("Approved"),
+			),
+		},
+		data.ImportStep("dns_validation_record_enabled"),
+		{
+			Config: r.dnsValidationRecord(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r CdnFrontDoorCustomDomainResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FrontDoorCustomDomainID(state.ID)
 	if err != nil {
@@ -185,6 +208,26 @@ resource "azurerm_cdn_frontdoor_custom_domain" "test" {
 `, template, data.RandomInteger, data.RandomString)
 }
 
+func (r CdnFrontDoorCustomDomainResource) dnsValidationRecord(data acceptance.TestData, enabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_custom_domain" "test" {
+  name                          = "acctestcustomdomain-%d"
+  cdn_frontdoor_profile_id      = azurerm_cdn_frontdoor_profile.test.id
+  dns_zone_id                   = azurerm_dns_zone.test.id
+  dns_validation_record_enabled = %t
+  host_name                     = join(".", ["%s", azurerm_dns_zone.test.name])
+
+  tls {
+    certificate_type    = "ManagedCertificate"
+    minimum_tls_version = "TLS12"
+  }
+}
+`, template, data.RandomInteger, enabled, data.RandomString)
+}
+
 // TODO: Add test case that uses pre_validated_custom_domain_resource_id
 // TODO: Add test case that uses CMK, this cannot be a test cert or a self
 // signed cert it must be an official cert from the approved list of cert
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const frontDoorCustomDomainValidationRecordTTL = 3600
+
+// frontDoorCustomDomainValidationRecordId returns the ID of the `_dnsauth` TXT record which Front Door uses to validate
+// the ownership of the Host Name, which must be within the DNS Zone
+func frontDoorCustomDomainValidationRecordId(dnsZoneId string, hostName string) (*recordsets.RecordTypeId, error) {
+	zoneId, err := zones.ParseDnsZoneID(dnsZoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneName := strings.ToLower(strings.TrimSuffix(zoneId.DnsZoneName, "."))
+	host := strings.ToLower(strings.TrimSuffix(hostName, "."))
+
+	name := "_dnsauth"
+	switch {
+	case host == zoneName:
+		// the Host Name is the apex of the DNS Zone
+	case strings.HasSuffix(host, "."+zoneName):
+		name = fmt.Sprintf("_dnsauth.%s", strings.TrimSuffix(host, "."+zoneName))
+	default:
+		return nil, fmt.Errorf("the `host_name` %q must be within the DNS Zone %q to create the validation record", hostName, zoneId.DnsZoneName)
+	}
+
+	id := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName, recordsets.RecordTypeTXT, name)
+	return &id, nil
+}
+
+// createFrontDoorCustomDomainValidationRecord creates (or refreshes) the `_dnsauth` TXT record using the current
+// validation token and then waits for Front Door to approve the Custom Domain
+func createFrontDoorCustomDomainValidationRecord(ctx context.Context, meta interface{}, id parse.FrontDoorCustomDomainId, dnsZoneId string, hostName string) error {
+	recordSetsClient := meta.(*clients.Client).Dns.RecordSets
+
+	recordId, err := frontDoorCustomDomainValidationRecordId(dnsZoneId, hostName)
+	if err != nil {
+		return err
+	}
+
+	token, err := waitForFrontDoorCustomDomainValidationToken(ctx, meta, id)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		// the domain has already been approved, so there's nothing to validate
+		return nil
+	}
+
+	payload := recordsets.RecordSet{
+		Properties: &recordsets.RecordSetProperties{
+			TTL: pointer.To(int64(frontDoorCustomDomainValidationRecordTTL)),
+			TXTRecords: &[]recordsets.TxtRecord{
+				{
+					Value: &[]string{token},
+				},
+			},
+		},
+	}
+	if _, err := recordSetsClient.CreateOrUpdate(ctx, *recordId, payload, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating the validation record %s for %s: %+v", recordId, id, err)
+	}
+
+	return waitForFrontDoorCustomDomainApproval(ctx, meta, id)
+}
+
+func deleteFrontDoorCustomDomainValidationRecord(ctx context.Context, meta interface{}, dnsZoneId string, hostName string) error {
+	recordSetsClient := meta.(*clients.Client).Dns.RecordSets
+
+	recordId, err := frontDoorCustomDomainValidationRecordId(dnsZoneId, hostName)
+	if err != nil {
+		return err
+	}
+
+	if resp, err := recordSetsClient.Delete(ctx, *recordId, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("deleting the validation record %s: %+v", recordId, err)
+	}
+
+	return nil
+}
+
+// frontDoorCustomDomainValidationRecordIsUpToDate returns whether the `_dnsauth` TXT record exists and contains the
+// current validation token
+func frontDoorCustomDomainValidationRecordIsUpToDate(ctx context.Context, meta interface{}, dnsZoneId string, hostName string, token string) (bool, error) {
+	recordSetsClient := meta.(*clients.Client).Dns.RecordSets
+
+	recordId, err := frontDoorCustomDomainValidationRecordId(dnsZoneId, hostName)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := recordSetsClient.Get(ctx, *recordId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving the validation record %s: %+v", recordId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.TXTRecords != nil {
+		for _, record := range *model.Properties.TXTRecords {
+			if strings.Join(pointer.From(record.Value), "") == token {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// waitForFrontDoorCustomDomainValidationToken waits for Front Door to generate the validation token, returning an
+// empty token if the Custom Domain has already been approved
+func waitForFrontDoorCustomDomainValidationToken(ctx context.Context, meta interface{}, id parse.FrontDoorCustomDomainId) (string, error) {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return "", fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	token := ""
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Available", string(cdn.DomainValidationStateApproved)},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			props := resp.AFDDomainProperties
+			if props == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			if props.DomainValidationState == cdn.DomainValidationStateApproved {
+				return resp, string(cdn.DomainValidationStateApproved), nil
+			}
+
+			if props.ValidationProperties != nil && pointer.From(props.ValidationProperties.ValidationToken) != "" {
+				token = *props.ValidationProperties.ValidationToken
+				return resp, "Available", nil
+			}
+
+			return resp, "Pending", nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return "", fmt.Errorf("waiting for the validation token of %s to be generated: %+v", id, err)
+	}
+
+	return token, nil
+}
+
+func waitForFrontDoorCustomDomainApproval(ctx context.Context, meta interface{}, id parse.FrontDoorCustomDomainId) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to be approved..", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			"",
+			string(cdn.DomainValidationStateUnknown),
+			string(cdn.DomainValidationStateSubmitting),
+			string(cdn.DomainValidationStatePending),
+			string(cdn.DomainValidationStatePendingRevalidation),
+			string(cdn.DomainValidationStateRefreshingValidationToken),
+		},
+		Target: []string{
+			string(cdn.DomainValidationStateApproved),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.AFDDomainProperties == nil {
+				return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			return resp, string(resp.AFDDomainProperties.DomainValidationState), nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the domain validation of %s to be approved: %+v", id, err)
+	}
+
+	return nil
+}
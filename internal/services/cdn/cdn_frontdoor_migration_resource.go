@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-09-01/profiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
+	waf "github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2025-03-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceCdnFrontDoorMigration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorMigrationCreate,
+		Read:   resourceCdnFrontDoorMigrationRead,
+		Update: resourceCdnFrontDoorMigrationUpdate,
+		Delete: resourceCdnFrontDoorMigrationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(3 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := profiles.ParseProfileID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorName,
+			},
+
+			"frontdoor_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: frontdoors.ValidateFrontDoorID,
+			},
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(profiles.SkuNamePremiumAzureFrontDoor),
+					string(profiles.SkuNameStandardAzureFrontDoor),
+				}, false),
+			},
+
+			"firewall_policy_mapping": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"frontdoor_firewall_policy_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: waf.ValidateFrontDoorWebApplicationFirewallPolicyID,
+						},
+
+						"cdn_frontdoor_firewall_policy_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: waf.ValidateFrontDoorWebApplicationFirewallPolicyID,
+						},
+					},
+				},
+			},
+
+			"commit_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resource_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// Once committed the Classic Front Door is removed, so the migration cannot be reverted...
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if diff.Id() == "" {
+					return nil
+				}
+
+				if oldValue, newValue := diff.GetChange("commit_enabled"); oldValue.(bool) && !newValue.(bool) {
+					return fmt.Errorf("`commit_enabled` cannot be disabled once the migration has been committed")
+				}
+
+				return nil
+			}),
+		),
+	}
+}
+
+func resourceCdnFrontDoorMigrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorMigrationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	frontDoorId, err := frontdoors.ParseFrontDoorID(d.Get("frontdoor_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the migrated Front Door Profile is always created within the Resource Group of the Classic Front Door
+	id := profiles.NewProfileID(frontDoorId.SubscriptionId, frontDoorId.ResourceGroupName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_migration", id.ID())
+	}
+
+	payload := profiles.MigrationParameters{
+		ClassicResourceReference: profiles.ResourceReference{
+			Id: pointer.To(frontDoorId.ID()),
+		},
+		MigrationWebApplicationFirewallMappings: expandCdnFrontDoorMigrationFirewallPolicyMappings(d.Get("firewall_policy_mapping").([]interface{})),
+		ProfileName:                             id.ProfileName,
+		Sku: profiles.Sku{
+			Name: pointer.To(profiles.SkuName(d.Get("sku_name").(string))),
+		},
+	}
+
+	resourceGroupId := commonids.NewResourceGroupID(id.SubscriptionId, id.ResourceGroupName)
+	if err := client.MigrateThenPoll(ctx, resourceGroupId, payload); err != nil {
+		return fmt.Errorf("migrating %s to %s: %+v", frontDoorId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("commit_enabled").(bool) {
+		if err := client.MigrationCommitThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("committing the migration of %s to %s: %+v", frontDoorId, id, err)
+		}
+	}
+
+	return resourceCdnFrontDoorMigrationRead(d, meta)
+}
+
+func resourceCdnFrontDoorMigrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorMigrationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.ProfileName)
+
+	if model := resp.Model; model != nil {
+		d.Set("sku_name", string(pointer.From(model.Sku.Name)))
+
+		resourceState := ""
+		if props := model.Properties; props != nil {
+			resourceState = string(pointer.From(props.ResourceState))
+		}
+		d.Set("resource_state", resourceState)
+		d.Set("commit_enabled", cdnFrontDoorMigrationIsCommitted(resourceState))
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorMigrationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorMigrationsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("commit_enabled") && d.Get("commit_enabled").(bool) {
+		if err := client.MigrationCommitThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("committing the migration to %s: %+v", id, err)
+		}
+	}
+
+	return resourceCdnFrontDoorMigrationRead(d, meta)
+}
+
+func resourceCdnFrontDoorMigrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorMigrationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	resourceState := ""
+	if model := resp.Model; model != nil && model.Properties != nil {
+		resourceState = string(pointer.From(model.Properties.ResourceState))
+	}
+
+	// once committed the migrated Profile is a regular Front Door Profile which should be managed using the
+	// `azurerm_cdn_frontdoor_profile` resource, so is intentionally left in place
+	if cdnFrontDoorMigrationIsCommitted(resourceState) {
+		log.Printf("[DEBUG] the migration to %s has been committed - removing from state", *id)
+		return nil
+	}
+
+	// aborting the migration removes the migrated Profile, leaving the Classic Front Door untouched
+	if err := client.MigrationAbortThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("aborting the migration to %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func cdnFrontDoorMigrationIsCommitted(resourceState string) bool {
+	switch profiles.ProfileResourceState(resourceState) {
+	case profiles.ProfileResourceStateMigrating, profiles.ProfileResourceStatePendingMigrationCommit, profiles.ProfileResourceStateAbortingMigration:
+		return false
+	}
+
+	return true
+}
+
+func expandCdnFrontDoorMigrationFirewallPolicyMappings(input []interface{}) *[]profiles.MigrationWebApplicationFirewallMapping {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]profiles.MigrationWebApplicationFirewallMapping, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		results = append(results, profiles.MigrationWebApplicationFirewallMapping{
+			MigratedFrom: &profiles.ResourceReference{
+				Id: pointer.To(v["frontdoor_firewall_policy_id"].(string)),
+			},
+			MigratedTo: &profiles.ResourceReference{
+				Id: pointer.To(v["cdn_frontdoor_firewall_policy_id"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
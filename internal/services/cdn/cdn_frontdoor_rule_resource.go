@@ -111,9 +111,12 @@ func resourceCdnFrontDoorRule() *pluginsdk.Resource {
 									// NOTE: it is valid for the destination hostname to be an empty string.
 									// Leave blank to preserve the incoming host. Issue #18249
 									"destination_hostname": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringLenBetween(0, 2048),
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(0, 2048),
+											validate.CdnFrontDoorActionServerVariables,
+										),
 									},
 
 									// NOTE: it is valid for the query string to be an empty string.
@@ -129,10 +132,13 @@ func resourceCdnFrontDoorRule() *pluginsdk.Resource {
 									// NOTE: it is valid for the destination fragment to be an empty string.
 									// Leave blank to preserve the incoming fragment. Issue #18249
 									"destination_fragment": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										Default:      "",
-										ValidateFunc: validation.StringLenBetween(0, 1024),
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										Default:  "",
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(0, 1024),
+											validate.CdnFrontDoorActionServerVariables,
+										),
 									},
 								},
 							},
@@ -152,9 +158,12 @@ func resourceCdnFrontDoorRule() *pluginsdk.Resource {
 									},
 
 									"destination": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringIsNotEmpty,
+											validate.CdnFrontDoorActionServerVariables,
+										),
 									},
 
 									"preserve_unmatched_path": {
@@ -186,9 +195,12 @@ func resourceCdnFrontDoorRule() *pluginsdk.Resource {
 									},
 
 									"value": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringIsNotEmpty,
+											validate.CdnFrontDoorActionServerVariables,
+										),
 									},
 								},
 							},
@@ -214,9 +226,12 @@ func resourceCdnFrontDoorRule() *pluginsdk.Resource {
 									},
 
 									"value": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringIsNotEmpty,
+											validate.CdnFrontDoorActionServerVariables,
+										),
 									},
 								},
 							},
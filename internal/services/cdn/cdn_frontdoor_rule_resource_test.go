@@ -78,6 +78,20 @@ func TestAccCdnFrontDoorRule_urlRedirectAction(t *testing.T) {
 	})
 }
 
+func TestAccCdnFrontDoorRule_serverVariables(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_rule", "test")
+	r := CdnFrontDoorRuleResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.serverVariables(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccCdnFrontDoorRule_originGroupIdOptional(t *testing.T) {
 	// NOTE: Regression test case for issue #18889
 	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_rule", "test")
@@ -655,6 +669,51 @@ resource "azurerm_cdn_frontdoor_rule" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r CdnFrontDoorRuleResource) serverVariables(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_cdn_frontdoor_rule" "test" {
+  depends_on = [azurerm_cdn_frontdoor_origin_group.test, azurerm_cdn_frontdoor_origin.test]
+
+  name                      = "accTestRule%d"
+  cdn_frontdoor_rule_set_id = azurerm_cdn_frontdoor_rule_set.test.id
+
+  order = 1
+
+  conditions {
+    url_path_condition {
+      match_values     = ["legacy/"]
+      negate_condition = false
+      operator         = "BeginsWith"
+    }
+  }
+
+  actions {
+    url_redirect_action {
+      redirect_type        = "Moved"
+      redirect_protocol    = "Https"
+      destination_hostname = "{hostname}"
+      destination_path     = "/archive/{url_path:seg1}"
+      query_string         = "clientIp={client_ip}&region={geo_country}"
+      destination_fragment = "{url_path:0:5}"
+    }
+
+    response_header_action {
+      header_action = "Append"
+      header_name   = "X-Client-Port"
+      value         = "{client_port}"
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r CdnFrontDoorRuleResource) originGroupIdOptional(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
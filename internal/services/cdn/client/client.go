@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/profiles"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/rulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/securitypolicies"
+	profileMigrations "github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-09-01/profiles"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-09-01/rules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2025-04-15/afdcustomdomains"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2025-06-01/afdendpoints"
@@ -28,6 +29,7 @@ type Client struct {
 	FrontDoorRoutesClient           *cdnFrontDoorSdk.RoutesClient
 	FrontDoorRulesClient            *rules.RulesClient
 	FrontDoorProfilesClient         *profiles.ProfilesClient
+	FrontDoorMigrationsClient       *profileMigrations.ProfilesClient
 	FrontDoorSecretsClient          *cdnFrontDoorSdk.SecretsClient
 	FrontDoorRuleSetsClient         *rulesets.RuleSetsClient
 	FrontDoorFirewallPoliciesClient *waf.WebApplicationFirewallPoliciesClient
@@ -81,6 +83,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(frontDoorProfilesClient.Client, o.Authorizers.ResourceManager)
 
+	// NOTE: aborting a migration is only available from API Version 2024-09-01
+	frontDoorMigrationsClient, err := profileMigrations.NewProfilesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Migrations ProfilesClient: %+v", err)
+	}
+	o.Configure(frontDoorMigrationsClient.Client, o.Authorizers.ResourceManager)
+
 	frontDoorPolicySecretsClient := cdnFrontDoorSdk.NewSecretsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&frontDoorPolicySecretsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -115,6 +124,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		FrontDoorRoutesClient:           &frontDoorRoutesClient,
 		FrontDoorRulesClient:            frontDoorRulesClient,
 		FrontDoorProfilesClient:         frontDoorProfilesClient,
+		FrontDoorMigrationsClient:       frontDoorMigrationsClient,
 		FrontDoorSecretsClient:          &frontDoorPolicySecretsClient,
 		FrontDoorRuleSetsClient:         frontDoorRuleSetsClient,
 		FrontDoorFirewallPoliciesClient: &frontDoorFirewallPoliciesClient,
@@ -62,6 +62,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cdn_frontdoor_custom_domain_association": resourceCdnFrontDoorCustomDomainAssociation(),
 		"azurerm_cdn_frontdoor_endpoint":                  resourceCdnFrontDoorEndpoint(),
 		"azurerm_cdn_frontdoor_firewall_policy":           resourceCdnFrontDoorFirewallPolicy(),
+		"azurerm_cdn_frontdoor_migration":                 resourceCdnFrontDoorMigration(),
 		"azurerm_cdn_frontdoor_origin":                    resourceCdnFrontDoorOrigin(),
 		"azurerm_cdn_frontdoor_origin_group":              resourceCdnFrontDoorOriginGroup(),
 		"azurerm_cdn_frontdoor_profile":                   resourceCdnFrontDoorProfile(),
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestCdnFrontDoorActionServerVariables(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// Empty
+			Input: "",
+			Valid: true,
+		},
+
+		{
+			// No Server Variables
+			Input: "/images/example.png",
+			Valid: true,
+		},
+
+		{
+			// Entire Variable
+			Input: "clientIp={client_ip}",
+			Valid: true,
+		},
+
+		{
+			// Offset
+			Input: "{client_ip:3}",
+			Valid: true,
+		},
+
+		{
+			// Offset And Length
+			Input: "{client_ip:4:3}",
+			Valid: true,
+		},
+
+		{
+			// Negative Offset
+			Input: "{hostname:-3}",
+			Valid: true,
+		},
+
+		{
+			// Path Segment
+			Input: "/archive{url_path:seg1}",
+			Valid: true,
+		},
+
+		{
+			// Multiple Variables
+			Input: "{request_scheme}://{hostname}{url_path:seg0}",
+			Valid: true,
+		},
+
+		{
+			// Unsupported Variable Is Passed Through Literally
+			Input: "{example:abc}",
+			Valid: true,
+		},
+
+		{
+			// Invalid Offset
+			Input: "{client_ip:abc}",
+			Valid: false,
+		},
+
+		{
+			// Invalid Length
+			Input: "{client_ip:4:abc}",
+			Valid: false,
+		},
+
+		{
+			// Too Many Parts
+			Input: "{client_ip:1:2:3}",
+			Valid: false,
+		},
+
+		{
+			// Invalid Path Segment
+			Input: "{url_path:segment}",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := CdnFrontDoorActionServerVariables(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 )
 
+var serverVariableRegex = regexp.MustCompile(`\{([a-z_]+)(:[^{}]*)?\}`)
+
 func CdnFrontDoorRouteName(i interface{}, k string) (_ []string, errors []error) {
 	if m, regexErrs := validate.RegExHelper(i, k, `^[\da-zA-Z][-\da-zA-Z]{0,88}[\da-zA-Z]$`); !m {
 		return nil, append(regexErrs, fmt.Errorf(`%q must be between 2 and 90 characters begin with a letter or number, end with a letter or number and may contain only letters, numbers or hyphens, got %q`, k, i))
@@ -139,7 +142,7 @@ func CdnFrontDoorUrlRedirectActionQueryString(i interface{}, k string) (_ []stri
 		}
 	}
 
-	return nil, nil
+	return CdnFrontDoorActionServerVariables(v, k)
 }
 
 func CdnFrontDoorUrlRedirectActionDestinationPath(i interface{}, k string) (_ []string, errors []error) {
@@ -149,10 +152,67 @@ func CdnFrontDoorUrlRedirectActionDestinationPath(i interface{}, k string) (_ []
 	}
 
 	if v != "" {
-		if !strings.HasPrefix(v, "/") {
-			return nil, []error{fmt.Errorf("'url_redirect_action' is invalid: %q must begin with a '/', got %q. If you are trying to preserve the incoming path leave the 'destination_path' value empty", k, v)}
+		// the path may also begin with a server variable (e.g. `{url_path}`) which evaluates to a path with a leading '/'
+		if !strings.HasPrefix(v, "/") && !strings.HasPrefix(v, "{") {
+			return nil, []error{fmt.Errorf("'url_redirect_action' is invalid: %q must begin with a '/' or a server variable, got %q. If you are trying to preserve the incoming path leave the 'destination_path' value empty", k, v)}
 		}
 	}
 
-	return nil, nil
+	return CdnFrontDoorActionServerVariables(v, k)
+}
+
+// CdnFrontDoorActionServerVariables validates the format of any server variables (e.g. `{client_ip}`, `{client_ip:3}`,
+// `{client_ip:4:3}` or `{url_path:seg1}`) contained in the value of a rule action. Values wrapped in braces which
+// aren't a supported server variable are passed through literally by the service, so are not validated here.
+func CdnFrontDoorActionServerVariables(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	supportedVariables := map[string]struct{}{
+		"socket_ip":      {},
+		"client_ip":      {},
+		"client_port":    {},
+		"hostname":       {},
+		"geo_country":    {},
+		"http_method":    {},
+		"http_version":   {},
+		"query_string":   {},
+		"request_scheme": {},
+		"request_uri":    {},
+		"ssl_protocol":   {},
+		"server_port":    {},
+		"url_path":       {},
+	}
+
+	for _, match := range serverVariableRegex.FindAllStringSubmatch(v, -1) {
+		name := match[1]
+		if _, ok := supportedVariables[name]; !ok || match[2] == "" {
+			continue
+		}
+
+		capture := strings.TrimPrefix(match[2], ":")
+		if name == "url_path" && strings.HasPrefix(capture, "seg") {
+			if _, err := strconv.Atoi(strings.TrimPrefix(capture, "seg")); err != nil {
+				errors = append(errors, fmt.Errorf("%q contains an invalid server variable %q: the segment of the `url_path` must be specified in the format `{url_path:seg<index>}`", k, match[0]))
+			}
+			continue
+		}
+
+		parts := strings.Split(capture, ":")
+		if len(parts) > 2 {
+			errors = append(errors, fmt.Errorf("%q contains an invalid server variable %q: expected the format `{variable}`, `{variable:offset}` or `{variable:offset:length}`", k, match[0]))
+			continue
+		}
+
+		for _, part := range parts {
+			if _, err := strconv.Atoi(part); err != nil {
+				errors = append(errors, fmt.Errorf("%q contains an invalid server variable %q: the offset and length must be integers", k, match[0]))
+				break
+			}
+		}
+	}
+
+	return nil, errors
 }
@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = ChaosStudioExperimentRunTriggerResource{}
+
+const (
+	experimentExecutionStatusCancelled = "Cancelled"
+	experimentExecutionStatusFailed    = "Failed"
+	experimentExecutionStatusSuccess   = "Success"
+)
+
+type ChaosStudioExperimentRunTriggerResource struct{}
+
+type ChaosStudioExperimentRunTriggerResourceSchema struct {
+	ChaosStudioExperimentId string            `tfschema:"chaos_studio_experiment_id"`
+	Triggers                map[string]string `tfschema:"triggers"`
+	WaitForCompletion       bool              `tfschema:"wait_for_completion"`
+
+	ExecutionName string `tfschema:"execution_name"`
+	StartedAt     string `tfschema:"started_at"`
+	Status        string `tfschema:"status"`
+	StoppedAt     string `tfschema:"stopped_at"`
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) ModelObject() interface{} {
+	return &ChaosStudioExperimentRunTriggerResourceSchema{}
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return experiments.ValidateExecutionID
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) ResourceType() string {
+	return "azurerm_chaos_studio_experiment_run_trigger"
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"chaos_studio_experiment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: experiments.ValidateExperimentID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"wait_for_completion": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"execution_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"started_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"stopped_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.V20231101.Experiments
+
+			var config ChaosStudioExperimentRunTriggerResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			experimentId, err := experiments.ParseExperimentID(config.ChaosStudioExperimentId)
+			if err != nil {
+				return err
+			}
+
+			// the start operation doesn't return the execution, so it's identified as the one which didn't exist beforehand
+			existing, err := client.ListAllExecutionsComplete(ctx, *experimentId)
+			if err != nil {
+				return fmt.Errorf("listing executions for %s: %+v", experimentId, err)
+			}
+			existingExecutions := make(map[string]struct{})
+			for _, item := range existing.Items {
+				existingExecutions[strings.ToLower(pointer.From(item.Name))] = struct{}{}
+			}
+
+			if err := client.StartThenPoll(ctx, *experimentId); err != nil {
+				return fmt.Errorf("starting %s: %+v", experimentId, err)
+			}
+
+			executions, err := client.ListAllExecutionsComplete(ctx, *experimentId)
+			if err != nil {
+				return fmt.Errorf("listing executions for %s: %+v", experimentId, err)
+			}
+
+			var latest *experiments.ExperimentExecution
+			for _, item := range executions.Items {
+				if _, ok := existingExecutions[strings.ToLower(pointer.From(item.Name))]; ok || item.Name == nil {
+					continue
+				}
+				if latest == nil || experimentExecutionStartedAt(item) > experimentExecutionStartedAt(*latest) {
+					latest = pointer.To(item)
+				}
+			}
+			if latest == nil {
+				return fmt.Errorf("starting %s: the new execution could not be found", experimentId)
+			}
+
+			id := experiments.NewExecutionID(experimentId.SubscriptionId, experimentId.ResourceGroupName, experimentId.ExperimentName, *latest.Name)
+			metadata.SetID(id)
+
+			if config.WaitForCompletion {
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return fmt.Errorf("internal-error: context had no deadline")
+				}
+
+				stateConf := &pluginsdk.StateChangeConf{
+					Pending:    []string{"InProgress"},
+					Target:     []string{experimentExecutionStatusSuccess, experimentExecutionStatusFailed, experimentExecutionStatusCancelled},
+					Refresh:    chaosStudioExperimentExecutionRefreshFunc(ctx, client, id),
+					MinTimeout: 30 * time.Second,
+					Timeout:    time.Until(deadline),
+				}
+
+				result, err := stateConf.WaitForStateContext(ctx)
+				if err != nil {
+					return fmt.Errorf("waiting for %s to finish: %+v", id, err)
+				}
+
+				if execution, ok := result.(*experiments.ExperimentExecution); ok && execution.Properties != nil {
+					if status := pointer.From(execution.Properties.Status); !strings.EqualFold(status, experimentExecutionStatusSuccess) {
+						return fmt.Errorf("%s finished with the status %q", id, status)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.V20231101.Experiments
+
+			id, err := experiments.ParseExecutionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetExecution(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// `triggers` and `wait_for_completion` aren't returned by the API, so they're retained from the state
+			var state ChaosStudioExperimentRunTriggerResourceSchema
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.ChaosStudioExperimentId = experiments.NewExperimentID(id.SubscriptionId, id.ResourceGroupName, id.ExperimentName).ID()
+			state.ExecutionName = id.ExecutionId
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.StartedAt = pointer.From(model.Properties.StartedAt)
+				state.Status = pointer.From(model.Properties.Status)
+				state.StoppedAt = pointer.From(model.Properties.StoppedAt)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunTriggerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.V20231101.Experiments
+
+			id, err := experiments.ParseExecutionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetExecution(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// executions can't be deleted, so an execution which is still in progress is cancelled and anything else is only removed from the state
+			if model := resp.Model; model != nil && model.Properties != nil && !isChaosStudioExperimentExecutionFinished(pointer.From(model.Properties.Status)) {
+				experimentId := experiments.NewExperimentID(id.SubscriptionId, id.ResourceGroupName, id.ExperimentName)
+				if err := client.CancelThenPoll(ctx, experimentId); err != nil {
+					return fmt.Errorf("cancelling %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func chaosStudioExperimentExecutionRefreshFunc(ctx context.Context, client *experiments.ExperimentsClient, id experiments.ExecutionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetExecution(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		status := pointer.From(resp.Model.Properties.Status)
+		for _, v := range []string{experimentExecutionStatusSuccess, experimentExecutionStatusFailed, experimentExecutionStatusCancelled} {
+			if strings.EqualFold(status, v) {
+				return resp.Model, v, nil
+			}
+		}
+
+		return resp.Model, "InProgress", nil
+	}
+}
+
+func isChaosStudioExperimentExecutionFinished(status string) bool {
+	return strings.EqualFold(status, experimentExecutionStatusSuccess) || strings.EqualFold(status, experimentExecutionStatusFailed) || strings.EqualFold(status, experimentExecutionStatusCancelled)
+}
+
+func experimentExecutionStartedAt(input experiments.ExperimentExecution) string {
+	if input.Properties == nil {
+		return ""
+	}
+	return pointer.From(input.Properties.StartedAt)
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ChaosStudioExperimentRunTriggerTestResource struct{}
+
+func TestAccChaosStudioExperimentRunTrigger_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment_run_trigger", "test")
+	r := ChaosStudioExperimentRunTriggerTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Success"),
+			),
+		},
+		data.ImportStep("triggers", "wait_for_completion"),
+		{
+			Config: r.basic(data, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Success"),
+			),
+		},
+		data.ImportStep("triggers", "wait_for_completion"),
+	})
+}
+
+func (r ChaosStudioExperimentRunTriggerTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := experiments.ParseExecutionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ChaosStudio.V20231101.Experiments.GetExecution(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ChaosStudioExperimentRunTriggerTestResource) basic(data acceptance.TestData, run string) string {
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  location            = azurerm_resource_group.test.location
+  name                = "acctestcse-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selectors {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  steps {
+    name = "acctestcse-${var.random_string}"
+    branch {
+      name = "acctestcse-${var.random_string}"
+      actions {
+        urn         = "urn:csci:microsoft:chaosStudio:TimedDelay/1.0"
+        action_type = "delay"
+        duration    = "PT1M"
+      }
+    }
+  }
+}
+
+resource "azurerm_chaos_studio_experiment_run_trigger" "test" {
+  chaos_studio_experiment_id = azurerm_chaos_studio_experiment.test.id
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, ChaosStudioExperimentTestResource{}.templateVM(data), run)
+}
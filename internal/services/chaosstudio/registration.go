@@ -37,6 +37,7 @@ func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
 		ChaosStudioCapabilityResource{},
 		ChaosStudioExperimentResource{},
+		ChaosStudioExperimentRunTriggerResource{},
 	}
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
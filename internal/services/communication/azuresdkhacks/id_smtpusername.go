@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&SmtpUsernameId{})
+}
+
+var _ resourceids.ResourceId = &SmtpUsernameId{}
+
+// SmtpUsernameId is a struct representing the Resource ID for a SMTP Username
+type SmtpUsernameId struct {
+	SubscriptionId           string
+	ResourceGroupName        string
+	CommunicationServiceName string
+	SmtpUsernameName         string
+}
+
+// NewSmtpUsernameID returns a new SmtpUsernameId struct
+func NewSmtpUsernameID(subscriptionId string, resourceGroupName string, communicationServiceName string, smtpUsernameName string) SmtpUsernameId {
+	return SmtpUsernameId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroupName:        resourceGroupName,
+		CommunicationServiceName: communicationServiceName,
+		SmtpUsernameName:         smtpUsernameName,
+	}
+}
+
+// ParseSmtpUsernameID parses 'input' into a SmtpUsernameId
+func ParseSmtpUsernameID(input string) (*SmtpUsernameId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SmtpUsernameId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SmtpUsernameId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseSmtpUsernameIDInsensitively parses 'input' case-insensitively into a SmtpUsernameId
+// note: this method should only be used for API response data and not user input
+func ParseSmtpUsernameIDInsensitively(input string) (*SmtpUsernameId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SmtpUsernameId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SmtpUsernameId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *SmtpUsernameId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.CommunicationServiceName, ok = input.Parsed["communicationServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "communicationServiceName", input)
+	}
+
+	if id.SmtpUsernameName, ok = input.Parsed["smtpUsernameName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "smtpUsernameName", input)
+	}
+
+	return nil
+}
+
+// ValidateSmtpUsernameID checks that 'input' can be parsed as a SMTP Username ID
+func ValidateSmtpUsernameID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSmtpUsernameID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted SMTP Username ID
+func (id SmtpUsernameId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Communication/communicationServices/%s/smtpUsernames/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.CommunicationServiceName, id.SmtpUsernameName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this SMTP Username ID
+func (id SmtpUsernameId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCommunication", "Microsoft.Communication", "Microsoft.Communication"),
+		resourceids.StaticSegment("staticCommunicationServices", "communicationServices", "communicationServices"),
+		resourceids.UserSpecifiedSegment("communicationServiceName", "communicationServiceName"),
+		resourceids.StaticSegment("staticSmtpUsernames", "smtpUsernames", "smtpUsernames"),
+		resourceids.UserSpecifiedSegment("smtpUsernameName", "smtpUsernameName"),
+	}
+}
+
+// String returns a human-readable description of this SMTP Username ID
+func (id SmtpUsernameId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Communication Service Name: %q", id.CommunicationServiceName),
+		fmt.Sprintf("SMTP Username Name: %q", id.SmtpUsernameName),
+	}
+	return fmt.Sprintf("SMTP Username (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not yet include the SMTP Usernames API, which is only
+// available from API Version `2025-05-01-preview`. Once the SDK supports a version of the Communication API which
+// includes SMTP Usernames, this can be removed.
+
+const smtpUsernameApiVersion = "2025-05-01-preview"
+
+type SmtpUsernamesClient struct {
+	client *resourcemanager.Client
+}
+
+func NewSmtpUsernamesWorkaroundClient(client *communicationservices.CommunicationServicesClient) SmtpUsernamesClient {
+	return SmtpUsernamesClient{
+		client: client.Client,
+	}
+}
+
+type SmtpUsernameResource struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *SmtpUsernameProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+type SmtpUsernameProperties struct {
+	EntraApplicationId string `json:"entraApplicationId"`
+	TenantId           string `json:"tenantId"`
+	Username           string `json:"username"`
+}
+
+type SmtpUsernameOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *SmtpUsernameResource
+}
+
+type smtpUsernameOperationOptions struct{}
+
+func (o smtpUsernameOperationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o smtpUsernameOperationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o smtpUsernameOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", smtpUsernameApiVersion)
+	return &out
+}
+
+func (c SmtpUsernamesClient) Get(ctx context.Context, id SmtpUsernameId) (result SmtpUsernameOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: smtpUsernameOperationOptions{},
+		Path:          id.ID(),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model SmtpUsernameResource
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c SmtpUsernamesClient) CreateOrUpdate(ctx context.Context, id SmtpUsernameId, input SmtpUsernameResource) (result SmtpUsernameOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: smtpUsernameOperationOptions{},
+		Path:          id.ID(),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model SmtpUsernameResource
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c SmtpUsernamesClient) Delete(ctx context.Context, id SmtpUsernameId) (result SmtpUsernameOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		OptionsObject: smtpUsernameOperationOptions{},
+		Path:          id.ID(),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
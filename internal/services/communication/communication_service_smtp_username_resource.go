@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package communication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = CommunicationServiceSmtpUsernameResource{}
+
+type CommunicationServiceSmtpUsernameResource struct{}
+
+type CommunicationServiceSmtpUsernameModel struct {
+	Name                   string `tfschema:"name"`
+	CommunicationServiceId string `tfschema:"communication_service_id"`
+	Username               string `tfschema:"username"`
+	EntraApplicationId     string `tfschema:"entra_application_id"`
+	TenantId               string `tfschema:"tenant_id"`
+}
+
+func (CommunicationServiceSmtpUsernameResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 253),
+		},
+
+		"communication_service_id": commonschema.ResourceIDReferenceRequiredForceNew(&communicationservices.CommunicationServiceId{}),
+
+		"username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 253),
+		},
+
+		"entra_application_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (CommunicationServiceSmtpUsernameResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (CommunicationServiceSmtpUsernameResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateSmtpUsernameID
+}
+
+func (CommunicationServiceSmtpUsernameResource) ModelObject() interface{} {
+	return &CommunicationServiceSmtpUsernameModel{}
+}
+
+func (CommunicationServiceSmtpUsernameResource) ResourceType() string {
+	return "azurerm_communication_service_smtp_username"
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSmtpUsernamesWorkaroundClient(metadata.Client.Communication.ServiceClient)
+
+			var model CommunicationServiceSmtpUsernameModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			communicationServiceId, err := communicationservices.ParseCommunicationServiceID(model.CommunicationServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewSmtpUsernameID(communicationServiceId.SubscriptionId, communicationServiceId.ResourceGroupName, communicationServiceId.CommunicationServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := azuresdkhacks.SmtpUsernameResource{
+				Properties: &azuresdkhacks.SmtpUsernameProperties{
+					Username:           model.Username,
+					EntraApplicationId: model.EntraApplicationId,
+					TenantId:           model.TenantId,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSmtpUsernamesWorkaroundClient(metadata.Client.Communication.ServiceClient)
+
+			var model CommunicationServiceSmtpUsernameModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := azuresdkhacks.ParseSmtpUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := *existing.Model
+			props := payload.Properties
+
+			if metadata.ResourceData.HasChange("username") {
+				props.Username = model.Username
+			}
+
+			if metadata.ResourceData.HasChange("entra_application_id") {
+				props.EntraApplicationId = model.EntraApplicationId
+			}
+
+			if metadata.ResourceData.HasChange("tenant_id") {
+				props.TenantId = model.TenantId
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (CommunicationServiceSmtpUsernameResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSmtpUsernamesWorkaroundClient(metadata.Client.Communication.ServiceClient)
+
+			id, err := azuresdkhacks.ParseSmtpUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := CommunicationServiceSmtpUsernameModel{
+				Name:                   id.SmtpUsernameName,
+				CommunicationServiceId: communicationservices.NewCommunicationServiceID(id.SubscriptionId, id.ResourceGroupName, id.CommunicationServiceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Username = props.Username
+					state.EntraApplicationId = props.EntraApplicationId
+					state.TenantId = props.TenantId
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (CommunicationServiceSmtpUsernameResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewSmtpUsernamesWorkaroundClient(metadata.Client.Communication.ServiceClient)
+
+			id, err := azuresdkhacks.ParseSmtpUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CommunicationServiceSmtpUsernameResource struct{}
+
+func TestAccCommunicationServiceSmtpUsername_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_smtp_username", "test")
+	r := CommunicationServiceSmtpUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "smtp-user"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCommunicationServiceSmtpUsername_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_smtp_username", "test")
+	r := CommunicationServiceSmtpUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "smtp-user"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCommunicationServiceSmtpUsername_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_smtp_username", "test")
+	r := CommunicationServiceSmtpUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "smtp-user"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "smtp-user-updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("username").HasValue("smtp-user-updated"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseSmtpUsernameID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := azuresdkhacks.NewSmtpUsernamesWorkaroundClient(client.Communication.ServiceClient).Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r CommunicationServiceSmtpUsernameResource) basic(data acceptance.TestData, username string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_communication_service_smtp_username" "test" {
+  name                     = "acctest-smtp-%d"
+  communication_service_id = azurerm_communication_service_email_domain_association.test.communication_service_id
+  username                 = "%s"
+  entra_application_id     = data.azurerm_client_config.current.client_id
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+}
+`, r.template(data), data.RandomInteger, username)
+}
+
+func (r CommunicationServiceSmtpUsernameResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_communication_service_smtp_username" "import" {
+  name                     = azurerm_communication_service_smtp_username.test.name
+  communication_service_id = azurerm_communication_service_smtp_username.test.communication_service_id
+  username                 = azurerm_communication_service_smtp_username.test.username
+  entra_application_id     = azurerm_communication_service_smtp_username.test.entra_application_id
+  tenant_id                = azurerm_communication_service_smtp_username.test.tenant_id
+}
+`, r.basic(data, "smtp-user"))
+}
+
+func (r CommunicationServiceSmtpUsernameResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-communicationservice-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_communication_service" "test" {
+  name                = "acctest-CommunicationService-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_location       = "United States"
+}
+
+resource "azurerm_email_communication_service" "test" {
+  name                = "acctest-CommunicationService-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_location       = "United States"
+}
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name             = "AzureManagedDomain"
+  email_service_id = azurerm_email_communication_service.test.id
+
+  domain_management = "AzureManaged"
+}
+
+resource "azurerm_communication_service_email_domain_association" "test" {
+  communication_service_id = azurerm_communication_service.test.id
+  email_service_domain_id  = azurerm_email_communication_service_domain.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package communication
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = EmailCommunicationServiceDomainVerificationResource{}
+
+type EmailCommunicationServiceDomainVerificationResource struct{}
+
+type EmailCommunicationServiceDomainVerificationResourceModel struct {
+	DomainId          string   `tfschema:"domain_id"`
+	VerificationTypes []string `tfschema:"verification_types"`
+}
+
+func (EmailCommunicationServiceDomainVerificationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"domain_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: domains.ValidateDomainID,
+		},
+
+		"verification_types": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(domains.PossibleValuesForVerificationType(), false),
+			},
+		},
+	}
+}
+
+func (EmailCommunicationServiceDomainVerificationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (EmailCommunicationServiceDomainVerificationResource) ModelObject() interface{} {
+	return &EmailCommunicationServiceDomainVerificationResourceModel{}
+}
+
+func (EmailCommunicationServiceDomainVerificationResource) ResourceType() string {
+	return "azurerm_email_communication_service_domain_verification"
+}
+
+func (EmailCommunicationServiceDomainVerificationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return domains.ValidateDomainID
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainClient
+
+			var model EmailCommunicationServiceDomainVerificationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id, err := domains.ParseDomainID(model.DomainId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			if existing.Model.Properties.DomainManagement == domains.DomainManagementAzureManaged {
+				return fmt.Errorf("%s is an `%s` domain which is verified by Azure, verification is only supported for `%s` and `%s` domains", *id, domains.DomainManagementAzureManaged, domains.DomainManagementCustomerManaged, domains.DomainManagementCustomerManagedInExchangeOnline)
+			}
+
+			// the `Domain` verification must succeed before the remaining records can be verified
+			for _, verificationType := range sortEmailDomainVerificationTypes(model.VerificationTypes) {
+				status := emailDomainVerificationStatus(existing.Model.Properties.VerificationStates, verificationType)
+				if status != nil && status.Status != nil && *status.Status == domains.VerificationStatusVerified {
+					log.Printf("[DEBUG] `%s` verification for %s has already succeeded, skipping", verificationType, *id)
+					continue
+				}
+
+				if err := client.InitiateVerificationThenPoll(ctx, *id, domains.VerificationParameter{VerificationType: verificationType}); err != nil {
+					return fmt.Errorf("initiating `%s` verification for %s: %+v", verificationType, *id, err)
+				}
+
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return fmt.Errorf("internal-error: context had no deadline")
+				}
+
+				stateConf := &pluginsdk.StateChangeConf{
+					Pending: []string{
+						string(domains.VerificationStatusNotStarted),
+						string(domains.VerificationStatusVerificationRequested),
+						string(domains.VerificationStatusVerificationInProgress),
+					},
+					Target:       []string{string(domains.VerificationStatusVerified)},
+					Refresh:      emailDomainVerificationStateRefreshFunc(ctx, client, *id, verificationType),
+					MinTimeout:   15 * time.Second,
+					PollInterval: 15 * time.Second,
+					Timeout:      time.Until(deadline),
+				}
+
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for `%s` verification of %s: %+v", verificationType, *id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (EmailCommunicationServiceDomainVerificationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state EmailCommunicationServiceDomainVerificationResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if len(state.VerificationTypes) == 0 {
+				// when importing, track every verification type which has already succeeded
+				state.VerificationTypes = make([]string, 0)
+				for _, verificationType := range domains.PossibleValuesForVerificationType() {
+					if model := resp.Model; model != nil && model.Properties != nil {
+						status := emailDomainVerificationStatus(model.Properties.VerificationStates, domains.VerificationType(verificationType))
+						if status != nil && pointer.From(status.Status) == domains.VerificationStatusVerified {
+							state.VerificationTypes = append(state.VerificationTypes, verificationType)
+						}
+					}
+				}
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				for _, verificationType := range state.VerificationTypes {
+					status := emailDomainVerificationStatus(model.Properties.VerificationStates, domains.VerificationType(verificationType))
+					if status == nil || pointer.From(status.Status) != domains.VerificationStatusVerified {
+						log.Printf("[DEBUG] `%s` verification for %s is no longer verified - removing from state", verificationType, *id)
+						return metadata.MarkAsGone(id)
+					}
+				}
+			}
+
+			state.DomainId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (EmailCommunicationServiceDomainVerificationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// a verified record can't be un-verified, so this is a no-op and the resource is only removed from the state
+			log.Printf("[DEBUG] removing %s from the state without modifying the domain", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+func emailDomainVerificationStateRefreshFunc(ctx context.Context, client *domains.DomainsClient, id domains.DomainId, verificationType domains.VerificationType) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		status := emailDomainVerificationStatus(resp.Model.Properties.VerificationStates, verificationType)
+		if status == nil || status.Status == nil {
+			return resp, string(domains.VerificationStatusNotStarted), nil
+		}
+
+		if *status.Status == domains.VerificationStatusVerificationFailed {
+			return resp, string(*status.Status), fmt.Errorf("verification failed with error code %q, check the `%s` DNS record matches the `verification_records` of the domain", pointer.From(status.ErrorCode), verificationType)
+		}
+
+		return resp, string(*status.Status), nil
+	}
+}
+
+func emailDomainVerificationStatus(input *domains.DomainPropertiesVerificationStates, verificationType domains.VerificationType) *domains.VerificationStatusRecord {
+	if input == nil {
+		return nil
+	}
+
+	switch verificationType {
+	case domains.VerificationTypeDomain:
+		return input.Domain
+	case domains.VerificationTypeSPF:
+		return input.SPF
+	case domains.VerificationTypeDKIM:
+		return input.DKIM
+	case domains.VerificationTypeDKIMTwo:
+		return input.DKIM2
+	case domains.VerificationTypeDMARC:
+		return input.DMARC
+	}
+
+	return nil
+}
+
+func sortEmailDomainVerificationTypes(input []string) []domains.VerificationType {
+	order := []domains.VerificationType{
+		domains.VerificationTypeDomain,
+		domains.VerificationTypeSPF,
+		domains.VerificationTypeDKIM,
+		domains.VerificationTypeDKIMTwo,
+		domains.VerificationTypeDMARC,
+	}
+
+	output := make([]domains.VerificationType, 0)
+	for _, v := range order {
+		for _, item := range input {
+			if string(v) == item {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EmailCommunicationServiceDomainVerificationResource struct{}
+
+func TestAccEmailServiceDomainVerification_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_verification", "test")
+	r := EmailCommunicationServiceDomainVerificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := domains.ParseDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.DomainClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.VerificationStates != nil {
+		if domain := model.Properties.VerificationStates.Domain; domain != nil {
+			return pointer.To(pointer.From(domain.Status) == domains.VerificationStatusVerified), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) basic(data acceptance.TestData) string {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-communicationservice-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[3]s"
+  resource_group_name = "%[4]s"
+}
+
+resource "azurerm_email_communication_service" "test" {
+  name                = "acctest-CommunicationService-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_location       = "United States"
+}
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name             = "acctest%[1]d.${data.azurerm_dns_zone.test.name}"
+  email_service_id = azurerm_email_communication_service.test.id
+
+  domain_management = "CustomerManaged"
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = "acctest%[1]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = azurerm_email_communication_service_domain.test.verification_records[0].domain[0].value
+  }
+
+  record {
+    value = azurerm_email_communication_service_domain.test.verification_records[0].spf[0].value
+  }
+}
+
+resource "azurerm_dns_cname_record" "dkim" {
+  name                = "${azurerm_email_communication_service_domain.test.verification_records[0].dkim[0].name}.acctest%[1]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+  record              = azurerm_email_communication_service_domain.test.verification_records[0].dkim[0].value
+}
+
+resource "azurerm_dns_cname_record" "dkim2" {
+  name                = "${azurerm_email_communication_service_domain.test.verification_records[0].dkim2[0].name}.acctest%[1]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+  record              = azurerm_email_communication_service_domain.test.verification_records[0].dkim2[0].value
+}
+
+resource "azurerm_email_communication_service_domain_verification" "test" {
+  domain_id          = azurerm_email_communication_service_domain.test.id
+  verification_types = ["Domain", "SPF", "DKIM", "DKIM2"]
+
+  depends_on = [
+    azurerm_dns_txt_record.test,
+    azurerm_dns_cname_record.dkim,
+    azurerm_dns_cname_record.dkim2,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, dnsZone, dataResourceGroup)
+}
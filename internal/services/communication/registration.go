@@ -40,8 +40,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		CommunicationServiceSmtpUsernameResource{},
 		EmailCommunicationServiceDomainSenderUsernameResource{},
 		EmailCommunicationServiceDomainResource{},
+		EmailCommunicationServiceDomainVerificationResource{},
 		EmailCommunicationServiceResource{},
 		EmailDomainAssociationResource{},
 		CommunicationServiceResource{},
@@ -122,6 +122,16 @@ func resourceAvailabilitySetCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	if !d.IsNewResource() && pluginsdk.HasOnlyTagsChange(d) {
+		updated, err := pluginsdk.UpdateTagsOnly(ctx, meta.(*clients.Client).Resource.TagsClient, id.ID(), d)
+		if err != nil {
+			return err
+		}
+		if updated {
+			return resourceAvailabilitySetRead(d, meta)
+		}
+	}
+
 	updateDomainCount := d.Get("platform_update_domain_count").(int)
 	faultDomainCount := d.Get("platform_fault_domain_count").(int)
 	managed := d.Get("managed").(bool)
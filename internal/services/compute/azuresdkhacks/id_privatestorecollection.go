@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&PrivateStoreCollectionId{})
+}
+
+var _ resourceids.ResourceId = &PrivateStoreCollectionId{}
+
+// PrivateStoreCollectionId is a struct representing the Resource ID for a Private Store Collection
+type PrivateStoreCollectionId struct {
+	PrivateStoreId string
+	CollectionId   string
+}
+
+// NewPrivateStoreCollectionID returns a new PrivateStoreCollectionId struct
+func NewPrivateStoreCollectionID(privateStoreId string, collectionId string) PrivateStoreCollectionId {
+	return PrivateStoreCollectionId{
+		PrivateStoreId: privateStoreId,
+		CollectionId:   collectionId,
+	}
+}
+
+// ParsePrivateStoreCollectionID parses 'input' into a PrivateStoreCollectionId
+func ParsePrivateStoreCollectionID(input string) (*PrivateStoreCollectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PrivateStoreCollectionId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := PrivateStoreCollectionId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParsePrivateStoreCollectionIDInsensitively parses 'input' case-insensitively into a PrivateStoreCollectionId
+// note: this method should only be used for API response data and not user input
+func ParsePrivateStoreCollectionIDInsensitively(input string) (*PrivateStoreCollectionId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PrivateStoreCollectionId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := PrivateStoreCollectionId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *PrivateStoreCollectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.PrivateStoreId, ok = input.Parsed["privateStoreId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateStoreId", input)
+	}
+
+	if id.CollectionId, ok = input.Parsed["collectionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "collectionId", input)
+	}
+
+	return nil
+}
+
+// ValidatePrivateStoreCollectionID checks that 'input' can be parsed as a Private Store Collection ID
+func ValidatePrivateStoreCollectionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePrivateStoreCollectionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Private Store Collection ID
+func (id PrivateStoreCollectionId) ID() string {
+	fmtString := "/providers/Microsoft.Marketplace/privateStores/%s/collections/%s"
+	return fmt.Sprintf(fmtString, id.PrivateStoreId, id.CollectionId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Private Store Collection ID
+func (id PrivateStoreCollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMarketplace", "Microsoft.Marketplace", "Microsoft.Marketplace"),
+		resourceids.StaticSegment("staticPrivateStores", "privateStores", "privateStores"),
+		resourceids.UserSpecifiedSegment("privateStoreId", "privateStoreId"),
+		resourceids.StaticSegment("staticCollections", "collections", "collections"),
+		resourceids.UserSpecifiedSegment("collectionId", "collectionId"),
+	}
+}
+
+// String returns a human-readable description of this Private Store Collection ID
+func (id PrivateStoreCollectionId) String() string {
+	components := []string{
+		fmt.Sprintf("Private Store: %q", id.PrivateStoreId),
+		fmt.Sprintf("Collection: %q", id.CollectionId),
+	}
+	return fmt.Sprintf("Private Store Collection (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&PrivateStoreOfferId{})
+}
+
+var _ resourceids.ResourceId = &PrivateStoreOfferId{}
+
+// PrivateStoreOfferId is a struct representing the Resource ID for a Private Store Offer
+type PrivateStoreOfferId struct {
+	PrivateStoreId string
+	CollectionId   string
+	OfferId        string
+}
+
+// NewPrivateStoreOfferID returns a new PrivateStoreOfferId struct
+func NewPrivateStoreOfferID(privateStoreId string, collectionId string, offerId string) PrivateStoreOfferId {
+	return PrivateStoreOfferId{
+		PrivateStoreId: privateStoreId,
+		CollectionId:   collectionId,
+		OfferId:        offerId,
+	}
+}
+
+// ParsePrivateStoreOfferID parses 'input' into a PrivateStoreOfferId
+func ParsePrivateStoreOfferID(input string) (*PrivateStoreOfferId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PrivateStoreOfferId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := PrivateStoreOfferId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParsePrivateStoreOfferIDInsensitively parses 'input' case-insensitively into a PrivateStoreOfferId
+// note: this method should only be used for API response data and not user input
+func ParsePrivateStoreOfferIDInsensitively(input string) (*PrivateStoreOfferId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PrivateStoreOfferId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := PrivateStoreOfferId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *PrivateStoreOfferId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.PrivateStoreId, ok = input.Parsed["privateStoreId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateStoreId", input)
+	}
+
+	if id.CollectionId, ok = input.Parsed["collectionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "collectionId", input)
+	}
+
+	if id.OfferId, ok = input.Parsed["offerId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "offerId", input)
+	}
+
+	return nil
+}
+
+// ValidatePrivateStoreOfferID checks that 'input' can be parsed as a Private Store Offer ID
+func ValidatePrivateStoreOfferID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePrivateStoreOfferID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Private Store Offer ID
+func (id PrivateStoreOfferId) ID() string {
+	fmtString := "/providers/Microsoft.Marketplace/privateStores/%s/collections/%s/offers/%s"
+	return fmt.Sprintf(fmtString, id.PrivateStoreId, id.CollectionId, id.OfferId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Private Store Offer ID
+func (id PrivateStoreOfferId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMarketplace", "Microsoft.Marketplace", "Microsoft.Marketplace"),
+		resourceids.StaticSegment("staticPrivateStores", "privateStores", "privateStores"),
+		resourceids.UserSpecifiedSegment("privateStoreId", "privateStoreId"),
+		resourceids.StaticSegment("staticCollections", "collections", "collections"),
+		resourceids.UserSpecifiedSegment("collectionId", "collectionId"),
+		resourceids.StaticSegment("staticOffers", "offers", "offers"),
+		resourceids.UserSpecifiedSegment("offerId", "offerId"),
+	}
+}
+
+// String returns a human-readable description of this Private Store Offer ID
+func (id PrivateStoreOfferId) String() string {
+	components := []string{
+		fmt.Sprintf("Private Store: %q", id.PrivateStoreId),
+		fmt.Sprintf("Collection: %q", id.CollectionId),
+		fmt.Sprintf("Offer: %q", id.OfferId),
+	}
+	return fmt.Sprintf("Private Store Offer (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not yet include the Private Store API from the
+// `Microsoft.Marketplace` Resource Provider, only the Marketplace Ordering API (which covers accepting the terms of
+// a plan). Once the SDK supports the Private Store API, this can be removed.
+
+const privateStoreApiVersion = "2023-01-01"
+
+type PrivateStoreClient struct {
+	client *resourcemanager.Client
+}
+
+func NewPrivateStoreWorkaroundClient(client *agreements.AgreementsClient) PrivateStoreClient {
+	return PrivateStoreClient{
+		client: client.Client,
+	}
+}
+
+type PrivateStoreCollection struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *PrivateStoreCollectionProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData            `json:"systemData,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
+
+type PrivateStoreCollectionProperties struct {
+	AllSubscriptions  *bool     `json:"allSubscriptions,omitempty"`
+	CollectionId      *string   `json:"collectionId,omitempty"`
+	CollectionName    *string   `json:"collectionName,omitempty"`
+	Enabled           *bool     `json:"enabled,omitempty"`
+	NumberOfOffers    *int64    `json:"numberOfOffers,omitempty"`
+	SubscriptionsList *[]string `json:"subscriptionsList,omitempty"`
+}
+
+type PrivateStoreOffer struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *PrivateStoreOfferProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData       `json:"systemData,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type PrivateStoreOfferProperties struct {
+	ETag                      *string                  `json:"eTag,omitempty"`
+	OfferDisplayName          *string                  `json:"offerDisplayName,omitempty"`
+	Plans                     *[]PrivateStoreOfferPlan `json:"plans,omitempty"`
+	PrivateStoreId            *string                  `json:"privateStoreId,omitempty"`
+	PublisherDisplayName      *string                  `json:"publisherDisplayName,omitempty"`
+	SpecificPlanIdsLimitation *[]string                `json:"specificPlanIdsLimitation,omitempty"`
+	UniqueOfferId             *string                  `json:"uniqueOfferId,omitempty"`
+}
+
+type PrivateStoreOfferPlan struct {
+	Accessibility   *string `json:"accessibility,omitempty"`
+	PlanDisplayName *string `json:"planDisplayName,omitempty"`
+	PlanId          *string `json:"planId,omitempty"`
+	SkuId           *string `json:"skuId,omitempty"`
+}
+
+type PrivateStoreCollectionOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *PrivateStoreCollection
+}
+
+type PrivateStoreOfferOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *PrivateStoreOffer
+}
+
+type PrivateStoreDeleteOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type privateStoreOperationOptions struct{}
+
+func (o privateStoreOperationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o privateStoreOperationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o privateStoreOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", privateStoreApiVersion)
+	return &out
+}
+
+func (c PrivateStoreClient) CollectionsGet(ctx context.Context, id PrivateStoreCollectionId) (result PrivateStoreCollectionOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil, []int{http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model PrivateStoreCollection
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c PrivateStoreClient) CollectionsCreateOrUpdate(ctx context.Context, id PrivateStoreCollectionId, input PrivateStoreCollection) (result PrivateStoreCollectionOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPut, id.ID(), input, []int{http.StatusCreated, http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model PrivateStoreCollection
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c PrivateStoreClient) CollectionsDelete(ctx context.Context, id PrivateStoreCollectionId) (result PrivateStoreDeleteOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, id.ID(), nil, []int{http.StatusNoContent, http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+func (c PrivateStoreClient) OffersGet(ctx context.Context, id PrivateStoreOfferId) (result PrivateStoreOfferOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), nil, []int{http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model PrivateStoreOffer
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c PrivateStoreClient) OffersCreateOrUpdate(ctx context.Context, id PrivateStoreOfferId, input PrivateStoreOffer) (result PrivateStoreOfferOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPut, id.ID(), input, []int{http.StatusCreated, http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model PrivateStoreOffer
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c PrivateStoreClient) OffersDelete(ctx context.Context, id PrivateStoreOfferId) (result PrivateStoreDeleteOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, id.ID(), nil, []int{http.StatusNoContent, http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+func (c PrivateStoreClient) execute(ctx context.Context, method string, path string, input interface{}, expectedStatusCodes []int) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		OptionsObject:       privateStoreOperationOptions{},
+		Path:                path,
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		if err = req.Marshal(input); err != nil {
+			return nil, err
+		}
+	}
+
+	return req.Execute(ctx)
+}
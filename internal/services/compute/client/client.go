@@ -38,6 +38,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetextensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetrollingupgrades"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/computerps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/standbypool/2025-03-01/standbyvirtualmachinepools"
@@ -50,6 +51,7 @@ type Client struct {
 	AvailabilitySetsClient                      *availabilitysets.AvailabilitySetsClient
 	CapacityReservationsClient                  *capacityreservations.CapacityReservationsClient
 	CapacityReservationGroupsClient             *capacityreservationgroups.CapacityReservationGroupsClient
+	ComputeRPSClient                            *computerps.ComputeRPSClient
 	DedicatedHostsClient                        *dedicatedhosts.DedicatedHostsClient
 	DedicatedHostGroupsClient                   *dedicatedhostgroups.DedicatedHostGroupsClient
 	DisksClient                                 *disks.DisksClient
@@ -99,6 +101,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(capacityReservationGroupsClient.Client, o.Authorizers.ResourceManager)
 
+	computeRPSClient, err := computerps.NewComputeRPSClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ComputeRPS client: %+v", err)
+	}
+	o.Configure(computeRPSClient.Client, o.Authorizers.ResourceManager)
+
 	dedicatedHostsClient, err := dedicatedhosts.NewDedicatedHostsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building DedicatedHosts client: %+v", err)
@@ -271,6 +279,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		AvailabilitySetsClient:                      availabilitySetsClient,
 		CapacityReservationsClient:                  capacityReservationsClient,
 		CapacityReservationGroupsClient:             capacityReservationGroupsClient,
+		ComputeRPSClient:                            computeRPSClient,
 		DedicatedHostsClient:                        dedicatedHostsClient,
 		DedicatedHostGroupsClient:                   dedicatedHostGroupsClient,
 		DisksClient:                                 disksClient,
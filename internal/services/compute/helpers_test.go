@@ -85,3 +85,74 @@ func TestSortVersions_invalid(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenVirtualMachineExtensionSettings(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    interface{}
+		shared   string
+		existing string
+		expected string
+	}{
+		{
+			name:     "no settings",
+			input:    nil,
+			expected: "",
+		},
+		{
+			name:     "imported",
+			input:    map[string]interface{}{"commandToExecute": "hostname", "timestamp": float64(1)},
+			expected: `{"commandToExecute":"hostname","timestamp":1}`,
+		},
+		{
+			name:     "shared settings removed",
+			input:    map[string]interface{}{"commandToExecute": "hostname", "timestamp": float64(1)},
+			shared:   `{"timestamp": 1}`,
+			existing: `{"commandToExecute": "hostname"}`,
+			expected: `{"commandToExecute":"hostname"}`,
+		},
+		{
+			name:     "only shared settings",
+			input:    map[string]interface{}{"timestamp": float64(1)},
+			shared:   `{"timestamp": 1}`,
+			expected: "",
+		},
+		{
+			name:     "shared setting overridden",
+			input:    map[string]interface{}{"timestamp": float64(2)},
+			shared:   `{"timestamp": 1}`,
+			existing: `{"timestamp": 2}`,
+			expected: `{"timestamp":2}`,
+		},
+		{
+			name:     "shared setting also defined on the extension",
+			input:    map[string]interface{}{"timestamp": float64(1)},
+			shared:   `{"timestamp": 1}`,
+			existing: `{"timestamp": 1}`,
+			expected: `{"timestamp":1}`,
+		},
+		{
+			name:     "changed outside of terraform",
+			input:    map[string]interface{}{"commandToExecute": "whoami"},
+			existing: `{"commandToExecute": "hostname"}`,
+			expected: `{"commandToExecute":"whoami"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			var input *interface{}
+			if v.input != nil {
+				input = &v.input
+			}
+
+			actual, err := flattenVirtualMachineExtensionSettings(input, v.shared, v.existing)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if actual != v.expected {
+				t.Fatalf("expected %q but got %q", v.expected, actual)
+			}
+		})
+	}
+}
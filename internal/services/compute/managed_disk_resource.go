@@ -90,6 +90,7 @@ func resourceManagedDisk() *pluginsdk.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(disks.DiskCreateOptionCopy),
+					string(disks.DiskCreateOptionCopyFromSanSnapshot),
 					string(disks.DiskCreateOptionEmpty),
 					string(disks.DiskCreateOptionFromImage),
 					string(disks.DiskCreateOptionImport),
@@ -429,6 +430,14 @@ func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 		props.CreationData.SourceResourceId = pointer.To(sourceResourceId)
 	}
+	if createOption == disks.DiskCreateOptionCopyFromSanSnapshot {
+		sourceResourceId := d.Get("source_resource_id").(string)
+		if sourceResourceId == "" {
+			return fmt.Errorf("`source_resource_id` must be specified when `create_option` is set to `CopyFromSanSnapshot`")
+		}
+
+		props.CreationData.ElasticSanResourceId = pointer.To(sourceResourceId)
+	}
 	if createOption == disks.DiskCreateOptionFromImage {
 		if imageReferenceId := d.Get("image_reference_id").(string); imageReferenceId != "" {
 			props.CreationData.ImageReference = &disks.ImageDiskReference{
@@ -623,6 +632,16 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if pluginsdk.HasOnlyTagsChange(d) {
+		updated, err := pluginsdk.UpdateTagsOnly(ctx, meta.(*clients.Client).Resource.TagsClient, id.ID(), d)
+		if err != nil {
+			return err
+		}
+		if updated {
+			return resourceManagedDiskRead(d, meta)
+		}
+	}
+
 	disk, err := client.Get(ctx, *id)
 	if err != nil {
 		if response.WasNotFound(disk.HttpResponse) {
@@ -895,7 +914,11 @@ func resourceManagedDiskRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("image_reference_id", imageReferenceId)
 
 			d.Set("performance_plus_enabled", creationData.PerformancePlus)
-			d.Set("source_resource_id", creationData.SourceResourceId)
+			sourceResourceId := pointer.From(creationData.SourceResourceId)
+			if creationData.CreateOption == disks.DiskCreateOptionCopyFromSanSnapshot {
+				sourceResourceId = pointer.From(creationData.ElasticSanResourceId)
+			}
+			d.Set("source_resource_id", sourceResourceId)
 			d.Set("source_uri", creationData.SourceUri)
 			d.Set("storage_account_id", creationData.StorageAccountId)
 			d.Set("upload_size_bytes", creationData.UploadSizeBytes)
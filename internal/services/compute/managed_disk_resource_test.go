@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2015-04-01/activitylogs"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -98,6 +101,78 @@ func TestAccManagedDisk_copy(t *testing.T) {
 	})
 }
 
+func TestAccManagedDisk_copyFromElasticSanSnapshot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
+	r := ManagedDiskResource{}
+
+	// Elastic SAN Volume Snapshots are only available as a data source, so the snapshot is managed out of band
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.elasticSanSnapshotSource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+					if _, ok := ctx.Deadline(); !ok {
+						var cancel context.CancelFunc
+						ctx, cancel = context.WithTimeout(ctx, 30*time.Minute)
+						defer cancel()
+					}
+
+					volumeId, err := volumes.ParseVolumeID(state.ID)
+					if err != nil {
+						return err
+					}
+
+					id := snapshots.NewSnapshotID(volumeId.SubscriptionId, volumeId.ResourceGroupName, volumeId.ElasticSanName, volumeId.VolumeGroupName, data.RandomString)
+					snapshot := snapshots.Snapshot{
+						Properties: snapshots.SnapshotProperties{
+							CreationData: snapshots.SnapshotCreationData{
+								SourceId: volumeId.ID(),
+							},
+						},
+					}
+
+					if err = clients.ElasticSan.Snapshots.VolumeSnapshotsCreateThenPoll(ctx, id, snapshot); err != nil {
+						return fmt.Errorf("creating %s: %+v", id, err)
+					}
+
+					return nil
+				}, "azurerm_elastic_san_volume.test"),
+			),
+		},
+		{
+			Config: r.copyFromElasticSanSnapshot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.elasticSanSnapshotSource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(func(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) error {
+					if _, ok := ctx.Deadline(); !ok {
+						var cancel context.CancelFunc
+						ctx, cancel = context.WithTimeout(ctx, 30*time.Minute)
+						defer cancel()
+					}
+
+					volumeId, err := volumes.ParseVolumeID(state.ID)
+					if err != nil {
+						return err
+					}
+
+					id := snapshots.NewSnapshotID(volumeId.SubscriptionId, volumeId.ResourceGroupName, volumeId.ElasticSanName, volumeId.VolumeGroupName, data.RandomString)
+					if err = clients.ElasticSan.Snapshots.VolumeSnapshotsDeleteThenPoll(ctx, id); err != nil {
+						return fmt.Errorf("deleting %s: %+v", id, err)
+					}
+
+					return nil
+				}, "azurerm_elastic_san_volume.test"),
+			),
+		},
+	})
+}
+
 func TestAccManagedDisk_fromPlatformImage(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
 	r := ManagedDiskResource{}
@@ -1210,6 +1285,62 @@ resource "azurerm_managed_disk" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (ManagedDiskResource) elasticSanSnapshotSource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_elastic_san" "test" {
+  name                = "acctestes-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  base_size_in_tib    = 1
+
+  sku {
+    name = "Premium_LRS"
+  }
+}
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name           = "acctestesvg-%[3]s"
+  elastic_san_id = azurerm_elastic_san.test.id
+}
+
+resource "azurerm_elastic_san_volume" "test" {
+  name            = "acctestesv-%[3]s"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r ManagedDiskResource) copyFromElasticSanSnapshot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_elastic_san_volume_snapshot" "test" {
+  name            = "%[3]s"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%[2]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Premium_LRS"
+  create_option        = "CopyFromSanSnapshot"
+  source_resource_id   = data.azurerm_elastic_san_volume_snapshot.test.id
+  disk_size_gb         = "1"
+}
+`, r.elasticSanSnapshotSource(data), data.RandomInteger, data.RandomString)
+}
+
 func (ManagedDiskResource) empty_updated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
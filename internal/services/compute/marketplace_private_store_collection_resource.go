@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = MarketplacePrivateStoreCollectionResource{}
+	_ sdk.ResourceWithUpdate = MarketplacePrivateStoreCollectionResource{}
+)
+
+type MarketplacePrivateStoreCollectionResource struct{}
+
+type MarketplacePrivateStoreCollectionResourceModel struct {
+	Name                    string   `tfschema:"name"`
+	PrivateStoreId          string   `tfschema:"private_store_id"`
+	CollectionId            string   `tfschema:"collection_id"`
+	AllSubscriptionsEnabled bool     `tfschema:"all_subscriptions_enabled"`
+	Enabled                 bool     `tfschema:"enabled"`
+	SubscriptionIds         []string `tfschema:"subscription_ids"`
+}
+
+func (r MarketplacePrivateStoreCollectionResource) ModelObject() interface{} {
+	return &MarketplacePrivateStoreCollectionResourceModel{}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidatePrivateStoreCollectionID
+}
+
+func (r MarketplacePrivateStoreCollectionResource) ResourceType() string {
+	return "azurerm_marketplace_private_store_collection"
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"private_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"collection_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"all_subscriptions_enabled": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"subscription_ids"},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"subscription_ids": {
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"all_subscriptions_enabled"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			var model MarketplacePrivateStoreCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			collectionId := model.CollectionId
+			if collectionId == "" {
+				uuid, err := uuid.GenerateUUID()
+				if err != nil {
+					return fmt.Errorf("generating UUID for the Collection: %+v", err)
+				}
+				collectionId = uuid
+			}
+
+			id := azuresdkhacks.NewPrivateStoreCollectionID(model.PrivateStoreId, collectionId)
+
+			existing, err := client.CollectionsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CollectionsCreateOrUpdate(ctx, id, expandMarketplacePrivateStoreCollection(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			id, err := azuresdkhacks.ParsePrivateStoreCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.CollectionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MarketplacePrivateStoreCollectionResourceModel{
+				PrivateStoreId: id.PrivateStoreId,
+				CollectionId:   id.CollectionId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Name = pointer.From(props.CollectionName)
+					state.AllSubscriptionsEnabled = pointer.From(props.AllSubscriptions)
+					state.Enabled = pointer.From(props.Enabled)
+
+					// the API returns the list of Subscriptions when these are all enabled, so these are only tracked when
+					// they're scoped
+					if !state.AllSubscriptionsEnabled {
+						state.SubscriptionIds = pointer.From(props.SubscriptionsList)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			id, err := azuresdkhacks.ParsePrivateStoreCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MarketplacePrivateStoreCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CollectionsCreateOrUpdate(ctx, *id, expandMarketplacePrivateStoreCollection(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			id, err := azuresdkhacks.ParsePrivateStoreCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CollectionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMarketplacePrivateStoreCollection(input MarketplacePrivateStoreCollectionResourceModel) azuresdkhacks.PrivateStoreCollection {
+	return azuresdkhacks.PrivateStoreCollection{
+		Properties: &azuresdkhacks.PrivateStoreCollectionProperties{
+			AllSubscriptions:  pointer.To(input.AllSubscriptionsEnabled),
+			CollectionName:    pointer.To(input.Name),
+			Enabled:           pointer.To(input.Enabled),
+			SubscriptionsList: pointer.To(input.SubscriptionIds),
+		},
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MarketplacePrivateStoreCollectionResource struct{}
+
+// The Private Store is shared across the Tenant and managing it requires the Marketplace Admin role, so these tests
+// run in sequence against an existing Private Store.
+func TestAccMarketplacePrivateStoreCollectionSequential(t *testing.T) {
+	if os.Getenv("ARM_TEST_PRIVATE_STORE_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_PRIVATE_STORE_ID` is not specified")
+	}
+
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"collection": {
+			"basic":          testAccMarketplacePrivateStoreCollection_basic,
+			"requiresImport": testAccMarketplacePrivateStoreCollection_requiresImport,
+			"update":         testAccMarketplacePrivateStoreCollection_update,
+		},
+		"offer": {
+			"basic":          testAccMarketplacePrivateStoreOffer_basic,
+			"requiresImport": testAccMarketplacePrivateStoreOffer_requiresImport,
+			"update":         testAccMarketplacePrivateStoreOffer_update,
+		},
+	})
+}
+
+func testAccMarketplacePrivateStoreCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccMarketplacePrivateStoreCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccMarketplacePrivateStoreCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParsePrivateStoreCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewPrivateStoreWorkaroundClient(clients.Compute.MarketplaceAgreementsClient)
+	resp, err := client.CollectionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MarketplacePrivateStoreCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  name             = "acctest-collection-%d"
+  private_store_id = "%s"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_PRIVATE_STORE_ID"))
+}
+
+func (r MarketplacePrivateStoreCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection" "import" {
+  name             = azurerm_marketplace_private_store_collection.test.name
+  private_store_id = azurerm_marketplace_private_store_collection.test.private_store_id
+  collection_id    = azurerm_marketplace_private_store_collection.test.collection_id
+}
+`, r.basic(data))
+}
+
+func (r MarketplacePrivateStoreCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  name             = "acctest-collection-updated-%d"
+  private_store_id = "%s"
+  enabled          = false
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_PRIVATE_STORE_ID"))
+}
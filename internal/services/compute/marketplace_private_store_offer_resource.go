@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = MarketplacePrivateStoreOfferResource{}
+	_ sdk.ResourceWithUpdate = MarketplacePrivateStoreOfferResource{}
+)
+
+type MarketplacePrivateStoreOfferResource struct{}
+
+type MarketplacePrivateStoreOfferResourceModel struct {
+	CollectionId         string   `tfschema:"collection_id"`
+	OfferId              string   `tfschema:"offer_id"`
+	PlanIds              []string `tfschema:"plan_ids"`
+	OfferDisplayName     string   `tfschema:"offer_display_name"`
+	PublisherDisplayName string   `tfschema:"publisher_display_name"`
+}
+
+func (r MarketplacePrivateStoreOfferResource) ModelObject() interface{} {
+	return &MarketplacePrivateStoreOfferResourceModel{}
+}
+
+func (r MarketplacePrivateStoreOfferResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidatePrivateStoreOfferID
+}
+
+func (r MarketplacePrivateStoreOfferResource) ResourceType() string {
+	return "azurerm_marketplace_private_store_offer"
+}
+
+func (r MarketplacePrivateStoreOfferResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuresdkhacks.ValidatePrivateStoreCollectionID,
+		},
+
+		"offer_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^.\s]+\.[^.\s]+$`),
+				"`offer_id` must be in the format `{publisherId}.{offerId}`",
+			),
+		},
+
+		"plan_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreOfferResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"offer_display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"publisher_display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreOfferResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			var model MarketplacePrivateStoreOfferResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			collectionId, err := azuresdkhacks.ParsePrivateStoreCollectionID(model.CollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewPrivateStoreOfferID(collectionId.PrivateStoreId, collectionId.CollectionId, model.OfferId)
+
+			existing, err := client.OffersGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuresdkhacks.PrivateStoreOffer{
+				Properties: &azuresdkhacks.PrivateStoreOfferProperties{
+					SpecificPlanIdsLimitation: pointer.To(model.PlanIds),
+				},
+			}
+
+			if _, err := client.OffersCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreOfferResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			id, err := azuresdkhacks.ParsePrivateStoreOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.OffersGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MarketplacePrivateStoreOfferResourceModel{
+				CollectionId: azuresdkhacks.NewPrivateStoreCollectionID(id.PrivateStoreId, id.CollectionId).ID(),
+				OfferId:      id.OfferId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.OfferDisplayName = pointer.From(props.OfferDisplayName)
+					state.PlanIds = pointer.From(props.SpecificPlanIdsLimitation)
+					state.PublisherDisplayName = pointer.From(props.PublisherDisplayName)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreOfferResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			id, err := azuresdkhacks.ParsePrivateStoreOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MarketplacePrivateStoreOfferResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.OffersGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the API requires the current `eTag` to update an existing Offer
+			payload := azuresdkhacks.PrivateStoreOffer{
+				Properties: &azuresdkhacks.PrivateStoreOfferProperties{
+					SpecificPlanIdsLimitation: pointer.To(model.PlanIds),
+				},
+			}
+			if props := existing.Model.Properties; props != nil {
+				payload.Properties.ETag = props.ETag
+			}
+
+			if _, err := client.OffersCreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreOfferResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewPrivateStoreWorkaroundClient(metadata.Client.Compute.MarketplaceAgreementsClient)
+
+			id, err := azuresdkhacks.ParsePrivateStoreOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.OffersDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MarketplacePrivateStoreOfferResource struct{}
+
+// NOTE: these tests are run as a part of `TestAccMarketplacePrivateStoreCollectionSequential`, since an Offer is
+// added to a Collection within the same Private Store.
+
+func testAccMarketplacePrivateStoreOffer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_offer", "test")
+	r := MarketplacePrivateStoreOfferResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccMarketplacePrivateStoreOffer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_offer", "test")
+	r := MarketplacePrivateStoreOfferResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccMarketplacePrivateStoreOffer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_offer", "test")
+	r := MarketplacePrivateStoreOfferResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.plans(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MarketplacePrivateStoreOfferResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParsePrivateStoreOfferID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewPrivateStoreWorkaroundClient(clients.Compute.MarketplaceAgreementsClient)
+	resp, err := client.OffersGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MarketplacePrivateStoreOfferResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_offer" "test" {
+  collection_id = azurerm_marketplace_private_store_collection.test.id
+  offer_id      = "barracudanetworks.waf"
+}
+`, MarketplacePrivateStoreCollectionResource{}.basic(data))
+}
+
+func (r MarketplacePrivateStoreOfferResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_offer" "import" {
+  collection_id = azurerm_marketplace_private_store_offer.test.collection_id
+  offer_id      = azurerm_marketplace_private_store_offer.test.offer_id
+}
+`, r.basic(data))
+}
+
+func (r MarketplacePrivateStoreOfferResource) plans(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_offer" "test" {
+  collection_id = azurerm_marketplace_private_store_collection.test.id
+  offer_id      = "barracudanetworks.waf"
+  plan_ids      = ["hourly"]
+}
+`, MarketplacePrivateStoreCollectionResource{}.basic(data))
+}
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = MarketplaceRolloutResource{}
+	_ sdk.ResourceWithUpdate = MarketplaceRolloutResource{}
+)
+
+type MarketplaceRolloutResource struct{}
+
+type MarketplaceRolloutResourceModel struct {
+	Name                   string                        `tfschema:"name"`
+	Agreement              []MarketplaceRolloutAgreement `tfschema:"agreement"`
+	CancelOnRemovalEnabled bool                          `tfschema:"cancel_on_removal_enabled"`
+}
+
+type MarketplaceRolloutAgreement struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Plan      string `tfschema:"plan"`
+}
+
+func (r MarketplaceRolloutResource) ModelObject() interface{} {
+	return &MarketplaceRolloutResourceModel{}
+}
+
+func (r MarketplaceRolloutResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.MarketplaceRolloutIDValidation
+}
+
+func (r MarketplaceRolloutResource) ResourceType() string {
+	return "azurerm_marketplace_rollout"
+}
+
+func (r MarketplaceRolloutResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringDoesNotContainAny("|"),
+			),
+		},
+
+		"agreement": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"plan": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"cancel_on_removal_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r MarketplaceRolloutResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MarketplaceRolloutResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.MarketplaceAgreementsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MarketplaceRolloutResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewMarketplaceRolloutID(subscriptionId, model.Name)
+
+			for _, agreement := range model.Agreement {
+				if err := acceptMarketplaceAgreement(ctx, client, agreements.NewOfferPlanID(subscriptionId, agreement.Publisher, agreement.Offer, agreement.Plan)); err != nil {
+					return err
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplaceRolloutResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.MarketplaceAgreementsClient
+
+			id, err := parse.MarketplaceRolloutID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state MarketplaceRolloutResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.Name
+
+			// the terms for a plan are no longer accepted once the publisher revises them (or when they're cancelled
+			// out of band) - so these are removed from the state, which surfaces a diff to accept them again
+			accepted := make([]MarketplaceRolloutAgreement, 0)
+			for _, agreement := range state.Agreement {
+				agreementId := agreements.NewOfferPlanID(id.SubscriptionId.SubscriptionId, agreement.Publisher, agreement.Offer, agreement.Plan)
+				resp, err := client.MarketplaceAgreementsGet(ctx, agreementId)
+				if err != nil {
+					return fmt.Errorf("retrieving the Marketplace Terms for %s: %+v", agreementId, err)
+				}
+
+				if model := resp.Model; model != nil && model.Properties != nil && pointer.From(model.Properties.Accepted) {
+					accepted = append(accepted, agreement)
+					continue
+				}
+
+				log.Printf("[DEBUG] the Marketplace Terms for %s are no longer accepted", agreementId)
+			}
+			state.Agreement = accepted
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplaceRolloutResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.MarketplaceAgreementsClient
+
+			id, err := parse.MarketplaceRolloutID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MarketplaceRolloutResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("agreement") {
+				oldRaw, _ := metadata.ResourceData.GetChange("agreement")
+				desired := make(map[string]struct{})
+
+				for _, agreement := range model.Agreement {
+					agreementId := agreements.NewOfferPlanID(id.SubscriptionId.SubscriptionId, agreement.Publisher, agreement.Offer, agreement.Plan)
+					desired[strings.ToLower(agreementId.ID())] = struct{}{}
+
+					if err := acceptMarketplaceAgreement(ctx, client, agreementId); err != nil {
+						return err
+					}
+				}
+
+				if model.CancelOnRemovalEnabled {
+					for _, raw := range oldRaw.(*pluginsdk.Set).List() {
+						v := raw.(map[string]interface{})
+						agreementId := agreements.NewOfferPlanID(id.SubscriptionId.SubscriptionId, v["publisher"].(string), v["offer"].(string), v["plan"].(string))
+						if _, ok := desired[strings.ToLower(agreementId.ID())]; ok {
+							continue
+						}
+
+						planId := agreements.NewPlanID(agreementId.SubscriptionId, agreementId.PublisherId, agreementId.OfferId, agreementId.PlanId)
+						if _, err := client.MarketplaceAgreementsCancel(ctx, planId); err != nil {
+							return fmt.Errorf("cancelling agreement for %s: %+v", planId, err)
+						}
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MarketplaceRolloutResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.MarketplaceAgreementsClient
+
+			id, err := parse.MarketplaceRolloutID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MarketplaceRolloutResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// agreements are shared across the subscription, so they're only cancelled when explicitly requested
+			if !model.CancelOnRemovalEnabled {
+				return nil
+			}
+
+			for _, agreement := range model.Agreement {
+				planId := agreements.NewPlanID(id.SubscriptionId.SubscriptionId, agreement.Publisher, agreement.Offer, agreement.Plan)
+				if _, err := client.MarketplaceAgreementsCancel(ctx, planId); err != nil {
+					return fmt.Errorf("cancelling agreement for %s: %+v", planId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func acceptMarketplaceAgreement(ctx context.Context, client *agreements.AgreementsClient, id agreements.OfferPlanId) error {
+	resp, err := client.MarketplaceAgreementsGet(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving the Marketplace Terms for %s: %+v", id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving the Marketplace Terms for %s: `properties` was nil", id)
+	}
+
+	terms := resp.Model
+	if pointer.From(terms.Properties.Accepted) {
+		return nil
+	}
+
+	terms.Properties.Accepted = pointer.To(true)
+
+	log.Printf("[DEBUG] Accepting the Marketplace Terms for %s", id)
+	if _, err := client.MarketplaceAgreementsCreate(ctx, id, *terms); err != nil {
+		return fmt.Errorf("accepting the Marketplace Terms for %s: %+v", id, err)
+	}
+
+	return nil
+}
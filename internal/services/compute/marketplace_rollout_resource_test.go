@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MarketplaceRolloutResource struct{}
+
+func TestAccMarketplaceRollout_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_rollout", "test")
+	r := MarketplaceRolloutResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agreement.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccMarketplaceRollout_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_rollout", "test")
+	r := MarketplaceRolloutResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agreement.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agreement.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agreement.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (r MarketplaceRolloutResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.MarketplaceRolloutID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count := state.Attributes["agreement.#"]
+	if count == "" || count == "0" {
+		return pointer.To(false), nil
+	}
+
+	// every agreement which remains in the state must have accepted terms
+	for key, publisher := range state.Attributes {
+		if !strings.HasPrefix(key, "agreement.") || !strings.HasSuffix(key, ".publisher") {
+			continue
+		}
+		prefix := strings.TrimSuffix(key, "publisher")
+
+		agreementId := agreements.NewOfferPlanID(id.SubscriptionId.SubscriptionId, publisher, state.Attributes[prefix+"offer"], state.Attributes[prefix+"plan"])
+		resp, err := clients.Compute.MarketplaceAgreementsClient.MarketplaceAgreementsGet(ctx, agreementId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the Marketplace Terms for %s: %+v", agreementId, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil || !pointer.From(resp.Model.Properties.Accepted) {
+			return pointer.To(false), nil
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r MarketplaceRolloutResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_rollout" "test" {
+  name                      = "acctest-rollout-%d"
+  cancel_on_removal_enabled = true
+
+  agreement {
+    publisher = "barracudanetworks"
+    offer     = "waf"
+    plan      = "hourly"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r MarketplaceRolloutResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_rollout" "test" {
+  name                      = "acctest-rollout-%d"
+  cancel_on_removal_enabled = true
+
+  agreement {
+    publisher = "barracudanetworks"
+    offer     = "waf"
+    plan      = "hourly"
+  }
+
+  agreement {
+    publisher = "barracudanetworks"
+    offer     = "barracuda-ng-firewall"
+    plan      = "hourly"
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = MarketplaceRolloutId{}
+
+// MarketplaceRolloutId identifies a set of Marketplace Agreements managed together - since there's no
+// corresponding resource in Azure, this is a combination of the Subscription ID and the name of the rollout
+type MarketplaceRolloutId struct {
+	SubscriptionId commonids.SubscriptionId
+	Name           string
+}
+
+func (id MarketplaceRolloutId) ID() string {
+	return fmt.Sprintf("%s|%s", id.SubscriptionId.ID(), id.Name)
+}
+
+func (id MarketplaceRolloutId) String() string {
+	components := []string{
+		fmt.Sprintf("SubscriptionId %s", id.SubscriptionId.SubscriptionId),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	return fmt.Sprintf("Marketplace Rollout: %s", strings.Join(components, " / "))
+}
+
+func NewMarketplaceRolloutID(subscriptionId, name string) MarketplaceRolloutId {
+	return MarketplaceRolloutId{
+		SubscriptionId: commonids.NewSubscriptionID(subscriptionId),
+		Name:           name,
+	}
+}
+
+func MarketplaceRolloutID(input string) (*MarketplaceRolloutId, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format {SubscriptionId}|{Name} but got %q", input)
+	}
+
+	subscriptionId, err := commonids.ParseSubscriptionID(splitId[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if splitId[1] == "" {
+		return nil, fmt.Errorf("expected the Name in %q not to be empty", input)
+	}
+
+	return &MarketplaceRolloutId{
+		SubscriptionId: *subscriptionId,
+		Name:           splitId[1],
+	}, nil
+}
+
+func MarketplaceRolloutIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := MarketplaceRolloutID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestMarketplaceRolloutId(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Expect *MarketplaceRolloutId
+		Error  bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Subscription ID only",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			Name:  "Empty Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000|",
+			Error: true,
+		},
+		{
+			Name:  "Invalid Subscription ID",
+			Input: "hello|rollout1",
+			Error: true,
+		},
+		{
+			Name:  "Subscription ID / Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000|rollout1",
+			Expect: &MarketplaceRolloutId{
+				SubscriptionId: NewMarketplaceRolloutID("00000000-0000-0000-0000-000000000000", "rollout1").SubscriptionId,
+				Name:           "rollout1",
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := MarketplaceRolloutID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.SubscriptionId.SubscriptionId != v.Expect.SubscriptionId.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expect.SubscriptionId.SubscriptionId, actual.SubscriptionId.SubscriptionId)
+		}
+
+		if actual.Name != v.Expect.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expect.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+)
+
+var _ resourceids.Id = VirtualMachineExtensionsId{}
+
+// VirtualMachineExtensionsId is a synthetic ID for the set of Extensions managed on a Virtual Machine, which is distinct
+// from the ID of the Virtual Machine itself so that the two can't be confused when importing.
+type VirtualMachineExtensionsId struct {
+	VirtualMachineId virtualmachines.VirtualMachineId
+}
+
+func (v VirtualMachineExtensionsId) ID() string {
+	return fmt.Sprintf("%s/extensions", v.VirtualMachineId.ID())
+}
+
+func (v VirtualMachineExtensionsId) String() string {
+	components := []string{
+		fmt.Sprintf("VirtualMachineId %s", v.VirtualMachineId.ID()),
+	}
+	return fmt.Sprintf("Virtual Machine Extensions: %s", strings.Join(components, " / "))
+}
+
+func NewVirtualMachineExtensionsID(virtualMachineId virtualmachines.VirtualMachineId) VirtualMachineExtensionsId {
+	return VirtualMachineExtensionsId{
+		VirtualMachineId: virtualMachineId,
+	}
+}
+
+func VirtualMachineExtensionsID(input string) (*VirtualMachineExtensionsId, error) {
+	virtualMachineIdRaw, ok := strings.CutSuffix(input, "/extensions")
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {VirtualMachineId}/extensions but got %q", input)
+	}
+
+	virtualMachineId, err := virtualmachines.ParseVirtualMachineID(virtualMachineIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineExtensionsId{
+		VirtualMachineId: *virtualMachineId,
+	}, nil
+}
+
+func VirtualMachineExtensionsIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := VirtualMachineExtensionsID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
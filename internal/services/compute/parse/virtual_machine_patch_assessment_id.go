@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+)
+
+var _ resourceids.Id = VirtualMachinePatchAssessmentId{}
+
+type VirtualMachinePatchAssessmentId struct {
+	VirtualMachineId virtualmachines.VirtualMachineId
+}
+
+func (v VirtualMachinePatchAssessmentId) ID() string {
+	return fmt.Sprintf("%s/patchAssessment", v.VirtualMachineId.ID())
+}
+
+func (v VirtualMachinePatchAssessmentId) String() string {
+	components := []string{
+		fmt.Sprintf("VirtualMachineId %s", v.VirtualMachineId.ID()),
+	}
+	return fmt.Sprintf("Virtual Machine Patch Assessment: %s", strings.Join(components, " / "))
+}
+
+func NewVirtualMachinePatchAssessmentID(virtualMachineId virtualmachines.VirtualMachineId) VirtualMachinePatchAssessmentId {
+	return VirtualMachinePatchAssessmentId{
+		VirtualMachineId: virtualMachineId,
+	}
+}
+
+func VirtualMachinePatchAssessmentID(input string) (*VirtualMachinePatchAssessmentId, error) {
+	virtualMachineIdRaw, ok := strings.CutSuffix(input, "/patchAssessment")
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {VirtualMachineId}/patchAssessment but got %q", input)
+	}
+
+	virtualMachineId, err := virtualmachines.ParseVirtualMachineID(virtualMachineIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachinePatchAssessmentId{
+		VirtualMachineId: *virtualMachineId,
+	}, nil
+}
+
+func VirtualMachinePatchAssessmentIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := VirtualMachinePatchAssessmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+)
+
+func TestVirtualMachinePatchAssessmentId(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Expect *VirtualMachinePatchAssessmentId
+		Error  bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Virtual Machine ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/virtualMachine1",
+			Error: true,
+		},
+		{
+			Name:  "Invalid Virtual Machine ID",
+			Input: "hello/patchAssessment",
+			Error: true,
+		},
+		{
+			Name:  "Virtual Machine Patch Assessment ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/virtualMachine1/patchAssessment",
+			Error: false,
+			Expect: &VirtualMachinePatchAssessmentId{
+				VirtualMachineId: virtualmachines.VirtualMachineId{
+					SubscriptionId:     "00000000-0000-0000-0000-000000000001",
+					ResourceGroupName:  "group1",
+					VirtualMachineName: "virtualMachine1",
+				},
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := VirtualMachinePatchAssessmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.VirtualMachineId != v.Expect.VirtualMachineId {
+			t.Fatalf("Expected %q but got %q for VirtualMachineId", v.Expect.VirtualMachineId, actual.VirtualMachineId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
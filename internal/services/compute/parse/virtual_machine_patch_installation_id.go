@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+)
+
+var _ resourceids.Id = VirtualMachinePatchInstallationId{}
+
+type VirtualMachinePatchInstallationId struct {
+	VirtualMachineId virtualmachines.VirtualMachineId
+}
+
+func (v VirtualMachinePatchInstallationId) ID() string {
+	return fmt.Sprintf("%s/patchInstallation", v.VirtualMachineId.ID())
+}
+
+func (v VirtualMachinePatchInstallationId) String() string {
+	components := []string{
+		fmt.Sprintf("VirtualMachineId %s", v.VirtualMachineId.ID()),
+	}
+	return fmt.Sprintf("Virtual Machine Patch Installation: %s", strings.Join(components, " / "))
+}
+
+func NewVirtualMachinePatchInstallationID(virtualMachineId virtualmachines.VirtualMachineId) VirtualMachinePatchInstallationId {
+	return VirtualMachinePatchInstallationId{
+		VirtualMachineId: virtualMachineId,
+	}
+}
+
+func VirtualMachinePatchInstallationID(input string) (*VirtualMachinePatchInstallationId, error) {
+	virtualMachineIdRaw, ok := strings.CutSuffix(input, "/patchInstallation")
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {VirtualMachineId}/patchInstallation but got %q", input)
+	}
+
+	virtualMachineId, err := virtualmachines.ParseVirtualMachineID(virtualMachineIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachinePatchInstallationId{
+		VirtualMachineId: *virtualMachineId,
+	}, nil
+}
+
+func VirtualMachinePatchInstallationIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := VirtualMachinePatchInstallationID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -90,18 +90,25 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		ManagedDisksDataSource{},
 		OrchestratedVirtualMachineScaleSetDataSource{},
+		VirtualMachineSizesDataSource{},
 	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		VirtualMachineImplicitDataDiskFromSourceResource{},
+		MarketplaceRolloutResource{},
+		MarketplacePrivateStoreCollectionResource{},
+		MarketplacePrivateStoreOfferResource{},
 		VirtualMachineRunCommandResource{},
 		GalleryApplicationResource{},
 		GalleryApplicationVersionResource{},
 		RestorePointCollectionResource{},
 		VirtualMachineRestorePointCollectionResource{},
 		VirtualMachineRestorePointResource{},
+		VirtualMachineExtensionsResource{},
+		VirtualMachinePatchAssessmentResource{},
+		VirtualMachinePatchInstallationResource{},
 		VirtualMachineGalleryApplicationAssignmentResource{},
 		VirtualMachineScaleSetStandbyPoolResource{},
 	}
@@ -0,0 +1,575 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachineextensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var (
+	_ sdk.ResourceWithUpdate         = VirtualMachineExtensionsResource{}
+	_ sdk.ResourceWithCustomImporter = VirtualMachineExtensionsResource{}
+)
+
+// VirtualMachineExtensionsResource manages an ordered list of Extensions on a Virtual Machine. Extensions are
+// installed one at a time, in the order they're defined, since Azure only allows a single operation on a
+// Virtual Machine at a time.
+type VirtualMachineExtensionsResource struct{}
+
+type VirtualMachineExtensionsResourceModel struct {
+	VirtualMachineId string                                   `tfschema:"virtual_machine_id"`
+	Extensions       []VirtualMachineExtensionsExtensionModel `tfschema:"extension"`
+	SharedSettings   string                                   `tfschema:"shared_settings"`
+}
+
+type VirtualMachineExtensionsExtensionModel struct {
+	Name                      string `tfschema:"name"`
+	Publisher                 string `tfschema:"publisher"`
+	Type                      string `tfschema:"type"`
+	TypeHandlerVersion        string `tfschema:"type_handler_version"`
+	AutoUpgradeMinorVersion   bool   `tfschema:"auto_upgrade_minor_version"`
+	AutomaticUpgradeEnabled   bool   `tfschema:"automatic_upgrade_enabled"`
+	FailureSuppressionEnabled bool   `tfschema:"failure_suppression_enabled"`
+	Settings                  string `tfschema:"settings"`
+	ProtectedSettings         string `tfschema:"protected_settings"`
+}
+
+func (r VirtualMachineExtensionsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualmachines.ValidateVirtualMachineID,
+		},
+
+		"extension": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.All(
+							validation.StringIsNotEmpty,
+							validation.StringDoesNotContainAny("/"),
+						),
+					},
+
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type_handler_version": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"auto_upgrade_minor_version": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"automatic_upgrade_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"failure_suppression_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"settings": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					},
+
+					// due to the sensitive nature, these are not returned by the API
+					"protected_settings": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						Sensitive:        true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					},
+				},
+			},
+		},
+
+		"shared_settings": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r VirtualMachineExtensionsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineExtensionsResource) ModelObject() interface{} {
+	return &VirtualMachineExtensionsResourceModel{}
+}
+
+func (r VirtualMachineExtensionsResource) ResourceType() string {
+	return "azurerm_virtual_machine_extensions"
+}
+
+func (r VirtualMachineExtensionsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.VirtualMachineExtensionsIDValidation
+}
+
+func (r VirtualMachineExtensionsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineExtensionsClient
+			vmClient := metadata.Client.Compute.VirtualMachinesClient
+
+			var config VirtualMachineExtensionsResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			virtualMachineId, err := virtualmachines.ParseVirtualMachineID(config.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+			id := parse.NewVirtualMachineExtensionsID(*virtualMachineId)
+
+			if err := validateVirtualMachineExtensionNamesAreUnique(config.Extensions); err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+
+			virtualMachine, err := vmClient.Get(ctx, id.VirtualMachineId, virtualmachines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+			}
+			if virtualMachine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id.VirtualMachineId)
+			}
+
+			for _, extension := range config.Extensions {
+				extensionId := virtualmachineextensions.NewExtensionID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroupName, id.VirtualMachineId.VirtualMachineName, extension.Name)
+				existing, err := client.Get(ctx, extensionId, virtualmachineextensions.DefaultGetOperationOptions())
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", extensionId, err)
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+				}
+			}
+
+			for _, extension := range config.Extensions {
+				if err := r.createOrUpdateExtension(ctx, client, id.VirtualMachineId, virtualMachine.Model.Location, extension, config.SharedSettings); err != nil {
+					return err
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 90 * time.Minute,
+	}
+}
+
+func (r VirtualMachineExtensionsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineExtensionsClient
+			vmClient := metadata.Client.Compute.VirtualMachinesClient
+
+			id, err := parse.VirtualMachineExtensionsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			virtualMachine, err := vmClient.Get(ctx, id.VirtualMachineId, virtualmachines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(virtualMachine.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+			}
+
+			var state VirtualMachineExtensionsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			names := make([]string, 0)
+			for _, extension := range state.Extensions {
+				names = append(names, extension.Name)
+			}
+
+			existing := make(map[string]VirtualMachineExtensionsExtensionModel)
+			for _, extension := range state.Extensions {
+				existing[extension.Name] = extension
+			}
+
+			retrieved, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, names, func(ctx context.Context, name string) (*VirtualMachineExtensionsExtensionModel, error) {
+				extensionId := virtualmachineextensions.NewExtensionID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroupName, id.VirtualMachineId.VirtualMachineName, name)
+				resp, err := client.Get(ctx, extensionId, virtualmachineextensions.DefaultGetOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						log.Printf("[DEBUG] %s was not found - removing from the state", extensionId)
+						return nil, nil
+					}
+					return nil, fmt.Errorf("retrieving %s: %+v", extensionId, err)
+				}
+
+				// the protected settings aren't returned by the API, so are retained from the state
+				extension := VirtualMachineExtensionsExtensionModel{
+					Name:              name,
+					ProtectedSettings: existing[name].ProtectedSettings,
+				}
+				if model := resp.Model; model != nil {
+					if props := model.Properties; props != nil {
+						extension.Publisher = pointer.From(props.Publisher)
+						extension.Type = pointer.From(props.Type)
+						extension.TypeHandlerVersion = pointer.From(props.TypeHandlerVersion)
+						extension.AutoUpgradeMinorVersion = pointer.From(props.AutoUpgradeMinorVersion)
+						extension.AutomaticUpgradeEnabled = pointer.From(props.EnableAutomaticUpgrade)
+						extension.FailureSuppressionEnabled = pointer.From(props.SuppressFailures)
+
+						settings, err := flattenVirtualMachineExtensionSettings(props.Settings, state.SharedSettings, existing[name].Settings)
+						if err != nil {
+							return nil, fmt.Errorf("flattening `settings` for %s: %+v", extensionId, err)
+						}
+						extension.Settings = settings
+					}
+				}
+
+				return &extension, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			extensions := make([]VirtualMachineExtensionsExtensionModel, 0)
+			for _, extension := range retrieved {
+				if extension != nil {
+					extensions = append(extensions, *extension)
+				}
+			}
+
+			return metadata.Encode(&VirtualMachineExtensionsResourceModel{
+				VirtualMachineId: id.VirtualMachineId.ID(),
+				Extensions:       extensions,
+				SharedSettings:   state.SharedSettings,
+			})
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+// CustomImporter tracks all of the Extensions on the Virtual Machine, since the set of Extensions isn't known when
+// importing. This is only done when importing - Extensions which are added outside of Terraform (for example by Azure
+// Policy, or by `azurerm_virtual_machine_extension`) aren't otherwise tracked by this resource.
+func (r VirtualMachineExtensionsResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		client := metadata.Client.Compute.VirtualMachineExtensionsClient
+
+		id, err := parse.VirtualMachineExtensionsID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		vmId := virtualmachineextensions.NewVirtualMachineID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroupName, id.VirtualMachineId.VirtualMachineName)
+		resp, err := client.List(ctx, vmId, virtualmachineextensions.DefaultListOperationOptions())
+		if err != nil {
+			return fmt.Errorf("listing Extensions for %s: %+v", id, err)
+		}
+
+		extensions := make([]interface{}, 0)
+		if model := resp.Model; model != nil && model.Value != nil {
+			for _, extension := range *model.Value {
+				if extension.Name != nil {
+					extensions = append(extensions, map[string]interface{}{
+						"name": *extension.Name,
+					})
+				}
+			}
+		}
+
+		return metadata.ResourceData.Set("extension", extensions)
+	}
+}
+
+func (r VirtualMachineExtensionsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineExtensionsClient
+			vmClient := metadata.Client.Compute.VirtualMachinesClient
+
+			id, err := parse.VirtualMachineExtensionsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualMachineExtensionsResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			if err := validateVirtualMachineExtensionNamesAreUnique(config.Extensions); err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+
+			virtualMachine, err := vmClient.Get(ctx, id.VirtualMachineId, virtualmachines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+			}
+			if virtualMachine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id.VirtualMachineId)
+			}
+
+			old, _ := metadata.ResourceData.GetChange("extension")
+			existing := make(map[string]map[string]interface{})
+			for _, raw := range old.([]interface{}) {
+				if v, ok := raw.(map[string]interface{}); ok {
+					existing[v["name"].(string)] = v
+				}
+			}
+
+			configured := make(map[string]struct{})
+			for _, extension := range config.Extensions {
+				configured[extension.Name] = struct{}{}
+			}
+
+			// remove the Extensions which are no longer defined first, in reverse order
+			oldExtensions := old.([]interface{})
+			for i := len(oldExtensions) - 1; i >= 0; i-- {
+				v, ok := oldExtensions[i].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name := v["name"].(string)
+				if _, ok := configured[name]; ok {
+					continue
+				}
+
+				extensionId := virtualmachineextensions.NewExtensionID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroupName, id.VirtualMachineId.VirtualMachineName, name)
+				log.Printf("[DEBUG] Deleting %s..", extensionId)
+				if err := client.DeleteThenPoll(ctx, extensionId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", extensionId, err)
+				}
+			}
+
+			// then (re-)apply the Extensions which are new or have changed, in order
+			sharedSettingsChanged := metadata.ResourceData.HasChange("shared_settings")
+			for i, extension := range config.Extensions {
+				if _, ok := existing[extension.Name]; ok && !sharedSettingsChanged && !metadata.ResourceData.HasChange(fmt.Sprintf("extension.%d", i)) {
+					continue
+				}
+
+				if err := r.createOrUpdateExtension(ctx, client, id.VirtualMachineId, virtualMachine.Model.Location, extension, config.SharedSettings); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Timeout: 90 * time.Minute,
+	}
+}
+
+func (r VirtualMachineExtensionsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineExtensionsClient
+
+			id, err := parse.VirtualMachineExtensionsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state VirtualMachineExtensionsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineId.VirtualMachineName, VirtualMachineResourceName)
+
+			// Extensions are removed in the reverse order to which they were installed
+			for i := len(state.Extensions) - 1; i >= 0; i-- {
+				extensionId := virtualmachineextensions.NewExtensionID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroupName, id.VirtualMachineId.VirtualMachineName, state.Extensions[i].Name)
+				log.Printf("[DEBUG] Deleting %s..", extensionId)
+				if err := client.DeleteThenPoll(ctx, extensionId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", extensionId, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 90 * time.Minute,
+	}
+}
+
+func (r VirtualMachineExtensionsResource) createOrUpdateExtension(ctx context.Context, client *virtualmachineextensions.VirtualMachineExtensionsClient, virtualMachineId virtualmachines.VirtualMachineId, location string, input VirtualMachineExtensionsExtensionModel, sharedSettings string) error {
+	id := virtualmachineextensions.NewExtensionID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroupName, virtualMachineId.VirtualMachineName, input.Name)
+
+	settings, err := mergeVirtualMachineExtensionSettings(sharedSettings, input.Settings)
+	if err != nil {
+		return fmt.Errorf("building settings for %s: %+v", id, err)
+	}
+
+	extension := virtualmachineextensions.VirtualMachineExtension{
+		Location: pointer.To(location),
+		Properties: &virtualmachineextensions.VirtualMachineExtensionProperties{
+			Publisher:               pointer.To(input.Publisher),
+			Type:                    pointer.To(input.Type),
+			TypeHandlerVersion:      pointer.To(input.TypeHandlerVersion),
+			AutoUpgradeMinorVersion: pointer.To(input.AutoUpgradeMinorVersion),
+			EnableAutomaticUpgrade:  pointer.To(input.AutomaticUpgradeEnabled),
+			SuppressFailures:        pointer.To(input.FailureSuppressionEnabled),
+		},
+	}
+	if settings != nil {
+		extension.Properties.Settings = pointer.To(interface{}(settings))
+	}
+
+	if input.ProtectedSettings != "" {
+		var protectedSettings interface{}
+		if err := json.Unmarshal([]byte(input.ProtectedSettings), &protectedSettings); err != nil {
+			return fmt.Errorf("unmarshaling `protected_settings` for %s: %+v", id, err)
+		}
+		extension.Properties.ProtectedSettings = pointer.To(protectedSettings)
+	}
+
+	log.Printf("[DEBUG] Creating/Updating %s..", id)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, extension); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func validateVirtualMachineExtensionNamesAreUnique(extensions []VirtualMachineExtensionsExtensionModel) error {
+	names := make(map[string]struct{})
+	for _, extension := range extensions {
+		if _, ok := names[extension.Name]; ok {
+			return fmt.Errorf("the Extension %q is defined more than once within `extension`", extension.Name)
+		}
+		names[extension.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// mergeVirtualMachineExtensionSettings returns the `settings` for an Extension merged over the `shared_settings`,
+// such that top-level keys defined in the Extension take precedence over those in the shared settings.
+func mergeVirtualMachineExtensionSettings(shared string, settings string) (map[string]interface{}, error) {
+	if shared == "" && settings == "" {
+		return nil, nil
+	}
+
+	result := make(map[string]interface{})
+	for _, input := range []string{shared, settings} {
+		if input == "" {
+			continue
+		}
+
+		var v map[string]interface{}
+		if err := json.Unmarshal([]byte(input), &v); err != nil {
+			return nil, fmt.Errorf("unmarshaling settings, these must be a JSON object: %+v", err)
+		}
+		for key, value := range v {
+			result[key] = value
+		}
+	}
+
+	return result, nil
+}
+
+// flattenVirtualMachineExtensionSettings returns the `settings` for an Extension from the settings returned by the API,
+// which contain the `shared_settings` merged in. Top-level keys matching the shared settings are removed, unless they're
+// also defined in the existing settings for the Extension.
+func flattenVirtualMachineExtensionSettings(input *interface{}, shared string, existing string) (string, error) {
+	if input == nil || *input == nil {
+		return "", nil
+	}
+
+	settings, ok := (*input).(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("expected the settings to be a JSON object but got %T", *input)
+	}
+
+	sharedSettings := make(map[string]interface{})
+	if shared != "" {
+		if err := json.Unmarshal([]byte(shared), &sharedSettings); err != nil {
+			return "", fmt.Errorf("unmarshaling `shared_settings`: %+v", err)
+		}
+	}
+
+	existingSettings := make(map[string]interface{})
+	if existing != "" {
+		if err := json.Unmarshal([]byte(existing), &existingSettings); err != nil {
+			return "", fmt.Errorf("unmarshaling `settings`: %+v", err)
+		}
+	}
+
+	result := make(map[string]interface{})
+	for key, value := range settings {
+		if sharedValue, ok := sharedSettings[key]; ok && reflect.DeepEqual(sharedValue, value) {
+			if _, ok := existingSettings[key]; !ok {
+				continue
+			}
+		}
+		result[key] = value
+	}
+
+	if len(result) == 0 {
+		return "", nil
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("marshaling settings: %+v", err)
+	}
+
+	return string(output), nil
+}
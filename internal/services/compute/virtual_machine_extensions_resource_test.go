@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachineextensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachineExtensionsResource struct{}
+
+func TestAccVirtualMachineExtensions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_extensions", "test")
+	r := VirtualMachineExtensionsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineExtensions_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_extensions", "test")
+	r := VirtualMachineExtensionsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension.#").HasValue("2"),
+				check.That(data.ResourceName).Key("extension.0.name").HasValue("CustomScript"),
+				check.That(data.ResourceName).Key("extension.1.name").HasValue("RunCommandLinux"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (VirtualMachineExtensionsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineExtensionsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := state.Attributes["extension.0.name"]
+	extensionId := virtualmachineextensions.NewExtensionID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroupName, id.VirtualMachineId.VirtualMachineName, name)
+	resp, err := clients.Compute.VirtualMachineExtensionsClient.Get(ctx, extensionId, virtualmachineextensions.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", extensionId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VirtualMachineExtensionsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_extensions" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  extension {
+    name                 = "CustomScript"
+    publisher            = "Microsoft.Azure.Extensions"
+    type                 = "CustomScript"
+    type_handler_version = "2.0"
+
+    settings = jsonencode({
+      commandToExecute = "hostname"
+    })
+  }
+}
+`, r.template(data))
+}
+
+func (r VirtualMachineExtensionsResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_extensions" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  shared_settings = jsonencode({
+    timestamp = 123456789
+  })
+
+  extension {
+    name                 = "CustomScript"
+    publisher            = "Microsoft.Azure.Extensions"
+    type                 = "CustomScript"
+    type_handler_version = "2.0"
+
+    settings = jsonencode({
+      commandToExecute = "hostname"
+    })
+  }
+
+  extension {
+    name                 = "RunCommandLinux"
+    publisher            = "Microsoft.CPlat.Core"
+    type                 = "RunCommandLinux"
+    type_handler_version = "1.0"
+  }
+}
+`, r.template(data))
+}
+
+func (VirtualMachineExtensionsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachinePatchAssessmentResource struct{}
+
+var (
+	_ sdk.Resource                   = VirtualMachinePatchAssessmentResource{}
+	_ sdk.ResourceWithCustomImporter = VirtualMachinePatchAssessmentResource{}
+)
+
+type VirtualMachinePatchAssessmentResourceModel struct {
+	VirtualMachineId string            `tfschema:"virtual_machine_id"`
+	Triggers         map[string]string `tfschema:"triggers"`
+
+	AssessmentActivityId          string                               `tfschema:"assessment_activity_id"`
+	AvailablePatches              []VirtualMachinePatchAssessmentPatch `tfschema:"available_patch"`
+	CriticalAndSecurityPatchCount int64                                `tfschema:"critical_and_security_patch_count"`
+	OtherPatchCount               int64                                `tfschema:"other_patch_count"`
+	RebootPending                 bool                                 `tfschema:"reboot_pending"`
+	StartDateTime                 string                               `tfschema:"start_date_time"`
+	Status                        string                               `tfschema:"status"`
+}
+
+type VirtualMachinePatchAssessmentPatch struct {
+	Classifications []string `tfschema:"classifications"`
+	KbId            string   `tfschema:"kb_id"`
+	Name            string   `tfschema:"name"`
+	PatchId         string   `tfschema:"patch_id"`
+	PublishedDate   string   `tfschema:"published_date"`
+	RebootBehavior  string   `tfschema:"reboot_behavior"`
+	Version         string   `tfschema:"version"`
+}
+
+func (VirtualMachinePatchAssessmentResource) ResourceType() string {
+	return "azurerm_virtual_machine_patch_assessment"
+}
+
+func (VirtualMachinePatchAssessmentResource) ModelObject() interface{} {
+	return &VirtualMachinePatchAssessmentResourceModel{}
+}
+
+func (VirtualMachinePatchAssessmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.VirtualMachinePatchAssessmentIDValidation
+}
+
+func (VirtualMachinePatchAssessmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualmachines.ValidateVirtualMachineID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (VirtualMachinePatchAssessmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assessment_activity_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"available_patch": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"classifications": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"kb_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"patch_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"published_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"reboot_behavior": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"critical_and_security_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"other_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"reboot_pending": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"start_date_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r VirtualMachinePatchAssessmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			var config VirtualMachinePatchAssessmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := virtualmachines.ParseVirtualMachineID(config.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
+
+			resp, err := client.AssessPatches(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("assessing patches on %s: %+v", id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the assessment of patches on %s: %+v", id, err)
+			}
+
+			var result virtualmachines.VirtualMachineAssessPatchesResult
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving the result of assessing patches on %s: %+v", id, err)
+			}
+
+			if status := pointer.From(result.Status); status == virtualmachines.PatchOperationStatusFailed {
+				message := "no error details were returned"
+				if result.Error != nil && result.Error.Message != nil {
+					message = *result.Error.Message
+				}
+				return fmt.Errorf("assessing patches on %s: the assessment finished with the status %q: %s", id, status, message)
+			}
+
+			config.AssessmentActivityId = pointer.From(result.AssessmentActivityId)
+			config.AvailablePatches = flattenVirtualMachinePatchAssessmentPatches(result.AvailablePatches)
+			config.CriticalAndSecurityPatchCount = pointer.From(result.CriticalAndSecurityPatchCount)
+			config.OtherPatchCount = pointer.From(result.OtherPatchCount)
+			config.RebootPending = pointer.From(result.RebootPending)
+			config.StartDateTime = pointer.From(result.StartDateTime)
+			config.Status = pointer.FromEnum(result.Status)
+
+			metadata.SetID(parse.NewVirtualMachinePatchAssessmentID(*id))
+
+			// the result of the assessment isn't retrievable from the API afterwards, so it's stored as-is
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (VirtualMachinePatchAssessmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			id, err := parse.VirtualMachinePatchAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.VirtualMachineId, virtualmachines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (VirtualMachinePatchAssessmentResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_virtual_machine_patch_assessment` can't be imported, since it represents a one-time patch assessment on the Virtual Machine")
+	}
+}
+
+func (VirtualMachinePatchAssessmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// an assessment doesn't change the Virtual Machine, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func flattenVirtualMachinePatchAssessmentPatches(input *[]virtualmachines.VirtualMachineSoftwarePatchProperties) []VirtualMachinePatchAssessmentPatch {
+	output := make([]VirtualMachinePatchAssessmentPatch, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, VirtualMachinePatchAssessmentPatch{
+			Classifications: pointer.From(v.Classifications),
+			KbId:            pointer.From(v.KbId),
+			Name:            pointer.From(v.Name),
+			PatchId:         pointer.From(v.PatchId),
+			PublishedDate:   pointer.From(v.PublishedDate),
+			RebootBehavior:  pointer.FromEnum(v.RebootBehavior),
+			Version:         pointer.From(v.Version),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachinePatchAssessmentResource struct{}
+
+func TestAccVirtualMachinePatchAssessment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_patch_assessment", "test")
+	r := VirtualMachinePatchAssessmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+				check.That(data.ResourceName).Key("assessment_activity_id").Exists(),
+			),
+		},
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachinePatchAssessmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachinePatchAssessmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VirtualMachinesClient.Get(ctx, id.VirtualMachineId, virtualmachines.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+	}
+
+	return pointer.To(state.Attributes["assessment_activity_id"] != ""), nil
+}
+
+func (VirtualMachinePatchAssessmentResource) basic(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_patch_assessment" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, VirtualMachineExtensionsResource{}.template(data), trigger)
+}
@@ -0,0 +1,406 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachinePatchInstallationResource struct{}
+
+var (
+	_ sdk.Resource                   = VirtualMachinePatchInstallationResource{}
+	_ sdk.ResourceWithCustomImporter = VirtualMachinePatchInstallationResource{}
+)
+
+type VirtualMachinePatchInstallationResourceModel struct {
+	VirtualMachineId string                                   `tfschema:"virtual_machine_id"`
+	MaximumDuration  string                                   `tfschema:"maximum_duration"`
+	RebootSetting    string                                   `tfschema:"reboot_setting"`
+	Linux            []VirtualMachinePatchInstallationLinux   `tfschema:"linux"`
+	Windows          []VirtualMachinePatchInstallationWindows `tfschema:"windows"`
+	Triggers         map[string]string                        `tfschema:"triggers"`
+
+	ExcludedPatchCount        int64  `tfschema:"excluded_patch_count"`
+	FailedPatchCount          int64  `tfschema:"failed_patch_count"`
+	InstallationActivityId    string `tfschema:"installation_activity_id"`
+	InstalledPatchCount       int64  `tfschema:"installed_patch_count"`
+	MaintenanceWindowExceeded bool   `tfschema:"maintenance_window_exceeded"`
+	NotSelectedPatchCount     int64  `tfschema:"not_selected_patch_count"`
+	PendingPatchCount         int64  `tfschema:"pending_patch_count"`
+	RebootStatus              string `tfschema:"reboot_status"`
+	StartDateTime             string `tfschema:"start_date_time"`
+	Status                    string `tfschema:"status"`
+}
+
+type VirtualMachinePatchInstallationLinux struct {
+	ClassificationsToInclude  []string `tfschema:"classifications_to_include"`
+	PackageNamesMaskToExclude []string `tfschema:"package_names_mask_to_exclude"`
+	PackageNamesMaskToInclude []string `tfschema:"package_names_mask_to_include"`
+}
+
+type VirtualMachinePatchInstallationWindows struct {
+	ClassificationsToInclude  []string `tfschema:"classifications_to_include"`
+	ExcludeKbsRequiringReboot bool     `tfschema:"exclude_kbs_requiring_reboot"`
+	KbNumbersToExclude        []string `tfschema:"kb_numbers_to_exclude"`
+	KbNumbersToInclude        []string `tfschema:"kb_numbers_to_include"`
+	MaxPatchPublishDate       string   `tfschema:"max_patch_publish_date"`
+}
+
+func (VirtualMachinePatchInstallationResource) ResourceType() string {
+	return "azurerm_virtual_machine_patch_installation"
+}
+
+func (VirtualMachinePatchInstallationResource) ModelObject() interface{} {
+	return &VirtualMachinePatchInstallationResourceModel{}
+}
+
+func (VirtualMachinePatchInstallationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.VirtualMachinePatchInstallationIDValidation
+}
+
+func (VirtualMachinePatchInstallationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualmachines.ValidateVirtualMachineID,
+		},
+
+		"maximum_duration": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ISO8601DurationBetween("PT5M", "PT4H"),
+		},
+
+		"reboot_setting": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(virtualmachines.PossibleValuesForVMGuestPatchRebootSetting(), false),
+		},
+
+		"linux": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"linux", "windows"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"classifications_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(virtualmachines.PossibleValuesForVMGuestPatchClassificationLinux(), false),
+						},
+					},
+
+					"package_names_mask_to_exclude": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"package_names_mask_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"windows": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"linux", "windows"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"classifications_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(virtualmachines.PossibleValuesForVMGuestPatchClassificationWindows(), false),
+						},
+					},
+
+					"exclude_kbs_requiring_reboot": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"kb_numbers_to_exclude": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"kb_numbers_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"max_patch_publish_date": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (VirtualMachinePatchInstallationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"excluded_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"failed_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"installation_activity_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"installed_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"maintenance_window_exceeded": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"not_selected_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"pending_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"reboot_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_date_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r VirtualMachinePatchInstallationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			var config VirtualMachinePatchInstallationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := virtualmachines.ParseVirtualMachineID(config.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
+
+			payload := virtualmachines.VirtualMachineInstallPatchesParameters{
+				MaximumDuration:   pointer.To(config.MaximumDuration),
+				RebootSetting:     virtualmachines.VMGuestPatchRebootSetting(config.RebootSetting),
+				LinuxParameters:   expandVirtualMachinePatchInstallationLinux(config.Linux),
+				WindowsParameters: expandVirtualMachinePatchInstallationWindows(config.Windows),
+			}
+
+			resp, err := client.InstallPatches(ctx, *id, payload)
+			if err != nil {
+				return fmt.Errorf("installing patches on %s: %+v", id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the installation of patches on %s: %+v", id, err)
+			}
+
+			var result virtualmachines.VirtualMachineInstallPatchesResult
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving the result of installing patches on %s: %+v", id, err)
+			}
+
+			if status := pointer.From(result.Status); status == virtualmachines.PatchOperationStatusFailed {
+				message := "no error details were returned"
+				if result.Error != nil && result.Error.Message != nil {
+					message = *result.Error.Message
+				}
+				return fmt.Errorf("installing patches on %s: the installation finished with the status %q: %s", id, status, message)
+			}
+
+			config.ExcludedPatchCount = pointer.From(result.ExcludedPatchCount)
+			config.FailedPatchCount = pointer.From(result.FailedPatchCount)
+			config.InstallationActivityId = pointer.From(result.InstallationActivityId)
+			config.InstalledPatchCount = pointer.From(result.InstalledPatchCount)
+			config.MaintenanceWindowExceeded = pointer.From(result.MaintenanceWindowExceeded)
+			config.NotSelectedPatchCount = pointer.From(result.NotSelectedPatchCount)
+			config.PendingPatchCount = pointer.From(result.PendingPatchCount)
+			config.RebootStatus = pointer.FromEnum(result.RebootStatus)
+			config.StartDateTime = pointer.From(result.StartDateTime)
+			config.Status = pointer.FromEnum(result.Status)
+
+			metadata.SetID(parse.NewVirtualMachinePatchInstallationID(*id))
+
+			// the result of the installation isn't retrievable from the API afterwards, so it's stored as-is
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (VirtualMachinePatchInstallationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachinesClient
+
+			id, err := parse.VirtualMachinePatchInstallationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.VirtualMachineId, virtualmachines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (VirtualMachinePatchInstallationResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_virtual_machine_patch_installation` can't be imported, since it represents a one-time patch installation on the Virtual Machine")
+	}
+}
+
+func (VirtualMachinePatchInstallationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// patches can't be uninstalled, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func expandVirtualMachinePatchInstallationLinux(input []VirtualMachinePatchInstallationLinux) *virtualmachines.LinuxParameters {
+	if len(input) == 0 {
+		return nil
+	}
+
+	linux := input[0]
+	classifications := make([]virtualmachines.VMGuestPatchClassificationLinux, 0)
+	for _, v := range linux.ClassificationsToInclude {
+		classifications = append(classifications, virtualmachines.VMGuestPatchClassificationLinux(v))
+	}
+
+	return &virtualmachines.LinuxParameters{
+		ClassificationsToInclude:  pointer.To(classifications),
+		PackageNameMasksToExclude: pointer.To(linux.PackageNamesMaskToExclude),
+		PackageNameMasksToInclude: pointer.To(linux.PackageNamesMaskToInclude),
+	}
+}
+
+func expandVirtualMachinePatchInstallationWindows(input []VirtualMachinePatchInstallationWindows) *virtualmachines.WindowsParameters {
+	if len(input) == 0 {
+		return nil
+	}
+
+	windows := input[0]
+	classifications := make([]virtualmachines.VMGuestPatchClassificationWindows, 0)
+	for _, v := range windows.ClassificationsToInclude {
+		classifications = append(classifications, virtualmachines.VMGuestPatchClassificationWindows(v))
+	}
+
+	output := &virtualmachines.WindowsParameters{
+		ClassificationsToInclude:  pointer.To(classifications),
+		ExcludeKbsRequiringReboot: pointer.To(windows.ExcludeKbsRequiringReboot),
+		KbNumbersToExclude:        pointer.To(windows.KbNumbersToExclude),
+		KbNumbersToInclude:        pointer.To(windows.KbNumbersToInclude),
+	}
+
+	if windows.MaxPatchPublishDate != "" {
+		output.MaxPatchPublishDate = pointer.To(windows.MaxPatchPublishDate)
+	}
+
+	return output
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachinePatchInstallationResource struct{}
+
+func TestAccVirtualMachinePatchInstallation_linux(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_patch_installation", "test")
+	r := VirtualMachinePatchInstallationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linux(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+				check.That(data.ResourceName).Key("installation_activity_id").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachinePatchInstallationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachinePatchInstallationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VirtualMachinesClient.Get(ctx, id.VirtualMachineId, virtualmachines.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+	}
+
+	return pointer.To(state.Attributes["installation_activity_id"] != ""), nil
+}
+
+func (VirtualMachinePatchInstallationResource) linux(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_patch_installation" "test" {
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  maximum_duration   = "PT1H"
+  reboot_setting     = "IfRequired"
+
+  linux {
+    classifications_to_include    = ["Critical", "Security"]
+    package_names_mask_to_exclude = ["kernel*"]
+  }
+}
+`, VirtualMachineExtensionsResource{}.template(data))
+}
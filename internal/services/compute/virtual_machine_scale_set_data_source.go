@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceVirtualMachineScaleSet() *pluginsdk.Resource {
@@ -194,35 +195,47 @@ func dataSourceVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("listing VM Instances for %q: %+v", id, err)
 	}
 
-	var connInfo *connectionInfo
-	var vmModel *virtualmachines.VirtualMachine
-	for _, item := range result.Items {
-		if item.InstanceId != nil {
-			vmId := networkinterfaces.NewVirtualMachineID(subscriptionId, id.ResourceGroupName, id.VirtualMachineScaleSetName, *item.InstanceId)
-			nics, err := networkInterfacesClient.ListVirtualMachineScaleSetVMNetworkInterfacesComplete(ctx, vmId)
-			if err != nil {
-				if !response.WasNotFound(nics.LatestHttpResponse) {
-					return fmt.Errorf("listing Network Interfaces for VM Instance %q for %q: %+v", *item.InstanceId, id, err)
-				}
+	// the connection information for each instance requires further requests, so these are retrieved concurrently
+	flattened, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, result.Items, func(ctx context.Context, item virtualmachinescalesetvms.VirtualMachineScaleSetVM) (interface{}, error) {
+		if item.InstanceId == nil {
+			return nil, nil
+		}
 
-				// Network Interfaces of VM in Flexible VMSS are accessed from single VM
-				virtualMachineId := virtualmachines.NewVirtualMachineID(subscriptionId, id.ResourceGroupName, *item.InstanceId)
-				vm, err := virtualMachinesClient.Get(ctx, virtualMachineId, optionsVM)
-				if err != nil {
-					return fmt.Errorf("retrieving VM Instance %q for %q: %+v", *item.InstanceId, id, err)
-				}
-				connInfoRaw := retrieveConnectionInformation(ctx, networkInterfacesClient, publicIPAddressesClient, vm.Model.Properties)
-				connInfo = &connInfoRaw
-				vmModel = vm.Model
-			} else {
-				connInfo, err = getVirtualMachineScaleSetVMConnectionInfo(ctx, nics.Items, id.ResourceGroupName, id.VirtualMachineScaleSetName, *item.InstanceId, vmssPublicIpAddressesClient)
-				if err != nil {
-					return err
-				}
+		var connInfo *connectionInfo
+		var vmModel *virtualmachines.VirtualMachine
+
+		vmId := networkinterfaces.NewVirtualMachineID(subscriptionId, id.ResourceGroupName, id.VirtualMachineScaleSetName, *item.InstanceId)
+		nics, err := networkInterfacesClient.ListVirtualMachineScaleSetVMNetworkInterfacesComplete(ctx, vmId)
+		if err != nil {
+			if !response.WasNotFound(nics.LatestHttpResponse) {
+				return nil, fmt.Errorf("listing Network Interfaces for VM Instance %q for %q: %+v", *item.InstanceId, id, err)
+			}
+
+			// Network Interfaces of VM in Flexible VMSS are accessed from single VM
+			virtualMachineId := virtualmachines.NewVirtualMachineID(subscriptionId, id.ResourceGroupName, *item.InstanceId)
+			vm, err := virtualMachinesClient.Get(ctx, virtualMachineId, optionsVM)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving VM Instance %q for %q: %+v", *item.InstanceId, id, err)
+			}
+			connInfoRaw := retrieveConnectionInformation(ctx, networkInterfacesClient, publicIPAddressesClient, vm.Model.Properties)
+			connInfo = &connInfoRaw
+			vmModel = vm.Model
+		} else {
+			connInfo, err = getVirtualMachineScaleSetVMConnectionInfo(ctx, nics.Items, id.ResourceGroupName, id.VirtualMachineScaleSetName, *item.InstanceId, vmssPublicIpAddressesClient)
+			if err != nil {
+				return nil, err
 			}
+		}
+
+		return flattenVirtualMachineScaleSetVM(item, connInfo, vmModel, orchestrationMode), nil
+	})
+	if err != nil {
+		return err
+	}
 
-			flattenedInstances := flattenVirtualMachineScaleSetVM(item, connInfo, vmModel, orchestrationMode)
-			instances = append(instances, flattenedInstances)
+	for _, instance := range flattened {
+		if instance != nil {
+			instances = append(instances, instance)
 		}
 	}
 	if err := d.Set("instances", instances); err != nil {
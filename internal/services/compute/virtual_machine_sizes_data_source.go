@@ -0,0 +1,389 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/computerps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachineSizesDataSource struct{}
+
+var _ sdk.DataSource = VirtualMachineSizesDataSource{}
+
+type VirtualMachineSizesDataSourceModel struct {
+	Location                       string                    `tfschema:"location"`
+	Zone                           string                    `tfschema:"zone"`
+	MinimumVCPUs                   int64                     `tfschema:"minimum_vcpus"`
+	MaximumVCPUs                   int64                     `tfschema:"maximum_vcpus"`
+	MinimumMemoryInGB              float64                   `tfschema:"minimum_memory_in_gb"`
+	MaximumMemoryInGB              float64                   `tfschema:"maximum_memory_in_gb"`
+	PremiumIOSupported             bool                      `tfschema:"premium_io_supported"`
+	AcceleratedNetworkingSupported bool                      `tfschema:"accelerated_networking_supported"`
+	ConfidentialComputingSupported bool                      `tfschema:"confidential_computing_supported"`
+	InstancesWithinQuota           int64                     `tfschema:"instances_within_quota"`
+	Sizes                          []VirtualMachineSizeModel `tfschema:"sizes"`
+}
+
+type VirtualMachineSizeModel struct {
+	Name                           string   `tfschema:"name"`
+	Family                         string   `tfschema:"family"`
+	VCPUs                          int64    `tfschema:"vcpus"`
+	MemoryInGB                     float64  `tfschema:"memory_in_gb"`
+	MaxDataDiskCount               int64    `tfschema:"max_data_disk_count"`
+	PremiumIOSupported             bool     `tfschema:"premium_io_supported"`
+	AcceleratedNetworkingSupported bool     `tfschema:"accelerated_networking_supported"`
+	ConfidentialComputingType      string   `tfschema:"confidential_computing_type"`
+	Zones                          []string `tfschema:"zones"`
+}
+
+func (r VirtualMachineSizesDataSource) ResourceType() string {
+	return "azurerm_virtual_machine_sizes"
+}
+
+func (r VirtualMachineSizesDataSource) ModelObject() interface{} {
+	return &VirtualMachineSizesDataSourceModel{}
+}
+
+func (r VirtualMachineSizesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"zone": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"minimum_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"maximum_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"minimum_memory_in_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+
+		"maximum_memory_in_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+
+		"premium_io_supported": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"accelerated_networking_supported": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"confidential_computing_supported": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"instances_within_quota": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func (r VirtualMachineSizesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sizes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"family": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"vcpus": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"memory_in_gb": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"max_data_disk_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"premium_io_supported": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"accelerated_networking_supported": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"confidential_computing_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"zones": commonschema.ZonesMultipleComputed(),
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineSizesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.SkusClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state VirtualMachineSizesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := virtualmachines.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			// the Resource SKUs API returns every SKU in every location unless filtered
+			opts := skus.DefaultResourceSkusListOperationOptions()
+			opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", id.LocationName))
+			resp, err := client.ResourceSkusListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), opts)
+			if err != nil {
+				return fmt.Errorf("listing the Resource SKUs for %s: %+v", id, err)
+			}
+
+			var availableQuota map[string]int64
+			if state.InstancesWithinQuota > 0 {
+				usagesId := computerps.NewLocationID(subscriptionId, id.LocationName)
+				usages, err := metadata.Client.Compute.ComputeRPSClient.UsageListComplete(ctx, usagesId)
+				if err != nil {
+					return fmt.Errorf("listing the Compute Usages for %s: %+v", id, err)
+				}
+				availableQuota = flattenVirtualMachineSizesAvailableQuota(usages.Items)
+			}
+
+			sizes := make([]VirtualMachineSizeModel, 0)
+			for _, sku := range resp.Items {
+				if !strings.EqualFold(pointer.From(sku.ResourceType), "virtualMachines") {
+					continue
+				}
+
+				size, available := flattenVirtualMachineSize(sku, id.LocationName)
+				if !available || !virtualMachineSizeMatches(size, state) {
+					continue
+				}
+
+				if availableQuota != nil && !virtualMachineSizeWithinQuota(size, state.InstancesWithinQuota, availableQuota) {
+					continue
+				}
+
+				sizes = append(sizes, size)
+			}
+
+			// ordered from the smallest to the largest size, so that the first item can be used as the cheapest option
+			sort.SliceStable(sizes, func(i, j int) bool {
+				if sizes[i].VCPUs != sizes[j].VCPUs {
+					return sizes[i].VCPUs < sizes[j].VCPUs
+				}
+				if sizes[i].MemoryInGB != sizes[j].MemoryInGB {
+					return sizes[i].MemoryInGB < sizes[j].MemoryInGB
+				}
+				return sizes[i].Name < sizes[j].Name
+			})
+
+			state.Location = id.LocationName
+			state.Sizes = sizes
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenVirtualMachineSize returns the details of the SKU and whether it's available to this Subscription in the Location
+func flattenVirtualMachineSize(sku skus.ResourceSku, locationName string) (VirtualMachineSizeModel, bool) {
+	output := VirtualMachineSizeModel{
+		Name:   pointer.From(sku.Name),
+		Family: pointer.From(sku.Family),
+		Zones:  make([]string, 0),
+	}
+
+	for _, capability := range pointer.From(sku.Capabilities) {
+		if capability.Name == nil || capability.Value == nil {
+			continue
+		}
+
+		switch strings.ToLower(*capability.Name) {
+		case "vcpus":
+			if v, err := strconv.ParseInt(*capability.Value, 10, 64); err == nil {
+				output.VCPUs = v
+			}
+		case "memorygb":
+			if v, err := strconv.ParseFloat(*capability.Value, 64); err == nil {
+				output.MemoryInGB = v
+			}
+		case "maxdatadiskcount":
+			if v, err := strconv.ParseInt(*capability.Value, 10, 64); err == nil {
+				output.MaxDataDiskCount = v
+			}
+		case "premiumio":
+			output.PremiumIOSupported = strings.EqualFold(*capability.Value, "True")
+		case "acceleratednetworkingenabled":
+			output.AcceleratedNetworkingSupported = strings.EqualFold(*capability.Value, "True")
+		case "confidentialcomputingtype":
+			output.ConfidentialComputingType = *capability.Value
+		}
+	}
+
+	restrictedZones := make(map[string]struct{})
+	for _, restriction := range pointer.From(sku.Restrictions) {
+		switch pointer.From(restriction.Type) {
+		case skus.ResourceSkuRestrictionsTypeLocation:
+			for _, v := range pointer.From(restriction.Values) {
+				if location.Normalize(v) == locationName {
+					return output, false
+				}
+			}
+		case skus.ResourceSkuRestrictionsTypeZone:
+			if info := restriction.RestrictionInfo; info != nil {
+				for _, zone := range pointer.From(info.Zones) {
+					restrictedZones[zone] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for _, info := range pointer.From(sku.LocationInfo) {
+		if location.Normalize(pointer.From(info.Location)) != locationName {
+			continue
+		}
+
+		for _, zone := range pointer.From(info.Zones) {
+			if _, restricted := restrictedZones[zone]; !restricted {
+				output.Zones = append(output.Zones, zone)
+			}
+		}
+	}
+	sort.Strings(output.Zones)
+
+	return output, true
+}
+
+func virtualMachineSizeMatches(size VirtualMachineSizeModel, filter VirtualMachineSizesDataSourceModel) bool {
+	if filter.Zone != "" {
+		found := false
+		for _, zone := range size.Zones {
+			if zone == filter.Zone {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if filter.MinimumVCPUs > 0 && size.VCPUs < filter.MinimumVCPUs {
+		return false
+	}
+
+	if filter.MaximumVCPUs > 0 && size.VCPUs > filter.MaximumVCPUs {
+		return false
+	}
+
+	if filter.MinimumMemoryInGB > 0 && size.MemoryInGB < filter.MinimumMemoryInGB {
+		return false
+	}
+
+	if filter.MaximumMemoryInGB > 0 && size.MemoryInGB > filter.MaximumMemoryInGB {
+		return false
+	}
+
+	if filter.PremiumIOSupported && !size.PremiumIOSupported {
+		return false
+	}
+
+	if filter.AcceleratedNetworkingSupported && !size.AcceleratedNetworkingSupported {
+		return false
+	}
+
+	if filter.ConfidentialComputingSupported && size.ConfidentialComputingType == "" {
+		return false
+	}
+
+	return true
+}
+
+// flattenVirtualMachineSizesAvailableQuota returns the number of vCPUs remaining within each quota for the Location,
+// keyed by the lower-cased name of the quota - which is either the name of a Virtual Machine Size family, or `cores`
+// for the total number of vCPUs in the Location
+func flattenVirtualMachineSizesAvailableQuota(input []computerps.Usage) map[string]int64 {
+	output := make(map[string]int64)
+	for _, usage := range input {
+		if usage.Name.Value == nil || usage.Unit != computerps.UnitCount {
+			continue
+		}
+
+		output[strings.ToLower(*usage.Name.Value)] = usage.Limit - usage.CurrentValue
+	}
+
+	return output
+}
+
+// virtualMachineSizeWithinQuota returns whether the specified number of instances of the Virtual Machine Size can be
+// deployed within both the quota for its family and the total vCPU quota for the Location. Sizes whose quota isn't
+// returned by the API are treated as not being within the quota.
+func virtualMachineSizeWithinQuota(size VirtualMachineSizeModel, instances int64, availableQuota map[string]int64) bool {
+	required := size.VCPUs * instances
+
+	for _, name := range []string{"cores", strings.ToLower(size.Family)} {
+		available, ok := availableQuota[name]
+		if !ok || available < required {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineSizesDataSource struct{}
+
+func TestAccDataSourceVirtualMachineSizes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	r := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.0.name").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualMachineSizes_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	r := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.0.name").Exists(),
+				check.That(data.ResourceName).Key("sizes.0.vcpus").HasValue("2"),
+				check.That(data.ResourceName).Key("sizes.0.premium_io_supported").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualMachineSizes_withinQuota(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	r := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.withinQuota(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.0.name").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachineSizesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (VirtualMachineSizesDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location             = "%s"
+  zone                 = "1"
+  minimum_vcpus        = 2
+  maximum_vcpus        = 2
+  minimum_memory_in_gb = 4
+  premium_io_supported = true
+}
+`, data.Locations.Primary)
+}
+
+func (VirtualMachineSizesDataSource) withinQuota(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location               = "%s"
+  maximum_vcpus          = 2
+  instances_within_quota = 1
+}
+`, data.Locations.Primary)
+}
@@ -52,6 +52,7 @@ type ConsumptionBudgetNotificationModel struct {
 	ContactEmails []string `tfschema:"contact_emails"`
 	ContactGroups []string `tfschema:"contact_groups"`
 	ContactRoles  []string `tfschema:"contact_roles"`
+	Locale        string   `tfschema:"locale"`
 }
 
 func getDimensionNames() []string {
@@ -228,6 +229,12 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
+
+					"locale": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForCultureCode(), false),
+					},
 				},
 			},
 		},
@@ -373,6 +380,9 @@ func expandConsumptionBudgetNotificationsFromModel(input []ConsumptionBudgetNoti
 		if len(n.ContactGroups) > 0 {
 			notification.ContactGroups = &n.ContactGroups
 		}
+		if n.Locale != "" {
+			notification.Locale = pointer.To(budgets.CultureCode(n.Locale))
+		}
 
 		notificationKey := fmt.Sprintf("%s_%s_%f_Percent", string(thresholdType), string(notification.Operator), notification.Threshold)
 		notifications[notificationKey] = notification
@@ -399,6 +409,7 @@ func flattenConsumptionBudgetNotificationsToModel(input *map[string]budgets.Noti
 			Threshold:     int64(n.Threshold),
 			ThresholdType: thresholdType,
 			ContactEmails: n.ContactEmails,
+			Locale:        pointer.FromEnum(n.Locale),
 		}
 
 		if v := n.ContactRoles; v != nil {
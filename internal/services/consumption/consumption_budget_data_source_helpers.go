@@ -4,6 +4,7 @@
 package consumption
 
 import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -59,6 +60,8 @@ func flattenConsumptionBudgetNotifications(input *map[string]budgets.Notificatio
 		}
 		block["contact_emails"] = emails
 
+		block["locale"] = pointer.FromEnum(n.Locale)
+
 		if scope != "management_group_id" {
 			var roles []interface{}
 			if v := n.ContactRoles; v != nil {
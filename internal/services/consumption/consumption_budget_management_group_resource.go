@@ -40,6 +40,7 @@ type ConsumptionBudgetMgmtNotificationModel struct {
 	ThresholdType string   `tfschema:"threshold_type"`
 	Operator      string   `tfschema:"operator"`
 	ContactEmails []string `tfschema:"contact_emails"`
+	Locale        string   `tfschema:"locale"`
 }
 
 var (
@@ -108,6 +109,12 @@ func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Sche
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
+
+					"locale": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(budgets.PossibleValuesForCultureCode(), false),
+					},
 				},
 			},
 		},
@@ -298,6 +305,9 @@ func expandConsumptionBudgetMgmtNotificationsFromModel(input []ConsumptionBudget
 		notification.ThresholdType = &thresholdType
 
 		notification.ContactEmails = n.ContactEmails
+		if n.Locale != "" {
+			notification.Locale = pointer.To(budgets.CultureCode(n.Locale))
+		}
 
 		notificationKey := fmt.Sprintf("%s_%s_%f_Percent", string(thresholdType), string(notification.Operator), notification.Threshold)
 		notifications[notificationKey] = notification
@@ -324,6 +334,7 @@ func flattenConsumptionBudgetMgmtNotificationsToModel(input *map[string]budgets.
 			Threshold:     int64(n.Threshold),
 			ThresholdType: thresholdType,
 			ContactEmails: n.ContactEmails,
+			Locale:        pointer.FromEnum(n.Locale),
 		})
 	}
 
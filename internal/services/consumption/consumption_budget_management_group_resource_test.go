@@ -320,6 +320,7 @@ resource "azurerm_consumption_budget_management_group" "test" {
     threshold      = 100.0
     operator       = "GreaterThan"
     threshold_type = "Forecasted"
+    locale         = "en-gb"
 
     contact_emails = [
       "foo@example.com",
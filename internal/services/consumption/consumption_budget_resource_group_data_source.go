@@ -192,6 +192,11 @@ func resourceArmConsumptionBudgetResourceGroupDataSource() *pluginsdk.Resource {
 								Type: pluginsdk.TypeString,
 							},
 						},
+
+						"locale": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
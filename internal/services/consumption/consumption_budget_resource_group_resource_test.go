@@ -307,6 +307,7 @@ resource "azurerm_consumption_budget_resource_group" "test" {
     threshold      = 90.0
     operator       = "EqualTo"
     threshold_type = "Forecasted"
+    locale         = "en-gb"
 
     contact_emails = [
       "foo@example.com",
@@ -323,6 +323,7 @@ resource "azurerm_consumption_budget_subscription" "test" {
     threshold      = 100.0
     operator       = "GreaterThan"
     threshold_type = "Forecasted"
+    locale         = "en-gb"
 
     contact_emails = [
       "foo@example.com",
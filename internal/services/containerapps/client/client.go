@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsrevisions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/daprcomponents"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/javacomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/jobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironmentsstorages"
@@ -21,6 +22,7 @@ type Client struct {
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
+	JavaComponentsClient       *javacomponents.JavaComponentsClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 	JobClient                  *jobs.JobsClient
@@ -63,6 +65,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(daprComponentClient.Client, o.Authorizers.ResourceManager)
 
+	javaComponentsClient, err := javacomponents.NewJavaComponentsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Java Components client : %+v", err)
+	}
+	o.Configure(javaComponentsClient.Client, o.Authorizers.ResourceManager)
+
 	jobsClient, err := jobs.NewJobsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Jobs client : %+v", err)
@@ -74,6 +82,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ContainerAppClient:         containerAppsClient,
 		ContainerAppRevisionClient: containerAppsRevisionsClient,
 		DaprComponentsClient:       daprComponentClient,
+		JavaComponentsClient:       javaComponentsClient,
 		ManagedEnvironmentClient:   managedEnvironmentClient,
 		StorageClient:              managedEnvironmentStoragesClient,
 		JobClient:                  jobsClient,
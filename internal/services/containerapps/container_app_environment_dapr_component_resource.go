@@ -30,6 +30,7 @@ type ContainerAppEnvironmentDaprComponentModel struct {
 	Secrets              []helpers.DaprSecret   `tfschema:"secret"`
 	Scopes               []string               `tfschema:"scopes"`
 	Metadata             []helpers.DaprMetadata `tfschema:"metadata"`
+	SecretStoreComponent string                 `tfschema:"secret_store_component"`
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentDaprComponentResource{}
@@ -107,6 +108,13 @@ func (r ContainerAppEnvironmentDaprComponentResource) Arguments() map[string]*pl
 			},
 			Description: "A list of scopes to which this component applies. e.g. a Container App's `dapr.app_id` value.",
 		},
+
+		"secret_store_component": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.DaprComponentName,
+			Description:  "The name of a Dapr secret store component used to resolve the `secret_name` of the `metadata` blocks.",
+		},
 	}
 }
 
@@ -157,6 +165,10 @@ func (r ContainerAppEnvironmentDaprComponentResource) Create() sdk.ResourceFunc
 				},
 			}
 
+			if daprComponent.SecretStoreComponent != "" {
+				daprComponentRequest.Properties.SecretStoreComponent = pointer.To(daprComponent.SecretStoreComponent)
+			}
+
 			if len(daprComponent.Scopes) > 0 {
 				daprComponentRequest.Properties.Scopes = &daprComponent.Scopes
 			}
@@ -194,6 +206,8 @@ func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
 			state.Name = id.DaprComponentName
 			state.ManagedEnvironmentId = daprcomponents.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID()
 
+			var secrets *[]daprcomponents.Secret
+
 			if model := daprComponentResp.Model; model != nil {
 				if props := model.Properties; props != nil {
 					state.Version = pointer.From(props.Version)
@@ -202,6 +216,8 @@ func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
 					state.InitTimeout = pointer.From(props.InitTimeout)
 					state.IgnoreErrors = pointer.From(props.IgnoreErrors)
 					state.Metadata = flattenDaprComponentPropertiesMetadata(props.Metadata)
+					state.SecretStoreComponent = pointer.From(props.SecretStoreComponent)
+					secrets = props.Secrets
 				}
 			}
 
@@ -210,7 +226,7 @@ func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
 			}
 
-			state.Secrets = helpers.FlattenContainerAppDaprSecrets(secretsResp.Model)
+			state.Secrets = helpers.FlattenContainerAppDaprSecrets(secretsResp.Model, secrets)
 
 			return metadata.Encode(&state)
 		},
@@ -262,7 +278,7 @@ func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
 			}
 
-			existing.Model.Properties.Secrets = helpers.UnpackContainerDaprSecretsCollection(secretsResp.Model)
+			existing.Model.Properties.Secrets = helpers.UnpackContainerDaprSecretsCollection(secretsResp.Model, existing.Model.Properties.Secrets)
 
 			if metadata.ResourceData.HasChange("version") {
 				existing.Model.Properties.Version = pointer.To(state.Version)
@@ -288,6 +304,13 @@ func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc
 				existing.Model.Properties.Scopes = pointer.To(state.Scopes)
 			}
 
+			if metadata.ResourceData.HasChange("secret_store_component") {
+				existing.Model.Properties.SecretStoreComponent = nil
+				if state.SecretStoreComponent != "" {
+					existing.Model.Properties.SecretStoreComponent = pointer.To(state.SecretStoreComponent)
+				}
+			}
+
 			if _, err := client.CreateOrUpdate(ctx, *id, *existing.Model); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
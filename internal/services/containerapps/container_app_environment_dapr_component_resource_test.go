@@ -86,6 +86,29 @@ func TestAccContainerAppEnvironmentDaprComponent_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccContainerAppEnvironmentDaprComponent_secretStoreComponent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_dapr_component", "test")
+	r := ContainerAppEnvironmentDaprComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.secretStoreComponent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secret_store_component").HasValue(fmt.Sprintf("acctest-secretstore-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ContainerAppEnvironmentDaprComponentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := daprcomponents.ParseDaprComponentID(state.ID)
 	if err != nil {
@@ -265,6 +288,50 @@ resource "azurerm_container_app_environment_dapr_component" "test" {
 `, r.template(data), data.RandomInteger, data.RandomString)
 }
 
+func (r ContainerAppEnvironmentDaprComponentResource) secretStoreComponent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_dapr_component" "secretstore" {
+  name                         = "acctest-secretstore-%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "secretstores.azure.keyvault"
+  version                      = "v1"
+
+  metadata {
+    name  = "vaultName"
+    value = "acctestkv%[3]s"
+  }
+
+  scopes = ["testapp"]
+}
+
+resource "azurerm_container_app_environment_dapr_component" "test" {
+  name                         = "acctest-dapr-%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "state.azure.blobstorage"
+  version                      = "v1"
+  secret_store_component       = azurerm_container_app_environment_dapr_component.secretstore.name
+
+  metadata {
+    name        = "storage-account-key"
+    secret_name = "storage-account-access-key"
+  }
+
+  metadata {
+    name  = "storage-container-name"
+    value = "container-app-storage"
+  }
+
+  scopes = ["testapp"]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
 func (r ContainerAppEnvironmentDaprComponentResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,375 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/javacomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct{}
+
+type ContainerAppEnvironmentJavaComponentModel struct {
+	Name                      string                                            `tfschema:"name"`
+	ContainerAppEnvironmentId string                                            `tfschema:"container_app_environment_id"`
+	ComponentType             string                                            `tfschema:"component_type"`
+	Configuration             map[string]string                                 `tfschema:"configuration"`
+	MinReplicas               int64                                             `tfschema:"min_replicas"`
+	MaxReplicas               int64                                             `tfschema:"max_replicas"`
+	ServiceBind               []ContainerAppEnvironmentJavaComponentServiceBind `tfschema:"service_bind"`
+	IngressFqdn               string                                            `tfschema:"ingress_fqdn"`
+}
+
+type ContainerAppEnvironmentJavaComponentServiceBind struct {
+	Name      string `tfschema:"name"`
+	ServiceId string `tfschema:"service_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentJavaComponentResource{}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentJavaComponentModel{}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_java_component"
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return javacomponents.ValidateJavaComponentID
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.JavaComponentName,
+			Description:  "The name for this Java Component.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: javacomponents.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment to which this Java Component belongs.",
+		},
+
+		"component_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(javacomponents.PossibleValuesForJavaComponentType(), false),
+			Description:  "The type of the Java Component. Possible values include `SpringBootAdmin`, `SpringCloudConfig` and `SpringCloudEureka`.",
+		},
+
+		"configuration": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Description: "A mapping of the Spring configuration property names to their values for this Java Component.",
+		},
+
+		"min_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The minimum number of replicas for this Java Component.",
+		},
+
+		"max_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of replicas for this Java Component.",
+		},
+
+		"service_bind": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"service_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: javacomponents.ValidateJavaComponentID,
+						Description:  "The ID of the Java Component to bind to.",
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the service bind.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"ingress_fqdn": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The FQDN of the ingress for this Java Component, when the Java Component type exposes one.",
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			var javaComponent ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&javaComponent); err != nil {
+				return err
+			}
+
+			containerAppEnvironmentId, err := javacomponents.ParseManagedEnvironmentID(javaComponent.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := javacomponents.NewJavaComponentID(metadata.Client.Account.SubscriptionId, containerAppEnvironmentId.ResourceGroupName, containerAppEnvironmentId.ManagedEnvironmentName, javaComponent.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := javacomponents.JavaComponent{
+				Properties: expandContainerAppEnvironmentJavaComponentProperties(javaComponent),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			var state ContainerAppEnvironmentJavaComponentModel
+
+			state.Name = id.JavaComponentName
+			state.ContainerAppEnvironmentId = javacomponents.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID()
+
+			if model := existing.Model; model != nil && model.Properties != nil {
+				props := model.Properties.JavaComponentProperties()
+
+				state.ComponentType = string(props.ComponentType)
+				state.Configuration = flattenContainerAppEnvironmentJavaComponentConfiguration(props.Configurations)
+				state.ServiceBind = flattenContainerAppEnvironmentJavaComponentServiceBinds(props.ServiceBinds)
+
+				if scale := props.Scale; scale != nil {
+					state.MinReplicas = pointer.From(scale.MinReplicas)
+					state.MaxReplicas = pointer.From(scale.MaxReplicas)
+				}
+
+				switch v := model.Properties.(type) {
+				case javacomponents.SpringBootAdminComponent:
+					if v.Ingress != nil {
+						state.IngressFqdn = pointer.From(v.Ingress.Fqdn)
+					}
+				case javacomponents.SpringCloudEurekaComponent:
+					if v.Ingress != nil {
+						state.IngressFqdn = pointer.From(v.Ingress.Fqdn)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var javaComponent ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&javaComponent); err != nil {
+				return err
+			}
+
+			// the configuration, scale and service binds are all replaced, so the full payload is sent
+			payload := javacomponents.JavaComponent{
+				Properties: expandContainerAppEnvironmentJavaComponentProperties(javaComponent),
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppEnvironmentJavaComponentProperties(input ContainerAppEnvironmentJavaComponentModel) javacomponents.JavaComponentProperties {
+	componentType := javacomponents.JavaComponentType(input.ComponentType)
+	configurations := expandContainerAppEnvironmentJavaComponentConfiguration(input.Configuration)
+	scale := &javacomponents.JavaComponentPropertiesScale{
+		MinReplicas: pointer.To(input.MinReplicas),
+		MaxReplicas: pointer.To(input.MaxReplicas),
+	}
+	serviceBinds := expandContainerAppEnvironmentJavaComponentServiceBinds(input.ServiceBind)
+
+	switch componentType {
+	case javacomponents.JavaComponentTypeSpringBootAdmin:
+		return javacomponents.SpringBootAdminComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	case javacomponents.JavaComponentTypeSpringCloudConfig:
+		return javacomponents.SpringCloudConfigComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	default:
+		return javacomponents.SpringCloudEurekaComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	}
+}
+
+func expandContainerAppEnvironmentJavaComponentConfiguration(input map[string]string) *[]javacomponents.JavaComponentConfigurationProperty {
+	result := make([]javacomponents.JavaComponentConfigurationProperty, 0)
+	for name, value := range input {
+		result = append(result, javacomponents.JavaComponentConfigurationProperty{
+			PropertyName: pointer.To(name),
+			Value:        pointer.To(value),
+		})
+	}
+
+	return &result
+}
+
+func flattenContainerAppEnvironmentJavaComponentConfiguration(input *[]javacomponents.JavaComponentConfigurationProperty) map[string]string {
+	result := make(map[string]string)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.PropertyName == nil {
+			continue
+		}
+		result[*v.PropertyName] = pointer.From(v.Value)
+	}
+
+	return result
+}
+
+func expandContainerAppEnvironmentJavaComponentServiceBinds(input []ContainerAppEnvironmentJavaComponentServiceBind) *[]javacomponents.JavaComponentServiceBind {
+	result := make([]javacomponents.JavaComponentServiceBind, 0)
+	for _, v := range input {
+		serviceBind := javacomponents.JavaComponentServiceBind{
+			ServiceId: pointer.To(v.ServiceId),
+		}
+		if v.Name != "" {
+			serviceBind.Name = pointer.To(v.Name)
+		}
+		result = append(result, serviceBind)
+	}
+
+	return &result
+}
+
+func flattenContainerAppEnvironmentJavaComponentServiceBinds(input *[]javacomponents.JavaComponentServiceBind) []ContainerAppEnvironmentJavaComponentServiceBind {
+	result := make([]ContainerAppEnvironmentJavaComponentServiceBind, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, ContainerAppEnvironmentJavaComponentServiceBind{
+			Name:      pointer.From(v.Name),
+			ServiceId: pointer.From(v.ServiceId),
+		})
+	}
+
+	return result
+}
@@ -2966,13 +2966,23 @@ func UnpackContainerSecretsCollection(input *containerapps.SecretsCollection) *[
 	return &result
 }
 
-func UnpackContainerDaprSecretsCollection(input *daprcomponents.DaprSecretsCollection) *[]daprcomponents.Secret {
+// UnpackContainerDaprSecretsCollection builds the secrets to send when updating a Dapr Component from the values
+// returned by the ListSecrets API - Key Vault references in the existing secrets are kept so that they aren't replaced
+// with the values resolved from Key Vault
+func UnpackContainerDaprSecretsCollection(input *daprcomponents.DaprSecretsCollection, existing *[]daprcomponents.Secret) *[]daprcomponents.Secret {
 	if input == nil || len(input.Value) == 0 {
 		return nil
 	}
 
+	keyVaultReferences := daprKeyVaultSecretReferences(existing)
+
 	result := make([]daprcomponents.Secret, 0)
 	for _, v := range input.Value {
+		if reference, ok := keyVaultReferences[pointer.From(v.Name)]; ok {
+			result = append(result, reference)
+			continue
+		}
+
 		result = append(result, daprcomponents.Secret{
 			Name:  v.Name,
 			Value: v.Value,
@@ -2982,9 +2992,30 @@ func UnpackContainerDaprSecretsCollection(input *daprcomponents.DaprSecretsColle
 	return &result
 }
 
+func daprKeyVaultSecretReferences(input *[]daprcomponents.Secret) map[string]daprcomponents.Secret {
+	result := make(map[string]daprcomponents.Secret)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if pointer.From(v.KeyVaultURL) != "" {
+			result[pointer.From(v.Name)] = daprcomponents.Secret{
+				Identity:    v.Identity,
+				KeyVaultURL: v.KeyVaultURL,
+				Name:        v.Name,
+			}
+		}
+	}
+
+	return result
+}
+
 type DaprSecret struct {
-	Name  string `tfschema:"name"`
-	Value string `tfschema:"value"`
+	Identity         string `tfschema:"identity"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+	Name             string `tfschema:"name"`
+	Value            string `tfschema:"value"`
 }
 
 func ExpandDaprSecrets(input []DaprSecret) *[]daprcomponents.Secret {
@@ -2995,6 +3026,18 @@ func ExpandDaprSecrets(input []DaprSecret) *[]daprcomponents.Secret {
 	result := make([]daprcomponents.Secret, 0)
 
 	for _, v := range input {
+		if v.KeyVaultSecretId != "" {
+			secret := daprcomponents.Secret{
+				KeyVaultURL: pointer.To(v.KeyVaultSecretId),
+				Name:        pointer.To(v.Name),
+			}
+			if v.Identity != "" {
+				secret.Identity = pointer.To(v.Identity)
+			}
+			result = append(result, secret)
+			continue
+		}
+
 		result = append(result, daprcomponents.Secret{
 			Name:  pointer.To(v.Name),
 			Value: pointer.To(v.Value),
@@ -3024,14 +3067,29 @@ func FlattenContainerAppSecrets(input *containerapps.SecretsCollection) []Secret
 	return result
 }
 
-func FlattenContainerAppDaprSecrets(input *daprcomponents.DaprSecretsCollection) []DaprSecret {
+// FlattenContainerAppDaprSecrets flattens the values returned by the ListSecrets API, the Key Vault references are only
+// returned in the properties of the Dapr Component so are taken from there
+func FlattenContainerAppDaprSecrets(input *daprcomponents.DaprSecretsCollection, properties *[]daprcomponents.Secret) []DaprSecret {
 	if input == nil || input.Value == nil {
 		return []DaprSecret{}
 	}
+
+	keyVaultReferences := daprKeyVaultSecretReferences(properties)
+
 	result := make([]DaprSecret, 0)
 	for _, v := range input.Value {
+		name := pointer.From(v.Name)
+		if reference, ok := keyVaultReferences[name]; ok {
+			result = append(result, DaprSecret{
+				Identity:         pointer.From(reference.Identity),
+				KeyVaultSecretId: pointer.From(reference.KeyVaultURL),
+				Name:             name,
+			})
+			continue
+		}
+
 		result = append(result, DaprSecret{
-			Name:  pointer.From(v.Name),
+			Name:  name,
 			Value: pointer.From(v.Value),
 		})
 	}
@@ -177,6 +177,57 @@ func TestValidateManagedEnvironmentStorageName(t *testing.T) {
 	}
 }
 
+func TestValidateJavaComponentName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "a",
+		},
+		{
+			Input: "9a",
+		},
+		{
+			Input: "a-",
+		},
+		{
+			Input: "a--a",
+		},
+		{
+			Input: "Eureka",
+		},
+		{
+			Input: "a1",
+			Valid: true,
+		},
+		{
+			Input: "config-server",
+			Valid: true,
+		},
+		{
+			Input: "valid123456789012345678901234567",
+			Valid: true,
+		},
+		{
+			Input: "invalid12345678901234567890123456",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := JavaComponentName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %s: %+v", tc.Valid, valid, tc.Input, errors)
+		}
+	}
+}
+
 func TestValidateManagedEnvironmentName(t *testing.T) {
 	cases := []struct {
 		Input string
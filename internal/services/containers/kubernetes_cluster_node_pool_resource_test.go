@@ -516,6 +516,36 @@ func TestAccKubernetesClusterNodePool_osDiskType(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePool_deletionProtection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deletionProtectionConfig(data, true, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the lock on the Kubernetes Cluster also applies to the Node Pool
+			Config:      r.deletionProtectionConfig(data, true, false),
+			ExpectError: regexp.MustCompile("ScopeLocked"),
+		},
+		{
+			Config: r.deletionProtectionConfig(data, false, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.deletionProtectionConfig(data, false, false),
+		},
+	})
+}
+
 func TestAccKubernetesClusterNodePool_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
 	r := KubernetesClusterNodePoolResource{}
@@ -1866,6 +1896,57 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 `, r.templateConfig(data))
 }
 
+func (r KubernetesClusterNodePoolResource) deletionProtectionConfig(data acceptance.TestData, deletionProtectionEnabled bool, nodePool bool) string {
+	nodePoolConfig := ""
+	if nodePool {
+		nodePoolConfig = `
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+
+  upgrade_settings {
+    max_surge = "10%"
+  }
+}
+`
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                        = "acctestaks%d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  dns_prefix                  = "acctestaks%d"
+  deletion_protection_enabled = %t
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+%s
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, deletionProtectionEnabled, nodePoolConfig)
+}
+
 func (r KubernetesClusterNodePoolResource) manualScaleIgnoreChangesConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KubernetesClusterOutboundNetworkDependenciesDataSourceModel struct {
+	KubernetesClusterId         string                                     `tfschema:"kubernetes_cluster_id"`
+	OutboundNetworkDependencies []KubernetesClusterOutboundDependencyModel `tfschema:"outbound_network_dependencies"`
+}
+
+type KubernetesClusterOutboundDependencyModel struct {
+	Category    string `tfschema:"category"`
+	Description string `tfschema:"description"`
+	DomainName  string `tfschema:"domain_name"`
+	IPAddress   string `tfschema:"ip_address"`
+	Port        int64  `tfschema:"port"`
+	Protocol    string `tfschema:"protocol"`
+}
+
+type KubernetesClusterOutboundNetworkDependenciesDataSource struct{}
+
+var _ sdk.DataSource = KubernetesClusterOutboundNetworkDependenciesDataSource{}
+
+func (r KubernetesClusterOutboundNetworkDependenciesDataSource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_outbound_network_dependencies"
+}
+
+func (r KubernetesClusterOutboundNetworkDependenciesDataSource) ModelObject() interface{} {
+	return &KubernetesClusterOutboundNetworkDependenciesDataSourceModel{}
+}
+
+func (r KubernetesClusterOutboundNetworkDependenciesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_cluster_id": commonschema.ResourceIDReferenceRequired(&commonids.KubernetesClusterId{}),
+	}
+}
+
+func (r KubernetesClusterOutboundNetworkDependenciesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"outbound_network_dependencies": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"domain_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"port": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KubernetesClusterOutboundNetworkDependenciesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesClustersClient
+
+			var state KubernetesClusterOutboundNetworkDependenciesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseKubernetesClusterID(state.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListOutboundNetworkDependenciesEndpointsComplete(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.LatestHttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("listing Outbound Network Dependencies for %s: %+v", id, err)
+			}
+
+			state.OutboundNetworkDependencies = flattenKubernetesClusterOutboundNetworkDependencies(resp.Items)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenKubernetesClusterOutboundNetworkDependencies(input []managedclusters.OutboundEnvironmentEndpoint) []KubernetesClusterOutboundDependencyModel {
+	results := make([]KubernetesClusterOutboundDependencyModel, 0)
+	for _, v := range input {
+		if v.Endpoints == nil {
+			continue
+		}
+
+		for _, endpoint := range *v.Endpoints {
+			result := KubernetesClusterOutboundDependencyModel{
+				Category:   pointer.From(v.Category),
+				DomainName: pointer.From(endpoint.DomainName),
+			}
+
+			if endpoint.EndpointDetails == nil || len(*endpoint.EndpointDetails) == 0 {
+				results = append(results, result)
+				continue
+			}
+
+			for _, detail := range *endpoint.EndpointDetails {
+				result.Description = pointer.From(detail.Description)
+				result.IPAddress = pointer.From(detail.IPAddress)
+				result.Port = pointer.From(detail.Port)
+				result.Protocol = pointer.From(detail.Protocol)
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results
+}
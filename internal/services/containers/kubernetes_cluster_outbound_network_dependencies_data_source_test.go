@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesClusterOutboundNetworkDependenciesDataSource struct{}
+
+func TestAccDataSourceKubernetesClusterOutboundNetworkDependencies_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_outbound_network_dependencies", "test")
+	r := KubernetesClusterOutboundNetworkDependenciesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("outbound_network_dependencies.0.category").IsNotEmpty(),
+				check.That(data.ResourceName).Key("outbound_network_dependencies.0.domain_name").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (KubernetesClusterOutboundNetworkDependenciesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_cluster_outbound_network_dependencies" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+}
+`, KubernetesClusterResource{}.basic(data))
+}
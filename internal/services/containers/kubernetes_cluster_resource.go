@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/deletionprotection"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
@@ -52,11 +53,11 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 		Update: resourceKubernetesClusterUpdate,
 		Delete: resourceKubernetesClusterDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(
 			func(id string) error {
 				_, err := commonids.ParseKubernetesClusterID(id)
 				return err
-			}),
+			}, deletionprotection.Import),
 
 		CustomizeDiff: pluginsdk.CustomDiffInSequence(
 			// The behaviour of the API requires this, but this could be removed when https://github.com/Azure/azure-rest-api-specs/issues/27373 has been addressed
@@ -177,6 +178,11 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				if len(d.Get("network_profile.0.advanced_networking").([]interface{})) == 1 {
 					securityEnabled := d.Get("network_profile.0.advanced_networking.0.security_enabled").(bool)
 
+					policies := d.Get("network_profile.0.advanced_networking.0.security_advanced_network_policies").(string)
+					if !securityEnabled && d.HasChange("network_profile.0.advanced_networking.0.security_advanced_network_policies") && policies != "" && policies != string(managedclusters.AdvancedNetworkPoliciesNone) {
+						return fmt.Errorf("`network_profile.0.advanced_networking.0.security_advanced_network_policies` can only be set to `%s` or `%s` when `security_enabled` is set to `true`", managedclusters.AdvancedNetworkPoliciesFQDN, managedclusters.AdvancedNetworkPoliciesLSeven)
+					}
+
 					if securityEnabled {
 						if d.Get("network_profile.0.network_data_plane").(string) != string(managedclusters.NetworkDataplaneCilium) {
 							return fmt.Errorf("when `network_profile.0.advanced_networking` has `security_enabled` set to `true`, `network_profile.0.network_data_plane` must be set to `%s`", managedclusters.NetworkDataplaneCilium)
@@ -1366,6 +1372,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 										Default:      false,
 										AtLeastOneOf: []string{"network_profile.0.advanced_networking.0.observability_enabled", "network_profile.0.advanced_networking.0.security_enabled"},
 									},
+									"security_advanced_network_policies": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(managedclusters.PossibleValuesForAdvancedNetworkPolicies(), false),
+									},
 								},
 							},
 						},
@@ -1603,6 +1615,8 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"deletion_protection_enabled": deletionprotection.Schema(),
+
 			"tags": commonschema.Tags(),
 
 			"upgrade_override": {
@@ -2099,6 +2113,13 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+
+	if d.Get("deletion_protection_enabled").(bool) {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesClusterRead(d, meta)
 }
 
@@ -2881,6 +2902,12 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("deletion_protection_enabled") {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), d.Get("deletion_protection_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceKubernetesClusterRead(d, meta)
@@ -3230,6 +3257,10 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	if err := deletionprotection.FlattenAndSet(ctx, d, meta.(*clients.Client).Resource.LocksClient, id.ID()); err != nil {
+		return fmt.Errorf("setting `deletion_protection_enabled`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -3243,6 +3274,10 @@ func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	if err := deletionprotection.EnsureDeletable(d, id.ID()); err != nil {
+		return err
+	}
+
 	if _, ok := d.GetOk("maintenance_window"); ok {
 		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "default")
@@ -3739,14 +3774,19 @@ func expandKubernetesClusterAdvancedNetworking(input []interface{}, d *pluginsdk
 	observabilityEnabled := config["observability_enabled"].(bool)
 	securityEnabled := config["security_enabled"].(bool)
 
+	security := &managedclusters.AdvancedNetworkingSecurity{
+		Enabled: pointer.To(securityEnabled),
+	}
+	if v := config["security_advanced_network_policies"].(string); v != "" {
+		security.AdvancedNetworkPolicies = pointer.To(managedclusters.AdvancedNetworkPolicies(v))
+	}
+
 	return &managedclusters.AdvancedNetworking{
 		Enabled: pointer.To(true),
 		Observability: &managedclusters.AdvancedNetworkingObservability{
 			Enabled: pointer.To(observabilityEnabled),
 		},
-		Security: &managedclusters.AdvancedNetworkingSecurity{
-			Enabled: pointer.To(securityEnabled),
-		},
+		Security: security,
 	}
 }
 
@@ -3761,14 +3801,17 @@ func flattenKubernetesClusterAdvancedNetworking(advancedNetworking *managedclust
 	}
 
 	securityEnabled := false
+	advancedNetworkPolicies := ""
 	if advancedNetworking.Security != nil {
 		securityEnabled = pointer.From(advancedNetworking.Security.Enabled)
+		advancedNetworkPolicies = string(pointer.From(advancedNetworking.Security.AdvancedNetworkPolicies))
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"observability_enabled": observabilityEnabled,
-			"security_enabled":      securityEnabled,
+			"observability_enabled":              observabilityEnabled,
+			"security_enabled":                   securityEnabled,
+			"security_advanced_network_policies": advancedNetworkPolicies,
 		},
 	}
 }
@@ -76,6 +76,20 @@ func (AnomalyAlertResource) Arguments() map[string]*pluginsdk.Schema {
 			Optional:     true,
 			ValidateFunc: validation.StringLenBetween(1, 250),
 		},
+
+		"language": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"regional_format": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
 	}
 }
 
@@ -139,9 +153,11 @@ func (r AnomalyAlertResource) Create() sdk.ResourceFunc {
 					},
 					NotificationEmail: &notificationEmail,
 					Notification: scheduledactions.NotificationProperties{
-						Subject: metadata.ResourceData.Get("email_subject").(string),
-						Message: pointer.To(metadata.ResourceData.Get("message").(string)),
-						To:      *emailAddresses,
+						Subject:        metadata.ResourceData.Get("email_subject").(string),
+						Message:        pointer.To(metadata.ResourceData.Get("message").(string)),
+						To:             *emailAddresses,
+						Language:       expandCostAnomalyAlertOptionalString(metadata.ResourceData, "language"),
+						RegionalFormat: expandCostAnomalyAlertOptionalString(metadata.ResourceData, "regional_format"),
 					},
 					Schedule: schedule,
 				},
@@ -208,9 +224,11 @@ func (r AnomalyAlertResource) Update() sdk.ResourceFunc {
 					ViewId:            viewId.ID(),
 					NotificationEmail: &notificationEmail,
 					Notification: scheduledactions.NotificationProperties{
-						Subject: metadata.ResourceData.Get("email_subject").(string),
-						Message: pointer.To(metadata.ResourceData.Get("message").(string)),
-						To:      *emailAddresses,
+						Subject:        metadata.ResourceData.Get("email_subject").(string),
+						Message:        pointer.To(metadata.ResourceData.Get("message").(string)),
+						To:             *emailAddresses,
+						Language:       expandCostAnomalyAlertOptionalString(metadata.ResourceData, "language"),
+						RegionalFormat: expandCostAnomalyAlertOptionalString(metadata.ResourceData, "regional_format"),
 					},
 					Schedule: schedule,
 				},
@@ -254,6 +272,8 @@ func (AnomalyAlertResource) Read() sdk.ResourceFunc {
 					metadata.ResourceData.Set("notification_email", props.NotificationEmail)
 					metadata.ResourceData.Set("email_addresses", props.Notification.To)
 					metadata.ResourceData.Set("message", props.Notification.Message)
+					metadata.ResourceData.Set("language", pointer.From(props.Notification.Language))
+					metadata.ResourceData.Set("regional_format", pointer.From(props.Notification.RegionalFormat))
 				}
 			}
 
@@ -285,3 +305,10 @@ func (AnomalyAlertResource) Delete() sdk.ResourceFunc {
 func (AnomalyAlertResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return scheduledactions.ValidateScopedScheduledActionID
 }
+
+func expandCostAnomalyAlertOptionalString(d *pluginsdk.ResourceData, key string) *string {
+	if v, ok := d.GetOk(key); ok {
+		return pointer.To(v.(string))
+	}
+	return nil
+}
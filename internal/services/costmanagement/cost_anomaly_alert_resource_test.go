@@ -106,6 +106,8 @@ resource "azurerm_cost_anomaly_alert" "test" {
   email_subject   = "Hi"
   email_addresses = ["test@test.com", "test@hashicorp.developer"]
   message         = "Cost anomaly complete test"
+  language        = "en"
+  regional_format = "en-gb"
 }
 `, data.RandomInteger, data.RandomInteger)
 }
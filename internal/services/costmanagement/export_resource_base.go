@@ -6,12 +6,13 @@ package costmanagement
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-08-01/exports"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2025-03-01/exports"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -57,10 +58,30 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 			Default:  string(exports.FormatTypeCsv),
 			ValidateFunc: validation.StringInSlice([]string{
 				string(exports.FormatTypeCsv),
-				// TODO add support for Parquet once added to the SDK
+				string(exports.FormatTypeParquet),
 			}, false),
 		},
 
+		// NOTE: `gzip` compression is only supported for `Csv` exports and `snappy` compression only for `Parquet` exports
+		"compression_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(exports.CompressionModeTypeNone),
+			ValidateFunc: validation.StringInSlice(exports.PossibleValuesForCompressionModeType(), false),
+		},
+
+		"file_partitioning_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"run_on_create_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"export_data_storage_location": {
 			Type:     pluginsdk.TypeList,
 			MaxItems: 1,
@@ -95,6 +116,7 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 						ValidateFunc: validation.StringInSlice([]string{
 							string(exports.ExportTypeActualCost),
 							string(exports.ExportTypeAmortizedCost),
+							string(exports.ExportTypeFocusCost),
 							string(exports.ExportTypeUsage),
 						}, false),
 					},
@@ -109,10 +131,18 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 							string(exports.TimeframeTypeTheLastMonth),
 							string(exports.TimeframeTypeWeekToDate),
 							string(exports.TimeframeTypeMonthToDate),
+							string(exports.TimeframeTypeTheCurrentMonth),
 							// TODO Use value from SDK after https://github.com/Azure/azure-rest-api-specs/issues/23707 is fixed
 							"TheLast7Days",
 						}, false),
 					},
+
+					"data_version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
 				},
 			},
 		},
@@ -126,7 +156,22 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 }
 
 func (br costManagementExportBaseResource) attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"last_run_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_run_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_run_time_estimate": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (br costManagementExportBaseResource) createFunc(resourceName, scopeFieldName string) sdk.ResourceFunc {
@@ -152,6 +197,14 @@ func (br costManagementExportBaseResource) createFunc(resourceName, scopeFieldNa
 			}
 
 			metadata.SetID(id)
+
+			if metadata.ResourceData.Get("run_on_create_enabled").(bool) {
+				// the run is queued by the API and completes asynchronously, its status is exposed via `last_run_status`
+				log.Printf("[DEBUG] Triggering an on-demand run of %s", id)
+				if _, err := client.Execute(ctx, id, exports.ExportRunRequest{}); err != nil {
+					return fmt.Errorf("running %s: %+v", id, err)
+				}
+			}
 			return nil
 		},
 	}
@@ -168,7 +221,9 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 				return err
 			}
 
-			var opts exports.GetOperationOptions
+			opts := exports.GetOperationOptions{
+				Expand: pointer.To("runHistory"),
+			}
 			resp, err := client.Get(ctx, *id, opts)
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
@@ -205,6 +260,18 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 						return fmt.Errorf("setting `export_data_options`: %+v", err)
 					}
 					metadata.ResourceData.Set("file_format", string(pointer.From(props.Format)))
+
+					compressionMode := string(exports.CompressionModeTypeNone)
+					if v := props.CompressionMode; v != nil && *v != "" {
+						compressionMode = string(*v)
+					}
+					metadata.ResourceData.Set("compression_mode", compressionMode)
+					metadata.ResourceData.Set("file_partitioning_enabled", pointer.From(props.PartitionData))
+					metadata.ResourceData.Set("next_run_time_estimate", pointer.From(props.NextRunTimeEstimate))
+
+					lastRunStatus, lastRunTime := flattenExportLastRun(props.RunHistory)
+					metadata.ResourceData.Set("last_run_status", lastRunStatus)
+					metadata.ResourceData.Set("last_run_time", lastRunTime)
 				}
 			}
 
@@ -278,6 +345,13 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *exports.Exp
 	}
 
 	format := exports.FormatType(metadata.ResourceData.Get("file_format").(string))
+	compressionMode := exports.CompressionModeType(metadata.ResourceData.Get("compression_mode").(string))
+	if compressionMode == exports.CompressionModeTypeGzip && format != exports.FormatTypeCsv {
+		return fmt.Errorf("`compression_mode` can only be set to `%s` when `file_format` is `%s`", exports.CompressionModeTypeGzip, exports.FormatTypeCsv)
+	}
+	if compressionMode == exports.CompressionModeTypeSnappy && format != exports.FormatTypeParquet {
+		return fmt.Errorf("`compression_mode` can only be set to `%s` when `file_format` is `%s`", exports.CompressionModeTypeSnappy, exports.FormatTypeParquet)
+	}
 
 	recurrenceType := exports.RecurrenceType(metadata.ResourceData.Get("recurrence_type").(string))
 	props := exports.Export{
@@ -291,9 +365,11 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *exports.Exp
 				},
 				Status: &status,
 			},
-			DeliveryInfo: *deliveryInfo,
-			Format:       &format,
-			Definition:   *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			DeliveryInfo:    *deliveryInfo,
+			Format:          &format,
+			CompressionMode: &compressionMode,
+			PartitionData:   pointer.To(metadata.ResourceData.Get("file_partitioning_enabled").(bool)),
+			Definition:      *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
 		},
 	}
 
@@ -337,6 +413,14 @@ func expandExportDefinition(input []interface{}) *exports.ExportDefinition {
 		Timeframe: exports.TimeframeType(attrs["time_frame"].(string)),
 	}
 
+	if v := attrs["data_version"].(string); v != "" {
+		definitionInfo.DataSet = &exports.ExportDataset{
+			Configuration: &exports.ExportDatasetConfiguration{
+				DataVersion: pointer.To(v),
+			},
+		}
+	}
+
 	return definitionInfo
 }
 
@@ -384,10 +468,39 @@ func flattenExportDefinition(input *exports.ExportDefinition) []interface{} {
 		queryType = string(input.Type)
 	}
 
+	dataVersion := ""
+	if dataSet := input.DataSet; dataSet != nil && dataSet.Configuration != nil {
+		dataVersion = pointer.From(dataSet.Configuration.DataVersion)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"time_frame": string(input.Timeframe),
-			"type":       queryType,
+			"data_version": dataVersion,
+			"time_frame":   string(input.Timeframe),
+			"type":         queryType,
 		},
 	}
 }
+
+// flattenExportLastRun returns the status and submission time of the most recently submitted run of the Export
+func flattenExportLastRun(input *exports.ExportExecutionListResult) (string, string) {
+	if input == nil || input.Value == nil {
+		return "", ""
+	}
+
+	var lastRun *exports.ExportRunProperties
+	for _, run := range *input.Value {
+		if run.Properties == nil {
+			continue
+		}
+		if lastRun == nil || pointer.From(run.Properties.SubmittedTime) > pointer.From(lastRun.SubmittedTime) {
+			lastRun = run.Properties
+		}
+	}
+
+	if lastRun == nil {
+		return "", ""
+	}
+
+	return string(pointer.From(lastRun.Status)), pointer.From(lastRun.SubmittedTime)
+}
@@ -10,7 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-08-01/exports"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2025-03-01/exports"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -81,6 +81,22 @@ func TestAccSubscriptionCostManagementExport_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionCostManagementExport_focusParquet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_cost_management_export", "test")
+	r := SubscriptionCostManagementExport{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.focusParquet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("last_run_status").IsSet(),
+			),
+		},
+		data.ImportStep("run_on_create_enabled"),
+	})
+}
+
 func (t SubscriptionCostManagementExport) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := exports.ParseScopedExportID(state.ID)
 	if err != nil {
@@ -196,6 +212,61 @@ resource "azurerm_subscription_cost_management_export" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, start, end)
 }
 
+func (SubscriptionCostManagementExport) focusParquet(data acceptance.TestData) string {
+	start := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	end := time.Now().AddDate(0, 0, 2).Format("2006-01-02")
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cm-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                 = "acctestcontainer%s"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+resource "azurerm_subscription_cost_management_export" "test" {
+  name                         = "accrg%d"
+  subscription_id              = data.azurerm_subscription.test.id
+  recurrence_type              = "Daily"
+  recurrence_period_start_date = "%sT00:00:00Z"
+  recurrence_period_end_date   = "%sT00:00:00Z"
+  file_format                  = "Parquet"
+  compression_mode             = "snappy"
+  file_partitioning_enabled    = true
+  run_on_create_enabled        = true
+
+  export_data_storage_location {
+    container_id     = "${azurerm_storage_account.test.id}/blobServices/default/containers/${azurerm_storage_container.test.name}"
+    root_folder_path = "/root"
+  }
+
+  export_data_options {
+    type         = "FocusCost"
+    time_frame   = "MonthToDate"
+    data_version = "1.0"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, start, end)
+}
+
 func (SubscriptionCostManagementExport) requiresImport(data acceptance.TestData) string {
 	template := SubscriptionCostManagementExport{}.basic(data)
 	return fmt.Sprintf(`
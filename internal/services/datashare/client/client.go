@@ -7,17 +7,25 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/consumerinvitation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/dataset"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/datasetmapping"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/share"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/sharesubscription"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/synchronizationsetting"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/trigger"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AccountClient         *account.AccountClient
-	DataSetClient         *dataset.DataSetClient
-	SharesClient          *share.ShareClient
-	SynchronizationClient *synchronizationsetting.SynchronizationSettingClient
+	AccountClient            *account.AccountClient
+	ConsumerInvitationClient *consumerinvitation.ConsumerInvitationClient
+	DataSetClient            *dataset.DataSetClient
+	DataSetMappingClient     *datasetmapping.DataSetMappingClient
+	SharesClient             *share.ShareClient
+	ShareSubscriptionClient  *sharesubscription.ShareSubscriptionClient
+	SynchronizationClient    *synchronizationsetting.SynchronizationSettingClient
+	TriggerClient            *trigger.TriggerClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -27,28 +35,56 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(accountClient.Client, o.Authorizers.ResourceManager)
 
+	consumerInvitationClient, err := consumerinvitation.NewConsumerInvitationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ConsumerInvitation client: %+v", err)
+	}
+	o.Configure(consumerInvitationClient.Client, o.Authorizers.ResourceManager)
+
 	dataSetClient, err := dataset.NewDataSetClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building DataSet client: %+v", err)
 	}
 	o.Configure(dataSetClient.Client, o.Authorizers.ResourceManager)
 
+	dataSetMappingClient, err := datasetmapping.NewDataSetMappingClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DataSetMapping client: %+v", err)
+	}
+	o.Configure(dataSetMappingClient.Client, o.Authorizers.ResourceManager)
+
 	sharesClient, err := share.NewShareClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Share client: %+v", err)
 	}
 	o.Configure(sharesClient.Client, o.Authorizers.ResourceManager)
 
+	shareSubscriptionClient, err := sharesubscription.NewShareSubscriptionClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ShareSubscription client: %+v", err)
+	}
+	o.Configure(shareSubscriptionClient.Client, o.Authorizers.ResourceManager)
+
 	synchronizationSettingsClient, err := synchronizationsetting.NewSynchronizationSettingClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building SynchronizationSetting client: %+v", err)
 	}
 	o.Configure(synchronizationSettingsClient.Client, o.Authorizers.ResourceManager)
 
+	triggerClient, err := trigger.NewTriggerClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Trigger client: %+v", err)
+	}
+	o.Configure(triggerClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		AccountClient:         accountClient,
-		DataSetClient:         dataSetClient,
-		SharesClient:          sharesClient,
-		SynchronizationClient: synchronizationSettingsClient,
+		AccountClient:            accountClient,
+		ConsumerInvitationClient: consumerInvitationClient,
+		DataSetClient:            dataSetClient,
+		DataSetMappingClient:     dataSetMappingClient,
+		SharesClient:             sharesClient,
+		ShareSubscriptionClient:  shareSubscriptionClient,
+		SynchronizationClient:    synchronizationSettingsClient,
+		TriggerClient:            triggerClient,
 	}, nil
 }
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/consumerinvitation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceDataShareConsumerInvitation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDataShareConsumerInvitationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"invitation_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"location": commonschema.Location(),
+
+			"data_set_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_email": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_tenant_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"terms": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDataShareConsumerInvitationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ConsumerInvitationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := consumerinvitation.NewConsumerInvitationID(location.Normalize(d.Get("location").(string)), d.Get("invitation_id").(string))
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s does not exist", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("invitation_id", id.InvitationId)
+	d.Set("location", id.LocationName)
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("data_set_count", pointer.From(props.DataSetCount))
+		d.Set("description", pointer.From(props.Description))
+		d.Set("provider_email", pointer.From(props.ProviderEmail))
+		d.Set("provider_name", pointer.From(props.ProviderName))
+		d.Set("provider_tenant_name", pointer.From(props.ProviderTenantName))
+		d.Set("share_name", pointer.From(props.ShareName))
+		d.Set("status", string(pointer.From(props.InvitationStatus)))
+		d.Set("terms", pointer.From(props.TermsOfUse))
+	}
+
+	return nil
+}
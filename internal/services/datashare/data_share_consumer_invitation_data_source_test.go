@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DataShareConsumerInvitationDataSource struct{}
+
+// the invitation has to be sent from another tenant, see `TestAccDataShareSubscription_basic`
+func TestAccDataShareConsumerInvitationDataSource_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID` and `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_data_share_consumer_invitation", "test")
+	r := DataShareConsumerInvitationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("share_name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("provider_name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+	})
+}
+
+func (DataShareConsumerInvitationDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_data_share_consumer_invitation" "test" {
+  invitation_id = "%s"
+  location      = "%s"
+}
+`, os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"), os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION"))
+}
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/datasetmapping"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDataShareSubscriptionDataSetMappingBlobStorage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareSubscriptionDataSetMappingBlobStorageCreate,
+		Read:   resourceDataShareSubscriptionDataSetMappingBlobStorageRead,
+		Delete: resourceDataShareSubscriptionDataSetMappingBlobStorageDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := datasetmapping.ParseDataSetMappingID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataSetName(),
+			},
+
+			"share_subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: datasetmapping.ValidateShareSubscriptionID,
+			},
+
+			"data_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageContainerName,
+			},
+
+			"storage_account": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: storageValidate.StorageAccountName,
+						},
+
+						"resource_group_name": commonschema.ResourceGroupName(),
+
+						"subscription_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
+			"file_path": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"folder_path"},
+			},
+
+			"folder_path": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"file_path"},
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareSubscriptionDataSetMappingBlobStorageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareSubscriptionId, err := datasetmapping.ParseShareSubscriptionID(d.Get("share_subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	id := datasetmapping.NewDataSetMappingID(shareSubscriptionId.SubscriptionId, shareSubscriptionId.ResourceGroupName, shareSubscriptionId.AccountName, shareSubscriptionId.ShareSubscriptionName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_data_share_subscription_dataset_mapping_blob_storage", id.ID())
+	}
+
+	var mapping datasetmapping.DataSetMapping
+	if filePath, ok := d.GetOk("file_path"); ok {
+		mapping = datasetmapping.BlobDataSetMapping{
+			Properties: datasetmapping.BlobMappingProperties{
+				DataSetId:          d.Get("data_set_id").(string),
+				ContainerName:      d.Get("container_name").(string),
+				StorageAccountName: d.Get("storage_account.0.name").(string),
+				ResourceGroup:      d.Get("storage_account.0.resource_group_name").(string),
+				SubscriptionId:     d.Get("storage_account.0.subscription_id").(string),
+				FilePath:           filePath.(string),
+			},
+		}
+	} else if folderPath, ok := d.GetOk("folder_path"); ok {
+		mapping = datasetmapping.BlobFolderDataSetMapping{
+			Properties: datasetmapping.BlobFolderMappingProperties{
+				DataSetId:          d.Get("data_set_id").(string),
+				ContainerName:      d.Get("container_name").(string),
+				StorageAccountName: d.Get("storage_account.0.name").(string),
+				ResourceGroup:      d.Get("storage_account.0.resource_group_name").(string),
+				SubscriptionId:     d.Get("storage_account.0.subscription_id").(string),
+				Prefix:             folderPath.(string),
+			},
+		}
+	} else {
+		mapping = datasetmapping.BlobContainerDataSetMapping{
+			Properties: datasetmapping.BlobContainerMappingProperties{
+				DataSetId:          d.Get("data_set_id").(string),
+				ContainerName:      d.Get("container_name").(string),
+				StorageAccountName: d.Get("storage_account.0.name").(string),
+				ResourceGroup:      d.Get("storage_account.0.resource_group_name").(string),
+				SubscriptionId:     d.Get("storage_account.0.subscription_id").(string),
+			},
+		}
+	}
+
+	if _, err := client.Create(ctx, id, mapping); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataShareSubscriptionDataSetMappingBlobStorageRead(d, meta)
+}
+
+func resourceDataShareSubscriptionDataSetMappingBlobStorageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datasetmapping.ParseDataSetMappingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DataSetMappingName)
+	d.Set("share_subscription_id", datasetmapping.NewShareSubscriptionID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.ShareSubscriptionName).ID())
+
+	if model := resp.Model; model != nil {
+		if m, ok := model.(datasetmapping.BlobDataSetMapping); ok {
+			props := m.Properties
+			d.Set("data_set_id", props.DataSetId)
+			d.Set("container_name", props.ContainerName)
+			if err := d.Set("storage_account", flattenAzureRmDataShareDataSetBlobStorageAccount(props.StorageAccountName, props.ResourceGroup, props.SubscriptionId)); err != nil {
+				return fmt.Errorf("setting `storage_account`: %+v", err)
+			}
+			d.Set("file_path", props.FilePath)
+			d.Set("status", string(pointer.From(props.DataSetMappingStatus)))
+		} else if m, ok := model.(datasetmapping.BlobFolderDataSetMapping); ok {
+			props := m.Properties
+			d.Set("data_set_id", props.DataSetId)
+			d.Set("container_name", props.ContainerName)
+			if err := d.Set("storage_account", flattenAzureRmDataShareDataSetBlobStorageAccount(props.StorageAccountName, props.ResourceGroup, props.SubscriptionId)); err != nil {
+				return fmt.Errorf("setting `storage_account`: %+v", err)
+			}
+			d.Set("folder_path", props.Prefix)
+			d.Set("status", string(pointer.From(props.DataSetMappingStatus)))
+		} else if m, ok := model.(datasetmapping.BlobContainerDataSetMapping); ok {
+			props := m.Properties
+			d.Set("data_set_id", props.DataSetId)
+			d.Set("container_name", props.ContainerName)
+			if err := d.Set("storage_account", flattenAzureRmDataShareDataSetBlobStorageAccount(props.StorageAccountName, props.ResourceGroup, props.SubscriptionId)); err != nil {
+				return fmt.Errorf("setting `storage_account`: %+v", err)
+			}
+			d.Set("status", string(pointer.From(props.DataSetMappingStatus)))
+		} else {
+			return fmt.Errorf("%s is not a blob storage dataset mapping", *id)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataShareSubscriptionDataSetMappingBlobStorageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datasetmapping.ParseDataSetMappingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
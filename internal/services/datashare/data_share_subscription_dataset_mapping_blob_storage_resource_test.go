@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/datasetmapping"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DataShareSubscriptionDataSetMappingBlobStorageResource struct{}
+
+// the Share Subscription requires an invitation sent from another tenant, see `TestAccDataShareSubscription_basic` - in addition
+// the ID of the Blob Storage Data Set within the shared Data Share has to be provided
+func TestAccDataShareSubscriptionDataSetMappingBlobStorage_basicContainer(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" || os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_DATA_SET_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID`, `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` and `ARM_TEST_DATA_SHARE_SOURCE_DATA_SET_ID` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription_dataset_mapping_blob_storage", "test")
+	r := DataShareSubscriptionDataSetMappingBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareSubscriptionDataSetMappingBlobStorage_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" || os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_DATA_SET_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID`, `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` and `ARM_TEST_DATA_SHARE_SOURCE_DATA_SET_ID` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription_dataset_mapping_blob_storage", "test")
+	r := DataShareSubscriptionDataSetMappingBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t DataShareSubscriptionDataSetMappingBlobStorageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := datasetmapping.ParseDataSetMappingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.DataSetMappingClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DataShareSubscriptionDataSetMappingBlobStorageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctest-sc-%[3]d"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+data "azuread_service_principal" "test" {
+  display_name = azurerm_data_share_account.test.name
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = data.azuread_service_principal.test.object_id
+}
+`, DataShareSubscriptionResource{}.basic(data), data.RandomString, data.RandomInteger)
+}
+
+func (r DataShareSubscriptionDataSetMappingBlobStorageResource) basicContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_share_subscription_dataset_mapping_blob_storage" "test" {
+  name                  = "acctest-DSDSMBS-%[2]d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  data_set_id           = "%[3]s"
+  container_name        = azurerm_storage_container.test.name
+  storage_account {
+    name                = azurerm_storage_account.test.name
+    resource_group_name = azurerm_storage_account.test.resource_group_name
+    subscription_id     = "%[4]s"
+  }
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_DATA_SET_ID"), os.Getenv("ARM_SUBSCRIPTION_ID"))
+}
+
+func (r DataShareSubscriptionDataSetMappingBlobStorageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription_dataset_mapping_blob_storage" "import" {
+  name                  = azurerm_data_share_subscription_dataset_mapping_blob_storage.test.name
+  share_subscription_id = azurerm_data_share_subscription_dataset_mapping_blob_storage.test.share_subscription_id
+  data_set_id           = azurerm_data_share_subscription_dataset_mapping_blob_storage.test.data_set_id
+  container_name        = azurerm_data_share_subscription_dataset_mapping_blob_storage.test.container_name
+  storage_account {
+    name                = azurerm_data_share_subscription_dataset_mapping_blob_storage.test.storage_account.0.name
+    resource_group_name = azurerm_data_share_subscription_dataset_mapping_blob_storage.test.storage_account.0.resource_group_name
+    subscription_id     = azurerm_data_share_subscription_dataset_mapping_blob_storage.test.storage_account.0.subscription_id
+  }
+}
+`, r.basicContainer(data))
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/sharesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDataShareSubscription() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareSubscriptionCreate,
+		Read:   resourceDataShareSubscriptionRead,
+		Delete: resourceDataShareSubscriptionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := sharesubscription.ParseShareSubscriptionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ShareName(),
+			},
+
+			"account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: account.ValidateAccountID,
+			},
+
+			"invitation_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"source_share_location": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"provider_email": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_tenant_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_kind": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_terms": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := account.ParseAccountID(d.Get("account_id").(string))
+	if err != nil {
+		return err
+	}
+	id := sharesubscription.NewShareSubscriptionID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_data_share_subscription", id.ID())
+	}
+
+	payload := sharesubscription.ShareSubscription{
+		Properties: sharesubscription.ShareSubscriptionProperties{
+			InvitationId:        d.Get("invitation_id").(string),
+			SourceShareLocation: location.Normalize(d.Get("source_share_location").(string)),
+		},
+	}
+
+	if _, err := client.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataShareSubscriptionRead(d, meta)
+}
+
+func resourceDataShareSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharesubscription.ParseShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ShareSubscriptionName)
+	d.Set("account_id", account.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("invitation_id", props.InvitationId)
+		d.Set("source_share_location", location.Normalize(props.SourceShareLocation))
+		d.Set("provider_email", pointer.From(props.ProviderEmail))
+		d.Set("provider_name", pointer.From(props.ProviderName))
+		d.Set("provider_tenant_name", pointer.From(props.ProviderTenantName))
+		d.Set("share_description", pointer.From(props.ShareDescription))
+		d.Set("share_kind", string(pointer.From(props.ShareKind)))
+		d.Set("share_name", pointer.From(props.ShareName))
+		d.Set("share_terms", pointer.From(props.ShareTerms))
+		d.Set("status", string(pointer.From(props.ShareSubscriptionStatus)))
+	}
+
+	return nil
+}
+
+func resourceDataShareSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharesubscription.ParseShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
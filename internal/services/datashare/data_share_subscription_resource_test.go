@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/sharesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DataShareSubscriptionResource struct{}
+
+// A Data Share Invitation can only be accepted by the user or service principal it was sent to, which must be in a different
+// tenant to the provider - as such the invitation has to be sent outside of the test and its ID and location provided
+func TestAccDataShareSubscription_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID` and `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("share_name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("status").HasValue("Active"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareSubscription_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID` and `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t DataShareSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sharesubscription.ParseShareSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.ShareSubscriptionClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DataShareSubscriptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-DSA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DataShareSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_DSS_%d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%s"
+  source_share_location = "%s"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"), os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION"))
+}
+
+func (r DataShareSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "import" {
+  name                  = azurerm_data_share_subscription.test.name
+  account_id            = azurerm_data_share_subscription.test.account_id
+  invitation_id         = azurerm_data_share_subscription.test.invitation_id
+  source_share_location = azurerm_data_share_subscription.test.source_share_location
+}
+`, r.basic(data))
+}
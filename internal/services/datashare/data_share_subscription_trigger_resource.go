@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/trigger"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDataShareSubscriptionTrigger() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareSubscriptionTriggerCreate,
+		Read:   resourceDataShareSubscriptionTriggerRead,
+		Delete: resourceDataShareSubscriptionTriggerDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := trigger.ParseTriggerID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SnapshotScheduleName(),
+			},
+
+			"share_subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: trigger.ValidateShareSubscriptionID,
+			},
+
+			"recurrence": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(trigger.PossibleValuesForRecurrenceInterval(), false),
+			},
+
+			"start_time": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"synchronization_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(trigger.SynchronizationModeIncremental),
+				ValidateFunc: validation.StringInSlice(trigger.PossibleValuesForSynchronizationMode(), false),
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareSubscriptionTriggerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.TriggerClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareSubscriptionId, err := trigger.ParseShareSubscriptionID(d.Get("share_subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	id := trigger.NewTriggerID(shareSubscriptionId.SubscriptionId, shareSubscriptionId.ResourceGroupName, shareSubscriptionId.AccountName, shareSubscriptionId.ShareSubscriptionName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_data_share_subscription_trigger", id.ID())
+	}
+
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+
+	payload := trigger.ScheduledTrigger{
+		Properties: trigger.ScheduledTriggerProperties{
+			RecurrenceInterval:  trigger.RecurrenceInterval(d.Get("recurrence").(string)),
+			SynchronizationMode: pointer.To(trigger.SynchronizationMode(d.Get("synchronization_mode").(string))),
+		},
+	}
+	payload.Properties.SetSynchronizationTimeAsTime(startTime)
+
+	if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataShareSubscriptionTriggerRead(d, meta)
+}
+
+func resourceDataShareSubscriptionTriggerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.TriggerClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := trigger.ParseTriggerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TriggerName)
+	d.Set("share_subscription_id", trigger.NewShareSubscriptionID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.ShareSubscriptionName).ID())
+
+	if model := resp.Model; model != nil {
+		scheduled, ok := model.(trigger.ScheduledTrigger)
+		if !ok {
+			return fmt.Errorf("%s is not a scheduled trigger", *id)
+		}
+
+		props := scheduled.Properties
+		d.Set("recurrence", string(props.RecurrenceInterval))
+		d.Set("start_time", props.SynchronizationTime)
+		d.Set("synchronization_mode", string(pointer.From(props.SynchronizationMode)))
+		d.Set("status", string(pointer.From(props.TriggerStatus)))
+	}
+
+	return nil
+}
+
+func resourceDataShareSubscriptionTriggerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.TriggerClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := trigger.ParseTriggerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/trigger"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DataShareSubscriptionTriggerResource struct{}
+
+// the Share Subscription requires an invitation sent from another tenant, see `TestAccDataShareSubscription_basic`
+func TestAccDataShareSubscriptionTrigger_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID` and `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription_trigger", "test")
+	r := DataShareSubscriptionTriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareSubscriptionTrigger_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID` and `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription_trigger", "test")
+	r := DataShareSubscriptionTriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataShareSubscriptionTrigger_fullSync(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_LOCATION") == "" {
+		t.Skip("Skipping as `ARM_TEST_DATA_SHARE_INVITATION_ID` and `ARM_TEST_DATA_SHARE_INVITATION_LOCATION` were not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription_trigger", "test")
+	r := DataShareSubscriptionTriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fullSync(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("synchronization_mode").HasValue("FullSync"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t DataShareSubscriptionTriggerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := trigger.ParseTriggerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.TriggerClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DataShareSubscriptionTriggerResource) basic(data acceptance.TestData) string {
+	startTime := time.Now().Add(time.Hour * 7).Format(time.RFC3339)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription_trigger" "test" {
+  name                  = "acctest-DSST-%d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  recurrence            = "Day"
+  start_time            = "%s"
+}
+`, DataShareSubscriptionResource{}.basic(data), data.RandomInteger, startTime)
+}
+
+func (r DataShareSubscriptionTriggerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription_trigger" "import" {
+  name                  = azurerm_data_share_subscription_trigger.test.name
+  share_subscription_id = azurerm_data_share_subscription_trigger.test.share_subscription_id
+  recurrence            = azurerm_data_share_subscription_trigger.test.recurrence
+  start_time            = azurerm_data_share_subscription_trigger.test.start_time
+}
+`, r.basic(data))
+}
+
+func (r DataShareSubscriptionTriggerResource) fullSync(data acceptance.TestData) string {
+	startTime := time.Now().Add(time.Hour * 7).Format(time.RFC3339)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription_trigger" "test" {
+  name                  = "acctest-DSST-%d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  recurrence            = "Hour"
+  start_time            = "%s"
+  synchronization_mode  = "FullSync"
+}
+`, DataShareSubscriptionResource{}.basic(data), data.RandomInteger, startTime)
+}
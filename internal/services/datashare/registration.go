@@ -38,6 +38,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_data_share_account":                dataSourceDataShareAccount(),
 		"azurerm_data_share":                        dataSourceDataShare(),
+		"azurerm_data_share_consumer_invitation":    dataSourceDataShareConsumerInvitation(),
 		"azurerm_data_share_dataset_blob_storage":   dataSourceDataShareDatasetBlobStorage(),
 		"azurerm_data_share_dataset_data_lake_gen2": dataSourceDataShareDatasetDataLakeGen2(),
 		"azurerm_data_share_dataset_kusto_cluster":  dataSourceDataShareDatasetKustoCluster(),
@@ -48,12 +49,15 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_data_share_account":                resourceDataShareAccount(),
-		"azurerm_data_share":                        resourceDataShare(),
-		"azurerm_data_share_dataset_blob_storage":   resourceDataShareDataSetBlobStorage(),
-		"azurerm_data_share_dataset_data_lake_gen2": resourceDataShareDataSetDataLakeGen2(),
-		"azurerm_data_share_dataset_kusto_cluster":  resourceDataShareDataSetKustoCluster(),
-		"azurerm_data_share_dataset_kusto_database": resourceDataShareDataSetKustoDatabase(),
+		"azurerm_data_share_account":                                   resourceDataShareAccount(),
+		"azurerm_data_share":                                           resourceDataShare(),
+		"azurerm_data_share_dataset_blob_storage":                      resourceDataShareDataSetBlobStorage(),
+		"azurerm_data_share_dataset_data_lake_gen2":                    resourceDataShareDataSetDataLakeGen2(),
+		"azurerm_data_share_dataset_kusto_cluster":                     resourceDataShareDataSetKustoCluster(),
+		"azurerm_data_share_dataset_kusto_database":                    resourceDataShareDataSetKustoDatabase(),
+		"azurerm_data_share_subscription":                              resourceDataShareSubscription(),
+		"azurerm_data_share_subscription_dataset_mapping_blob_storage": resourceDataShareSubscriptionDataSetMappingBlobStorage(),
+		"azurerm_data_share_subscription_trigger":                      resourceDataShareSubscriptionTrigger(),
 	}
 }
 
@@ -41,7 +41,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 }
 
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{}
+	return []sdk.Resource{
+		VirtualDesktopAppAttachPackageResource{},
+		VirtualDesktopScalingPlanPersonalScheduleResource{},
+	}
 }
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
@@ -0,0 +1,482 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/appattachpackage"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/appattachpackageinfo"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/hostpool"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = VirtualDesktopAppAttachPackageResource{}
+
+type VirtualDesktopAppAttachPackageResource struct{}
+
+type VirtualDesktopAppAttachPackageModel struct {
+	Name                            string            `tfschema:"name"`
+	ResourceGroupName               string            `tfschema:"resource_group_name"`
+	Location                        string            `tfschema:"location"`
+	ImagePath                       string            `tfschema:"image_path"`
+	HostPoolIds                     []string          `tfschema:"host_pool_ids"`
+	PackageArchitecture             string            `tfschema:"package_architecture"`
+	DisplayName                     string            `tfschema:"display_name"`
+	ActiveEnabled                   bool              `tfschema:"active_enabled"`
+	RegularRegistrationEnabled      bool              `tfschema:"regular_registration_enabled"`
+	FailHealthCheckOnStagingFailure string            `tfschema:"fail_health_check_on_staging_failure"`
+	KeyVaultUrl                     string            `tfschema:"key_vault_url"`
+	Tags                            map[string]string `tfschema:"tags"`
+
+	CertificateExpiry     string   `tfschema:"certificate_expiry"`
+	CertificateName       string   `tfschema:"certificate_name"`
+	LastUpdated           string   `tfschema:"last_updated"`
+	PackageFamilyName     string   `tfschema:"package_family_name"`
+	PackageFullName       string   `tfschema:"package_full_name"`
+	PackageName           string   `tfschema:"package_name"`
+	PackageTimestamped    bool     `tfschema:"package_timestamped"`
+	PackageRelativePath   string   `tfschema:"package_relative_path"`
+	PackageVersion        string   `tfschema:"package_version"`
+	PackageApplicationIds []string `tfschema:"package_application_ids"`
+}
+
+func (VirtualDesktopAppAttachPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(3, 100),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"image_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"host_pool_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: hostpool.ValidateHostPoolID,
+			},
+		},
+
+		"package_architecture": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(appattachpackageinfo.PossibleValuesForAppAttachPackageArchitectures(), false),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"active_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"regular_registration_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"fail_health_check_on_staging_failure": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(appattachpackage.FailHealthCheckOnStagingFailureNeedsAssistance),
+			ValidateFunc: validation.StringInSlice(appattachpackage.PossibleValuesForFailHealthCheckOnStagingFailure(), false),
+		},
+
+		"key_vault_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (VirtualDesktopAppAttachPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"certificate_expiry": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"certificate_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_updated": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_application_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"package_family_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_full_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_relative_path": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_timestamped": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"package_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (VirtualDesktopAppAttachPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return appattachpackage.ValidateAppAttachPackageID
+}
+
+func (VirtualDesktopAppAttachPackageResource) ModelObject() interface{} {
+	return &VirtualDesktopAppAttachPackageModel{}
+}
+
+func (VirtualDesktopAppAttachPackageResource) ResourceType() string {
+	return "azurerm_virtual_desktop_app_attach_package"
+}
+
+func (r VirtualDesktopAppAttachPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.AppAttachPackagesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model VirtualDesktopAppAttachPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := appattachpackage.NewAppAttachPackageID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			image, err := importVirtualDesktopAppAttachPackageImage(ctx, metadata, model)
+			if err != nil {
+				return err
+			}
+
+			payload := appattachpackage.AppAttachPackage{
+				Location: location.Normalize(model.Location),
+				Properties: appattachpackage.AppAttachPackageProperties{
+					FailHealthCheckOnStagingFailure: pointer.To(appattachpackage.FailHealthCheckOnStagingFailure(model.FailHealthCheckOnStagingFailure)),
+					HostPoolReferences:              pointer.To(model.HostPoolIds),
+					Image:                           image,
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.KeyVaultUrl != "" {
+				payload.Properties.KeyVaultURL = pointer.To(model.KeyVaultUrl)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualDesktopAppAttachPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.AppAttachPackagesClient
+
+			id, err := appattachpackage.ParseAppAttachPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualDesktopAppAttachPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			props := &payload.Properties
+
+			if metadata.ResourceData.HasChanges("image_path", "package_architecture") {
+				image, err := importVirtualDesktopAppAttachPackageImage(ctx, metadata, model)
+				if err != nil {
+					return err
+				}
+				props.Image = image
+			} else if props.Image != nil {
+				if metadata.ResourceData.HasChange("display_name") {
+					props.Image.DisplayName = pointer.To(model.DisplayName)
+				}
+				props.Image.IsActive = pointer.To(model.ActiveEnabled)
+				props.Image.IsRegularRegistration = pointer.To(model.RegularRegistrationEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("host_pool_ids") {
+				props.HostPoolReferences = pointer.To(model.HostPoolIds)
+			}
+
+			if metadata.ResourceData.HasChange("fail_health_check_on_staging_failure") {
+				props.FailHealthCheckOnStagingFailure = pointer.To(appattachpackage.FailHealthCheckOnStagingFailure(model.FailHealthCheckOnStagingFailure))
+			}
+
+			if metadata.ResourceData.HasChange("key_vault_url") {
+				props.KeyVaultURL = pointer.To(model.KeyVaultUrl)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (VirtualDesktopAppAttachPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.AppAttachPackagesClient
+
+			id, err := appattachpackage.ParseAppAttachPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualDesktopAppAttachPackageModel{
+				Name:              id.AppAttachPackageName,
+				ResourceGroupName: id.ResourceGroupName,
+				// `package_architecture` is only used when importing the image, so isn't returned by the API
+				PackageArchitecture: metadata.ResourceData.Get("package_architecture").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				props := model.Properties
+				state.FailHealthCheckOnStagingFailure = string(pointer.From(props.FailHealthCheckOnStagingFailure))
+				state.KeyVaultUrl = pointer.From(props.KeyVaultURL)
+
+				hostPoolIds := make([]string, 0)
+				for _, v := range pointer.From(props.HostPoolReferences) {
+					hostPoolId, err := hostpool.ParseHostPoolIDInsensitively(v)
+					if err != nil {
+						return err
+					}
+					hostPoolIds = append(hostPoolIds, hostPoolId.ID())
+				}
+				state.HostPoolIds = hostPoolIds
+
+				if image := props.Image; image != nil {
+					state.ImagePath = pointer.From(image.ImagePath)
+					state.DisplayName = pointer.From(image.DisplayName)
+					state.ActiveEnabled = pointer.From(image.IsActive)
+					state.RegularRegistrationEnabled = pointer.From(image.IsRegularRegistration)
+					state.CertificateExpiry = pointer.From(image.CertificateExpiry)
+					state.CertificateName = pointer.From(image.CertificateName)
+					state.LastUpdated = pointer.From(image.LastUpdated)
+					state.PackageFamilyName = pointer.From(image.PackageFamilyName)
+					state.PackageFullName = pointer.From(image.PackageFullName)
+					state.PackageName = pointer.From(image.PackageName)
+					state.PackageRelativePath = pointer.From(image.PackageRelativePath)
+					state.PackageTimestamped = pointer.From(image.IsPackageTimestamped) == appattachpackage.PackageTimestampedTimestamped
+					state.PackageVersion = pointer.From(image.Version)
+
+					applicationIds := make([]string, 0)
+					for _, v := range pointer.From(image.PackageApplications) {
+						applicationIds = append(applicationIds, pointer.From(v.AppId))
+					}
+					state.PackageApplicationIds = applicationIds
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (VirtualDesktopAppAttachPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.AppAttachPackagesClient
+
+			id, err := appattachpackage.ParseAppAttachPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// importVirtualDesktopAppAttachPackageImage inspects the image using the session hosts of the first Host Pool, which
+// returns the package information (such as the applications and certificate) required to create the App Attach Package
+func importVirtualDesktopAppAttachPackageImage(ctx context.Context, metadata sdk.ResourceMetaData, model VirtualDesktopAppAttachPackageModel) (*appattachpackage.AppAttachPackageInfoProperties, error) {
+	client := metadata.Client.DesktopVirtualization.AppAttachPackageInfoClient
+
+	hostPoolId, err := appattachpackageinfo.ParseHostPoolID(model.HostPoolIds[0])
+	if err != nil {
+		return nil, err
+	}
+
+	input := appattachpackageinfo.ImportPackageInfoRequest{
+		Path: pointer.To(model.ImagePath),
+	}
+	if model.PackageArchitecture != "" {
+		input.PackageArchitecture = pointer.To(appattachpackageinfo.AppAttachPackageArchitectures(model.PackageArchitecture))
+	}
+
+	resp, err := client.ImportComplete(ctx, *hostPoolId, input)
+	if err != nil {
+		return nil, fmt.Errorf("importing the package information of %q using %s: %+v", model.ImagePath, hostPoolId, err)
+	}
+	if len(resp.Items) == 0 || resp.Items[0].Properties.Image == nil {
+		return nil, fmt.Errorf("importing the package information of %q using %s: no packages were found", model.ImagePath, hostPoolId)
+	}
+
+	info := resp.Items[0].Properties.Image
+	image := &appattachpackage.AppAttachPackageInfoProperties{
+		CertificateExpiry:     info.CertificateExpiry,
+		CertificateName:       info.CertificateName,
+		DisplayName:           info.DisplayName,
+		ImagePath:             pointer.To(model.ImagePath),
+		IsActive:              pointer.To(model.ActiveEnabled),
+		IsRegularRegistration: pointer.To(model.RegularRegistrationEnabled),
+		LastUpdated:           info.LastUpdated,
+		PackageAlias:          info.PackageAlias,
+		PackageFamilyName:     info.PackageFamilyName,
+		PackageFullName:       info.PackageFullName,
+		PackageName:           info.PackageName,
+		PackageRelativePath:   info.PackageRelativePath,
+		Version:               info.Version,
+	}
+
+	if model.DisplayName != "" {
+		image.DisplayName = pointer.To(model.DisplayName)
+	}
+
+	if info.IsPackageTimestamped != nil {
+		image.IsPackageTimestamped = pointer.To(appattachpackage.PackageTimestamped(*info.IsPackageTimestamped))
+	}
+
+	if info.PackageApplications != nil {
+		applications := make([]appattachpackage.MsixPackageApplications, 0)
+		for _, v := range *info.PackageApplications {
+			applications = append(applications, appattachpackage.MsixPackageApplications{
+				AppId:          v.AppId,
+				AppUserModelID: v.AppUserModelID,
+				Description:    v.Description,
+				FriendlyName:   v.FriendlyName,
+				IconImageName:  v.IconImageName,
+				RawIcon:        v.RawIcon,
+				RawPng:         v.RawPng,
+			})
+		}
+		image.PackageApplications = &applications
+	}
+
+	if info.PackageDependencies != nil {
+		dependencies := make([]appattachpackage.MsixPackageDependencies, 0)
+		for _, v := range *info.PackageDependencies {
+			dependencies = append(dependencies, appattachpackage.MsixPackageDependencies{
+				DependencyName: v.DependencyName,
+				MinVersion:     v.MinVersion,
+				Publisher:      v.Publisher,
+			})
+		}
+		image.PackageDependencies = &dependencies
+	}
+
+	return image, nil
+}
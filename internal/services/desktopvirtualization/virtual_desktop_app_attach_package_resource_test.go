@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/appattachpackage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// importing the package information requires a Host Pool with session hosts which can access the MSIX/App Attach image,
+// so these tests rely on existing infrastructure
+type VirtualDesktopAppAttachPackageResource struct {
+	hostPoolId string
+	imagePath  string
+}
+
+func newVirtualDesktopAppAttachPackageResource(t *testing.T) VirtualDesktopAppAttachPackageResource {
+	hostPoolId := os.Getenv("ARM_TEST_VIRTUAL_DESKTOP_HOST_POOL_ID")
+	imagePath := os.Getenv("ARM_TEST_VIRTUAL_DESKTOP_APP_ATTACH_IMAGE_PATH")
+	if hostPoolId == "" || imagePath == "" {
+		t.Skip("Skipping as ARM_TEST_VIRTUAL_DESKTOP_HOST_POOL_ID and/or ARM_TEST_VIRTUAL_DESKTOP_APP_ATTACH_IMAGE_PATH are not specified")
+	}
+
+	return VirtualDesktopAppAttachPackageResource{
+		hostPoolId: hostPoolId,
+		imagePath:  imagePath,
+	}
+}
+
+func TestAccVirtualDesktopAppAttachPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_app_attach_package", "test")
+	r := newVirtualDesktopAppAttachPackageResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("package_name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("package_version").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualDesktopAppAttachPackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_app_attach_package", "test")
+	r := newVirtualDesktopAppAttachPackageResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualDesktopAppAttachPackage_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_app_attach_package", "test")
+	r := newVirtualDesktopAppAttachPackageResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualDesktopAppAttachPackageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := appattachpackage.ParseAppAttachPackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DesktopVirtualization.AppAttachPackagesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VirtualDesktopAppAttachPackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_app_attach_package" "test" {
+  name                = "acctestaap%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  image_path          = "%[3]s"
+  host_pool_ids       = ["%[4]s"]
+}
+`, r.template(data), data.RandomInteger, r.imagePath, r.hostPoolId)
+}
+
+func (r VirtualDesktopAppAttachPackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_app_attach_package" "import" {
+  name                = azurerm_virtual_desktop_app_attach_package.test.name
+  resource_group_name = azurerm_virtual_desktop_app_attach_package.test.resource_group_name
+  location            = azurerm_virtual_desktop_app_attach_package.test.location
+  image_path          = azurerm_virtual_desktop_app_attach_package.test.image_path
+  host_pool_ids       = azurerm_virtual_desktop_app_attach_package.test.host_pool_ids
+}
+`, r.basic(data))
+}
+
+func (r VirtualDesktopAppAttachPackageResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_app_attach_package" "test" {
+  name                                 = "acctestaap%[2]d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  image_path                           = "%[3]s"
+  host_pool_ids                        = ["%[4]s"]
+  display_name                         = "acctest-app-attach-package"
+  active_enabled                       = false
+  regular_registration_enabled         = true
+  fail_health_check_on_staging_failure = "Unhealthy"
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, r.imagePath, r.hostPoolId)
+}
+
+func (VirtualDesktopAppAttachPackageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktop-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
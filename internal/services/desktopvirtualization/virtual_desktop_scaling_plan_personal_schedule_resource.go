@@ -0,0 +1,415 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplanpersonalschedule"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = VirtualDesktopScalingPlanPersonalScheduleResource{}
+
+type VirtualDesktopScalingPlanPersonalScheduleResource struct{}
+
+type VirtualDesktopScalingPlanPersonalScheduleModel struct {
+	Name          string                                      `tfschema:"name"`
+	ScalingPlanId string                                      `tfschema:"scaling_plan_id"`
+	DaysOfWeek    []string                                    `tfschema:"days_of_week"`
+	RampUp        []VirtualDesktopPersonalScheduleRampUpModel `tfschema:"ramp_up"`
+	Peak          []VirtualDesktopPersonalScheduleModel       `tfschema:"peak"`
+	RampDown      []VirtualDesktopPersonalScheduleModel       `tfschema:"ramp_down"`
+	OffPeak       []VirtualDesktopPersonalScheduleModel       `tfschema:"off_peak"`
+}
+
+type VirtualDesktopPersonalScheduleRampUpModel struct {
+	StartTime                 string `tfschema:"start_time"`
+	AutoStartHosts            string `tfschema:"auto_start_hosts"`
+	StartVMOnConnectEnabled   bool   `tfschema:"start_vm_on_connect_enabled"`
+	ActionOnDisconnect        string `tfschema:"action_on_disconnect"`
+	MinutesToWaitOnDisconnect int64  `tfschema:"minutes_to_wait_on_disconnect"`
+	ActionOnLogoff            string `tfschema:"action_on_logoff"`
+	MinutesToWaitOnLogoff     int64  `tfschema:"minutes_to_wait_on_logoff"`
+}
+
+type VirtualDesktopPersonalScheduleModel struct {
+	StartTime                 string `tfschema:"start_time"`
+	StartVMOnConnectEnabled   bool   `tfschema:"start_vm_on_connect_enabled"`
+	ActionOnDisconnect        string `tfschema:"action_on_disconnect"`
+	MinutesToWaitOnDisconnect int64  `tfschema:"minutes_to_wait_on_disconnect"`
+	ActionOnLogoff            string `tfschema:"action_on_logoff"`
+	MinutesToWaitOnLogoff     int64  `tfschema:"minutes_to_wait_on_logoff"`
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"scaling_plan_id": commonschema.ResourceIDReferenceRequiredForceNew(&scalingplanpersonalschedule.ScalingPlanId{}),
+
+		"days_of_week": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForDayOfWeek(), false),
+			},
+		},
+
+		"ramp_up": virtualDesktopPersonalScheduleSchema(true),
+
+		"peak": virtualDesktopPersonalScheduleSchema(false),
+
+		"ramp_down": virtualDesktopPersonalScheduleSchema(false),
+
+		"off_peak": virtualDesktopPersonalScheduleSchema(false),
+	}
+}
+
+func virtualDesktopPersonalScheduleSchema(rampUp bool) *pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"start_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validateTime(),
+		},
+
+		"start_vm_on_connect_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"action_on_disconnect": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.SessionHandlingOperationNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForSessionHandlingOperation(), false),
+		},
+
+		"minutes_to_wait_on_disconnect": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 360),
+		},
+
+		"action_on_logoff": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.SessionHandlingOperationNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForSessionHandlingOperation(), false),
+		},
+
+		"minutes_to_wait_on_logoff": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 360),
+		},
+	}
+
+	// the hosts to start automatically can only be configured for the ramp up phase
+	if rampUp {
+		s["auto_start_hosts"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.StartupBehaviorNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForStartupBehavior(), false),
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return scalingplanpersonalschedule.ValidatePersonalScheduleID
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) ModelObject() interface{} {
+	return &VirtualDesktopScalingPlanPersonalScheduleModel{}
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) ResourceType() string {
+	return "azurerm_virtual_desktop_scaling_plan_personal_schedule"
+}
+
+func (r VirtualDesktopScalingPlanPersonalScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.ScalingPlanPersonalSchedulesClient
+
+			var model VirtualDesktopScalingPlanPersonalScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scalingPlanId, err := scalingplanpersonalschedule.ParseScalingPlanID(model.ScalingPlanId)
+			if err != nil {
+				return err
+			}
+
+			id := scalingplanpersonalschedule.NewPersonalScheduleID(scalingPlanId.SubscriptionId, scalingPlanId.ResourceGroupName, scalingPlanId.ScalingPlanName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := scalingplanpersonalschedule.ScalingPlanPersonalSchedule{
+				Properties: expandVirtualDesktopScalingPlanPersonalScheduleProperties(model),
+			}
+
+			if _, err := client.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualDesktopScalingPlanPersonalScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.ScalingPlanPersonalSchedulesClient
+
+			id, err := scalingplanpersonalschedule.ParsePersonalScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualDesktopScalingPlanPersonalScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := scalingplanpersonalschedule.ScalingPlanPersonalSchedulePatch{
+				Properties: pointer.To(expandVirtualDesktopScalingPlanPersonalScheduleProperties(model)),
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.ScalingPlanPersonalSchedulesClient
+
+			id, err := scalingplanpersonalschedule.ParsePersonalScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualDesktopScalingPlanPersonalScheduleModel{
+				Name:          id.PersonalScheduleName,
+				ScalingPlanId: scalingplanpersonalschedule.NewScalingPlanID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+
+				daysOfWeek := make([]string, 0)
+				for _, v := range pointer.From(props.DaysOfWeek) {
+					daysOfWeek = append(daysOfWeek, string(v))
+				}
+				state.DaysOfWeek = daysOfWeek
+
+				state.RampUp = []VirtualDesktopPersonalScheduleRampUpModel{
+					{
+						StartTime:                 flattenVirtualDesktopPersonalScheduleTime(props.RampUpStartTime),
+						AutoStartHosts:            string(pointer.From(props.RampUpAutoStartHosts)),
+						StartVMOnConnectEnabled:   pointer.From(props.RampUpStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+						ActionOnDisconnect:        string(pointer.From(props.RampUpActionOnDisconnect)),
+						MinutesToWaitOnDisconnect: pointer.From(props.RampUpMinutesToWaitOnDisconnect),
+						ActionOnLogoff:            string(pointer.From(props.RampUpActionOnLogoff)),
+						MinutesToWaitOnLogoff:     pointer.From(props.RampUpMinutesToWaitOnLogoff),
+					},
+				}
+				state.Peak = []VirtualDesktopPersonalScheduleModel{
+					{
+						StartTime:                 flattenVirtualDesktopPersonalScheduleTime(props.PeakStartTime),
+						StartVMOnConnectEnabled:   pointer.From(props.PeakStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+						ActionOnDisconnect:        string(pointer.From(props.PeakActionOnDisconnect)),
+						MinutesToWaitOnDisconnect: pointer.From(props.PeakMinutesToWaitOnDisconnect),
+						ActionOnLogoff:            string(pointer.From(props.PeakActionOnLogoff)),
+						MinutesToWaitOnLogoff:     pointer.From(props.PeakMinutesToWaitOnLogoff),
+					},
+				}
+				state.RampDown = []VirtualDesktopPersonalScheduleModel{
+					{
+						StartTime:                 flattenVirtualDesktopPersonalScheduleTime(props.RampDownStartTime),
+						StartVMOnConnectEnabled:   pointer.From(props.RampDownStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+						ActionOnDisconnect:        string(pointer.From(props.RampDownActionOnDisconnect)),
+						MinutesToWaitOnDisconnect: pointer.From(props.RampDownMinutesToWaitOnDisconnect),
+						ActionOnLogoff:            string(pointer.From(props.RampDownActionOnLogoff)),
+						MinutesToWaitOnLogoff:     pointer.From(props.RampDownMinutesToWaitOnLogoff),
+					},
+				}
+				state.OffPeak = []VirtualDesktopPersonalScheduleModel{
+					{
+						StartTime:                 flattenVirtualDesktopPersonalScheduleTime(props.OffPeakStartTime),
+						StartVMOnConnectEnabled:   pointer.From(props.OffPeakStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+						ActionOnDisconnect:        string(pointer.From(props.OffPeakActionOnDisconnect)),
+						MinutesToWaitOnDisconnect: pointer.From(props.OffPeakMinutesToWaitOnDisconnect),
+						ActionOnLogoff:            string(pointer.From(props.OffPeakActionOnLogoff)),
+						MinutesToWaitOnLogoff:     pointer.From(props.OffPeakMinutesToWaitOnLogoff),
+					},
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.ScalingPlanPersonalSchedulesClient
+
+			id, err := scalingplanpersonalschedule.ParsePersonalScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandVirtualDesktopScalingPlanPersonalScheduleProperties(input VirtualDesktopScalingPlanPersonalScheduleModel) scalingplanpersonalschedule.ScalingPlanPersonalScheduleProperties {
+	daysOfWeek := make([]scalingplanpersonalschedule.DayOfWeek, 0)
+	for _, v := range input.DaysOfWeek {
+		daysOfWeek = append(daysOfWeek, scalingplanpersonalschedule.DayOfWeek(v))
+	}
+
+	props := scalingplanpersonalschedule.ScalingPlanPersonalScheduleProperties{
+		DaysOfWeek: &daysOfWeek,
+	}
+
+	if len(input.RampUp) > 0 {
+		v := input.RampUp[0]
+		props.RampUpStartTime = expandVirtualDesktopPersonalScheduleTime(v.StartTime)
+		props.RampUpAutoStartHosts = pointer.To(scalingplanpersonalschedule.StartupBehavior(v.AutoStartHosts))
+		props.RampUpStartVMOnConnect = expandVirtualDesktopPersonalScheduleStartVMOnConnect(v.StartVMOnConnectEnabled)
+		props.RampUpActionOnDisconnect = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnDisconnect))
+		props.RampUpMinutesToWaitOnDisconnect = pointer.To(v.MinutesToWaitOnDisconnect)
+		props.RampUpActionOnLogoff = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnLogoff))
+		props.RampUpMinutesToWaitOnLogoff = pointer.To(v.MinutesToWaitOnLogoff)
+	}
+
+	if len(input.Peak) > 0 {
+		v := input.Peak[0]
+		props.PeakStartTime = expandVirtualDesktopPersonalScheduleTime(v.StartTime)
+		props.PeakStartVMOnConnect = expandVirtualDesktopPersonalScheduleStartVMOnConnect(v.StartVMOnConnectEnabled)
+		props.PeakActionOnDisconnect = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnDisconnect))
+		props.PeakMinutesToWaitOnDisconnect = pointer.To(v.MinutesToWaitOnDisconnect)
+		props.PeakActionOnLogoff = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnLogoff))
+		props.PeakMinutesToWaitOnLogoff = pointer.To(v.MinutesToWaitOnLogoff)
+	}
+
+	if len(input.RampDown) > 0 {
+		v := input.RampDown[0]
+		props.RampDownStartTime = expandVirtualDesktopPersonalScheduleTime(v.StartTime)
+		props.RampDownStartVMOnConnect = expandVirtualDesktopPersonalScheduleStartVMOnConnect(v.StartVMOnConnectEnabled)
+		props.RampDownActionOnDisconnect = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnDisconnect))
+		props.RampDownMinutesToWaitOnDisconnect = pointer.To(v.MinutesToWaitOnDisconnect)
+		props.RampDownActionOnLogoff = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnLogoff))
+		props.RampDownMinutesToWaitOnLogoff = pointer.To(v.MinutesToWaitOnLogoff)
+	}
+
+	if len(input.OffPeak) > 0 {
+		v := input.OffPeak[0]
+		props.OffPeakStartTime = expandVirtualDesktopPersonalScheduleTime(v.StartTime)
+		props.OffPeakStartVMOnConnect = expandVirtualDesktopPersonalScheduleStartVMOnConnect(v.StartVMOnConnectEnabled)
+		props.OffPeakActionOnDisconnect = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnDisconnect))
+		props.OffPeakMinutesToWaitOnDisconnect = pointer.To(v.MinutesToWaitOnDisconnect)
+		props.OffPeakActionOnLogoff = pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(v.ActionOnLogoff))
+		props.OffPeakMinutesToWaitOnLogoff = pointer.To(v.MinutesToWaitOnLogoff)
+	}
+
+	return props
+}
+
+func expandVirtualDesktopPersonalScheduleStartVMOnConnect(input bool) *scalingplanpersonalschedule.SetStartVMOnConnect {
+	if input {
+		return pointer.To(scalingplanpersonalschedule.SetStartVMOnConnectEnable)
+	}
+	return pointer.To(scalingplanpersonalschedule.SetStartVMOnConnectDisable)
+}
+
+func expandVirtualDesktopPersonalScheduleTime(input string) *scalingplanpersonalschedule.Time {
+	if len(input) == 0 {
+		return nil
+	}
+
+	time := strings.Split(input, ":")
+	hour, _ := strconv.Atoi(time[0])
+	minute, _ := strconv.Atoi(time[1])
+
+	return &scalingplanpersonalschedule.Time{
+		Hour:   int64(hour),
+		Minute: int64(minute),
+	}
+}
+
+func flattenVirtualDesktopPersonalScheduleTime(input *scalingplanpersonalschedule.Time) string {
+	if input == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%02d:%02d", input.Hour, input.Minute)
+}
@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplanpersonalschedule"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualDesktopScalingPlanPersonalScheduleResource struct{}
+
+func TestAccVirtualDesktopScalingPlanPersonalSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan_personal_schedule", "test")
+	r := VirtualDesktopScalingPlanPersonalScheduleResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_virtual_desktop_scaling_plan.test").Key("host_pool_type").HasValue("Personal"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualDesktopScalingPlanPersonalSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan_personal_schedule", "test")
+	r := VirtualDesktopScalingPlanPersonalScheduleResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, roleAssignmentId)
+		}),
+	})
+}
+
+func TestAccVirtualDesktopScalingPlanPersonalSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan_personal_schedule", "test")
+	r := VirtualDesktopScalingPlanPersonalScheduleResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := scalingplanpersonalschedule.ParsePersonalScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DesktopVirtualization.ScalingPlanPersonalSchedulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VirtualDesktopScalingPlanPersonalScheduleResource) basic(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan_personal_schedule" "test" {
+  name            = "Weekdays"
+  scaling_plan_id = azurerm_virtual_desktop_scaling_plan.test.id
+  days_of_week    = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+
+  ramp_up {
+    start_time = "06:00"
+  }
+
+  peak {
+    start_time = "09:00"
+  }
+
+  ramp_down {
+    start_time = "18:00"
+  }
+
+  off_peak {
+    start_time = "22:00"
+  }
+}
+`, r.template(data, roleAssignmentId))
+}
+
+func (r VirtualDesktopScalingPlanPersonalScheduleResource) requiresImport(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan_personal_schedule" "import" {
+  name            = azurerm_virtual_desktop_scaling_plan_personal_schedule.test.name
+  scaling_plan_id = azurerm_virtual_desktop_scaling_plan_personal_schedule.test.scaling_plan_id
+  days_of_week    = azurerm_virtual_desktop_scaling_plan_personal_schedule.test.days_of_week
+
+  ramp_up {
+    start_time = "06:00"
+  }
+
+  peak {
+    start_time = "09:00"
+  }
+
+  ramp_down {
+    start_time = "18:00"
+  }
+
+  off_peak {
+    start_time = "22:00"
+  }
+}
+`, r.basic(data, roleAssignmentId))
+}
+
+func (r VirtualDesktopScalingPlanPersonalScheduleResource) complete(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan_personal_schedule" "test" {
+  name            = "Weekdays"
+  scaling_plan_id = azurerm_virtual_desktop_scaling_plan.test.id
+  days_of_week    = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+
+  ramp_up {
+    start_time                    = "07:00"
+    auto_start_hosts              = "WithAssignedUser"
+    start_vm_on_connect_enabled   = true
+    action_on_disconnect          = "Deallocate"
+    minutes_to_wait_on_disconnect = 30
+    action_on_logoff              = "Deallocate"
+    minutes_to_wait_on_logoff     = 15
+  }
+
+  peak {
+    start_time                    = "09:30"
+    start_vm_on_connect_enabled   = true
+    action_on_disconnect          = "None"
+    minutes_to_wait_on_disconnect = 0
+    action_on_logoff              = "Deallocate"
+    minutes_to_wait_on_logoff     = 60
+  }
+
+  ramp_down {
+    start_time                    = "17:30"
+    start_vm_on_connect_enabled   = false
+    action_on_disconnect          = "Deallocate"
+    minutes_to_wait_on_disconnect = 15
+    action_on_logoff              = "Deallocate"
+    minutes_to_wait_on_logoff     = 15
+  }
+
+  off_peak {
+    start_time                    = "20:00"
+    start_vm_on_connect_enabled   = false
+    action_on_disconnect          = "Deallocate"
+    minutes_to_wait_on_disconnect = 5
+    action_on_logoff              = "Deallocate"
+    minutes_to_wait_on_logoff     = 5
+  }
+}
+`, r.template(data, roleAssignmentId))
+}
+
+func (VirtualDesktopScalingPlanPersonalScheduleResource) template(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktop-%[1]d"
+  location = "%[2]s"
+}
+
+data "azuread_service_principal" "test" {
+  display_name = "Windows Virtual Desktop"
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                             = "%[3]s"
+  scope                            = azurerm_resource_group.test.id
+  role_definition_name             = "Desktop Virtualization Power On Off Contributor"
+  principal_id                     = data.azuread_service_principal.test.object_id
+  skip_service_principal_aad_check = true
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                             = "acctestHP%[4]s"
+  location                         = azurerm_resource_group.test.location
+  resource_group_name              = azurerm_resource_group.test.name
+  type                             = "Personal"
+  personal_desktop_assignment_type = "Automatic"
+  start_vm_on_connect              = true
+  load_balancer_type               = "Persistent"
+}
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%[4]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  time_zone           = "GMT Standard Time"
+  host_pool_type      = "Personal"
+
+  host_pool {
+    hostpool_id          = azurerm_virtual_desktop_host_pool.test.id
+    scaling_plan_enabled = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary, roleAssignmentId, data.RandomString)
+}
@@ -4,6 +4,7 @@
 package desktopvirtualization
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -27,6 +28,9 @@ import (
 
 var scalingPlanResourceType = "azurerm_virtual_desktop_scaling_plan"
 
+// scalingHostPoolTypePersonal is supported by the API but is missing from the `ScalingHostPoolType` constants in the SDK
+const scalingHostPoolTypePersonal = "Personal"
+
 func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualDesktopScalingPlanCreate,
@@ -46,6 +50,18 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			// the schedules of a Personal Scaling Plan are managed using the `azurerm_virtual_desktop_scaling_plan_personal_schedule` resource
+			schedules := d.Get("schedule").([]interface{})
+			if d.Get("host_pool_type").(string) == string(scalingplan.ScalingHostPoolTypePooled) && len(schedules) == 0 {
+				return fmt.Errorf("at least one `schedule` block must be specified when `host_pool_type` is `Pooled`")
+			}
+			if d.Get("host_pool_type").(string) == scalingHostPoolTypePersonal && len(schedules) > 0 {
+				return fmt.Errorf("`schedule` cannot be specified when `host_pool_type` is `Personal`, use the `azurerm_virtual_desktop_scaling_plan_personal_schedule` resource instead")
+			}
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -75,6 +91,17 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 				Required: true,
 			},
 
+			"host_pool_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(scalingplan.ScalingHostPoolTypePooled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(scalingplan.ScalingHostPoolTypePooled),
+					scalingHostPoolTypePersonal,
+				}, false),
+			},
+
 			"exclusion_tag": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -82,8 +109,7 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 
 			"schedule": {
 				Type:     pluginsdk.TypeList,
-				Required: true,
-				MinItems: 1,
+				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -273,7 +299,7 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 	location := location.Normalize(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	hostPoolType := scalingplan.ScalingHostPoolTypePooled // Only one possible value for this
+	hostPoolType := scalingplan.ScalingHostPoolType(d.Get("host_pool_type").(string))
 	payload := scalingplan.ScalingPlan{
 		Name:     pointer.To(d.Get("name").(string)),
 		Location: location,
@@ -360,6 +386,12 @@ func resourceVirtualDesktopScalingPlanRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("friendly_name", model.Properties.FriendlyName)
 		d.Set("time_zone", model.Properties.TimeZone)
 		d.Set("exclusion_tag", model.Properties.ExclusionTag)
+
+		hostPoolType := string(scalingplan.ScalingHostPoolTypePooled)
+		if v := model.Properties.HostPoolType; v != nil && *v != "" {
+			hostPoolType = string(*v)
+		}
+		d.Set("host_pool_type", hostPoolType)
 		d.Set("schedule", flattenScalingPlanSchedule(model.Properties.Schedules))
 		d.Set("host_pool", flattenScalingHostpoolReference(model.Properties.HostPoolReferences))
 
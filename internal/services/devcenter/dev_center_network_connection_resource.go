@@ -42,6 +42,7 @@ type DevCenterNetworkConnectionResourceModel struct {
 	DomainUsername    string            `tfschema:"domain_username"`
 	OrganizationUnit  string            `tfschema:"organization_unit"`
 	Tags              map[string]string `tfschema:"tags"`
+	HealthCheckStatus string            `tfschema:"health_check_status"`
 }
 
 func (r DevCenterNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -104,7 +105,12 @@ func (r DevCenterNetworkConnectionResource) Arguments() map[string]*pluginsdk.Sc
 }
 
 func (r DevCenterNetworkConnectionResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"health_check_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r DevCenterNetworkConnectionResource) Create() sdk.ResourceFunc {
@@ -162,6 +168,11 @@ func (r DevCenterNetworkConnectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if err := waitForDevCenterNetworkConnectionHealthCheck(ctx, client, id); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -201,6 +212,7 @@ func (r DevCenterNetworkConnectionResource) Read() sdk.ResourceFunc {
 					state.DomainName = pointer.From(props.DomainName)
 					state.DomainUsername = pointer.From(props.DomainUsername)
 					state.OrganizationUnit = pointer.From(props.OrganizationUnit)
+					state.HealthCheckStatus = pointer.FromEnum(props.HealthCheckStatus)
 
 					if v := props.DomainJoinType; v != "" {
 						state.DomainJoinType = string(v)
@@ -281,7 +293,56 @@ func (r DevCenterNetworkConnectionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if err := waitForDevCenterNetworkConnectionHealthCheck(ctx, client, *id); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
 }
+
+// the health checks are run once the Network Connection has been created or updated, and Dev Box Pools using the
+// Network Connection can't be created until they've finished - so we wait for them to complete
+func waitForDevCenterNetworkConnectionHealthCheck(ctx context.Context, client *networkconnections.NetworkConnectionsClient, id networkconnections.NetworkConnectionId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			"",
+			string(networkconnections.HealthCheckStatusPending),
+			string(networkconnections.HealthCheckStatusRunning),
+		},
+		Target: []string{
+			string(networkconnections.HealthCheckStatusFailed),
+			string(networkconnections.HealthCheckStatusInformational),
+			string(networkconnections.HealthCheckStatusPassed),
+			string(networkconnections.HealthCheckStatusUnknown),
+			string(networkconnections.HealthCheckStatusWarning),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			status := ""
+			if resp.Model != nil && resp.Model.Properties != nil {
+				status = pointer.FromEnum(resp.Model.Properties.HealthCheckStatus)
+			}
+
+			return resp, status, nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the health checks of %s to finish: %+v", id, err)
+	}
+
+	return nil
+}
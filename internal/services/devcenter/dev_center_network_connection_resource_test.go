@@ -27,6 +27,7 @@ func TestAccDevCenterNetworkConnection_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("health_check_status").IsNotEmpty(),
 			),
 		},
 		data.ImportStep(),
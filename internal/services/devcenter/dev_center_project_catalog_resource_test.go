@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projectcatalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DevCenterProjectCatalogTestResource struct{}
+
+func TestAccDevCenterProjectCatalog_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_catalog", "test")
+	r := DevCenterProjectCatalogTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectCatalog_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_catalog", "test")
+	r := DevCenterProjectCatalogTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterProjectCatalog_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_catalog", "test")
+	r := DevCenterProjectCatalogTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DevCenterProjectCatalogTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := projectcatalogs.ParseCatalogID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.V20250201.ProjectCatalogs.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DevCenterProjectCatalogTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_catalog" "test" {
+  name                  = "acctest-catalog-%d"
+  dev_center_project_id = azurerm_dev_center_project.test.id
+
+  catalog_github {
+    branch            = "main"
+    path              = "/template"
+    uri               = "https://github.com/am-lim/deployment-environments.git"
+    key_vault_key_url = "https://amlim-kv.vault.azure.net/secrets/envTest/0a79f15246ce4b35a13957367b422cab"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevCenterProjectCatalogTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_catalog" "import" {
+  name                  = azurerm_dev_center_project_catalog.test.name
+  dev_center_project_id = azurerm_dev_center_project_catalog.test.dev_center_project_id
+
+  catalog_github {
+    branch            = "main"
+    path              = "/template"
+    uri               = "https://github.com/am-lim/deployment-environments.git"
+    key_vault_key_url = "https://amlim-kv.vault.azure.net/secrets/envTest/0a79f15246ce4b35a13957367b422cab"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DevCenterProjectCatalogTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_catalog" "test" {
+  name                  = "acctest-catalog-%d"
+  dev_center_project_id = azurerm_dev_center_project.test.id
+  sync_type             = "Scheduled"
+
+  catalog_github {
+    branch            = "foo"
+    path              = ""
+    uri               = "https://github.com/am-lim/deployment-environments.git"
+    key_vault_key_url = "https://amlim-kv.vault.azure.net/secrets/envTest/0a79f15246ce4b35a13957367b422cab"
+  }
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DevCenterProjectCatalogTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-dcpc-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dev_center" "test" {
+  name                = "acctest-dc-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctest-dcp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  dev_center_id       = azurerm_dev_center.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
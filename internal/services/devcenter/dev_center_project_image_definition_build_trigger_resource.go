@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/imagedefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = DevCenterProjectImageDefinitionBuildTriggerResource{}
+
+type DevCenterProjectImageDefinitionBuildTriggerResource struct{}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) ModelObject() interface{} {
+	return &DevCenterProjectImageDefinitionBuildTriggerResourceModel{}
+}
+
+type DevCenterProjectImageDefinitionBuildTriggerResourceModel struct {
+	DevCenterProjectImageDefinitionId string            `tfschema:"dev_center_project_image_definition_id"`
+	Triggers                          map[string]string `tfschema:"triggers"`
+
+	BuildName    string `tfschema:"build_name"`
+	EndTime      string `tfschema:"end_time"`
+	ImageId      string `tfschema:"image_id"`
+	ImageVersion string `tfschema:"image_version"`
+	StartTime    string `tfschema:"start_time"`
+	Status       string `tfschema:"status"`
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return imagedefinitions.ValidateBuildID
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) ResourceType() string {
+	return "azurerm_dev_center_project_image_definition_build_trigger"
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dev_center_project_image_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: imagedefinitions.ValidateImageDefinitionID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"build_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"end_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"image_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"image_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ImageDefinitions
+
+			var model DevCenterProjectImageDefinitionBuildTriggerResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			imageDefinitionId, err := imagedefinitions.ParseImageDefinitionID(model.DevCenterProjectImageDefinitionId)
+			if err != nil {
+				return err
+			}
+
+			// the build operation doesn't return the build, so it's identified as the one which didn't exist beforehand
+			existing, err := client.ProjectCatalogImageDefinitionBuildsListByImageDefinitionComplete(ctx, *imageDefinitionId)
+			if err != nil {
+				return fmt.Errorf("listing builds for %s: %+v", imageDefinitionId, err)
+			}
+			existingBuilds := make(map[string]struct{})
+			for _, item := range existing.Items {
+				existingBuilds[strings.ToLower(pointer.From(item.Name))] = struct{}{}
+			}
+
+			if err := client.ProjectCatalogImageDefinitionsBuildImageThenPoll(ctx, *imageDefinitionId); err != nil {
+				return fmt.Errorf("building an image for %s: %+v", imageDefinitionId, err)
+			}
+
+			builds, err := client.ProjectCatalogImageDefinitionBuildsListByImageDefinitionComplete(ctx, *imageDefinitionId)
+			if err != nil {
+				return fmt.Errorf("listing builds for %s: %+v", imageDefinitionId, err)
+			}
+
+			var latest *imagedefinitions.ImageDefinitionBuild
+			for _, item := range builds.Items {
+				if _, ok := existingBuilds[strings.ToLower(pointer.From(item.Name))]; ok || item.Name == nil {
+					continue
+				}
+				if latest == nil || imageDefinitionBuildStartTime(item) > imageDefinitionBuildStartTime(*latest) {
+					latest = pointer.To(item)
+				}
+			}
+			if latest == nil {
+				return fmt.Errorf("building an image for %s: the new build could not be found", imageDefinitionId)
+			}
+
+			id := imagedefinitions.NewBuildID(imageDefinitionId.SubscriptionId, imageDefinitionId.ResourceGroupName, imageDefinitionId.ProjectName, imageDefinitionId.CatalogName, imageDefinitionId.ImageDefinitionName, *latest.Name)
+			metadata.SetID(id)
+
+			if latest.Properties != nil {
+				if status := pointer.From(latest.Properties.Status); status != imagedefinitions.ImageDefinitionBuildStatusSucceeded && status != imagedefinitions.ImageDefinitionBuildStatusRunning {
+					return fmt.Errorf("%s finished with the status %q", id, status)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ImageDefinitions
+
+			id, err := imagedefinitions.ParseBuildID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ProjectCatalogImageDefinitionBuildGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// `triggers` isn't returned by the API, so it's retained from the state
+			var state DevCenterProjectImageDefinitionBuildTriggerResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.DevCenterProjectImageDefinitionId = imagedefinitions.NewImageDefinitionID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName, id.CatalogName, id.ImageDefinitionName).ID()
+			state.BuildName = id.BuildName
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EndTime = pointer.From(props.EndTime)
+					state.StartTime = pointer.From(props.StartTime)
+					state.Status = pointer.FromEnum(props.Status)
+
+					if ref := props.ImageReference; ref != nil {
+						state.ImageId = pointer.From(ref.Id)
+						state.ImageVersion = pointer.From(ref.ExactVersion)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ImageDefinitions
+
+			id, err := imagedefinitions.ParseBuildID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ProjectCatalogImageDefinitionBuildGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// builds can't be deleted, so a build which is still running is cancelled and anything else is only removed from the state
+			if model := resp.Model; model != nil && model.Properties != nil && pointer.From(model.Properties.Status) == imagedefinitions.ImageDefinitionBuildStatusRunning {
+				if err := client.ProjectCatalogImageDefinitionBuildCancelThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("cancelling %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func imageDefinitionBuildStartTime(input imagedefinitions.ImageDefinitionBuild) string {
+	if input.Properties == nil {
+		return ""
+	}
+	return pointer.From(input.Properties.StartTime)
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/imagedefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DevCenterProjectImageDefinitionBuildTriggerTestResource struct{}
+
+func TestAccDevCenterProjectImageDefinitionBuildTrigger_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_IMAGE_DEFINITION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_DEV_CENTER_PROJECT_IMAGE_DEFINITION_ID is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_image_definition_build_trigger", "test")
+	r := DevCenterProjectImageDefinitionBuildTriggerTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic("1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic("2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := imagedefinitions.ParseBuildID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.V20250201.ImageDefinitions.ProjectCatalogImageDefinitionBuildGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DevCenterProjectImageDefinitionBuildTriggerTestResource) basic(wave string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_dev_center_project_image_definition_build_trigger" "test" {
+  dev_center_project_image_definition_id = "%s"
+
+  triggers = {
+    wave = "%s"
+  }
+}
+`, os.Getenv("ARM_TEST_DEV_CENTER_PROJECT_IMAGE_DEFINITION_ID"), wave)
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the Dev Center API only supports a single schedule per Pool, which must be named `default`
+const devCenterProjectPoolScheduleName = "default"
+
+var (
+	_ sdk.Resource           = DevCenterProjectPoolScheduleResource{}
+	_ sdk.ResourceWithUpdate = DevCenterProjectPoolScheduleResource{}
+)
+
+type DevCenterProjectPoolScheduleResource struct{}
+
+func (r DevCenterProjectPoolScheduleResource) ModelObject() interface{} {
+	return &DevCenterProjectPoolScheduleResourceModel{}
+}
+
+type DevCenterProjectPoolScheduleResourceModel struct {
+	DevCenterProjectPoolId string            `tfschema:"dev_center_project_pool_id"`
+	Enabled                bool              `tfschema:"enabled"`
+	Time                   string            `tfschema:"time"`
+	TimeZone               string            `tfschema:"time_zone"`
+	Tags                   map[string]string `tfschema:"tags"`
+}
+
+func (r DevCenterProjectPoolScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schedules.ValidateScheduleID
+}
+
+func (r DevCenterProjectPoolScheduleResource) ResourceType() string {
+	return "azurerm_dev_center_project_pool_schedule"
+}
+
+func (r DevCenterProjectPoolScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dev_center_project_pool_id": commonschema.ResourceIDReferenceRequiredForceNew(&schedules.PoolId{}),
+
+		"time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "`time` must be in the format `HH:MM`"),
+		},
+
+		"time_zone": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.Schedules
+
+			var model DevCenterProjectPoolScheduleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			poolId, err := schedules.ParsePoolID(model.DevCenterProjectPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := schedules.NewScheduleID(poolId.SubscriptionId, poolId.ResourceGroupName, poolId.ProjectName, poolId.PoolName, devCenterProjectPoolScheduleName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := schedules.Schedule{
+				Properties: &schedules.ScheduleProperties{
+					Frequency: pointer.To(schedules.ScheduledFrequencyDaily),
+					State:     expandDevCenterProjectPoolScheduleState(model.Enabled),
+					Tags:      pointer.To(model.Tags),
+					Time:      pointer.To(model.Time),
+					TimeZone:  pointer.To(model.TimeZone),
+					Type:      pointer.To(schedules.ScheduledTypeStopDevBox),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.Schedules
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectPoolScheduleResourceModel{
+				DevCenterProjectPoolId: schedules.NewPoolID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName, id.PoolName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Enabled = pointer.From(props.State) == schedules.ScheduleEnableStatusEnabled
+					state.Tags = pointer.From(props.Tags)
+					state.Time = pointer.From(props.Time)
+					state.TimeZone = pointer.From(props.TimeZone)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.Schedules
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectPoolScheduleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := schedules.ScheduleUpdate{
+				Properties: &schedules.ScheduleUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				parameters.Properties.State = expandDevCenterProjectPoolScheduleState(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("time") {
+				parameters.Properties.Time = pointer.To(model.Time)
+			}
+
+			if metadata.ResourceData.HasChange("time_zone") {
+				parameters.Properties.TimeZone = pointer.To(model.TimeZone)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Properties.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPoolScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.Schedules
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectPoolScheduleState(input bool) *schedules.ScheduleEnableStatus {
+	if input {
+		return pointer.To(schedules.ScheduleEnableStatusEnabled)
+	}
+
+	return pointer.To(schedules.ScheduleEnableStatusDisabled)
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DevCenterProjectPoolScheduleTestResource struct{}
+
+func TestAccDevCenterProjectPoolSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool_schedule", "test")
+	r := DevCenterProjectPoolScheduleTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectPoolSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool_schedule", "test")
+	r := DevCenterProjectPoolScheduleTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterProjectPoolSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_pool_schedule", "test")
+	r := DevCenterProjectPoolScheduleTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := schedules.ParseScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.V20250201.Schedules.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool_schedule" "test" {
+  dev_center_project_pool_id = azurerm_dev_center_project_pool.test.id
+  time                       = "23:30"
+  time_zone                  = "Europe/London"
+}
+`, DevCenterProjectPoolTestResource{}.basic(data))
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool_schedule" "import" {
+  dev_center_project_pool_id = azurerm_dev_center_project_pool_schedule.test.dev_center_project_pool_id
+  time                       = azurerm_dev_center_project_pool_schedule.test.time
+  time_zone                  = azurerm_dev_center_project_pool_schedule.test.time_zone
+}
+`, r.basic(data))
+}
+
+func (r DevCenterProjectPoolScheduleTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_pool_schedule" "test" {
+  dev_center_project_pool_id = azurerm_dev_center_project_pool.test.id
+  time                       = "19:00"
+  time_zone                  = "America/Los_Angeles"
+  enabled                    = false
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, DevCenterProjectPoolTestResource{}.basic(data))
+}
@@ -53,9 +53,12 @@ func (r Registration) Resources() []sdk.Resource {
 		DevCenterDevBoxDefinitionResource{},
 		DevCenterEnvironmentTypeResource{},
 		DevCenterNetworkConnectionResource{},
+		DevCenterProjectCatalogResource{},
 		DevCenterProjectPoolResource{},
+		DevCenterProjectPoolScheduleResource{},
 		DevCenterProjectResource{},
 		DevCenterProjectEnvironmentTypeResource{},
+		DevCenterProjectImageDefinitionBuildTriggerResource{},
 		DevCenterResource{},
 	}
 }
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+
+	deviceregistry_v2025_10_01 "github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	V20251001 deviceregistry_v2025_10_01.Client
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	v20251001Client, err := deviceregistry_v2025_10_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building client for deviceregistry v2025-10-01: %+v", err)
+	}
+
+	return &Client{
+		V20251001: *v20251001Client,
+	}, nil
+}
@@ -0,0 +1,402 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/assetendpointprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DeviceRegistryAssetEndpointProfileModel struct {
+	Name                    string                               `tfschema:"name"`
+	ResourceGroupName       string                               `tfschema:"resource_group_name"`
+	Location                string                               `tfschema:"location"`
+	CustomLocationId        string                               `tfschema:"custom_location_id"`
+	EndpointProfileType     string                               `tfschema:"endpoint_profile_type"`
+	TargetAddress           string                               `tfschema:"target_address"`
+	AdditionalConfiguration string                               `tfschema:"additional_configuration"`
+	Authentication          []AssetEndpointProfileAuthentication `tfschema:"authentication"`
+	Tags                    map[string]string                    `tfschema:"tags"`
+	Uuid                    string                               `tfschema:"uuid"`
+}
+
+type AssetEndpointProfileAuthentication struct {
+	Method                string `tfschema:"method"`
+	UsernameSecretName    string `tfschema:"username_secret_name"`
+	PasswordSecretName    string `tfschema:"password_secret_name"`
+	CertificateSecretName string `tfschema:"certificate_secret_name"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = DeviceRegistryAssetEndpointProfileResource{}
+	_ sdk.ResourceWithCustomizeDiff = DeviceRegistryAssetEndpointProfileResource{}
+)
+
+type DeviceRegistryAssetEndpointProfileResource struct{}
+
+func (r DeviceRegistryAssetEndpointProfileResource) ModelObject() interface{} {
+	return &DeviceRegistryAssetEndpointProfileModel{}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return assetendpointprofiles.ValidateAssetEndpointProfileID
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) ResourceType() string {
+	return "azurerm_device_registry_asset_endpoint_profile"
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(3, 63),
+				validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`),
+					"`name` must start and end with a lowercase letter or number and can only contain lowercase letters, numbers and hyphens",
+				),
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": commonschema.ResourceIDReferenceRequiredForceNew(&customlocations.CustomLocationId{}),
+
+		"endpoint_profile_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"additional_configuration": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"method": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(assetendpointprofiles.PossibleValuesForAuthenticationMethod(), false),
+					},
+
+					"username_secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password_secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"certificate_secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"uuid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DeviceRegistryAssetEndpointProfileModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, v := range model.Authentication {
+				switch assetendpointprofiles.AuthenticationMethod(v.Method) {
+				case assetendpointprofiles.AuthenticationMethodUsernamePassword:
+					if v.UsernameSecretName == "" || v.PasswordSecretName == "" {
+						return fmt.Errorf("`username_secret_name` and `password_secret_name` must be specified when `method` is `%s`", v.Method)
+					}
+				case assetendpointprofiles.AuthenticationMethodCertificate:
+					if v.CertificateSecretName == "" {
+						return fmt.Errorf("`certificate_secret_name` must be specified when `method` is `%s`", v.Method)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.AssetEndpointProfiles
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DeviceRegistryAssetEndpointProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := assetendpointprofiles.NewAssetEndpointProfileID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := assetendpointprofiles.AssetEndpointProfile{
+				Location: location.Normalize(model.Location),
+				ExtendedLocation: assetendpointprofiles.ExtendedLocation{
+					Type: "CustomLocation",
+					Name: model.CustomLocationId,
+				},
+				Properties: &assetendpointprofiles.AssetEndpointProfileProperties{
+					EndpointProfileType: model.EndpointProfileType,
+					TargetAddress:       model.TargetAddress,
+					Authentication:      expandAssetEndpointProfileAuthentication(model.Authentication),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.AdditionalConfiguration != "" {
+				parameters.Properties.AdditionalConfiguration = pointer.To(model.AdditionalConfiguration)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.AssetEndpointProfiles
+
+			id, err := assetendpointprofiles.ParseAssetEndpointProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DeviceRegistryAssetEndpointProfileModel{
+				Name:              id.AssetEndpointProfileName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(model.ExtendedLocation.Name)
+				if err != nil {
+					return err
+				}
+				state.CustomLocationId = customLocationId.ID()
+
+				if props := model.Properties; props != nil {
+					state.EndpointProfileType = props.EndpointProfileType
+					state.TargetAddress = props.TargetAddress
+					state.AdditionalConfiguration = pointer.From(props.AdditionalConfiguration)
+					state.Authentication = flattenAssetEndpointProfileAuthentication(props.Authentication)
+					state.Uuid = pointer.From(props.Uuid)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.AssetEndpointProfiles
+
+			id, err := assetendpointprofiles.ParseAssetEndpointProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DeviceRegistryAssetEndpointProfileModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("endpoint_profile_type") {
+				payload.Properties.EndpointProfileType = model.EndpointProfileType
+			}
+
+			if metadata.ResourceData.HasChange("target_address") {
+				payload.Properties.TargetAddress = model.TargetAddress
+			}
+
+			if metadata.ResourceData.HasChange("additional_configuration") {
+				payload.Properties.AdditionalConfiguration = nil
+				if model.AdditionalConfiguration != "" {
+					payload.Properties.AdditionalConfiguration = pointer.To(model.AdditionalConfiguration)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("authentication") {
+				payload.Properties.Authentication = expandAssetEndpointProfileAuthentication(model.Authentication)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.AssetEndpointProfiles
+
+			id, err := assetendpointprofiles.ParseAssetEndpointProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAssetEndpointProfileAuthentication(input []AssetEndpointProfileAuthentication) *assetendpointprofiles.Authentication {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &assetendpointprofiles.Authentication{
+		Method: assetendpointprofiles.AuthenticationMethod(v.Method),
+	}
+
+	if v.UsernameSecretName != "" && v.PasswordSecretName != "" {
+		output.UsernamePasswordCredentials = &assetendpointprofiles.UsernamePasswordCredentials{
+			UsernameSecretName: v.UsernameSecretName,
+			PasswordSecretName: v.PasswordSecretName,
+		}
+	}
+
+	if v.CertificateSecretName != "" {
+		output.X509Credentials = &assetendpointprofiles.X509Credentials{
+			CertificateSecretName: v.CertificateSecretName,
+		}
+	}
+
+	return output
+}
+
+func flattenAssetEndpointProfileAuthentication(input *assetendpointprofiles.Authentication) []AssetEndpointProfileAuthentication {
+	if input == nil {
+		return []AssetEndpointProfileAuthentication{}
+	}
+
+	output := AssetEndpointProfileAuthentication{
+		Method: string(input.Method),
+	}
+
+	if v := input.UsernamePasswordCredentials; v != nil {
+		output.UsernameSecretName = v.UsernameSecretName
+		output.PasswordSecretName = v.PasswordSecretName
+	}
+
+	if v := input.X509Credentials; v != nil {
+		output.CertificateSecretName = v.CertificateSecretName
+	}
+
+	return []AssetEndpointProfileAuthentication{output}
+}
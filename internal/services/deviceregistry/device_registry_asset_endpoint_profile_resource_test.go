@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/assetendpointprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DeviceRegistryAssetEndpointProfileResource struct{}
+
+func TestAccDeviceRegistryAssetEndpointProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset_endpoint_profile", "test")
+	r := DeviceRegistryAssetEndpointProfileResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistryAssetEndpointProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset_endpoint_profile", "test")
+	r := DeviceRegistryAssetEndpointProfileResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDeviceRegistryAssetEndpointProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset_endpoint_profile", "test")
+	r := DeviceRegistryAssetEndpointProfileResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := assetendpointprofiles.ParseAssetEndpointProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DeviceRegistry.V20251001.AssetEndpointProfiles.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_CUSTOM_LOCATION_ID` was not specified")
+	}
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset_endpoint_profile" "test" {
+  name                  = "acctest-draep-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  custom_location_id    = "%s"
+  endpoint_profile_type = "Microsoft.OpcUa"
+  target_address        = "opc.tcp://opcplc-000000:50000"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset_endpoint_profile" "import" {
+  name                  = azurerm_device_registry_asset_endpoint_profile.test.name
+  resource_group_name   = azurerm_device_registry_asset_endpoint_profile.test.resource_group_name
+  location              = azurerm_device_registry_asset_endpoint_profile.test.location
+  custom_location_id    = azurerm_device_registry_asset_endpoint_profile.test.custom_location_id
+  endpoint_profile_type = azurerm_device_registry_asset_endpoint_profile.test.endpoint_profile_type
+  target_address        = azurerm_device_registry_asset_endpoint_profile.test.target_address
+}
+`, r.basic(data))
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset_endpoint_profile" "test" {
+  name                  = "acctest-draep-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  custom_location_id    = "%s"
+  endpoint_profile_type = "Microsoft.OpcUa"
+  target_address        = "opc.tcp://opcplc-000001:50000"
+
+  additional_configuration = jsonencode({
+    defaults = {
+      publishingIntervalMilliseconds = 1000
+    }
+  })
+
+  authentication {
+    method               = "UsernamePassword"
+    username_secret_name = "opc-username"
+    password_secret_name = "opc-password"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r DeviceRegistryAssetEndpointProfileResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-deviceregistry-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,840 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/assets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DeviceRegistryAssetModel struct {
+	Name                         string            `tfschema:"name"`
+	ResourceGroupName            string            `tfschema:"resource_group_name"`
+	Location                     string            `tfschema:"location"`
+	CustomLocationId             string            `tfschema:"custom_location_id"`
+	AssetEndpointProfileRef      string            `tfschema:"asset_endpoint_profile_ref"`
+	Attributes                   map[string]string `tfschema:"attributes"`
+	Dataset                      []AssetDataset    `tfschema:"dataset"`
+	DefaultDatasetsConfiguration string            `tfschema:"default_datasets_configuration"`
+	DefaultEventsConfiguration   string            `tfschema:"default_events_configuration"`
+	DefaultTopic                 []AssetTopic      `tfschema:"default_topic"`
+	Description                  string            `tfschema:"description"`
+	DisplayName                  string            `tfschema:"display_name"`
+	DocumentationUri             string            `tfschema:"documentation_uri"`
+	Enabled                      bool              `tfschema:"enabled"`
+	Event                        []AssetEvent      `tfschema:"event"`
+	ExternalAssetId              string            `tfschema:"external_asset_id"`
+	HardwareRevision             string            `tfschema:"hardware_revision"`
+	Manufacturer                 string            `tfschema:"manufacturer"`
+	ManufacturerUri              string            `tfschema:"manufacturer_uri"`
+	Model                        string            `tfschema:"model"`
+	ProductCode                  string            `tfschema:"product_code"`
+	SerialNumber                 string            `tfschema:"serial_number"`
+	SoftwareRevision             string            `tfschema:"software_revision"`
+	Tags                         map[string]string `tfschema:"tags"`
+	Uuid                         string            `tfschema:"uuid"`
+	Version                      int64             `tfschema:"version"`
+}
+
+type AssetDataset struct {
+	Name                 string           `tfschema:"name"`
+	DatasetConfiguration string           `tfschema:"dataset_configuration"`
+	DataPoint            []AssetDataPoint `tfschema:"data_point"`
+	Topic                []AssetTopic     `tfschema:"topic"`
+}
+
+type AssetDataPoint struct {
+	Name                   string `tfschema:"name"`
+	DataSource             string `tfschema:"data_source"`
+	DataPointConfiguration string `tfschema:"data_point_configuration"`
+	ObservabilityMode      string `tfschema:"observability_mode"`
+}
+
+type AssetEvent struct {
+	Name               string       `tfschema:"name"`
+	EventNotifier      string       `tfschema:"event_notifier"`
+	EventConfiguration string       `tfschema:"event_configuration"`
+	ObservabilityMode  string       `tfschema:"observability_mode"`
+	Topic              []AssetTopic `tfschema:"topic"`
+}
+
+type AssetTopic struct {
+	Path   string `tfschema:"path"`
+	Retain string `tfschema:"retain"`
+}
+
+var _ sdk.ResourceWithUpdate = DeviceRegistryAssetResource{}
+
+type DeviceRegistryAssetResource struct{}
+
+func (r DeviceRegistryAssetResource) ModelObject() interface{} {
+	return &DeviceRegistryAssetModel{}
+}
+
+func (r DeviceRegistryAssetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return assets.ValidateAssetID
+}
+
+func (r DeviceRegistryAssetResource) ResourceType() string {
+	return "azurerm_device_registry_asset"
+}
+
+func (r DeviceRegistryAssetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(3, 63),
+				validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`),
+					"`name` must start and end with a lowercase letter or number and can only contain lowercase letters, numbers and hyphens",
+				),
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": commonschema.ResourceIDReferenceRequiredForceNew(&customlocations.CustomLocationId{}),
+
+		"asset_endpoint_profile_ref": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"dataset": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"dataset_configuration": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					},
+
+					"data_point": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"data_source": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"data_point_configuration": {
+									Type:             pluginsdk.TypeString,
+									Optional:         true,
+									ValidateFunc:     validation.StringIsJSON,
+									DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+								},
+
+								"observability_mode": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(assets.DataPointObservabilityModeNone),
+									ValidateFunc: validation.StringInSlice(assets.PossibleValuesForDataPointObservabilityMode(), false),
+								},
+							},
+						},
+					},
+
+					"topic": assetTopicSchema(),
+				},
+			},
+		},
+
+		"default_datasets_configuration": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"default_events_configuration": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"default_topic": assetTopicSchema(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"documentation_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"event": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"event_notifier": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"event_configuration": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						ValidateFunc:     validation.StringIsJSON,
+						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					},
+
+					"observability_mode": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(assets.EventObservabilityModeNone),
+						ValidateFunc: validation.StringInSlice(assets.PossibleValuesForEventObservabilityMode(), false),
+					},
+
+					"topic": assetTopicSchema(),
+				},
+			},
+		},
+
+		"external_asset_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"hardware_revision": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"manufacturer": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"manufacturer_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"model": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"product_code": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"serial_number": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"software_revision": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DeviceRegistryAssetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"uuid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r DeviceRegistryAssetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Assets
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DeviceRegistryAssetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := assets.NewAssetID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := assets.Asset{
+				Location: location.Normalize(model.Location),
+				ExtendedLocation: assets.ExtendedLocation{
+					Type: "CustomLocation",
+					Name: model.CustomLocationId,
+				},
+				Properties: &assets.AssetProperties{
+					AssetEndpointProfileRef: model.AssetEndpointProfileRef,
+					Attributes:              expandAssetAttributes(model.Attributes),
+					Datasets:                expandAssetDatasets(model.Dataset),
+					DefaultTopic:            expandAssetTopic(model.DefaultTopic),
+					Enabled:                 pointer.To(model.Enabled),
+					Events:                  expandAssetEvents(model.Event),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.ExternalAssetId != "" {
+				parameters.Properties.ExternalAssetId = pointer.To(model.ExternalAssetId)
+			}
+
+			if model.DefaultDatasetsConfiguration != "" {
+				parameters.Properties.DefaultDatasetsConfiguration = pointer.To(model.DefaultDatasetsConfiguration)
+			}
+
+			if model.DefaultEventsConfiguration != "" {
+				parameters.Properties.DefaultEventsConfiguration = pointer.To(model.DefaultEventsConfiguration)
+			}
+
+			if model.Description != "" {
+				parameters.Properties.Description = pointer.To(model.Description)
+			}
+
+			if model.DisplayName != "" {
+				parameters.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if model.DocumentationUri != "" {
+				parameters.Properties.DocumentationUri = pointer.To(model.DocumentationUri)
+			}
+
+			if model.HardwareRevision != "" {
+				parameters.Properties.HardwareRevision = pointer.To(model.HardwareRevision)
+			}
+
+			if model.Manufacturer != "" {
+				parameters.Properties.Manufacturer = pointer.To(model.Manufacturer)
+			}
+
+			if model.ManufacturerUri != "" {
+				parameters.Properties.ManufacturerUri = pointer.To(model.ManufacturerUri)
+			}
+
+			if model.Model != "" {
+				parameters.Properties.Model = pointer.To(model.Model)
+			}
+
+			if model.ProductCode != "" {
+				parameters.Properties.ProductCode = pointer.To(model.ProductCode)
+			}
+
+			if model.SerialNumber != "" {
+				parameters.Properties.SerialNumber = pointer.To(model.SerialNumber)
+			}
+
+			if model.SoftwareRevision != "" {
+				parameters.Properties.SoftwareRevision = pointer.To(model.SoftwareRevision)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistryAssetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Assets
+
+			id, err := assets.ParseAssetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DeviceRegistryAssetModel{
+				Name:              id.AssetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(model.ExtendedLocation.Name)
+				if err != nil {
+					return err
+				}
+				state.CustomLocationId = customLocationId.ID()
+
+				if props := model.Properties; props != nil {
+					state.AssetEndpointProfileRef = props.AssetEndpointProfileRef
+					state.Attributes = flattenAssetAttributes(props.Attributes)
+					state.Dataset = flattenAssetDatasets(props.Datasets)
+					state.DefaultDatasetsConfiguration = pointer.From(props.DefaultDatasetsConfiguration)
+					state.DefaultEventsConfiguration = pointer.From(props.DefaultEventsConfiguration)
+					state.DefaultTopic = flattenAssetTopic(props.DefaultTopic)
+					state.Description = pointer.From(props.Description)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.DocumentationUri = pointer.From(props.DocumentationUri)
+					state.Enabled = pointer.From(props.Enabled)
+					state.Event = flattenAssetEvents(props.Events)
+					state.ExternalAssetId = pointer.From(props.ExternalAssetId)
+					state.HardwareRevision = pointer.From(props.HardwareRevision)
+					state.Manufacturer = pointer.From(props.Manufacturer)
+					state.ManufacturerUri = pointer.From(props.ManufacturerUri)
+					state.Model = pointer.From(props.Model)
+					state.ProductCode = pointer.From(props.ProductCode)
+					state.SerialNumber = pointer.From(props.SerialNumber)
+					state.SoftwareRevision = pointer.From(props.SoftwareRevision)
+					state.Uuid = pointer.From(props.Uuid)
+					state.Version = pointer.From(props.Version)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DeviceRegistryAssetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Assets
+
+			id, err := assets.ParseAssetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DeviceRegistryAssetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			props := payload.Properties
+
+			// `status` is read-only and is re-computed by the service
+			props.Status = nil
+
+			if metadata.ResourceData.HasChange("attributes") {
+				props.Attributes = expandAssetAttributes(model.Attributes)
+			}
+
+			if metadata.ResourceData.HasChange("dataset") {
+				props.Datasets = expandAssetDatasets(model.Dataset)
+			}
+
+			if metadata.ResourceData.HasChange("default_datasets_configuration") {
+				props.DefaultDatasetsConfiguration = nil
+				if model.DefaultDatasetsConfiguration != "" {
+					props.DefaultDatasetsConfiguration = pointer.To(model.DefaultDatasetsConfiguration)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("default_events_configuration") {
+				props.DefaultEventsConfiguration = nil
+				if model.DefaultEventsConfiguration != "" {
+					props.DefaultEventsConfiguration = pointer.To(model.DefaultEventsConfiguration)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("default_topic") {
+				props.DefaultTopic = expandAssetTopic(model.DefaultTopic)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = nil
+				if model.Description != "" {
+					props.Description = pointer.To(model.Description)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				props.DisplayName = nil
+				if model.DisplayName != "" {
+					props.DisplayName = pointer.To(model.DisplayName)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("documentation_uri") {
+				props.DocumentationUri = nil
+				if model.DocumentationUri != "" {
+					props.DocumentationUri = pointer.To(model.DocumentationUri)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				props.Enabled = pointer.To(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("event") {
+				props.Events = expandAssetEvents(model.Event)
+			}
+
+			if metadata.ResourceData.HasChange("hardware_revision") {
+				props.HardwareRevision = nil
+				if model.HardwareRevision != "" {
+					props.HardwareRevision = pointer.To(model.HardwareRevision)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("manufacturer") {
+				props.Manufacturer = nil
+				if model.Manufacturer != "" {
+					props.Manufacturer = pointer.To(model.Manufacturer)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("manufacturer_uri") {
+				props.ManufacturerUri = nil
+				if model.ManufacturerUri != "" {
+					props.ManufacturerUri = pointer.To(model.ManufacturerUri)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("model") {
+				props.Model = nil
+				if model.Model != "" {
+					props.Model = pointer.To(model.Model)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("product_code") {
+				props.ProductCode = nil
+				if model.ProductCode != "" {
+					props.ProductCode = pointer.To(model.ProductCode)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("serial_number") {
+				props.SerialNumber = nil
+				if model.SerialNumber != "" {
+					props.SerialNumber = pointer.To(model.SerialNumber)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("software_revision") {
+				props.SoftwareRevision = nil
+				if model.SoftwareRevision != "" {
+					props.SoftwareRevision = pointer.To(model.SoftwareRevision)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistryAssetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Assets
+
+			id, err := assets.ParseAssetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func assetTopicSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"retain": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(assets.TopicRetainTypeNever),
+					ValidateFunc: validation.StringInSlice(assets.PossibleValuesForTopicRetainType(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandAssetAttributes(input map[string]string) *map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return &output
+}
+
+func flattenAssetAttributes(input *map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = fmt.Sprintf("%v", v)
+	}
+
+	return output
+}
+
+func expandAssetTopic(input []AssetTopic) *assets.Topic {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &assets.Topic{
+		Path:   input[0].Path,
+		Retain: pointer.ToEnum[assets.TopicRetainType](input[0].Retain),
+	}
+}
+
+func flattenAssetTopic(input *assets.Topic) []AssetTopic {
+	if input == nil {
+		return []AssetTopic{}
+	}
+
+	return []AssetTopic{
+		{
+			Path:   input.Path,
+			Retain: string(pointer.From(input.Retain)),
+		},
+	}
+}
+
+func expandAssetDatasets(input []AssetDataset) *[]assets.Dataset {
+	output := make([]assets.Dataset, 0)
+	for _, v := range input {
+		dataPoints := make([]assets.DataPoint, 0)
+		for _, dp := range v.DataPoint {
+			dataPoint := assets.DataPoint{
+				Name:              dp.Name,
+				DataSource:        dp.DataSource,
+				ObservabilityMode: pointer.ToEnum[assets.DataPointObservabilityMode](dp.ObservabilityMode),
+			}
+
+			if dp.DataPointConfiguration != "" {
+				dataPoint.DataPointConfiguration = pointer.To(dp.DataPointConfiguration)
+			}
+
+			dataPoints = append(dataPoints, dataPoint)
+		}
+
+		dataset := assets.Dataset{
+			Name:       v.Name,
+			DataPoints: &dataPoints,
+			Topic:      expandAssetTopic(v.Topic),
+		}
+
+		if v.DatasetConfiguration != "" {
+			dataset.DatasetConfiguration = pointer.To(v.DatasetConfiguration)
+		}
+
+		output = append(output, dataset)
+	}
+
+	return &output
+}
+
+func flattenAssetDatasets(input *[]assets.Dataset) []AssetDataset {
+	output := make([]AssetDataset, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		dataPoints := make([]AssetDataPoint, 0)
+		if v.DataPoints != nil {
+			for _, dp := range *v.DataPoints {
+				dataPoints = append(dataPoints, AssetDataPoint{
+					Name:                   dp.Name,
+					DataSource:             dp.DataSource,
+					DataPointConfiguration: pointer.From(dp.DataPointConfiguration),
+					ObservabilityMode:      string(pointer.From(dp.ObservabilityMode)),
+				})
+			}
+		}
+
+		output = append(output, AssetDataset{
+			Name:                 v.Name,
+			DatasetConfiguration: pointer.From(v.DatasetConfiguration),
+			DataPoint:            dataPoints,
+			Topic:                flattenAssetTopic(v.Topic),
+		})
+	}
+
+	return output
+}
+
+func expandAssetEvents(input []AssetEvent) *[]assets.Event {
+	output := make([]assets.Event, 0)
+	for _, v := range input {
+		event := assets.Event{
+			Name:              v.Name,
+			EventNotifier:     v.EventNotifier,
+			ObservabilityMode: pointer.ToEnum[assets.EventObservabilityMode](v.ObservabilityMode),
+			Topic:             expandAssetTopic(v.Topic),
+		}
+
+		if v.EventConfiguration != "" {
+			event.EventConfiguration = pointer.To(v.EventConfiguration)
+		}
+
+		output = append(output, event)
+	}
+
+	return &output
+}
+
+func flattenAssetEvents(input *[]assets.Event) []AssetEvent {
+	output := make([]AssetEvent, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, AssetEvent{
+			Name:               v.Name,
+			EventNotifier:      v.EventNotifier,
+			EventConfiguration: pointer.From(v.EventConfiguration),
+			ObservabilityMode:  string(pointer.From(v.ObservabilityMode)),
+			Topic:              flattenAssetTopic(v.Topic),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/assets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DeviceRegistryAssetResource struct{}
+
+func TestAccDeviceRegistryAsset_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset", "test")
+	r := DeviceRegistryAssetResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistryAsset_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset", "test")
+	r := DeviceRegistryAssetResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDeviceRegistryAsset_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_asset", "test")
+	r := DeviceRegistryAssetResource{}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DeviceRegistryAssetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := assets.ParseAssetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DeviceRegistry.V20251001.Assets.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DeviceRegistryAssetResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_CUSTOM_LOCATION_ID` was not specified")
+	}
+}
+
+func (r DeviceRegistryAssetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset" "test" {
+  name                       = "acctest-dra-%d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  custom_location_id         = azurerm_device_registry_asset_endpoint_profile.test.custom_location_id
+  asset_endpoint_profile_ref = azurerm_device_registry_asset_endpoint_profile.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DeviceRegistryAssetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset" "import" {
+  name                       = azurerm_device_registry_asset.test.name
+  resource_group_name        = azurerm_device_registry_asset.test.resource_group_name
+  location                   = azurerm_device_registry_asset.test.location
+  custom_location_id         = azurerm_device_registry_asset.test.custom_location_id
+  asset_endpoint_profile_ref = azurerm_device_registry_asset.test.asset_endpoint_profile_ref
+}
+`, r.basic(data))
+}
+
+func (r DeviceRegistryAssetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_asset" "test" {
+  name                       = "acctest-dra-%d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  custom_location_id         = azurerm_device_registry_asset_endpoint_profile.test.custom_location_id
+  asset_endpoint_profile_ref = azurerm_device_registry_asset_endpoint_profile.test.name
+  display_name               = "Oven"
+  description                = "A commercial oven"
+  enabled                    = false
+  manufacturer               = "Contoso"
+  manufacturer_uri           = "https://www.contoso.com"
+  model                      = "Oven-003"
+  product_code               = "12345C"
+  hardware_revision          = "2.3"
+  software_revision          = "14.1"
+  serial_number              = "12345"
+  documentation_uri          = "https://www.example.com/manual"
+
+  attributes = {
+    site = "building-1"
+  }
+
+  default_datasets_configuration = jsonencode({
+    publishingInterval = 1000
+    samplingInterval   = 500
+    queueSize          = 1
+  })
+
+  default_events_configuration = jsonencode({
+    publishingInterval = 1000
+    samplingInterval   = 500
+    queueSize          = 1
+  })
+
+  default_topic {
+    path   = "/path/defaultTopic"
+    retain = "Keep"
+  }
+
+  dataset {
+    name = "dataset1"
+
+    data_point {
+      name               = "temperature"
+      data_source        = "nsu=http://microsoft.com/Opc/OpcPlc/;s=FastUInt1"
+      observability_mode = "Counter"
+    }
+
+    topic {
+      path = "/path/dataset1"
+    }
+  }
+
+  event {
+    name               = "event1"
+    event_notifier     = "nsu=http://microsoft.com/Opc/OpcPlc/;s=Server"
+    observability_mode = "Log"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DeviceRegistryAssetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-deviceregistry-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_device_registry_asset_endpoint_profile" "test" {
+  name                  = "acctest-draep-%[1]d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  custom_location_id    = "%[3]s"
+  endpoint_profile_type = "Microsoft.OpcUa"
+  target_address        = "opc.tcp://opcplc-000000:50000"
+}
+`, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DeviceRegistryNamespaceModel struct {
+	Name              string                         `tfschema:"name"`
+	ResourceGroupName string                         `tfschema:"resource_group_name"`
+	Location          string                         `tfschema:"location"`
+	Identity          []identity.ModelSystemAssigned `tfschema:"identity"`
+	MessagingEndpoint []NamespaceMessagingEndpoint   `tfschema:"messaging_endpoint"`
+	Tags              map[string]string              `tfschema:"tags"`
+	Uuid              string                         `tfschema:"uuid"`
+}
+
+type NamespaceMessagingEndpoint struct {
+	Name         string `tfschema:"name"`
+	Address      string `tfschema:"address"`
+	EndpointType string `tfschema:"endpoint_type"`
+	ResourceId   string `tfschema:"resource_id"`
+}
+
+var _ sdk.ResourceWithUpdate = DeviceRegistryNamespaceResource{}
+
+type DeviceRegistryNamespaceResource struct{}
+
+func (r DeviceRegistryNamespaceResource) ModelObject() interface{} {
+	return &DeviceRegistryNamespaceModel{}
+}
+
+func (r DeviceRegistryNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return namespaces.ValidateNamespaceID
+}
+
+func (r DeviceRegistryNamespaceResource) ResourceType() string {
+	return "azurerm_device_registry_namespace"
+}
+
+func (r DeviceRegistryNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(3, 64),
+				validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`),
+					"`name` must start and end with a lowercase letter or number and can only contain lowercase letters, numbers and hyphens",
+				),
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedIdentityOptional(),
+
+		"messaging_endpoint": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"endpoint_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DeviceRegistryNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"uuid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DeviceRegistryNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Namespaces
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DeviceRegistryNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := namespaces.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			parameters := namespaces.Namespace{
+				Location: location.Normalize(model.Location),
+				Identity: identityValue,
+				Properties: &namespaces.NamespaceProperties{
+					Messaging: expandNamespaceMessaging(model.MessagingEndpoint),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistryNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Namespaces
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DeviceRegistryNamespaceModel{
+				Name:              id.NamespaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.MessagingEndpoint = flattenNamespaceMessaging(props.Messaging)
+					state.Uuid = pointer.From(props.Uuid)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DeviceRegistryNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Namespaces
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DeviceRegistryNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if payload.Properties == nil {
+				payload.Properties = &namespaces.NamespaceProperties{}
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("messaging_endpoint") {
+				payload.Properties.Messaging = expandNamespaceMessaging(model.MessagingEndpoint)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistryNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.Namespaces
+
+			id, err := namespaces.ParseNamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespaceMessaging(input []NamespaceMessagingEndpoint) *namespaces.Messaging {
+	endpoints := make(map[string]namespaces.MessagingEndpoint)
+	for _, v := range input {
+		endpoint := namespaces.MessagingEndpoint{
+			Address: v.Address,
+		}
+
+		if v.EndpointType != "" {
+			endpoint.EndpointType = pointer.To(v.EndpointType)
+		}
+
+		if v.ResourceId != "" {
+			endpoint.ResourceId = pointer.To(v.ResourceId)
+		}
+
+		endpoints[v.Name] = endpoint
+	}
+
+	return &namespaces.Messaging{
+		Endpoints: &endpoints,
+	}
+}
+
+func flattenNamespaceMessaging(input *namespaces.Messaging) []NamespaceMessagingEndpoint {
+	output := make([]NamespaceMessagingEndpoint, 0)
+	if input == nil || input.Endpoints == nil {
+		return output
+	}
+
+	for name, v := range *input.Endpoints {
+		output = append(output, NamespaceMessagingEndpoint{
+			Name:         name,
+			Address:      v.Address,
+			EndpointType: pointer.From(v.EndpointType),
+			ResourceId:   pointer.From(v.ResourceId),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DeviceRegistryNamespaceResource struct{}
+
+func TestAccDeviceRegistryNamespace_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_namespace", "test")
+	r := DeviceRegistryNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uuid").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistryNamespace_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_namespace", "test")
+	r := DeviceRegistryNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDeviceRegistryNamespace_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_namespace", "test")
+	r := DeviceRegistryNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistryNamespace_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_namespace", "test")
+	r := DeviceRegistryNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DeviceRegistryNamespaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := namespaces.ParseNamespaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DeviceRegistry.V20251001.Namespaces.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DeviceRegistryNamespaceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_namespace" "test" {
+  name                = "acctest-drns-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DeviceRegistryNamespaceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_namespace" "import" {
+  name                = azurerm_device_registry_namespace.test.name
+  resource_group_name = azurerm_device_registry_namespace.test.resource_group_name
+  location            = azurerm_device_registry_namespace.test.location
+}
+`, r.basic(data))
+}
+
+func (r DeviceRegistryNamespaceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_namespace" "test" {
+  name                = "acctest-drns-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  messaging_endpoint {
+    name          = "eventgrid"
+    address       = "https://acctest.eventgrid.azure.net"
+    endpoint_type = "Microsoft.EventGrid"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DeviceRegistryNamespaceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-deviceregistry-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
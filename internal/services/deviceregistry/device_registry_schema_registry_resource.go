@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/schemaregistries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DeviceRegistrySchemaRegistryModel struct {
+	Name                       string                         `tfschema:"name"`
+	ResourceGroupName          string                         `tfschema:"resource_group_name"`
+	Location                   string                         `tfschema:"location"`
+	Namespace                  string                         `tfschema:"namespace"`
+	StorageAccountContainerUrl string                         `tfschema:"storage_account_container_url"`
+	Description                string                         `tfschema:"description"`
+	DisplayName                string                         `tfschema:"display_name"`
+	Identity                   []identity.ModelSystemAssigned `tfschema:"identity"`
+	Tags                       map[string]string              `tfschema:"tags"`
+	Uuid                       string                         `tfschema:"uuid"`
+}
+
+var _ sdk.ResourceWithUpdate = DeviceRegistrySchemaRegistryResource{}
+
+type DeviceRegistrySchemaRegistryResource struct{}
+
+func (r DeviceRegistrySchemaRegistryResource) ModelObject() interface{} {
+	return &DeviceRegistrySchemaRegistryModel{}
+}
+
+func (r DeviceRegistrySchemaRegistryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schemaregistries.ValidateSchemaRegistryID
+}
+
+func (r DeviceRegistrySchemaRegistryResource) ResourceType() string {
+	return "azurerm_device_registry_schema_registry"
+}
+
+func (r DeviceRegistrySchemaRegistryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(3, 63),
+				validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`),
+					"`name` must start and end with a lowercase letter or number and can only contain lowercase letters, numbers and hyphens",
+				),
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"namespace": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(3, 32),
+				validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`),
+					"`namespace` must start and end with a lowercase letter or number and can only contain lowercase letters, numbers and hyphens",
+				),
+			),
+		},
+
+		"storage_account_container_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"identity": commonschema.SystemAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DeviceRegistrySchemaRegistryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"uuid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DeviceRegistrySchemaRegistryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.SchemaRegistries
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DeviceRegistrySchemaRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := schemaregistries.NewSchemaRegistryID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			parameters := schemaregistries.SchemaRegistry{
+				Location: location.Normalize(model.Location),
+				Identity: identityValue,
+				Properties: &schemaregistries.SchemaRegistryProperties{
+					Namespace:                  model.Namespace,
+					StorageAccountContainerURL: model.StorageAccountContainerUrl,
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.Description != "" {
+				parameters.Properties.Description = pointer.To(model.Description)
+			}
+
+			if model.DisplayName != "" {
+				parameters.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistrySchemaRegistryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.SchemaRegistries
+
+			id, err := schemaregistries.ParseSchemaRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DeviceRegistrySchemaRegistryModel{
+				Name:              id.SchemaRegistryName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.Namespace = props.Namespace
+					state.StorageAccountContainerUrl = props.StorageAccountContainerURL
+					state.Description = pointer.From(props.Description)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Uuid = pointer.From(props.Uuid)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DeviceRegistrySchemaRegistryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.SchemaRegistries
+
+			id, err := schemaregistries.ParseSchemaRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DeviceRegistrySchemaRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = nil
+				if model.Description != "" {
+					payload.Properties.Description = pointer.To(model.Description)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				payload.Properties.DisplayName = nil
+				if model.DisplayName != "" {
+					payload.Properties.DisplayName = pointer.To(model.DisplayName)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrReplaceThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DeviceRegistrySchemaRegistryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DeviceRegistry.V20251001.SchemaRegistries
+
+			id, err := schemaregistries.ParseSchemaRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package deviceregistry_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceregistry/2025-10-01/schemaregistries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DeviceRegistrySchemaRegistryResource struct{}
+
+func TestAccDeviceRegistrySchemaRegistry_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_schema_registry", "test")
+	r := DeviceRegistrySchemaRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDeviceRegistrySchemaRegistry_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_schema_registry", "test")
+	r := DeviceRegistrySchemaRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDeviceRegistrySchemaRegistry_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_device_registry_schema_registry", "test")
+	r := DeviceRegistrySchemaRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DeviceRegistrySchemaRegistryResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := schemaregistries.ParseSchemaRegistryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DeviceRegistry.V20251001.SchemaRegistries.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DeviceRegistrySchemaRegistryResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_device_registry_schema_registry" "test" {
+  name                          = "acctest-drsr-%[2]d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  namespace                     = "acctest-%[2]d"
+  storage_account_container_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DeviceRegistrySchemaRegistryResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_device_registry_schema_registry" "import" {
+  name                          = azurerm_device_registry_schema_registry.test.name
+  resource_group_name           = azurerm_device_registry_schema_registry.test.resource_group_name
+  location                      = azurerm_device_registry_schema_registry.test.location
+  namespace                     = azurerm_device_registry_schema_registry.test.namespace
+  storage_account_container_url = azurerm_device_registry_schema_registry.test.storage_account_container_url
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DeviceRegistrySchemaRegistryResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_device_registry_schema_registry" "test" {
+  name                          = "acctest-drsr-%[2]d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  namespace                     = "acctest-%[2]d"
+  storage_account_container_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  display_name                  = "Acceptance Test Schema Registry"
+  description                   = "Schema registry for acceptance testing"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DeviceRegistrySchemaRegistryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-deviceregistry-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "schemas"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
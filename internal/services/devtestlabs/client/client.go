@@ -6,25 +6,35 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/formulas"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/globalschedules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/labs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/policies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/schedules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualmachineschedules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualnetworks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	GlobalLabSchedulesClient *globalschedules.GlobalSchedulesClient
-	LabsClient               *labs.LabsClient
-	LabSchedulesClient       *schedules.SchedulesClient
-	PoliciesClient           *policies.PoliciesClient
-	VirtualMachinesClient    *virtualmachines.VirtualMachinesClient
-	VirtualNetworksClient    *virtualnetworks.VirtualNetworksClient
+	FormulasClient                *formulas.FormulasClient
+	GlobalLabSchedulesClient      *globalschedules.GlobalSchedulesClient
+	LabsClient                    *labs.LabsClient
+	LabSchedulesClient            *schedules.SchedulesClient
+	PoliciesClient                *policies.PoliciesClient
+	VirtualMachinesClient         *virtualmachines.VirtualMachinesClient
+	VirtualMachineSchedulesClient *virtualmachineschedules.VirtualMachineSchedulesClient
+	VirtualNetworksClient         *virtualnetworks.VirtualNetworksClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	formulasClient, err := formulas.NewFormulasClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Formulas client: %+v", err)
+	}
+	o.Configure(formulasClient.Client, o.Authorizers.ResourceManager)
+
 	globalLabSchedulesClient, err := globalschedules.NewGlobalSchedulesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building GlobalSchedules client: %+v", err)
@@ -55,6 +65,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(virtualMachinesClient.Client, o.Authorizers.ResourceManager)
 
+	virtualMachineSchedulesClient, err := virtualmachineschedules.NewVirtualMachineSchedulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building VirtualMachineSchedules client: %+v", err)
+	}
+	o.Configure(virtualMachineSchedulesClient.Client, o.Authorizers.ResourceManager)
+
 	virtualNetworksClient, err := virtualnetworks.NewVirtualNetworksClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building VirtualNetworks client: %+v", err)
@@ -62,11 +78,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(virtualNetworksClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		GlobalLabSchedulesClient: globalLabSchedulesClient,
-		LabsClient:               labsClient,
-		LabSchedulesClient:       labSchedulesClient,
-		PoliciesClient:           policiesClient,
-		VirtualMachinesClient:    virtualMachinesClient,
-		VirtualNetworksClient:    virtualNetworksClient,
+		FormulasClient:                formulasClient,
+		GlobalLabSchedulesClient:      globalLabSchedulesClient,
+		LabsClient:                    labsClient,
+		LabSchedulesClient:            labSchedulesClient,
+		PoliciesClient:                policiesClient,
+		VirtualMachinesClient:         virtualMachinesClient,
+		VirtualMachineSchedulesClient: virtualMachineSchedulesClient,
+		VirtualNetworksClient:         virtualNetworksClient,
 	}, nil
 }
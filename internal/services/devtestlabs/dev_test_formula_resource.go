@@ -0,0 +1,364 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devtestlabs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/formulas"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDevTestFormula() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDevTestFormulaCreateUpdate,
+		Read:   resourceDevTestFormulaRead,
+		Update: resourceDevTestFormulaCreateUpdate,
+		Delete: resourceDevTestFormulaDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := formulas.ParseFormulaID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"lab_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevTestLabName(),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/3964
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"location": commonschema.Location(),
+
+			"os_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+				}, false),
+			},
+
+			"size": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"username": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"storage_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Standard",
+					"Premium",
+				}, false),
+			},
+
+			"lab_subnet_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"lab_virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"gallery_image_reference": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"offer": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"publisher": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"sku": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"allow_claim": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"disallow_public_ip_address": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"notes": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			// since these aren't returned from the API
+			"password": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"ssh_key"},
+			},
+
+			"ssh_key": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"password"},
+			},
+
+			"tags": commonschema.Tags(),
+
+			"author": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"unique_identifier": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDevTestFormulaCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.FormulasClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := formulas.NewFormulaID(subscriptionId, d.Get("resource_group_name").(string), d.Get("lab_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id, formulas.GetOperationOptions{})
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_dev_test_formula", id.ID())
+		}
+	}
+
+	osType := d.Get("os_type").(string)
+	sshKey := d.Get("ssh_key").(string)
+
+	properties := formulas.LabVirtualMachineCreationParameterProperties{
+		AllowClaim:                 pointer.To(d.Get("allow_claim").(bool)),
+		DisallowPublicIPAddress:    pointer.To(d.Get("disallow_public_ip_address").(bool)),
+		GalleryImageReference:      expandDevTestFormulaGalleryImageReference(d.Get("gallery_image_reference").([]interface{}), osType),
+		IsAuthenticationWithSshKey: pointer.To(sshKey != ""),
+		LabSubnetName:              pointer.To(d.Get("lab_subnet_name").(string)),
+		LabVirtualNetworkId:        pointer.To(d.Get("lab_virtual_network_id").(string)),
+		Notes:                      pointer.To(d.Get("notes").(string)),
+		Size:                       pointer.To(d.Get("size").(string)),
+		StorageType:                pointer.To(d.Get("storage_type").(string)),
+		UserName:                   pointer.To(d.Get("username").(string)),
+	}
+
+	if v := d.Get("password").(string); v != "" {
+		properties.Password = pointer.To(v)
+	}
+
+	if sshKey != "" {
+		properties.SshKey = pointer.To(sshKey)
+	}
+
+	parameters := formulas.Formula{
+		Location: pointer.To(location.Normalize(d.Get("location").(string))),
+		Properties: formulas.FormulaProperties{
+			Description: pointer.To(d.Get("description").(string)),
+			FormulaContent: &formulas.LabVirtualMachineCreationParameter{
+				Properties: &properties,
+			},
+			OsType: pointer.To(osType),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDevTestFormulaRead(d, meta)
+}
+
+func resourceDevTestFormulaRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.FormulasClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := formulas.ParseFormulaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id, formulas.GetOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.FormulaName)
+	d.Set("lab_name", id.LabName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if loc := model.Location; loc != nil {
+			d.Set("location", location.Normalize(*loc))
+		}
+
+		props := model.Properties
+		d.Set("author", props.Author)
+		d.Set("description", props.Description)
+		d.Set("os_type", props.OsType)
+		d.Set("unique_identifier", props.UniqueIdentifier)
+
+		if content := props.FormulaContent; content != nil && content.Properties != nil {
+			vm := content.Properties
+			d.Set("allow_claim", pointer.From(vm.AllowClaim))
+			d.Set("disallow_public_ip_address", pointer.From(vm.DisallowPublicIPAddress))
+			// the network details aren't always returned from the API
+			if vm.LabSubnetName != nil {
+				d.Set("lab_subnet_name", vm.LabSubnetName)
+			}
+			if vm.LabVirtualNetworkId != nil {
+				d.Set("lab_virtual_network_id", vm.LabVirtualNetworkId)
+			}
+			d.Set("notes", vm.Notes)
+			d.Set("size", vm.Size)
+			d.Set("storage_type", vm.StorageType)
+			d.Set("username", vm.UserName)
+
+			if err := d.Set("gallery_image_reference", flattenDevTestFormulaGalleryImageReference(vm.GalleryImageReference)); err != nil {
+				return fmt.Errorf("setting `gallery_image_reference`: %+v", err)
+			}
+		}
+
+		if err = tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceDevTestFormulaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.FormulasClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := formulas.ParseFormulaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandDevTestFormulaGalleryImageReference(input []interface{}, osType string) *formulas.GalleryImageReference {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &formulas.GalleryImageReference{
+		Offer:     pointer.To(v["offer"].(string)),
+		OsType:    pointer.To(osType),
+		Publisher: pointer.To(v["publisher"].(string)),
+		Sku:       pointer.To(v["sku"].(string)),
+		Version:   pointer.To(v["version"].(string)),
+	}
+}
+
+func flattenDevTestFormulaGalleryImageReference(input *formulas.GalleryImageReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"offer":     pointer.From(input.Offer),
+			"publisher": pointer.From(input.Publisher),
+			"sku":       pointer.From(input.Sku),
+			"version":   pointer.From(input.Version),
+		},
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devtestlabs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/formulas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DevTestFormulaResource struct{}
+
+func TestAccDevTestFormula_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_formula", "test")
+	r := DevTestFormulaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// not returned from the API
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccDevTestFormula_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_formula", "test")
+	r := DevTestFormulaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevTestFormula_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_formula", "test")
+	r := DevTestFormulaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func (DevTestFormulaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := formulas.ParseFormulaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevTestLabs.FormulasClient.Get(ctx, *id, formulas.GetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DevTestFormulaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_formula" "test" {
+  name                   = "acctestdtf%d"
+  lab_name               = azurerm_dev_test_lab.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  os_type                = "Linux"
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "Pa$w0rd1234!"
+  lab_virtual_network_id = azurerm_dev_test_virtual_network.test.id
+  lab_subnet_name        = azurerm_dev_test_virtual_network.test.subnet[0].name
+  storage_type           = "Standard"
+
+  gallery_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, DevTestLinuxVirtualMachineResource{}.template(data), data.RandomInteger)
+}
+
+func (r DevTestFormulaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_formula" "import" {
+  name                   = azurerm_dev_test_formula.test.name
+  lab_name               = azurerm_dev_test_formula.test.lab_name
+  resource_group_name    = azurerm_dev_test_formula.test.resource_group_name
+  location               = azurerm_dev_test_formula.test.location
+  os_type                = azurerm_dev_test_formula.test.os_type
+  size                   = azurerm_dev_test_formula.test.size
+  username               = azurerm_dev_test_formula.test.username
+  password               = "Pa$w0rd1234!"
+  lab_virtual_network_id = azurerm_dev_test_formula.test.lab_virtual_network_id
+  lab_subnet_name        = azurerm_dev_test_formula.test.lab_subnet_name
+  storage_type           = azurerm_dev_test_formula.test.storage_type
+
+  gallery_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, r.basic(data))
+}
+
+func (DevTestFormulaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_formula" "test" {
+  name                       = "acctestdtf%d"
+  lab_name                   = azurerm_dev_test_lab.test.name
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  description                = "Ubuntu build agent"
+  os_type                    = "Linux"
+  size                       = "Standard_F4"
+  username                   = "acct5stU5er"
+  password                   = "Pa$w0rd1234!"
+  lab_virtual_network_id     = azurerm_dev_test_virtual_network.test.id
+  lab_subnet_name            = azurerm_dev_test_virtual_network.test.subnet[0].name
+  storage_type               = "Premium"
+  allow_claim                = false
+  disallow_public_ip_address = true
+  notes                      = "Created by Terraform"
+
+  gallery_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+
+  tags = {
+    Acceptance = "Test"
+  }
+}
+`, DevTestLinuxVirtualMachineResource{}.template(data), data.RandomInteger)
+}
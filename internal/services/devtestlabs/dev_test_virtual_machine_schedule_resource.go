@@ -0,0 +1,366 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devtestlabs
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualmachineschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+const (
+	devTestVirtualMachineScheduleTaskTypeShutdown = "LabVmsShutdownTask"
+	devTestVirtualMachineScheduleTaskTypeStartup  = "LabVmsStartupTask"
+)
+
+// the API only accepts a fixed schedule name for each task type on a Lab Virtual Machine
+var devTestVirtualMachineScheduleNames = map[string]string{
+	devTestVirtualMachineScheduleTaskTypeShutdown: "LabVmsShutdown",
+	devTestVirtualMachineScheduleTaskTypeStartup:  "LabVmAutoStart",
+}
+
+func resourceDevTestVirtualMachineSchedule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDevTestVirtualMachineScheduleCreateUpdate,
+		Read:   resourceDevTestVirtualMachineScheduleRead,
+		Update: resourceDevTestVirtualMachineScheduleCreateUpdate,
+		Delete: resourceDevTestVirtualMachineScheduleDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := virtualmachineschedules.ParseVirtualMachineScheduleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: virtualmachineschedules.ValidateVirtualMachineID,
+			},
+
+			"task_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					devTestVirtualMachineScheduleTaskTypeShutdown,
+					devTestVirtualMachineScheduleTaskTypeStartup,
+				}, false),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"daily_recurrence_time": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"daily_recurrence_time", "weekly_recurrence"},
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^(0[0-9]|1[0-9]|2[0-3]|[0-9])[0-5][0-9]$"),
+					"Time of day must match the format HHmm where HH is 00-23 and mm is 00-59",
+				),
+			},
+
+			"weekly_recurrence": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"daily_recurrence_time", "weekly_recurrence"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"time": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile("^(0[0-9]|1[0-9]|2[0-3]|[0-9])[0-5][0-9]$"),
+								"Time of day must match the format HHmm where HH is 00-23 and mm is 00-59",
+							),
+						},
+
+						"week_days": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Monday",
+									"Tuesday",
+									"Wednesday",
+									"Thursday",
+									"Friday",
+									"Saturday",
+									"Sunday",
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
+			"timezone": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: computeValidate.VirtualMachineTimeZoneCaseInsensitive(),
+			},
+
+			"notification_settings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+						"time_in_minutes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntBetween(15, 120),
+						},
+						"webhook_url": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"email": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceDevTestVirtualMachineScheduleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.VirtualMachineSchedulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vmId, err := virtualmachineschedules.ParseVirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	taskType := d.Get("task_type").(string)
+	id := virtualmachineschedules.NewVirtualMachineScheduleID(vmId.SubscriptionId, vmId.ResourceGroupName, vmId.LabName, vmId.VirtualMachineName, devTestVirtualMachineScheduleNames[taskType])
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id, virtualmachineschedules.GetOperationOptions{})
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_dev_test_virtual_machine_schedule", id.ID())
+		}
+	}
+
+	status := virtualmachineschedules.EnableStatusDisabled
+	if d.Get("enabled").(bool) {
+		status = virtualmachineschedules.EnableStatusEnabled
+	}
+
+	schedule := virtualmachineschedules.Schedule{
+		Location: pointer.To(location.Normalize(d.Get("location").(string))),
+		Properties: virtualmachineschedules.ScheduleProperties{
+			NotificationSettings: expandDevTestVirtualMachineScheduleNotificationSettings(d.Get("notification_settings").([]interface{})),
+			Status:               pointer.To(status),
+			TargetResourceId:     pointer.To(vmId.ID()),
+			TaskType:             pointer.To(taskType),
+			TimeZoneId:           pointer.To(d.Get("timezone").(string)),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("daily_recurrence_time"); ok {
+		schedule.Properties.DailyRecurrence = &virtualmachineschedules.DayDetails{
+			Time: pointer.To(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("weekly_recurrence"); ok {
+		schedule.Properties.WeeklyRecurrence = expandDevTestVirtualMachineScheduleRecurrenceWeekly(v.([]interface{}))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, schedule); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDevTestVirtualMachineScheduleRead(d, meta)
+}
+
+func resourceDevTestVirtualMachineScheduleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.VirtualMachineSchedulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := virtualmachineschedules.ParseVirtualMachineScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id, virtualmachineschedules.GetOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("virtual_machine_id", virtualmachineschedules.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroupName, id.LabName, id.VirtualMachineName).ID())
+
+	if model := resp.Model; model != nil {
+		if loc := model.Location; loc != nil {
+			d.Set("location", location.Normalize(*loc))
+		}
+
+		props := model.Properties
+		d.Set("task_type", props.TaskType)
+		d.Set("timezone", props.TimeZoneId)
+		d.Set("enabled", pointer.From(props.Status) == virtualmachineschedules.EnableStatusEnabled)
+
+		dailyRecurrenceTime := ""
+		if props.DailyRecurrence != nil {
+			dailyRecurrenceTime = pointer.From(props.DailyRecurrence.Time)
+		}
+		d.Set("daily_recurrence_time", dailyRecurrenceTime)
+
+		if err := d.Set("weekly_recurrence", flattenDevTestVirtualMachineScheduleRecurrenceWeekly(props.WeeklyRecurrence)); err != nil {
+			return fmt.Errorf("setting `weekly_recurrence`: %+v", err)
+		}
+
+		if err := d.Set("notification_settings", flattenDevTestVirtualMachineScheduleNotificationSettings(props.NotificationSettings)); err != nil {
+			return fmt.Errorf("setting `notification_settings`: %+v", err)
+		}
+
+		if err = tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceDevTestVirtualMachineScheduleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.VirtualMachineSchedulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := virtualmachineschedules.ParseVirtualMachineScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandDevTestVirtualMachineScheduleRecurrenceWeekly(input []interface{}) *virtualmachineschedules.WeekDetails {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	weekDays := make([]string, 0)
+	for _, day := range v["week_days"].([]interface{}) {
+		weekDays = append(weekDays, day.(string))
+	}
+
+	return &virtualmachineschedules.WeekDetails{
+		Time:     pointer.To(v["time"].(string)),
+		Weekdays: &weekDays,
+	}
+}
+
+func flattenDevTestVirtualMachineScheduleRecurrenceWeekly(input *virtualmachineschedules.WeekDetails) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"time":      pointer.From(input.Time),
+			"week_days": pointer.From(input.Weekdays),
+		},
+	}
+}
+
+func expandDevTestVirtualMachineScheduleNotificationSettings(input []interface{}) *virtualmachineschedules.NotificationSettings {
+	if len(input) == 0 || input[0] == nil {
+		return &virtualmachineschedules.NotificationSettings{
+			Status: pointer.To(virtualmachineschedules.EnableStatusDisabled),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	status := virtualmachineschedules.EnableStatusDisabled
+	if v["enabled"].(bool) {
+		status = virtualmachineschedules.EnableStatusEnabled
+	}
+
+	return &virtualmachineschedules.NotificationSettings{
+		EmailRecipient: pointer.To(v["email"].(string)),
+		Status:         pointer.To(status),
+		TimeInMinutes:  pointer.To(int64(v["time_in_minutes"].(int))),
+		WebhookURL:     pointer.To(v["webhook_url"].(string)),
+	}
+}
+
+func flattenDevTestVirtualMachineScheduleNotificationSettings(input *virtualmachineschedules.NotificationSettings) []interface{} {
+	// notifications which have been disabled and never configured are returned as an empty block
+	if input == nil || (pointer.From(input.Status) != virtualmachineschedules.EnableStatusEnabled && pointer.From(input.WebhookURL) == "" && pointer.From(input.EmailRecipient) == "") {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":         pointer.From(input.Status) == virtualmachineschedules.EnableStatusEnabled,
+			"email":           pointer.From(input.EmailRecipient),
+			"time_in_minutes": pointer.From(input.TimeInMinutes),
+			"webhook_url":     pointer.From(input.WebhookURL),
+		},
+	}
+}
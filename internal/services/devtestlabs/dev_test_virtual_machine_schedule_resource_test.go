@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devtestlabs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualmachineschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DevTestVirtualMachineScheduleResource struct{}
+
+func TestAccDevTestVirtualMachineSchedule_autoShutdown(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_virtual_machine_schedule", "test")
+	r := DevTestVirtualMachineScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoShutdown(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevTestVirtualMachineSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_virtual_machine_schedule", "test")
+	r := DevTestVirtualMachineScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoShutdown(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevTestVirtualMachineSchedule_autoShutdownNotification(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_virtual_machine_schedule", "test")
+	r := DevTestVirtualMachineScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoShutdown(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoShutdownNotification(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notification_settings.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoShutdown(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevTestVirtualMachineSchedule_autoStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_virtual_machine_schedule", "test")
+	r := DevTestVirtualMachineScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoStart(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DevTestVirtualMachineScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualmachineschedules.ParseVirtualMachineScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevTestLabs.VirtualMachineSchedulesClient.Get(ctx, *id, virtualmachineschedules.GetOperationOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DevTestVirtualMachineScheduleResource) autoShutdown(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_machine_schedule" "test" {
+  location              = azurerm_resource_group.test.location
+  virtual_machine_id    = azurerm_dev_test_linux_virtual_machine.test.id
+  task_type             = "LabVmsShutdownTask"
+  daily_recurrence_time = "1900"
+  timezone              = "Pacific Standard Time"
+}
+`, DevTestLinuxVirtualMachineResource{}.basic(data))
+}
+
+func (r DevTestVirtualMachineScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_machine_schedule" "import" {
+  location              = azurerm_dev_test_virtual_machine_schedule.test.location
+  virtual_machine_id    = azurerm_dev_test_virtual_machine_schedule.test.virtual_machine_id
+  task_type             = azurerm_dev_test_virtual_machine_schedule.test.task_type
+  daily_recurrence_time = azurerm_dev_test_virtual_machine_schedule.test.daily_recurrence_time
+  timezone              = azurerm_dev_test_virtual_machine_schedule.test.timezone
+}
+`, r.autoShutdown(data))
+}
+
+func (r DevTestVirtualMachineScheduleResource) autoShutdownNotification(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_machine_schedule" "test" {
+  location              = azurerm_resource_group.test.location
+  virtual_machine_id    = azurerm_dev_test_linux_virtual_machine.test.id
+  task_type             = "LabVmsShutdownTask"
+  daily_recurrence_time = "2100"
+  timezone              = "Central Standard Time"
+
+  notification_settings {
+    enabled         = true
+    time_in_minutes = 60
+    webhook_url     = "https://www.bing.com/2/4"
+    email           = "alerts@devtest.com"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, DevTestLinuxVirtualMachineResource{}.basic(data))
+}
+
+func (r DevTestVirtualMachineScheduleResource) autoStart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_machine_schedule" "test" {
+  location           = azurerm_resource_group.test.location
+  virtual_machine_id = azurerm_dev_test_linux_virtual_machine.test.id
+  task_type          = "LabVmsStartupTask"
+  timezone           = "Pacific Standard Time"
+
+  weekly_recurrence {
+    time      = "0800"
+    week_days = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+  }
+}
+`, DevTestLinuxVirtualMachineResource{}.basic(data))
+}
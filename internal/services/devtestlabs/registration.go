@@ -44,11 +44,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_dev_test_formula":                     resourceDevTestFormula(),
 		"azurerm_dev_test_global_vm_shutdown_schedule": resourceDevTestGlobalVMShutdownSchedule(),
 		"azurerm_dev_test_lab":                         resourceDevTestLab(),
 		"azurerm_dev_test_schedule":                    resourceDevTestLabSchedules(),
 		"azurerm_dev_test_linux_virtual_machine":       resourceArmDevTestLinuxVirtualMachine(),
 		"azurerm_dev_test_policy":                      resourceArmDevTestPolicy(),
+		"azurerm_dev_test_virtual_machine_schedule":    resourceDevTestVirtualMachineSchedule(),
 		"azurerm_dev_test_virtual_network":             resourceArmDevTestVirtualNetwork(),
 		"azurerm_dev_test_windows_virtual_machine":     resourceArmDevTestWindowsVirtualMachine(),
 	}
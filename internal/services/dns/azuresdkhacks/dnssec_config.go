@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not yet include the DNSSEC Configuration API, which is
+// only available from API Version `2023-07-01-preview`. Once the SDK supports a version of the DNS API which includes
+// DNSSEC Configurations, this can be removed.
+
+const dnssecConfigApiVersion = "2023-07-01-preview"
+
+// the DNSSEC Configuration of a DNS Zone is a singleton which is always named `default`
+const dnssecConfigName = "default"
+
+type DnssecConfigsClient struct {
+	client *resourcemanager.Client
+}
+
+func NewDnssecConfigsWorkaroundClient(client *zones.ZonesClient) DnssecConfigsClient {
+	return DnssecConfigsClient{
+		client: client.Client,
+	}
+}
+
+type DnssecConfig struct {
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *DnssecProperties `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
+
+type DnssecProperties struct {
+	ProvisioningState *string       `json:"provisioningState,omitempty"`
+	SigningKeys       *[]SigningKey `json:"signingKeys,omitempty"`
+}
+
+type SigningKey struct {
+	DelegationSignerInfo  *[]DelegationSignerInfo `json:"delegationSignerInfo,omitempty"`
+	Flags                 *int64                  `json:"flags,omitempty"`
+	KeyTag                *int64                  `json:"keyTag,omitempty"`
+	Protocol              *int64                  `json:"protocol,omitempty"`
+	PublicKey             *string                 `json:"publicKey,omitempty"`
+	SecurityAlgorithmType *int64                  `json:"securityAlgorithmType,omitempty"`
+}
+
+type DelegationSignerInfo struct {
+	DigestAlgorithmType *int64  `json:"digestAlgorithmType,omitempty"`
+	DigestValue         *string `json:"digestValue,omitempty"`
+	Record              *string `json:"record,omitempty"`
+}
+
+type DnssecConfigGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *DnssecConfig
+}
+
+type DnssecConfigOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type dnssecConfigOperationOptions struct{}
+
+func (o dnssecConfigOperationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o dnssecConfigOperationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o dnssecConfigOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", dnssecConfigApiVersion)
+	return &out
+}
+
+func dnssecConfigPath(id zones.DnsZoneId) string {
+	return fmt.Sprintf("%s/dnssecConfigs/%s", id.ID(), dnssecConfigName)
+}
+
+func (c DnssecConfigsClient) Get(ctx context.Context, id zones.DnsZoneId) (result DnssecConfigGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: dnssecConfigOperationOptions{},
+		Path:          dnssecConfigPath(id),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model DnssecConfig
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c DnssecConfigsClient) CreateOrUpdate(ctx context.Context, id zones.DnsZoneId) (result DnssecConfigOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: dnssecConfigOperationOptions{},
+		Path:          dnssecConfigPath(id),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	// the DNSSEC Configuration has no configurable properties, so an empty payload is sent
+	if err = req.Marshal(DnssecConfig{}); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c DnssecConfigsClient) CreateOrUpdateThenPoll(ctx context.Context, id zones.DnsZoneId) error {
+	result, err := c.CreateOrUpdate(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+func (c DnssecConfigsClient) Delete(ctx context.Context, id zones.DnsZoneId) (result DnssecConfigOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		OptionsObject: dnssecConfigOperationOptions{},
+		Path:          dnssecConfigPath(id),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c DnssecConfigsClient) DeleteThenPoll(ctx context.Context, id zones.DnsZoneId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -132,12 +133,74 @@ func (DnsZoneResource) Arguments() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"dnssec_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
 
 func (DnsZoneResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
+		"dnssec_signing_key": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"delegation_signer_info": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"digest_algorithm_type": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"digest_value": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"record": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+
+					"flags": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"key_tag": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"public_key": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"security_algorithm_type": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+
 		"number_of_record_sets": {
 			Type:     pluginsdk.TypeInt,
 			Computed: true,
@@ -164,9 +227,26 @@ type DnsZoneResourceModel struct {
 	MaxNumberOfRecordSets int64                            `tfschema:"max_number_of_record_sets"`
 	NameServers           []string                         `tfschema:"name_servers"`
 	SoaRecord             []DnsZoneSoaRecordResourceRecord `tfschema:"soa_record"`
+	DnssecEnabled         bool                             `tfschema:"dnssec_enabled"`
+	DnssecSigningKey      []DnsZoneDnssecSigningKey        `tfschema:"dnssec_signing_key"`
 	Tags                  map[string]string                `tfschema:"tags"`
 }
 
+type DnsZoneDnssecSigningKey struct {
+	DelegationSignerInfo  []DnsZoneDnssecDelegationSignerInfo `tfschema:"delegation_signer_info"`
+	Flags                 int64                               `tfschema:"flags"`
+	KeyTag                int64                               `tfschema:"key_tag"`
+	Protocol              int64                               `tfschema:"protocol"`
+	PublicKey             string                              `tfschema:"public_key"`
+	SecurityAlgorithmType int64                               `tfschema:"security_algorithm_type"`
+}
+
+type DnsZoneDnssecDelegationSignerInfo struct {
+	DigestAlgorithmType int64  `tfschema:"digest_algorithm_type"`
+	DigestValue         string `tfschema:"digest_value"`
+	Record              string `tfschema:"record"`
+}
+
 type DnsZoneSoaRecordResourceRecord struct {
 	Email        string            `tfschema:"email"`
 	ExpireTime   int64             `tfschema:"expire_time"`
@@ -248,6 +328,13 @@ func (r DnsZoneResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if model.DnssecEnabled {
+				dnssecClient := azuresdkhacks.NewDnssecConfigsWorkaroundClient(client)
+				if err := dnssecClient.CreateOrUpdateThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("enabling DNSSEC for %s: %+v", id, err)
+				}
+			}
+
 			metadata.SetID(id)
 
 			return nil
@@ -285,6 +372,17 @@ func (DnsZoneResource) Read() sdk.ResourceFunc {
 
 			state.SoaRecord = flattenDNSZoneSOARecord(soaRecordResp.Model)
 
+			dnssecResp, err := azuresdkhacks.NewDnssecConfigsWorkaroundClient(zonesClient).Get(ctx, *id)
+			if err != nil {
+				if !response.WasNotFound(dnssecResp.HttpResponse) {
+					return fmt.Errorf("retrieving DNSSEC Configuration for %s: %+v", *id, err)
+				}
+			}
+			if !response.WasNotFound(dnssecResp.HttpResponse) {
+				state.DnssecEnabled = true
+				state.DnssecSigningKey = flattenDNSZoneDnssecSigningKeys(dnssecResp.Model)
+			}
+
 			state.Name = id.DnsZoneName
 			state.ResourceGroupName = id.ResourceGroupName
 
@@ -379,6 +477,19 @@ func (r DnsZoneResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("dnssec_enabled") {
+				dnssecClient := azuresdkhacks.NewDnssecConfigsWorkaroundClient(client)
+				if model.DnssecEnabled {
+					if err := dnssecClient.CreateOrUpdateThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("enabling DNSSEC for %s: %+v", *id, err)
+					}
+				} else {
+					if err := dnssecClient.DeleteThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("disabling DNSSEC for %s: %+v", *id, err)
+					}
+				}
+			}
+
 			return nil
 		},
 	}
@@ -395,6 +506,13 @@ func (r DnsZoneResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
+			// a DNS Zone which is signed with DNSSEC cannot be deleted, so DNSSEC needs to be disabled first
+			if metadata.ResourceData.Get("dnssec_enabled").(bool) {
+				if err := azuresdkhacks.NewDnssecConfigsWorkaroundClient(client).DeleteThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("disabling DNSSEC for %s: %+v", *id, err)
+				}
+			}
+
 			if err := client.DeleteThenPoll(ctx, *id, zones.DefaultDeleteOperationOptions()); err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
@@ -443,3 +561,34 @@ func flattenDNSZoneSOARecord(input *recordsets.RecordSet) []DnsZoneSoaRecordReso
 
 	return output
 }
+
+func flattenDNSZoneDnssecSigningKeys(input *azuresdkhacks.DnssecConfig) []DnsZoneDnssecSigningKey {
+	output := make([]DnsZoneDnssecSigningKey, 0)
+	if input == nil || input.Properties == nil || input.Properties.SigningKeys == nil {
+		return output
+	}
+
+	for _, key := range *input.Properties.SigningKeys {
+		delegationSignerInfo := make([]DnsZoneDnssecDelegationSignerInfo, 0)
+		if key.DelegationSignerInfo != nil {
+			for _, info := range *key.DelegationSignerInfo {
+				delegationSignerInfo = append(delegationSignerInfo, DnsZoneDnssecDelegationSignerInfo{
+					DigestAlgorithmType: pointer.From(info.DigestAlgorithmType),
+					DigestValue:         pointer.From(info.DigestValue),
+					Record:              pointer.From(info.Record),
+				})
+			}
+		}
+
+		output = append(output, DnsZoneDnssecSigningKey{
+			DelegationSignerInfo:  delegationSignerInfo,
+			Flags:                 pointer.From(key.Flags),
+			KeyTag:                pointer.From(key.KeyTag),
+			Protocol:              pointer.From(key.Protocol),
+			PublicKey:             pointer.From(key.PublicKey),
+			SecurityAlgorithmType: pointer.From(key.SecurityAlgorithmType),
+		})
+	}
+
+	return output
+}
@@ -140,6 +140,45 @@ func TestAccDnsZone_updateSOARecord(t *testing.T) {
 	})
 }
 
+func TestAccDnsZone_dnssec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone", "test")
+	r := DnsZoneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dnssec(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dnssec_signing_key.#").HasValue("1"),
+				check.That(data.ResourceName).Key("dnssec_signing_key.0.delegation_signer_info.0.record").IsSet(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dnssec(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dnssec_signing_key.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dnssec(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (DnsZoneResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := zones.ParseDnsZoneID(state.ID)
 	if err != nil {
@@ -172,6 +211,25 @@ resource "azurerm_dns_zone" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (DnsZoneResource) dnssec(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+  dnssec_enabled      = %t
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, enabled)
+}
+
 func (DnsZoneResource) requiresImport(data acceptance.TestData) string {
 	template := DnsZoneResource{}.basic(data)
 	return fmt.Sprintf(`
@@ -30,6 +30,10 @@ func expandEventSubscriptionDestination(d *pluginsdk.ResourceData) eventsubscrip
 		return expandEventSubscriptionDestinationHybridConnection(hybridConnectionEndpointId.(string), deliveryMappings)
 	}
 
+	if val, ok := d.GetOk("namespace_topic_endpoint_id"); ok {
+		return expandEventSubscriptionDestinationNamespaceTopic(val.(string))
+	}
+
 	if val, ok := d.GetOk("service_bus_queue_endpoint_id"); ok {
 		return expandEventSubscriptionDestinationServiceBusQueueEndpoint(val.(string), deliveryMappings)
 	}
@@ -173,6 +177,22 @@ func flattenEventSubscriptionDestinationServiceBusQueueEndpoint(input eventsubsc
 	return ""
 }
 
+func expandEventSubscriptionDestinationNamespaceTopic(namespaceTopicEndpointId string) eventsubscriptions.EventSubscriptionDestination {
+	return eventsubscriptions.NamespaceTopicEventSubscriptionDestination{
+		Properties: &eventsubscriptions.NamespaceTopicEventSubscriptionDestinationProperties{
+			ResourceId: pointer.To(namespaceTopicEndpointId),
+		},
+	}
+}
+
+func flattenEventSubscriptionDestinationNamespaceTopic(input eventsubscriptions.EventSubscriptionDestination) string {
+	if val, ok := input.(eventsubscriptions.NamespaceTopicEventSubscriptionDestination); ok && val.Properties != nil && val.Properties.ResourceId != nil {
+		return *val.Properties.ResourceId
+	}
+
+	return ""
+}
+
 func expandEventSubscriptionDestinationServiceBusTopicEndpoint(serviceBusTopicEndpointId string, deliveryMappings []eventsubscriptions.DeliveryAttributeMapping) eventsubscriptions.EventSubscriptionDestination {
 	return eventsubscriptions.ServiceBusTopicEventSubscriptionDestination{
 		Properties: &eventsubscriptions.ServiceBusTopicEventSubscriptionDestinationProperties{
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/namespacetopics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	serviceBusQueues "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/queues"
@@ -29,6 +30,8 @@ const (
 	EventHubEndpointID EventSubscriptionEndpointType = "eventhub_endpoint_id"
 	// HybridConnectionEndpointID ...
 	HybridConnectionEndpointID EventSubscriptionEndpointType = "hybrid_connection_endpoint_id"
+	// NamespaceTopicEndpointID ...
+	NamespaceTopicEndpointID EventSubscriptionEndpointType = "namespace_topic_endpoint_id"
 	// ServiceBusQueueEndpointID ...
 	ServiceBusQueueEndpointID EventSubscriptionEndpointType = "service_bus_queue_endpoint_id"
 	// ServiceBusTopicEndpointID ...
@@ -159,6 +162,15 @@ func eventSubscriptionSchemaHybridConnectionEndpointID(conflictsWith []string) *
 	}
 }
 
+func eventSubscriptionSchemaNamespaceTopicEndpointID(conflictsWith []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeString,
+		Optional:      true,
+		ConflictsWith: conflictsWith,
+		ValidateFunc:  namespacetopics.ValidateNamespaceTopicID,
+	}
+}
+
 func eventSubscriptionSchemaServiceBusQueueEndpointID(conflictsWith []string) *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:          pluginsdk.TypeString,
@@ -27,6 +27,7 @@ func possibleEventSubscriptionEndpointTypes() []string {
 		string(AzureFunctionEndpoint),
 		string(EventHubEndpointID),
 		string(HybridConnectionEndpointID),
+		string(NamespaceTopicEndpointID),
 		string(ServiceBusQueueEndpointID),
 		string(ServiceBusTopicEndpointID),
 		string(StorageQueueEndpoint),
@@ -109,6 +110,13 @@ func resourceEventGridEventSubscription() *pluginsdk.Resource {
 				),
 			),
 
+			"namespace_topic_endpoint_id": eventSubscriptionSchemaNamespaceTopicEndpointID(
+				utils.RemoveFromStringArray(
+					possibleEventSubscriptionEndpointTypes(),
+					string(NamespaceTopicEndpointID),
+				),
+			),
+
 			// TODO: this can become `service_bus_queue_id` in 4.0
 			"service_bus_queue_endpoint_id": eventSubscriptionSchemaServiceBusQueueEndpointID(
 				utils.RemoveFromStringArray(
@@ -192,6 +200,10 @@ func resourceEventGridEventSubscriptionCreateUpdate(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("one of the following endpoint types must be specificed to create an EventGrid Event Subscription: %q", possibleEventSubscriptionEndpointTypes())
 	}
 
+	if _, ok := destination.(eventsubscriptions.NamespaceTopicEventSubscriptionDestination); ok && d.Get("event_delivery_schema").(string) != string(eventsubscriptions.EventDeliverySchemaCloudEventSchemaVOneZero) {
+		return fmt.Errorf("`event_delivery_schema` must be set to `%s` when `namespace_topic_endpoint_id` is specified", eventsubscriptions.EventDeliverySchemaCloudEventSchemaVOneZero)
+	}
+
 	filter, err := expandEventSubscriptionFilter(d)
 	if err != nil {
 		return fmt.Errorf("expanding filters for %s: %+v", id, err)
@@ -324,6 +336,7 @@ func resourceEventGridEventSubscriptionRead(d *pluginsdk.ResourceData, meta inte
 
 			d.Set("eventhub_endpoint_id", flattenEventSubscriptionDestinationEventHub(destination))
 			d.Set("hybrid_connection_endpoint_id", flattenEventSubscriptionDestinationHybridConnection(destination))
+			d.Set("namespace_topic_endpoint_id", flattenEventSubscriptionDestinationNamespaceTopic(destination))
 			d.Set("service_bus_queue_endpoint_id", flattenEventSubscriptionDestinationServiceBusQueueEndpoint(destination))
 			d.Set("service_bus_topic_endpoint_id", flattenEventSubscriptionDestinationServiceBusTopicEndpoint(destination))
 			if err := d.Set("storage_queue_endpoint", flattenEventSubscriptionDestinationStorageQueueEndpoint(destination)); err != nil {
@@ -119,6 +119,22 @@ func TestAccEventGridEventSubscription_serviceBusTopicID(t *testing.T) {
 	})
 }
 
+func TestAccEventGridEventSubscription_namespaceTopicID(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_event_subscription", "test")
+	r := EventGridEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.namespaceTopicID(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("namespace_topic_endpoint_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccEventGridEventSubscription_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_eventgrid_event_subscription", "test")
 	r := EventGridEventSubscriptionResource{}
@@ -737,6 +753,62 @@ resource "azurerm_eventgrid_event_subscription" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (EventGridEventSubscriptionResource) namespaceTopicID(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventgrid_topic" "test" {
+  name                = "acctesteg-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  input_schema        = "CloudEventSchemaV1_0"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_eventgrid_namespace" "test" {
+  name                = "acctest-egn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_eventgrid_namespace_topic" "test" {
+  name                   = "acctest-egnt-%[1]d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventgrid_namespace_topic.test.id
+  role_definition_name = "EventGrid Data Sender"
+  principal_id         = azurerm_eventgrid_topic.test.identity.0.principal_id
+}
+
+resource "azurerm_eventgrid_event_subscription" "test" {
+  name                        = "acctesteg-%[1]d"
+  scope                       = azurerm_eventgrid_topic.test.id
+  event_delivery_schema       = "CloudEventSchemaV1_0"
+  namespace_topic_endpoint_id = azurerm_eventgrid_namespace_topic.test.id
+
+  delivery_identity {
+    type = "SystemAssigned"
+  }
+
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (EventGridEventSubscriptionResource) filter(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
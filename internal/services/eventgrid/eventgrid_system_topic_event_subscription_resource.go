@@ -27,6 +27,7 @@ func possibleSystemTopicEventSubscriptionEndpointTypes() []string {
 		string(AzureFunctionEndpoint),
 		string(EventHubEndpointID),
 		string(HybridConnectionEndpointID),
+		string(NamespaceTopicEndpointID),
 		string(ServiceBusQueueEndpointID),
 		string(ServiceBusTopicEndpointID),
 		string(StorageQueueEndpoint),
@@ -93,6 +94,13 @@ func resourceEventGridSystemTopicEventSubscription() *pluginsdk.Resource {
 				),
 			),
 
+			"namespace_topic_endpoint_id": eventSubscriptionSchemaNamespaceTopicEndpointID(
+				utils.RemoveFromStringArray(
+					possibleSystemTopicEventSubscriptionEndpointTypes(),
+					string(NamespaceTopicEndpointID),
+				),
+			),
+
 			"service_bus_queue_endpoint_id": eventSubscriptionSchemaServiceBusQueueEndpointID(
 				utils.RemoveFromStringArray(
 					possibleSystemTopicEventSubscriptionEndpointTypes(),
@@ -175,6 +183,10 @@ func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *pluginsdk.Reso
 		return fmt.Errorf("one of the following endpoint types must be specificed to create an EventGrid System Topic Event Subscription: %q", possibleSystemTopicEventSubscriptionEndpointTypes())
 	}
 
+	if _, ok := destination.(eventsubscriptions.NamespaceTopicEventSubscriptionDestination); ok && d.Get("event_delivery_schema").(string) != string(eventsubscriptions.EventDeliverySchemaCloudEventSchemaVOneZero) {
+		return fmt.Errorf("`event_delivery_schema` must be set to `%s` when `namespace_topic_endpoint_id` is specified", eventsubscriptions.EventDeliverySchemaCloudEventSchemaVOneZero)
+	}
+
 	filter, err := expandEventSubscriptionFilter(d)
 	if err != nil {
 		return fmt.Errorf("expanding `filters`: %+v", err)
@@ -303,6 +315,7 @@ func resourceEventGridSystemTopicEventSubscriptionRead(d *pluginsdk.ResourceData
 
 			d.Set("eventhub_endpoint_id", flattenEventSubscriptionDestinationEventHub(destination))
 			d.Set("hybrid_connection_endpoint_id", flattenEventSubscriptionDestinationHybridConnection(destination))
+			d.Set("namespace_topic_endpoint_id", flattenEventSubscriptionDestinationNamespaceTopic(destination))
 			d.Set("service_bus_queue_endpoint_id", flattenEventSubscriptionDestinationServiceBusQueueEndpoint(destination))
 			d.Set("service_bus_topic_endpoint_id", flattenEventSubscriptionDestinationServiceBusTopicEndpoint(destination))
 			if err := d.Set("storage_queue_endpoint", flattenEventSubscriptionDestinationStorageQueueEndpoint(destination)); err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/keyrotation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
@@ -68,6 +69,10 @@ func resourceEventHubAuthorizationRule() *pluginsdk.Resource {
 			},
 
 			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"rotation_trigger": keyrotation.TriggerSchema(),
+
+			"regenerate_key": keyrotation.KeyTypeSchema(),
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventHubAuthorizationRuleCustomizeDiff),
@@ -110,12 +115,25 @@ func resourceEventHubAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, me
 		},
 	}
 
-	return pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
-		localId := authorizationruleseventhubs.NewEventhubAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
-		if _, err := authorizationRulesClient.EventHubsCreateOrUpdateAuthorizationRule(ctx, localId, parameters); err != nil {
-			return pluginsdk.NonRetryableError(fmt.Errorf("creating %s: %+v", id, err))
+	localId := authorizationruleseventhubs.NewEventhubAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
+	if _, err := authorizationRulesClient.EventHubsCreateOrUpdateAuthorizationRule(ctx, localId, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	// the key is regenerated once outside of the retry below, since regenerating it on every attempt would rotate it more than once
+	if err := keyrotation.RegenerateIfTriggered(d, func(keyType string) error {
+		payload := authorizationruleseventhubs.RegenerateAccessKeyParameters{
+			KeyType: authorizationruleseventhubs.KeyType(keyType),
 		}
+		if _, err := authorizationRulesClient.EventHubsRegenerateKeys(ctx, localId, payload); err != nil {
+			return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
 
+	err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
 		read, err := eventhubsClient.GetAuthorizationRule(ctx, id)
 		if err != nil {
 			if response.WasNotFound(read.HttpResponse) {
@@ -124,14 +142,15 @@ func resourceEventHubAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, me
 			return pluginsdk.NonRetryableError(fmt.Errorf("expected %s was not found", id))
 		}
 
-		d.SetId(id.ID())
-
-		if err := resourceEventHubAuthorizationRuleRead(d, meta); err != nil {
-			return pluginsdk.NonRetryableError(err)
-		}
-
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceEventHubAuthorizationRuleRead(d, meta)
 }
 
 func resourceEventHubAuthorizationRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
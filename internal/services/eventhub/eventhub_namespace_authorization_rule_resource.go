@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationrulesnamespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/keyrotation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
@@ -61,6 +62,10 @@ func resourceEventHubNamespaceAuthorizationRule() *pluginsdk.Resource {
 			},
 
 			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"rotation_trigger": keyrotation.TriggerSchema(),
+
+			"regenerate_key": keyrotation.KeyTypeSchema(),
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventHubAuthorizationRuleCustomizeDiff),
@@ -103,6 +108,18 @@ func resourceEventHubNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.Resourc
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	if err := keyrotation.RegenerateIfTriggered(d, func(keyType string) error {
+		payload := authorizationrulesnamespaces.RegenerateAccessKeyParameters{
+			KeyType: authorizationrulesnamespaces.KeyType(keyType),
+		}
+		if _, err := client.NamespacesRegenerateKeys(ctx, id, payload); err != nil {
+			return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	d.SetId(id.ID())
 	return resourceEventHubNamespaceAuthorizationRuleRead(d, meta)
 }
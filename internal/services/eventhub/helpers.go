@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/keyrotation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -74,5 +75,5 @@ func eventHubAuthorizationRuleCustomizeDiff(_ context.Context, d *pluginsdk.Reso
 		return errors.New("if `manage` is set both `listen` and `send` must be set to true too")
 	}
 
-	return nil
+	return keyrotation.SetNewComputedIfTriggered(d, "key", "connection_string", "connection_string_alias")
 }
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicies"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type FirewallPolicyDeployAction struct {
+	sdk.ActionMetadata
+}
+
+type FirewallPolicyDeployActionModel struct {
+	FirewallPolicyId types.String `tfsdk:"firewall_policy_id"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+var _ sdk.Action = &FirewallPolicyDeployAction{}
+
+func newFirewallPolicyDeployAction() action.Action {
+	return &FirewallPolicyDeployAction{}
+}
+
+func (a *FirewallPolicyDeployAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"firewall_policy_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: firewallpolicies.ValidateFirewallPolicyID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the Firewall Policy Deploy action to complete. Defaults to 60m.",
+				MarkdownDescription: "Timeout duration for the Firewall Policy Deploy action to complete. Defaults to 60m.",
+			},
+		},
+	}
+}
+
+func (a *FirewallPolicyDeployAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_firewall_policy_deploy"
+}
+
+func (a *FirewallPolicyDeployAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Network.FirewallPolicies
+
+	model := FirewallPolicyDeployActionModel{}
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		timeout, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		ctxTimeout = timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := firewallpolicies.ParseFirewallPolicyID(model.FirewallPolicyId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "id parsing error", err)
+		return
+	}
+
+	locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deploying the Drafts of %s", id),
+	})
+
+	if err := client.FirewallPolicyDeploymentsDeployThenPoll(ctx, *id); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("deploying the Drafts of %s", id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployed the Drafts of %s", id),
+	})
+}
+
+func (a *FirewallPolicyDeployAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
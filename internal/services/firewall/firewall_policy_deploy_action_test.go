@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type FirewallPolicyDeployAction struct{}
+
+func TestAccFirewallPolicyDeployAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_deploy", "test")
+	a := FirewallPolicyDeployAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - terraform-plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *FirewallPolicyDeployAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-networkfw-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                     = "acctest-networkfw-Policy-%[1]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  threat_intelligence_mode = "Alert"
+
+  lifecycle {
+    ignore_changes = [threat_intelligence_mode]
+  }
+}
+
+resource "azurerm_firewall_policy_draft" "test" {
+  firewall_policy_id       = azurerm_firewall_policy.test.id
+  threat_intelligence_mode = "Deny"
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_firewall_policy_draft.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_firewall_policy_deploy.test]
+    }
+  }
+}
+
+action "azurerm_firewall_policy_deploy" "test" {
+  config {
+    firewall_policy_id = azurerm_firewall_policy.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Draft of a Firewall Policy is a singleton which is always named `default`
+const firewallPolicyDraftName = "default"
+
+func resourceFirewallPolicyDraft() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyDraftCreateUpdate,
+		Read:   resourceFirewallPolicyDraftRead,
+		Update: resourceFirewallPolicyDraftCreateUpdate,
+		Delete: resourceFirewallPolicyDraftDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyDraftID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceFirewallPolicyDraftSchema(),
+	}
+}
+
+func resourceFirewallPolicyDraftCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicies
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	policyId, err := firewallpolicies.ParseFirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyDraftID(policyId.SubscriptionId, policyId.ResourceGroupName, policyId.FirewallPolicyName, firewallPolicyDraftName)
+
+	locks.ByName(policyId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	defer locks.UnlockByName(policyId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+	if d.IsNewResource() {
+		existing, err := client.FirewallPolicyDraftsGet(ctx, *policyId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_firewall_policy_draft", id.ID())
+		}
+	}
+
+	// the Draft must be in the same location as the Firewall Policy
+	policy, err := client.Get(ctx, *policyId, firewallpolicies.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", policyId, err)
+	}
+	if policy.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", policyId)
+	}
+
+	payload := firewallpolicies.FirewallPolicyDraft{
+		Location: policy.Model.Location,
+		Properties: &firewallpolicies.FirewallPolicyDraftProperties{
+			ThreatIntelMode:      pointer.To(firewallpolicies.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string))),
+			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
+			DnsSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+			Insights:             expandFirewallPolicyInsights(d.Get("insights").([]interface{})),
+			ExplicitProxy:        expandFirewallPolicyExplicitProxy(d.Get("explicit_proxy").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("base_policy_id"); ok {
+		payload.Properties.BasePolicy = &firewallpolicies.SubResource{Id: pointer.To(v.(string))}
+	}
+
+	if v, ok := d.GetOk("sql_redirect_allowed"); ok {
+		payload.Properties.Sql = &firewallpolicies.FirewallPolicySQL{
+			AllowSqlRedirect: pointer.To(v.(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("private_ip_ranges"); ok {
+		payload.Properties.Snat = &firewallpolicies.FirewallPolicySNAT{
+			PrivateRanges: utils.ExpandStringSlice(v.([]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("auto_learn_private_ranges_enabled"); ok {
+		if payload.Properties.Snat == nil {
+			payload.Properties.Snat = &firewallpolicies.FirewallPolicySNAT{}
+		}
+		if v.(bool) {
+			payload.Properties.Snat.AutoLearnPrivateRanges = pointer.To(firewallpolicies.AutoLearnPrivateRangesModeEnabled)
+		}
+	}
+
+	if _, err := client.FirewallPolicyDraftsCreateOrUpdate(ctx, *policyId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyDraftRead(d, meta)
+}
+
+func resourceFirewallPolicyDraftRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicies
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyId := firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)
+
+	resp, err := client.FirewallPolicyDraftsGet(ctx, policyId)
+	if err != nil {
+		// the Draft is removed once it has been deployed
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("firewall_policy_id", policyId.ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			basePolicyId := ""
+			if props.BasePolicy != nil && props.BasePolicy.Id != nil {
+				basePolicyId = *props.BasePolicy.Id
+			}
+			d.Set("base_policy_id", basePolicyId)
+
+			d.Set("threat_intelligence_mode", string(pointer.From(props.ThreatIntelMode)))
+
+			if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(props.ThreatIntelWhitelist)); err != nil {
+				return fmt.Errorf("setting `threat_intelligence_allowlist`: %+v", err)
+			}
+
+			if err := d.Set("dns", flattenFirewallPolicyDNSSetting(props.DnsSettings)); err != nil {
+				return fmt.Errorf("setting `dns`: %+v", err)
+			}
+
+			if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(props.IntrusionDetection)); err != nil {
+				return fmt.Errorf("setting `intrusion_detection`: %+v", err)
+			}
+
+			if err := d.Set("insights", flattenFirewallPolicyInsights(props.Insights)); err != nil {
+				return fmt.Errorf("setting `insights`: %+v", err)
+			}
+
+			if err := d.Set("explicit_proxy", flattenFirewallPolicyExplicitProxy(props.ExplicitProxy)); err != nil {
+				return fmt.Errorf("setting `explicit_proxy`: %+v", err)
+			}
+
+			var privateIPRanges []interface{}
+			var isAutoLearnPrivateRangeEnabled bool
+			if props.Snat != nil {
+				privateIPRanges = utils.FlattenStringSlice(props.Snat.PrivateRanges)
+				isAutoLearnPrivateRangeEnabled = pointer.From(props.Snat.AutoLearnPrivateRanges) == firewallpolicies.AutoLearnPrivateRangesModeEnabled
+			}
+			if err := d.Set("private_ip_ranges", privateIPRanges); err != nil {
+				return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
+			}
+			d.Set("auto_learn_private_ranges_enabled", isAutoLearnPrivateRangeEnabled)
+
+			if props.Sql != nil && props.Sql.AllowSqlRedirect != nil {
+				d.Set("sql_redirect_allowed", *props.Sql.AllowSqlRedirect)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return fmt.Errorf("flattening `tags`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyDraftDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicies
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+	policyId := firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)
+	if resp, err := client.FirewallPolicyDraftsDelete(ctx, policyId); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyDraftSchema() map[string]*pluginsdk.Schema {
+	// the Draft supports a subset of the properties of the Firewall Policy, which share the same schema
+	policySchema := resourceFirewallPolicySchema()
+
+	return map[string]*pluginsdk.Schema{
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: firewallpolicies.ValidateFirewallPolicyID,
+		},
+
+		"base_policy_id": policySchema["base_policy_id"],
+
+		"dns": policySchema["dns"],
+
+		"threat_intelligence_mode": policySchema["threat_intelligence_mode"],
+
+		"threat_intelligence_allowlist": policySchema["threat_intelligence_allowlist"],
+
+		"intrusion_detection": policySchema["intrusion_detection"],
+
+		"insights": policySchema["insights"],
+
+		"explicit_proxy": policySchema["explicit_proxy"],
+
+		"sql_redirect_allowed": policySchema["sql_redirect_allowed"],
+
+		"private_ip_ranges": policySchema["private_ip_ranges"],
+
+		"auto_learn_private_ranges_enabled": policySchema["auto_learn_private_ranges_enabled"],
+
+		"tags": commonschema.Tags(),
+	}
+}
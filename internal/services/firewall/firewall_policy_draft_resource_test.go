@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyDraftResource struct{}
+
+func TestAccFirewallPolicyDraft_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_draft", "test")
+	r := FirewallPolicyDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyDraft_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_draft", "test")
+	r := FirewallPolicyDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyDraft_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_draft", "test")
+	r := FirewallPolicyDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyDraftResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyDraftID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	policyId := firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)
+	resp, err := clients.Network.FirewallPolicies.FirewallPolicyDraftsGet(ctx, policyId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (FirewallPolicyDraftResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-networkfw-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                     = "acctest-networkfw-Policy-%[1]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  threat_intelligence_mode = "Alert"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyDraftResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_draft" "test" {
+  firewall_policy_id = azurerm_firewall_policy.test.id
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyDraftResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_draft" "import" {
+  firewall_policy_id = azurerm_firewall_policy_draft.test.firewall_policy_id
+}
+`, r.basic(data))
+}
+
+func (r FirewallPolicyDraftResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_draft" "test" {
+  firewall_policy_id       = azurerm_firewall_policy.test.id
+  threat_intelligence_mode = "Deny"
+
+  dns {
+    proxy_enabled = true
+    servers       = ["1.1.1.1", "8.8.8.8"]
+  }
+
+  threat_intelligence_allowlist {
+    ip_addresses = ["101.0.0.0", "102.0.0.0/24"]
+    fqdns        = ["foo.com", "bar.com"]
+  }
+
+  private_ip_ranges = ["172.16.0.0/12", "192.168.0.0/16"]
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data))
+}
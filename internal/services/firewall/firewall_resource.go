@@ -4,6 +4,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -223,10 +224,50 @@ func resourceFirewall() *pluginsdk.Resource {
 				},
 			},
 
+			"autoscale_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"min_capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(2),
+							AtLeastOneOf: []string{"autoscale_configuration.0.min_capacity", "autoscale_configuration.0.max_capacity"},
+						},
+
+						"max_capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(2),
+							AtLeastOneOf: []string{"autoscale_configuration.0.min_capacity", "autoscale_configuration.0.max_capacity"},
+						},
+					},
+				},
+			},
+
 			"zones": commonschema.ZonesMultipleOptionalForceNew(),
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				autoscale := diff.Get("autoscale_configuration").([]interface{})
+				if len(autoscale) == 0 || autoscale[0] == nil {
+					return nil
+				}
+
+				config := autoscale[0].(map[string]interface{})
+				minCapacity, maxCapacity := config["min_capacity"].(int), config["max_capacity"].(int)
+				if minCapacity > 0 && maxCapacity > 0 && minCapacity > maxCapacity {
+					return fmt.Errorf("`autoscale_configuration.0.min_capacity` (%d) must be less than or equal to `autoscale_configuration.0.max_capacity` (%d)", minCapacity, maxCapacity)
+				}
+
+				return nil
+			}),
+		),
 	}
 
 	return &resource
@@ -315,6 +356,8 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		parameters.Properties.FirewallPolicy = &azurefirewalls.SubResource{Id: &policyId}
 	}
 
+	parameters.Properties.AutoscaleConfiguration = expandFirewallAutoscaleConfiguration(d.Get("autoscale_configuration").([]interface{}))
+
 	vhub, hubIpAddresses, ok := expandFirewallVirtualHubSetting(existing.Model, d.Get("virtual_hub").([]interface{}))
 	if ok {
 		parameters.Properties.VirtualHub = vhub
@@ -473,6 +516,10 @@ func resourceFirewallSetFlatten(d *pluginsdk.ResourceData, id *azurefirewalls.Az
 			if err := d.Set("virtual_hub", flattenFirewallVirtualHubSetting(props)); err != nil {
 				return fmt.Errorf("setting `virtual_hub`: %+v", err)
 			}
+
+			if err := d.Set("autoscale_configuration", flattenFirewallAutoscaleConfiguration(props.AutoscaleConfiguration)); err != nil {
+				return fmt.Errorf("setting `autoscale_configuration`: %+v", err)
+			}
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -779,6 +826,36 @@ func expandFirewallVirtualHubSetting(existing *azurefirewalls.AzureFirewall, inp
 	return vhub, ipAddresses, true
 }
 
+func expandFirewallAutoscaleConfiguration(input []interface{}) *azurefirewalls.AzureFirewallAutoscaleConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	output := &azurefirewalls.AzureFirewallAutoscaleConfiguration{}
+	if minCapacity := v["min_capacity"].(int); minCapacity > 0 {
+		output.MinCapacity = pointer.To(int64(minCapacity))
+	}
+	if maxCapacity := v["max_capacity"].(int); maxCapacity > 0 {
+		output.MaxCapacity = pointer.To(int64(maxCapacity))
+	}
+
+	return output
+}
+
+func flattenFirewallAutoscaleConfiguration(input *azurefirewalls.AzureFirewallAutoscaleConfiguration) []interface{} {
+	if input == nil || (input.MinCapacity == nil && input.MaxCapacity == nil) {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"min_capacity": int(pointer.From(input.MinCapacity)),
+			"max_capacity": int(pointer.From(input.MaxCapacity)),
+		},
+	}
+}
+
 func flattenFirewallVirtualHubSetting(props *azurefirewalls.AzureFirewallPropertiesFormat) []interface{} {
 	if props.VirtualHub == nil {
 		return nil
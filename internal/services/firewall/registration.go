@@ -46,6 +46,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_firewall_application_rule_collection":  resourceFirewallApplicationRuleCollection(),
 		"azurerm_firewall_policy":                       resourceFirewallPolicy(),
+		"azurerm_firewall_policy_draft":                 resourceFirewallPolicyDraft(),
 		"azurerm_firewall_policy_rule_collection_group": resourceFirewallPolicyRuleCollectionGroup(),
 		"azurerm_firewall_nat_rule_collection":          resourceFirewallNatRuleCollection(),
 		"azurerm_firewall_network_rule_collection":      resourceFirewallNetworkRuleCollection(),
@@ -54,7 +55,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newFirewallPolicyDeployAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
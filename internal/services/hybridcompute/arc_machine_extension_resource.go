@@ -16,23 +16,25 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machineextensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type MachineExtensionModel struct {
-	Name                   string            `tfschema:"name"`
-	HybridComputeMachineId string            `tfschema:"arc_machine_id"`
-	EnableAutomaticUpgrade bool              `tfschema:"automatic_upgrade_enabled"`
-	ForceUpdateTag         string            `tfschema:"force_update_tag"`
-	Location               string            `tfschema:"location"`
-	ProtectedSettings      string            `tfschema:"protected_settings"`
-	Publisher              string            `tfschema:"publisher"`
-	Settings               string            `tfschema:"settings"`
-	Tags                   map[string]string `tfschema:"tags"`
-	Type                   string            `tfschema:"type"`
-	TypeHandlerVersion     string            `tfschema:"type_handler_version"`
+	Name                    string            `tfschema:"name"`
+	HybridComputeMachineId  string            `tfschema:"arc_machine_id"`
+	AutoUpgradeMinorVersion bool              `tfschema:"auto_upgrade_minor_version"`
+	EnableAutomaticUpgrade  bool              `tfschema:"automatic_upgrade_enabled"`
+	ForceUpdateTag          string            `tfschema:"force_update_tag"`
+	Location                string            `tfschema:"location"`
+	ProtectedSettings       string            `tfschema:"protected_settings"`
+	Publisher               string            `tfschema:"publisher"`
+	Settings                string            `tfschema:"settings"`
+	Tags                    map[string]string `tfschema:"tags"`
+	Type                    string            `tfschema:"type"`
+	TypeHandlerVersion      string            `tfschema:"type_handler_version"`
 }
 
 type ArcMachineExtensionResource struct{}
@@ -72,6 +74,12 @@ func (r ArcMachineExtensionResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: machines.ValidateMachineID,
 		},
 
+		"auto_upgrade_minor_version": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"automatic_upgrade_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -154,6 +162,10 @@ func (r ArcMachineExtensionResource) Create() sdk.ResourceFunc {
 			}
 
 			id := machineextensions.NewExtensionID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, model.Name)
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
 			existing, err := client.Get(ctx, id)
 			if err != nil && !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for existing %s: %+v", id, err)
@@ -166,7 +178,8 @@ func (r ArcMachineExtensionResource) Create() sdk.ResourceFunc {
 			properties := &machineextensions.MachineExtension{
 				Location: location.Normalize(model.Location),
 				Properties: &machineextensions.MachineExtensionProperties{
-					EnableAutomaticUpgrade: &model.EnableAutomaticUpgrade,
+					AutoUpgradeMinorVersion: &model.AutoUpgradeMinorVersion,
+					EnableAutomaticUpgrade:  &model.EnableAutomaticUpgrade,
 				},
 				Tags: &model.Tags,
 			}
@@ -231,6 +244,9 @@ func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
 			resp, err := client.Get(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -241,6 +257,10 @@ func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: properties was nil", id)
 			}
 
+			if metadata.ResourceData.HasChange("auto_upgrade_minor_version") {
+				properties.Properties.AutoUpgradeMinorVersion = &model.AutoUpgradeMinorVersion
+			}
+
 			if metadata.ResourceData.HasChange("automatic_upgrade_enabled") {
 				properties.Properties.EnableAutomaticUpgrade = &model.EnableAutomaticUpgrade
 			}
@@ -255,9 +275,11 @@ func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
 
 			if metadata.ResourceData.HasChange("protected_settings") {
 				protectedSettingsValue := make(map[string]interface{})
-				err := json.Unmarshal([]byte(model.ProtectedSettings), &protectedSettingsValue)
-				if err != nil {
-					return err
+				if model.ProtectedSettings != "" {
+					err := json.Unmarshal([]byte(model.ProtectedSettings), &protectedSettingsValue)
+					if err != nil {
+						return err
+					}
 				}
 
 				properties.Properties.ProtectedSettings = &protectedSettingsValue
@@ -273,9 +295,11 @@ func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
 
 			if metadata.ResourceData.HasChange("settings") {
 				settingsValue := make(map[string]interface{})
-				err := json.Unmarshal([]byte(model.Settings), &settingsValue)
-				if err != nil {
-					return err
+				if model.Settings != "" {
+					err := json.Unmarshal([]byte(model.Settings), &settingsValue)
+					if err != nil {
+						return err
+					}
 				}
 
 				properties.Properties.Settings = &settingsValue
@@ -343,6 +367,10 @@ func (r ArcMachineExtensionResource) Read() sdk.ResourceFunc {
 				state.Location = location.Normalize(model.Location)
 
 				if properties := model.Properties; properties != nil {
+					if properties.AutoUpgradeMinorVersion != nil {
+						state.AutoUpgradeMinorVersion = *properties.AutoUpgradeMinorVersion
+					}
+
 					if properties.EnableAutomaticUpgrade != nil {
 						state.EnableAutomaticUpgrade = *properties.EnableAutomaticUpgrade
 					}
@@ -404,6 +432,9 @@ func (r ArcMachineExtensionResource) Delete() sdk.ResourceFunc {
 				return err
 			}
 
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
 			if err := client.DeleteThenPoll(ctx, *id); err != nil {
 				return fmt.Errorf("deleting %s: %+v", id, err)
 			}
@@ -100,6 +100,38 @@ func TestAccArcMachineExtension_update(t *testing.T) {
 	})
 }
 
+func TestAccArcMachineExtension_autoUpgradeMinorVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+	r := ArcMachineExtensionResource{}
+	template := r.template(data)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_upgrade_minor_version").HasValue("true"),
+			),
+		},
+		data.ImportStep("protected_settings"),
+		{
+			Config: r.autoUpgradeMinorVersionDisabled(data, template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_upgrade_minor_version").HasValue("false"),
+			),
+		},
+		data.ImportStep("protected_settings"),
+		{
+			Config: r.basic(data, template),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_upgrade_minor_version").HasValue("true"),
+			),
+		},
+		data.ImportStep("protected_settings"),
+	})
+}
+
 func (r ArcMachineExtensionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := machineextensions.ParseExtensionID(state.ID)
 	if err != nil {
@@ -135,6 +167,24 @@ resource "azurerm_arc_machine_extension" "test" {
 `, template, data.RandomInteger, data.Locations.Primary)
 }
 
+func (r ArcMachineExtensionResource) autoUpgradeMinorVersionDisabled(data acceptance.TestData, template string) string {
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_arc_machine_extension" "test" {
+  name                       = "acctest-hcme-%d"
+  arc_machine_id             = data.azurerm_arc_machine.test.id
+  publisher                  = "Microsoft.Azure.Monitor"
+  type                       = "AzureMonitorLinuxAgent"
+  location                   = "%s"
+  auto_upgrade_minor_version = false
+  lifecycle {
+    ignore_changes = [type_handler_version]
+  }
+}
+`, template, data.RandomInteger, data.Locations.Primary)
+}
+
 func (r ArcMachineExtensionResource) requiresImport(basicConfig string) string {
 	return fmt.Sprintf(`
 			%s
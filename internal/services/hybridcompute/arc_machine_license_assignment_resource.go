@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenseprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenses"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the License Profile of an Arc Machine is a singleton which is always named `default`
+const arcMachineLicenseProfileName = "default"
+
+var _ sdk.ResourceWithUpdate = ArcMachineLicenseAssignmentResource{}
+
+type ArcMachineLicenseAssignmentResource struct{}
+
+type ArcMachineLicenseAssignmentResourceModel struct {
+	ArcMachineId string `tfschema:"arc_machine_id"`
+	LicenseId    string `tfschema:"license_id"`
+
+	EsuEligibility string `tfschema:"esu_eligibility"`
+	EsuKeyState    string `tfschema:"esu_key_state"`
+	ServerType     string `tfschema:"server_type"`
+}
+
+func (r ArcMachineLicenseAssignmentResource) ResourceType() string {
+	return "azurerm_arc_machine_license_assignment"
+}
+
+func (r ArcMachineLicenseAssignmentResource) ModelObject() interface{} {
+	return &ArcMachineLicenseAssignmentResourceModel{}
+}
+
+func (r ArcMachineLicenseAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ArcMachineLicenseProfileID
+}
+
+func (r ArcMachineLicenseAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"license_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: licenses.ValidateLicenseID,
+		},
+	}
+}
+
+func (r ArcMachineLicenseAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"esu_eligibility": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_key_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"server_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineLicenseAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+			machinesClient := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Machines
+
+			var config ArcMachineLicenseAssignmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewArcMachineLicenseProfileID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, arcMachineLicenseProfileName)
+			profileMachineId := licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName)
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
+			existing, err := client.Get(ctx, profileMachineId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) && assignedEsuLicense(existing.Model) != "" {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			machine, err := machinesClient.Get(ctx, *machineId, machines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *machineId, err)
+			}
+			if machine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *machineId)
+			}
+
+			payload := licenseprofiles.LicenseProfile{
+				// the License Profile must be in the same location as the Arc Machine
+				Location: machine.Model.Location,
+				Properties: &licenseprofiles.LicenseProfileProperties{
+					EsuProfile: &licenseprofiles.LicenseProfileArmEsuProperties{
+						AssignedLicense: pointer.To(config.LicenseId),
+					},
+				},
+			}
+
+			if model := existing.Model; model != nil && model.Properties != nil {
+				// retain any Product Profile or Software Assurance configured outside of this resource
+				payload.Properties.ProductProfile = model.Properties.ProductProfile
+				payload.Properties.SoftwareAssurance = model.Properties.SoftwareAssurance
+				payload.Tags = model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, profileMachineId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			assignedLicense := assignedEsuLicense(resp.Model)
+			if assignedLicense == "" {
+				return metadata.MarkAsGone(id)
+			}
+
+			licenseId, err := licenses.ParseLicenseIDInsensitively(assignedLicense)
+			if err != nil {
+				return err
+			}
+
+			state := ArcMachineLicenseAssignmentResourceModel{
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName).ID(),
+				LicenseId:    licenseId.ID(),
+			}
+
+			if esuProfile := resp.Model.Properties.EsuProfile; esuProfile != nil {
+				state.EsuEligibility = pointer.FromEnum(esuProfile.EsuEligibility)
+				state.EsuKeyState = pointer.FromEnum(esuProfile.EsuKeyState)
+				state.ServerType = pointer.FromEnum(esuProfile.ServerType)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineLicenseAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ArcMachineLicenseAssignmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
+			payload := licenseprofiles.LicenseProfileUpdate{
+				Properties: &licenseprofiles.LicenseProfileUpdateProperties{
+					EsuProfile: &licenseprofiles.EsuProfileUpdateProperties{
+						AssignedLicense: pointer.To(config.LicenseId),
+					},
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName), payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
+			if err := client.DeleteThenPoll(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func assignedEsuLicense(input *licenseprofiles.LicenseProfile) string {
+	if input == nil || input.Properties == nil || input.Properties.EsuProfile == nil {
+		return ""
+	}
+
+	return pointer.From(input.Properties.EsuProfile.AssignedLicense)
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseAssignmentResource struct{}
+
+// ESU Licenses can only be assigned to Arc Machines running Windows Server 2012 or 2012 R2, which
+// can't be provisioned as part of the test - as such an existing Arc Machine has to be provided
+func TestAccArcMachineLicenseAssignment_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_WINDOWS_SERVER_2012_MACHINE_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_ARC_WINDOWS_SERVER_2012_MACHINE_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_assignment", "test")
+	r := ArcMachineLicenseAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("esu_eligibility").HasValue("Eligible"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ArcMachineLicenseAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ArcMachineLicenseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles.Get(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	exists := false
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.EsuProfile != nil {
+		exists = pointer.From(model.Properties.EsuProfile.AssignedLicense) != ""
+	}
+
+	return pointer.To(exists), nil
+}
+
+func (ArcMachineLicenseAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license" "test" {
+  name                = "acctest-esu-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_type           = "vCore"
+  edition             = "Standard"
+  processor_count     = 8
+  target              = "Windows Server 2012"
+}
+
+resource "azurerm_arc_machine_license_assignment" "test" {
+  arc_machine_id = "%s"
+  license_id     = azurerm_arc_machine_license.test.id
+}
+`, ArcMachineLicenseResource{}.template(data), data.RandomInteger, os.Getenv("ARM_TEST_ARC_WINDOWS_SERVER_2012_MACHINE_ID"))
+}
@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = ArcMachineLicenseResource{}
+	_ sdk.ResourceWithCustomizeDiff = ArcMachineLicenseResource{}
+)
+
+// ArcMachineLicenseResource manages a Windows Server Extended Security Updates (ESU) License which can be assigned to Arc Machines
+type ArcMachineLicenseResource struct{}
+
+type ArcMachineLicenseResourceModel struct {
+	Name                 string                                  `tfschema:"name"`
+	ResourceGroupName    string                                  `tfschema:"resource_group_name"`
+	Location             string                                  `tfschema:"location"`
+	CoreType             string                                  `tfschema:"core_type"`
+	Edition              string                                  `tfschema:"edition"`
+	ProcessorCount       int64                                   `tfschema:"processor_count"`
+	State                string                                  `tfschema:"state"`
+	Target               string                                  `tfschema:"target"`
+	VolumeLicenseDetails []ArcMachineLicenseVolumeLicenseDetails `tfschema:"volume_license_detail"`
+	Tags                 map[string]string                       `tfschema:"tags"`
+
+	AssignedLicenseCount int64  `tfschema:"assigned_license_count"`
+	ImmutableId          string `tfschema:"immutable_id"`
+}
+
+type ArcMachineLicenseVolumeLicenseDetails struct {
+	InvoiceId   string `tfschema:"invoice_id"`
+	ProgramYear string `tfschema:"program_year"`
+}
+
+func (r ArcMachineLicenseResource) ResourceType() string {
+	return "azurerm_arc_machine_license"
+}
+
+func (r ArcMachineLicenseResource) ModelObject() interface{} {
+	return &ArcMachineLicenseResourceModel{}
+}
+
+func (r ArcMachineLicenseResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return licenses.ValidateLicenseID
+}
+
+func (r ArcMachineLicenseResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringDoesNotContainAny("/"),
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"core_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseCoreType(), false),
+		},
+
+		"edition": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseEdition(), false),
+		},
+
+		"processor_count": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+			// the API requires at least 8 virtual cores or 16 physical cores
+			ValidateFunc: validation.IntAtLeast(8),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseTarget(), false),
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(licenses.LicenseStateActivated),
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseState(), false),
+		},
+
+		"volume_license_detail": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"program_year": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForProgramYear(), false),
+					},
+
+					"invoice_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineLicenseResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assigned_license_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ArcMachineLicenseResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if config.CoreType == string(licenses.LicenseCoreTypePCore) && config.ProcessorCount < 16 {
+				return fmt.Errorf("`processor_count` must be at least `16` when `core_type` is `%s`", licenses.LicenseCoreTypePCore)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config ArcMachineLicenseResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := licenses.NewLicenseID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := licenses.License{
+				Location: location.Normalize(config.Location),
+				Properties: &licenses.LicenseProperties{
+					LicenseType: pointer.To(licenses.LicenseTypeESU),
+					LicenseDetails: &licenses.LicenseDetails{
+						Edition:              pointer.To(licenses.LicenseEdition(config.Edition)),
+						Processors:           pointer.To(config.ProcessorCount),
+						State:                pointer.To(licenses.LicenseState(config.State)),
+						Target:               pointer.To(licenses.LicenseTarget(config.Target)),
+						Type:                 pointer.To(licenses.LicenseCoreType(config.CoreType)),
+						VolumeLicenseDetails: expandArcMachineLicenseVolumeLicenseDetails(config.VolumeLicenseDetails),
+					},
+				},
+				Tags: pointer.To(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineLicenseResourceModel{
+				Name:              id.LicenseName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if details := props.LicenseDetails; details != nil {
+						state.AssignedLicenseCount = pointer.From(details.AssignedLicenses)
+						state.CoreType = pointer.FromEnum(details.Type)
+						state.Edition = pointer.FromEnum(details.Edition)
+						state.ImmutableId = pointer.From(details.ImmutableId)
+						state.ProcessorCount = pointer.From(details.Processors)
+						state.State = pointer.FromEnum(details.State)
+						state.Target = pointer.FromEnum(details.Target)
+						state.VolumeLicenseDetails = flattenArcMachineLicenseVolumeLicenseDetails(details.VolumeLicenseDetails)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ArcMachineLicenseResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			details := &licenses.LicenseUpdatePropertiesLicenseDetails{}
+			payload := licenses.LicenseUpdate{
+				Properties: &licenses.LicenseUpdateProperties{
+					LicenseDetails: details,
+				},
+			}
+
+			if metadata.ResourceData.HasChange("edition") {
+				details.Edition = pointer.To(licenses.LicenseEdition(config.Edition))
+			}
+
+			if metadata.ResourceData.HasChange("processor_count") {
+				details.Processors = pointer.To(config.ProcessorCount)
+			}
+
+			if metadata.ResourceData.HasChange("state") {
+				details.State = pointer.To(licenses.LicenseState(config.State))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineLicenseVolumeLicenseDetails(input []ArcMachineLicenseVolumeLicenseDetails) *[]licenses.VolumeLicenseDetails {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]licenses.VolumeLicenseDetails, 0)
+	for _, v := range input {
+		details := licenses.VolumeLicenseDetails{
+			ProgramYear: pointer.To(licenses.ProgramYear(v.ProgramYear)),
+		}
+		if v.InvoiceId != "" {
+			details.InvoiceId = pointer.To(v.InvoiceId)
+		}
+		output = append(output, details)
+	}
+
+	return &output
+}
+
+func flattenArcMachineLicenseVolumeLicenseDetails(input *[]licenses.VolumeLicenseDetails) []ArcMachineLicenseVolumeLicenseDetails {
+	output := make([]ArcMachineLicenseVolumeLicenseDetails, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ArcMachineLicenseVolumeLicenseDetails{
+			InvoiceId:   pointer.From(v.InvoiceId),
+			ProgramYear: pointer.FromEnum(v.ProgramYear),
+		})
+	}
+
+	return output
+}
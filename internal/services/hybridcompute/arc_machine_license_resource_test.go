@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseResource struct{}
+
+func TestAccArcMachineLicense_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license", "test")
+	r := ArcMachineLicenseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutable_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineLicense_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license", "test")
+	r := ArcMachineLicenseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineLicense_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license", "test")
+	r := ArcMachineLicenseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ArcMachineLicenseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := licenses.ParseLicenseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.HybridComputeClient_v2024_07_10.Licenses.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ArcMachineLicenseResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hc-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ArcMachineLicenseResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license" "test" {
+  name                = "acctest-esu-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_type           = "vCore"
+  edition             = "Standard"
+  processor_count     = 8
+  state               = "Deactivated"
+  target              = "Windows Server 2012"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ArcMachineLicenseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license" "import" {
+  name                = azurerm_arc_machine_license.test.name
+  resource_group_name = azurerm_arc_machine_license.test.resource_group_name
+  location            = azurerm_arc_machine_license.test.location
+  core_type           = azurerm_arc_machine_license.test.core_type
+  edition             = azurerm_arc_machine_license.test.edition
+  processor_count     = azurerm_arc_machine_license.test.processor_count
+  state               = azurerm_arc_machine_license.test.state
+  target              = azurerm_arc_machine_license.test.target
+}
+`, r.basic(data))
+}
+
+func (r ArcMachineLicenseResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license" "test" {
+  name                = "acctest-esu-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_type           = "vCore"
+  edition             = "Datacenter"
+  processor_count     = 16
+  state               = "Deactivated"
+  target              = "Windows Server 2012"
+
+  tags = {
+    environment = "terraform-acctests"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachinePatchAssessmentResource struct{}
+
+var (
+	_ sdk.Resource                   = ArcMachinePatchAssessmentResource{}
+	_ sdk.ResourceWithCustomImporter = ArcMachinePatchAssessmentResource{}
+)
+
+type ArcMachinePatchAssessmentResourceModel struct {
+	ArcMachineId string            `tfschema:"arc_machine_id"`
+	Triggers     map[string]string `tfschema:"triggers"`
+
+	AssessmentActivityId string                                     `tfschema:"assessment_activity_id"`
+	AvailablePatchCount  []ArcMachinePatchAssessmentPatchCountModel `tfschema:"available_patch_count"`
+	OsType               string                                     `tfschema:"os_type"`
+	RebootPending        bool                                       `tfschema:"reboot_pending"`
+	StartDateTime        string                                     `tfschema:"start_date_time"`
+	Status               string                                     `tfschema:"status"`
+}
+
+type ArcMachinePatchAssessmentPatchCountModel struct {
+	Critical     int64 `tfschema:"critical"`
+	Definition   int64 `tfschema:"definition"`
+	FeaturePack  int64 `tfschema:"feature_pack"`
+	Other        int64 `tfschema:"other"`
+	Security     int64 `tfschema:"security"`
+	ServicePack  int64 `tfschema:"service_pack"`
+	Tools        int64 `tfschema:"tools"`
+	UpdateRollup int64 `tfschema:"update_rollup"`
+	Updates      int64 `tfschema:"updates"`
+}
+
+func (ArcMachinePatchAssessmentResource) ResourceType() string {
+	return "azurerm_arc_machine_patch_assessment"
+}
+
+func (ArcMachinePatchAssessmentResource) ModelObject() interface{} {
+	return &ArcMachinePatchAssessmentResourceModel{}
+}
+
+func (ArcMachinePatchAssessmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ArcMachinePatchAssessmentIDValidation
+}
+
+func (ArcMachinePatchAssessmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (ArcMachinePatchAssessmentResource) Attributes() map[string]*pluginsdk.Schema {
+	patchCount := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"assessment_activity_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"available_patch_count": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"critical":      patchCount(),
+					"definition":    patchCount(),
+					"feature_pack":  patchCount(),
+					"other":         patchCount(),
+					"security":      patchCount(),
+					"service_pack":  patchCount(),
+					"tools":         patchCount(),
+					"update_rollup": patchCount(),
+					"updates":       patchCount(),
+				},
+			},
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"reboot_pending": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"start_date_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachinePatchAssessmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Machines
+
+			var config ArcMachinePatchAssessmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AssessPatches(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("assessing patches on %s: %+v", id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the assessment of patches on %s: %+v", id, err)
+			}
+
+			var result machines.MachineAssessPatchesResult
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving the result of assessing patches on %s: %+v", id, err)
+			}
+
+			if status := pointer.From(result.Status); status == machines.PatchOperationStatusFailed {
+				message := "no error details were returned"
+				if result.ErrorDetails != nil && result.ErrorDetails.Message != nil {
+					message = *result.ErrorDetails.Message
+				}
+				return fmt.Errorf("assessing patches on %s: the assessment finished with the status %q: %s", id, status, message)
+			}
+
+			config.AssessmentActivityId = pointer.From(result.AssessmentActivityId)
+			config.AvailablePatchCount = flattenArcMachinePatchAssessmentPatchCount(result.AvailablePatchCountByClassification)
+			config.OsType = pointer.FromEnum(result.OsType)
+			config.RebootPending = pointer.From(result.RebootPending)
+			config.StartDateTime = pointer.From(result.StartDateTime)
+			config.Status = pointer.FromEnum(result.Status)
+
+			metadata.SetID(parse.NewArcMachinePatchAssessmentID(*id))
+
+			// the result of the assessment isn't retrievable from the API afterwards, so it's stored as-is
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (ArcMachinePatchAssessmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Machines
+
+			id, err := parse.ArcMachinePatchAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ArcMachineId, machines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id.ArcMachineId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ArcMachinePatchAssessmentResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_arc_machine_patch_assessment` can't be imported, since it represents a one-time patch assessment on the Arc Machine")
+	}
+}
+
+func (ArcMachinePatchAssessmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// an assessment doesn't change the Arc Machine, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func flattenArcMachinePatchAssessmentPatchCount(input *machines.AvailablePatchCountByClassification) []ArcMachinePatchAssessmentPatchCountModel {
+	if input == nil {
+		return []ArcMachinePatchAssessmentPatchCountModel{}
+	}
+
+	return []ArcMachinePatchAssessmentPatchCountModel{
+		{
+			Critical:     pointer.From(input.Critical),
+			Definition:   pointer.From(input.Definition),
+			FeaturePack:  pointer.From(input.FeaturePack),
+			Other:        pointer.From(input.Other),
+			Security:     pointer.From(input.Security),
+			ServicePack:  pointer.From(input.ServicePack),
+			Tools:        pointer.From(input.Tools),
+			UpdateRollup: pointer.From(input.UpdateRollup),
+			Updates:      pointer.From(input.Updates),
+		},
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachinePatchAssessmentResource struct{}
+
+func TestAccArcMachinePatchAssessment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_patch_assessment", "test")
+	r := ArcMachinePatchAssessmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+				check.That(data.ResourceName).Key("os_type").HasValue("linux"),
+			),
+		},
+	})
+}
+
+func (ArcMachinePatchAssessmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ArcMachinePatchAssessmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.HybridComputeClient_v2024_07_10.Machines.Get(ctx, id.ArcMachineId, machines.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.ArcMachineId, err)
+	}
+
+	return pointer.To(state.Attributes["assessment_activity_id"] != ""), nil
+}
+
+func (ArcMachinePatchAssessmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_patch_assessment" "test" {
+  arc_machine_id = data.azurerm_arc_machine.test.id
+}
+`, ArcMachineExtensionResource{}.template(data))
+}
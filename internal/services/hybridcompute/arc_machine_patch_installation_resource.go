@@ -0,0 +1,406 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ArcMachinePatchInstallationResource struct{}
+
+var (
+	_ sdk.Resource                   = ArcMachinePatchInstallationResource{}
+	_ sdk.ResourceWithCustomImporter = ArcMachinePatchInstallationResource{}
+)
+
+type ArcMachinePatchInstallationResourceModel struct {
+	ArcMachineId    string                               `tfschema:"arc_machine_id"`
+	MaximumDuration string                               `tfschema:"maximum_duration"`
+	RebootSetting   string                               `tfschema:"reboot_setting"`
+	Linux           []ArcMachinePatchInstallationLinux   `tfschema:"linux"`
+	Windows         []ArcMachinePatchInstallationWindows `tfschema:"windows"`
+	Triggers        map[string]string                    `tfschema:"triggers"`
+
+	ExcludedPatchCount        int64  `tfschema:"excluded_patch_count"`
+	FailedPatchCount          int64  `tfschema:"failed_patch_count"`
+	InstallationActivityId    string `tfschema:"installation_activity_id"`
+	InstalledPatchCount       int64  `tfschema:"installed_patch_count"`
+	MaintenanceWindowExceeded bool   `tfschema:"maintenance_window_exceeded"`
+	NotSelectedPatchCount     int64  `tfschema:"not_selected_patch_count"`
+	PendingPatchCount         int64  `tfschema:"pending_patch_count"`
+	RebootStatus              string `tfschema:"reboot_status"`
+	StartDateTime             string `tfschema:"start_date_time"`
+	Status                    string `tfschema:"status"`
+}
+
+type ArcMachinePatchInstallationLinux struct {
+	ClassificationsToInclude  []string `tfschema:"classifications_to_include"`
+	PackageNamesMaskToExclude []string `tfschema:"package_names_mask_to_exclude"`
+	PackageNamesMaskToInclude []string `tfschema:"package_names_mask_to_include"`
+}
+
+type ArcMachinePatchInstallationWindows struct {
+	ClassificationsToInclude  []string `tfschema:"classifications_to_include"`
+	ExcludeKbsRequiringReboot bool     `tfschema:"exclude_kbs_requiring_reboot"`
+	KbNumbersToExclude        []string `tfschema:"kb_numbers_to_exclude"`
+	KbNumbersToInclude        []string `tfschema:"kb_numbers_to_include"`
+	MaxPatchPublishDate       string   `tfschema:"max_patch_publish_date"`
+}
+
+func (ArcMachinePatchInstallationResource) ResourceType() string {
+	return "azurerm_arc_machine_patch_installation"
+}
+
+func (ArcMachinePatchInstallationResource) ModelObject() interface{} {
+	return &ArcMachinePatchInstallationResourceModel{}
+}
+
+func (ArcMachinePatchInstallationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ArcMachinePatchInstallationIDValidation
+}
+
+func (ArcMachinePatchInstallationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"maximum_duration": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ISO8601DurationBetween("PT5M", "PT4H"),
+		},
+
+		"reboot_setting": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(machines.PossibleValuesForVMGuestPatchRebootSetting(), false),
+		},
+
+		"linux": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"linux", "windows"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"classifications_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(machines.PossibleValuesForVMGuestPatchClassificationLinux(), false),
+						},
+					},
+
+					"package_names_mask_to_exclude": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"package_names_mask_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"windows": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"linux", "windows"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"classifications_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(machines.PossibleValuesForVMGuestPatchClassificationWindows(), false),
+						},
+					},
+
+					"exclude_kbs_requiring_reboot": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"kb_numbers_to_exclude": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"kb_numbers_to_include": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"max_patch_publish_date": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (ArcMachinePatchInstallationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"excluded_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"failed_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"installation_activity_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"installed_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"maintenance_window_exceeded": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"not_selected_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"pending_patch_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"reboot_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_date_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachinePatchInstallationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Machines
+
+			var config ArcMachinePatchInstallationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			payload := machines.MachineInstallPatchesParameters{
+				MaximumDuration:   config.MaximumDuration,
+				RebootSetting:     machines.VMGuestPatchRebootSetting(config.RebootSetting),
+				LinuxParameters:   expandArcMachinePatchInstallationLinux(config.Linux),
+				WindowsParameters: expandArcMachinePatchInstallationWindows(config.Windows),
+			}
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
+			resp, err := client.InstallPatches(ctx, *id, payload)
+			if err != nil {
+				return fmt.Errorf("installing patches on %s: %+v", id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the installation of patches on %s: %+v", id, err)
+			}
+
+			var result machines.MachineInstallPatchesResult
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving the result of installing patches on %s: %+v", id, err)
+			}
+
+			if status := pointer.From(result.Status); status == machines.PatchOperationStatusFailed {
+				message := "no error details were returned"
+				if result.ErrorDetails != nil && result.ErrorDetails.Message != nil {
+					message = *result.ErrorDetails.Message
+				}
+				return fmt.Errorf("installing patches on %s: the installation finished with the status %q: %s", id, status, message)
+			}
+
+			config.ExcludedPatchCount = pointer.From(result.ExcludedPatchCount)
+			config.FailedPatchCount = pointer.From(result.FailedPatchCount)
+			config.InstallationActivityId = pointer.From(result.InstallationActivityId)
+			config.InstalledPatchCount = pointer.From(result.InstalledPatchCount)
+			config.MaintenanceWindowExceeded = pointer.From(result.MaintenanceWindowExceeded)
+			config.NotSelectedPatchCount = pointer.From(result.NotSelectedPatchCount)
+			config.PendingPatchCount = pointer.From(result.PendingPatchCount)
+			config.RebootStatus = pointer.FromEnum(result.RebootStatus)
+			config.StartDateTime = pointer.From(result.StartDateTime)
+			config.Status = pointer.FromEnum(result.Status)
+
+			metadata.SetID(parse.NewArcMachinePatchInstallationID(*id))
+
+			// the result of the installation isn't retrievable from the API afterwards, so it's stored as-is
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (ArcMachinePatchInstallationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Machines
+
+			id, err := parse.ArcMachinePatchInstallationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ArcMachineId, machines.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id.ArcMachineId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ArcMachinePatchInstallationResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_arc_machine_patch_installation` can't be imported, since it represents a one-time patch installation on the Arc Machine")
+	}
+}
+
+func (ArcMachinePatchInstallationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// patches can't be uninstalled, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func expandArcMachinePatchInstallationLinux(input []ArcMachinePatchInstallationLinux) *machines.LinuxParameters {
+	if len(input) == 0 {
+		return nil
+	}
+
+	linux := input[0]
+	classifications := make([]machines.VMGuestPatchClassificationLinux, 0)
+	for _, v := range linux.ClassificationsToInclude {
+		classifications = append(classifications, machines.VMGuestPatchClassificationLinux(v))
+	}
+
+	return &machines.LinuxParameters{
+		ClassificationsToInclude:  pointer.To(classifications),
+		PackageNameMasksToExclude: pointer.To(linux.PackageNamesMaskToExclude),
+		PackageNameMasksToInclude: pointer.To(linux.PackageNamesMaskToInclude),
+	}
+}
+
+func expandArcMachinePatchInstallationWindows(input []ArcMachinePatchInstallationWindows) *machines.WindowsParameters {
+	if len(input) == 0 {
+		return nil
+	}
+
+	windows := input[0]
+	classifications := make([]machines.VMGuestPatchClassificationWindows, 0)
+	for _, v := range windows.ClassificationsToInclude {
+		classifications = append(classifications, machines.VMGuestPatchClassificationWindows(v))
+	}
+
+	output := &machines.WindowsParameters{
+		ClassificationsToInclude:  pointer.To(classifications),
+		ExcludeKbsRequiringReboot: pointer.To(windows.ExcludeKbsRequiringReboot),
+		KbNumbersToExclude:        pointer.To(windows.KbNumbersToExclude),
+		KbNumbersToInclude:        pointer.To(windows.KbNumbersToInclude),
+	}
+
+	if windows.MaxPatchPublishDate != "" {
+		output.MaxPatchPublishDate = pointer.To(windows.MaxPatchPublishDate)
+	}
+
+	return output
+}
@@ -0,0 +1,620 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2025-01-13/machineruncommands"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = ArcMachineRunCommandResource{}
+	_ sdk.ResourceWithUpdate = ArcMachineRunCommandResource{}
+)
+
+type ArcMachineRunCommandResource struct{}
+
+type ArcMachineRunCommandResourceModel struct {
+	Name                      string                                `tfschema:"name"`
+	ArcMachineId              string                                `tfschema:"arc_machine_id"`
+	Location                  string                                `tfschema:"location"`
+	Source                    []ArcMachineRunCommandScriptSource    `tfschema:"source"`
+	ErrorBlobManagedIdentity  []ArcMachineRunCommandManagedIdentity `tfschema:"error_blob_managed_identity"`
+	ErrorBlobUri              string                                `tfschema:"error_blob_uri"`
+	OutputBlobManagedIdentity []ArcMachineRunCommandManagedIdentity `tfschema:"output_blob_managed_identity"`
+	OutputBlobUri             string                                `tfschema:"output_blob_uri"`
+	Parameter                 []ArcMachineRunCommandInputParameter  `tfschema:"parameter"`
+	ProtectedParameter        []ArcMachineRunCommandInputParameter  `tfschema:"protected_parameter"`
+	RunAsPassword             string                                `tfschema:"run_as_password"`
+	RunAsUser                 string                                `tfschema:"run_as_user"`
+	Tags                      map[string]string                     `tfschema:"tags"`
+
+	InstanceView []ArcMachineRunCommandInstanceView `tfschema:"instance_view"`
+}
+
+type ArcMachineRunCommandScriptSource struct {
+	CommandId                string                                `tfschema:"command_id"`
+	Script                   string                                `tfschema:"script"`
+	ScriptUri                string                                `tfschema:"script_uri"`
+	ScriptUriManagedIdentity []ArcMachineRunCommandManagedIdentity `tfschema:"script_uri_managed_identity"`
+}
+
+type ArcMachineRunCommandManagedIdentity struct {
+	ClientId string `tfschema:"client_id"`
+	ObjectId string `tfschema:"object_id"`
+}
+
+type ArcMachineRunCommandInputParameter struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type ArcMachineRunCommandInstanceView struct {
+	ExitCode         int64  `tfschema:"exit_code"`
+	ExecutionState   string `tfschema:"execution_state"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	Output           string `tfschema:"output"`
+	ErrorMessage     string `tfschema:"error_message"`
+	StartTime        string `tfschema:"start_time"`
+	EndTime          string `tfschema:"end_time"`
+}
+
+func (r ArcMachineRunCommandResource) ResourceType() string {
+	return "azurerm_arc_machine_run_command"
+}
+
+func (r ArcMachineRunCommandResource) ModelObject() interface{} {
+	return &ArcMachineRunCommandResourceModel{}
+}
+
+func (r ArcMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machineruncommands.ValidateRunCommandID
+}
+
+func (r ArcMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	managedIdentitySchema := func(field string) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:      pluginsdk.TypeList,
+			Optional:  true,
+			MaxItems:  1,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_id": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ValidateFunc:  validation.IsUUID,
+						ConflictsWith: []string{fmt.Sprintf("%s.0.object_id", field)},
+					},
+
+					"object_id": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ValidateFunc:  validation.IsUUID,
+						ConflictsWith: []string{fmt.Sprintf("%s.0.client_id", field)},
+					},
+				},
+			},
+		}
+	}
+
+	parameterSchema := func(sensitive bool) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:      pluginsdk.TypeList,
+			Optional:  true,
+			Sensitive: sensitive,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    sensitive,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    sensitive,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		}
+	}
+
+	scriptUriManagedIdentity := managedIdentitySchema("source.0.script_uri_managed_identity")
+	scriptUriManagedIdentity.RequiredWith = []string{"source.0.script_uri"}
+
+	errorBlobManagedIdentity := managedIdentitySchema("error_blob_managed_identity")
+	errorBlobManagedIdentity.RequiredWith = []string{"error_blob_uri"}
+
+	outputBlobManagedIdentity := managedIdentitySchema("output_blob_managed_identity")
+	outputBlobManagedIdentity.RequiredWith = []string{"output_blob_uri"}
+
+	sources := []string{
+		"source.0.command_id",
+		"source.0.script",
+		"source.0.script_uri",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: computeValidate.VirtualMachineRunCommandName,
+		},
+
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: sources,
+					},
+
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: sources,
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: sources,
+					},
+
+					"script_uri_managed_identity": scriptUriManagedIdentity,
+				},
+			},
+		},
+
+		"error_blob_managed_identity": errorBlobManagedIdentity,
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"output_blob_managed_identity": outputBlobManagedIdentity,
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"parameter": parameterSchema(false),
+
+		"protected_parameter": parameterSchema(true),
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2025_01_13.MachineRunCommands
+
+			var config ArcMachineRunCommandResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := machineruncommands.NewRunCommandID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, config.Name)
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := machineruncommands.MachineRunCommand{
+				Location: location.Normalize(config.Location),
+				Tags:     pointer.To(config.Tags),
+				Properties: &machineruncommands.MachineRunCommandProperties{
+					ErrorBlobManagedIdentity:  expandArcMachineRunCommandManagedIdentity(config.ErrorBlobManagedIdentity),
+					ErrorBlobUri:              pointer.To(config.ErrorBlobUri),
+					OutputBlobManagedIdentity: expandArcMachineRunCommandManagedIdentity(config.OutputBlobManagedIdentity),
+					OutputBlobUri:             pointer.To(config.OutputBlobUri),
+					Parameters:                expandArcMachineRunCommandInputParameters(config.Parameter),
+					ProtectedParameters:       expandArcMachineRunCommandInputParameters(config.ProtectedParameter),
+					RunAsPassword:             pointer.To(config.RunAsPassword),
+					RunAsUser:                 pointer.To(config.RunAsUser),
+					Source:                    expandArcMachineRunCommandSource(config.Source),
+					TimeoutInSeconds:          pointer.To(int64(metadata.ResourceData.Timeout(pluginsdk.TimeoutCreate).Seconds())),
+
+					// wait for the command to complete, so that a failure to run it fails the apply
+					AsyncExecution: pointer.To(false),
+				},
+			}
+
+			result, err := client.CreateOrUpdate(ctx, id, payload)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the resource still exists if polling fails
+			metadata.SetID(id)
+
+			if err := result.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("running the command for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2025_01_13.MachineRunCommands
+
+			id, err := machineruncommands.ParseRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the managed identities, protected parameters and password aren't returned by the API
+			var config ArcMachineRunCommandResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineRunCommandResourceModel{
+				Name:                      id.RunCommandName,
+				ArcMachineId:              machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+				ErrorBlobManagedIdentity:  config.ErrorBlobManagedIdentity,
+				OutputBlobManagedIdentity: config.OutputBlobManagedIdentity,
+				ProtectedParameter:        config.ProtectedParameter,
+				RunAsPassword:             config.RunAsPassword,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.InstanceView = flattenArcMachineRunCommandInstanceView(props.InstanceView)
+					state.Parameter = flattenArcMachineRunCommandInputParameters(props.Parameters)
+					state.RunAsUser = pointer.From(props.RunAsUser)
+					state.Source = flattenArcMachineRunCommandSource(props.Source, config.Source)
+
+					// SAS URIs aren't returned by the API
+					state.ErrorBlobUri = pointer.From(props.ErrorBlobUri)
+					if strings.Contains(config.ErrorBlobUri, "sig=") {
+						state.ErrorBlobUri = config.ErrorBlobUri
+					}
+
+					state.OutputBlobUri = pointer.From(props.OutputBlobUri)
+					if strings.Contains(config.OutputBlobUri, "sig=") {
+						state.OutputBlobUri = config.OutputBlobUri
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2025_01_13.MachineRunCommands
+
+			id, err := machineruncommands.ParseRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ArcMachineRunCommandResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if resp.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := resp.Model
+			payload.SystemData = nil
+			payload.Properties.InstanceView = nil
+			payload.Properties.ProvisioningState = nil
+			payload.Properties.AsyncExecution = pointer.To(false)
+			payload.Properties.TimeoutInSeconds = pointer.To(int64(metadata.ResourceData.Timeout(pluginsdk.TimeoutUpdate).Seconds()))
+
+			// the sensitive values aren't returned by the API, so these are always sent
+			payload.Properties.ErrorBlobManagedIdentity = expandArcMachineRunCommandManagedIdentity(config.ErrorBlobManagedIdentity)
+			payload.Properties.OutputBlobManagedIdentity = expandArcMachineRunCommandManagedIdentity(config.OutputBlobManagedIdentity)
+			payload.Properties.ProtectedParameters = expandArcMachineRunCommandInputParameters(config.ProtectedParameter)
+			payload.Properties.RunAsPassword = pointer.To(config.RunAsPassword)
+			payload.Properties.Source = expandArcMachineRunCommandSource(config.Source)
+
+			if metadata.ResourceData.HasChange("error_blob_uri") {
+				payload.Properties.ErrorBlobUri = pointer.To(config.ErrorBlobUri)
+			}
+
+			if metadata.ResourceData.HasChange("output_blob_uri") {
+				payload.Properties.OutputBlobUri = pointer.To(config.OutputBlobUri)
+			}
+
+			if metadata.ResourceData.HasChange("parameter") {
+				payload.Properties.Parameters = expandArcMachineRunCommandInputParameters(config.Parameter)
+			}
+
+			if metadata.ResourceData.HasChange("run_as_user") {
+				payload.Properties.RunAsUser = pointer.To(config.RunAsUser)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2025_01_13.MachineRunCommands
+
+			id, err := machineruncommands.ParseRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.MachineName, ArcMachineResourceName)
+			defer locks.UnlockByName(id.MachineName, ArcMachineResourceName)
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineRunCommandSource(input []ArcMachineRunCommandScriptSource) *machineruncommands.MachineRunCommandScriptSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	source := input[0]
+	output := &machineruncommands.MachineRunCommandScriptSource{
+		ScriptUriManagedIdentity: expandArcMachineRunCommandManagedIdentity(source.ScriptUriManagedIdentity),
+	}
+
+	if source.CommandId != "" {
+		output.CommandId = pointer.To(source.CommandId)
+	}
+	if source.Script != "" {
+		output.Script = pointer.To(source.Script)
+	}
+	if source.ScriptUri != "" {
+		output.ScriptUri = pointer.To(source.ScriptUri)
+	}
+
+	return output
+}
+
+func flattenArcMachineRunCommandSource(input *machineruncommands.MachineRunCommandScriptSource, config []ArcMachineRunCommandScriptSource) []ArcMachineRunCommandScriptSource {
+	if input == nil {
+		return []ArcMachineRunCommandScriptSource{}
+	}
+
+	output := ArcMachineRunCommandScriptSource{
+		CommandId: pointer.From(input.CommandId),
+		Script:    pointer.From(input.Script),
+		ScriptUri: pointer.From(input.ScriptUri),
+	}
+
+	if len(config) > 0 {
+		// SAS URIs and the Managed Identity used to access the script aren't returned by the API
+		if strings.Contains(config[0].ScriptUri, "sig=") {
+			output.ScriptUri = config[0].ScriptUri
+		}
+		output.ScriptUriManagedIdentity = config[0].ScriptUriManagedIdentity
+	}
+
+	return []ArcMachineRunCommandScriptSource{output}
+}
+
+func expandArcMachineRunCommandManagedIdentity(input []ArcMachineRunCommandManagedIdentity) *machineruncommands.RunCommandManagedIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &machineruncommands.RunCommandManagedIdentity{}
+	if input[0].ClientId != "" {
+		output.ClientId = pointer.To(input[0].ClientId)
+	}
+	if input[0].ObjectId != "" {
+		output.ObjectId = pointer.To(input[0].ObjectId)
+	}
+
+	return output
+}
+
+func expandArcMachineRunCommandInputParameters(input []ArcMachineRunCommandInputParameter) *[]machineruncommands.RunCommandInputParameter {
+	output := make([]machineruncommands.RunCommandInputParameter, 0)
+	for _, v := range input {
+		output = append(output, machineruncommands.RunCommandInputParameter{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return &output
+}
+
+func flattenArcMachineRunCommandInputParameters(input *[]machineruncommands.RunCommandInputParameter) []ArcMachineRunCommandInputParameter {
+	output := make([]ArcMachineRunCommandInputParameter, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ArcMachineRunCommandInputParameter{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return output
+}
+
+func flattenArcMachineRunCommandInstanceView(input *machineruncommands.MachineRunCommandInstanceView) []ArcMachineRunCommandInstanceView {
+	if input == nil {
+		return []ArcMachineRunCommandInstanceView{}
+	}
+
+	return []ArcMachineRunCommandInstanceView{
+		{
+			ExitCode:         pointer.From(input.ExitCode),
+			ExecutionState:   pointer.FromEnum(input.ExecutionState),
+			ExecutionMessage: pointer.From(input.ExecutionMessage),
+			Output:           pointer.From(input.Output),
+			ErrorMessage:     pointer.From(input.Error),
+			StartTime:        pointer.From(input.StartTime),
+			EndTime:          pointer.From(input.EndTime),
+		},
+	}
+}
@@ -11,12 +11,14 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privateendpointconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
 	hybridcompute_v2024_07_10 "github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10"
+	hybridcompute_v2025_01_13 "github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2025-01-13"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
 	HybridComputeClient_v2024_07_10  *hybridcompute_v2024_07_10.Client
+	HybridComputeClient_v2025_01_13  *hybridcompute_v2025_01_13.Client
 	MachineExtensionsClient          *machineextensions.MachineExtensionsClient
 	MachinesClient                   *machines.MachinesClient
 	PrivateEndpointConnectionsClient *privateendpointconnections.PrivateEndpointConnectionsClient
@@ -31,6 +33,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building Hybrid Compute client: %+v", err)
 	}
 
+	hybridComputeClient_v2025_01_13, err := hybridcompute_v2025_01_13.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building Hybrid Compute client: %+v", err)
+	}
+
 	machineExtensionsClient, err := machineextensions.NewMachineExtensionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building MachineExtensions client: %+v", err)
@@ -57,6 +66,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	return &Client{
 		HybridComputeClient_v2024_07_10:  hybridComputeClient_v2024_07_10,
+		HybridComputeClient_v2025_01_13:  hybridComputeClient_v2025_01_13,
 		MachineExtensionsClient:          machineExtensionsClient,
 		MachinesClient:                   machinesClient,
 		PrivateEndpointConnectionsClient: privateEndpointConnectionsClient,
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+)
+
+var _ resourceids.Id = ArcMachinePatchAssessmentId{}
+
+type ArcMachinePatchAssessmentId struct {
+	ArcMachineId machines.MachineId
+}
+
+func (v ArcMachinePatchAssessmentId) ID() string {
+	return fmt.Sprintf("%s/patchAssessment", v.ArcMachineId.ID())
+}
+
+func (v ArcMachinePatchAssessmentId) String() string {
+	components := []string{
+		fmt.Sprintf("ArcMachineId %s", v.ArcMachineId.ID()),
+	}
+	return fmt.Sprintf("Arc Machine Patch Assessment: %s", strings.Join(components, " / "))
+}
+
+func NewArcMachinePatchAssessmentID(arcMachineId machines.MachineId) ArcMachinePatchAssessmentId {
+	return ArcMachinePatchAssessmentId{
+		ArcMachineId: arcMachineId,
+	}
+}
+
+func ArcMachinePatchAssessmentID(input string) (*ArcMachinePatchAssessmentId, error) {
+	arcMachineIdRaw, ok := strings.CutSuffix(input, "/patchAssessment")
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {ArcMachineId}/patchAssessment but got %q", input)
+	}
+
+	arcMachineId, err := machines.ParseMachineID(arcMachineIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArcMachinePatchAssessmentId{
+		ArcMachineId: *arcMachineId,
+	}, nil
+}
+
+func ArcMachinePatchAssessmentIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ArcMachinePatchAssessmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+)
+
+func TestArcMachinePatchAssessmentId(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Expect *ArcMachinePatchAssessmentId
+		Error  bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Arc Machine ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/group1/providers/Microsoft.HybridCompute/machines/machine1",
+			Error: true,
+		},
+		{
+			Name:  "Invalid Arc Machine ID",
+			Input: "hello/patchAssessment",
+			Error: true,
+		},
+		{
+			Name:  "Arc Machine Patch Assessment ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/group1/providers/Microsoft.HybridCompute/machines/machine1/patchAssessment",
+			Error: false,
+			Expect: &ArcMachinePatchAssessmentId{
+				ArcMachineId: machines.MachineId{
+					SubscriptionId:    "00000000-0000-0000-0000-000000000001",
+					ResourceGroupName: "group1",
+					MachineName:       "machine1",
+				},
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ArcMachinePatchAssessmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ArcMachineId != v.Expect.ArcMachineId {
+			t.Fatalf("Expected %q but got %q for ArcMachineId", v.Expect.ArcMachineId, actual.ArcMachineId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
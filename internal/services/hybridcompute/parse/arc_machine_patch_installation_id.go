@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+)
+
+var _ resourceids.Id = ArcMachinePatchInstallationId{}
+
+type ArcMachinePatchInstallationId struct {
+	ArcMachineId machines.MachineId
+}
+
+func (v ArcMachinePatchInstallationId) ID() string {
+	return fmt.Sprintf("%s/patchInstallation", v.ArcMachineId.ID())
+}
+
+func (v ArcMachinePatchInstallationId) String() string {
+	components := []string{
+		fmt.Sprintf("ArcMachineId %s", v.ArcMachineId.ID()),
+	}
+	return fmt.Sprintf("Arc Machine Patch Installation: %s", strings.Join(components, " / "))
+}
+
+func NewArcMachinePatchInstallationID(arcMachineId machines.MachineId) ArcMachinePatchInstallationId {
+	return ArcMachinePatchInstallationId{
+		ArcMachineId: arcMachineId,
+	}
+}
+
+func ArcMachinePatchInstallationID(input string) (*ArcMachinePatchInstallationId, error) {
+	arcMachineIdRaw, ok := strings.CutSuffix(input, "/patchInstallation")
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {ArcMachineId}/patchInstallation but got %q", input)
+	}
+
+	arcMachineId, err := machines.ParseMachineID(arcMachineIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArcMachinePatchInstallationId{
+		ArcMachineId: *arcMachineId,
+	}, nil
+}
+
+func ArcMachinePatchInstallationIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ArcMachinePatchInstallationID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
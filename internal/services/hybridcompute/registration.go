@@ -56,6 +56,11 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ArcMachineResource{},
 		ArcMachineExtensionResource{},
+		ArcMachineLicenseResource{},
+		ArcMachineLicenseAssignmentResource{},
+		ArcMachinePatchAssessmentResource{},
+		ArcMachinePatchInstallationResource{},
+		ArcMachineRunCommandResource{},
 		ArcPrivateLinkScopeResource{},
 	}
 }
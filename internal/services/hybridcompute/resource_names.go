@@ -0,0 +1,6 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+var ArcMachineResourceName = "azurerm_arc_machine"
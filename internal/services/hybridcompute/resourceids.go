@@ -0,0 +1,6 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ArcMachineLicenseProfile -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HybridCompute/machines/machine1/licenseProfiles/default
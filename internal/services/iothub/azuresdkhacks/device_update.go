@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not yet include the Device Update for IoT Hub Data Plane
+// API, which is needed to import Updates into a Device Update Instance. Once the SDK supports this API, this can be
+// removed.
+
+const (
+	DeviceUpdateDataPlaneApiVersion = "2022-10-01"
+
+	// DeviceUpdateDataPlaneResourceIdentifier is the audience used to obtain a token for the Data Plane API, which
+	// differs from the host name of the Device Update Account.
+	DeviceUpdateDataPlaneResourceIdentifier = "https://api.adu.microsoft.com"
+)
+
+type DeviceUpdateClient struct {
+	client *dataplane.Client
+}
+
+func NewDeviceUpdateClient(client *dataplane.Client) DeviceUpdateClient {
+	return DeviceUpdateClient{
+		client: client,
+	}
+}
+
+type ImportUpdateInputItem struct {
+	Files          *[]FileImportMetadata  `json:"files,omitempty"`
+	FriendlyName   *string                `json:"friendlyName,omitempty"`
+	ImportManifest ImportManifestMetadata `json:"importManifest"`
+}
+
+type ImportManifestMetadata struct {
+	Hashes      map[string]string `json:"hashes"`
+	SizeInBytes int64             `json:"sizeInBytes"`
+	Url         string            `json:"url"`
+}
+
+type FileImportMetadata struct {
+	Filename string `json:"filename"`
+	Url      string `json:"url"`
+}
+
+type UpdateId struct {
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	Version  string `json:"version"`
+}
+
+type UpdateInfo struct {
+	Description  *string  `json:"description,omitempty"`
+	FriendlyName *string  `json:"friendlyName,omitempty"`
+	UpdateId     UpdateId `json:"updateId"`
+}
+
+type Update struct {
+	Description       *string  `json:"description,omitempty"`
+	ETag              *string  `json:"etag,omitempty"`
+	FriendlyName      *string  `json:"friendlyName,omitempty"`
+	ImportedDateTime  *string  `json:"importedDateTime,omitempty"`
+	InstalledCriteria *string  `json:"installedCriteria,omitempty"`
+	IsDeployable      *bool    `json:"isDeployable,omitempty"`
+	ManifestVersion   *string  `json:"manifestVersion,omitempty"`
+	UpdateId          UpdateId `json:"updateId"`
+	UpdateType        *string  `json:"updateType,omitempty"`
+}
+
+type UpdateOperationStatus string
+
+const (
+	UpdateOperationStatusFailed     UpdateOperationStatus = "Failed"
+	UpdateOperationStatusNotStarted UpdateOperationStatus = "NotStarted"
+	UpdateOperationStatusRunning    UpdateOperationStatus = "Running"
+	UpdateOperationStatusSucceeded  UpdateOperationStatus = "Succeeded"
+)
+
+type UpdateOperation struct {
+	Error       *UpdateOperationError `json:"error,omitempty"`
+	OperationId string                `json:"operationId"`
+	Status      UpdateOperationStatus `json:"status"`
+	Update      *UpdateInfo           `json:"update,omitempty"`
+}
+
+type UpdateOperationError struct {
+	Code    string                  `json:"code"`
+	Details *[]UpdateOperationError `json:"details,omitempty"`
+	Message string                  `json:"message"`
+}
+
+type UpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+
+	// OperationPath is the path of the Operation which should be polled for the outcome, taken from the
+	// `Operation-Location` header.
+	OperationPath string
+}
+
+type GetUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *Update
+}
+
+type GetOperationOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *UpdateOperation
+}
+
+type deviceUpdateOperationOptions struct{}
+
+func (o deviceUpdateOperationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o deviceUpdateOperationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o deviceUpdateOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", DeviceUpdateDataPlaneApiVersion)
+	return &out
+}
+
+func (c DeviceUpdateClient) ImportUpdate(ctx context.Context, instanceName string, input []ImportUpdateInputItem) (result UpdateOperationResponse, err error) {
+	path := fmt.Sprintf("/deviceUpdate/%s/updates:import", instanceName)
+	resp, err := c.execute(ctx, http.MethodPost, path, input, []int{http.StatusAccepted})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.OperationPath, err = operationPathFromResponse(resp.Response)
+	return
+}
+
+func (c DeviceUpdateClient) GetUpdate(ctx context.Context, instanceName string, updateId UpdateId) (result GetUpdateOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, updatePath(instanceName, updateId), nil, []int{http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model Update
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c DeviceUpdateClient) DeleteUpdate(ctx context.Context, instanceName string, updateId UpdateId) (result UpdateOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, updatePath(instanceName, updateId), nil, []int{http.StatusAccepted})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.OperationPath, err = operationPathFromResponse(resp.Response)
+	return
+}
+
+// GetOperation retrieves the status of an Import or Delete Operation, where `operationPath` is the path returned
+// within the `Operation-Location` header.
+func (c DeviceUpdateClient) GetOperation(ctx context.Context, operationPath string) (result GetOperationOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, operationPath, nil, []int{http.StatusOK})
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model UpdateOperation
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c DeviceUpdateClient) execute(ctx context.Context, method string, path string, input interface{}, expectedStatusCodes []int) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		OptionsObject:       deviceUpdateOperationOptions{},
+		Path:                path,
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		if err = req.Marshal(input); err != nil {
+			return nil, err
+		}
+	}
+
+	return req.Execute(ctx)
+}
+
+func updatePath(instanceName string, updateId UpdateId) string {
+	return fmt.Sprintf("/deviceUpdate/%s/updates/providers/%s/names/%s/versions/%s", instanceName, updateId.Provider, updateId.Name, updateId.Version)
+}
+
+func operationPathFromResponse(resp *http.Response) (string, error) {
+	if resp == nil {
+		return "", fmt.Errorf("the response was nil")
+	}
+
+	location := resp.Header.Get("Operation-Location")
+	if location == "" {
+		return "", fmt.Errorf("the `Operation-Location` header was missing from the response")
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("parsing the `Operation-Location` header %q: %+v", location, err)
+	}
+
+	return u.Path, nil
+}
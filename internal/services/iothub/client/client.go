@@ -9,7 +9,10 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/dpscertificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/iotdpsresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/azuresdkhacks"
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
@@ -19,6 +22,8 @@ type Client struct {
 	DeviceUpdatesClient     *deviceupdates.DeviceupdatesClient
 	DPSResourceClient       *iotdpsresource.IotDpsResourceClient
 	DPSCertificateClient    *dpscertificate.DpsCertificateClient
+
+	o *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -52,5 +57,25 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DeviceUpdatesClient:     DeviceUpdatesClient,
 		DPSResourceClient:       DPSResourceClient,
 		DPSCertificateClient:    DPSCertificateClient,
+		o:                       o,
 	}, nil
 }
+
+// DeviceUpdateDataPlaneClient returns a client for the Device Update Data Plane API, using the host name of a Device
+// Update Account as the endpoint.
+func (c *Client) DeviceUpdateDataPlaneClient(endpoint string) (*azuresdkhacks.DeviceUpdateClient, error) {
+	api := environments.NewApiEndpoint("DeviceUpdate", endpoint, nil).WithResourceIdentifier(azuresdkhacks.DeviceUpdateDataPlaneResourceIdentifier)
+	deviceUpdateAuth, err := c.o.Authorizers.AuthorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", endpoint, err)
+	}
+
+	dataPlaneClient, err := dataplane.NewClient(endpoint, "deviceupdate", azuresdkhacks.DeviceUpdateDataPlaneApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building Device Update Data Plane client for %q: %+v", endpoint, err)
+	}
+	c.o.Configure(dataPlaneClient, deviceUpdateAuth)
+
+	client := azuresdkhacks.NewDeviceUpdateClient(dataPlaneClient)
+	return &client, nil
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/azuresdkhacks"
+)
+
+var _ pollers.PollerType = &DeviceUpdateOperationPoller{}
+
+// DeviceUpdateOperationPoller polls an Import or Delete Operation within a Device Update Instance. The Data Plane API
+// returns the Operation within the `Operation-Location` header, which isn't supported by the Data Plane pollers
+// within the SDK.
+type DeviceUpdateOperationPoller struct {
+	client        azuresdkhacks.DeviceUpdateClient
+	operationPath string
+
+	// Operation is the last Operation which was retrieved, which for an Import contains the ID of the imported Update
+	Operation *azuresdkhacks.UpdateOperation
+}
+
+func NewDeviceUpdateOperationPoller(client azuresdkhacks.DeviceUpdateClient, operationPath string) *DeviceUpdateOperationPoller {
+	return &DeviceUpdateOperationPoller{
+		client:        client,
+		operationPath: operationPath,
+	}
+}
+
+func (p *DeviceUpdateOperationPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.GetOperation(ctx, p.operationPath)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Operation %q: %+v", p.operationPath, err)
+	}
+
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving Operation %q: `model` was nil", p.operationPath)
+	}
+	p.Operation = resp.Model
+
+	result := &pollers.PollResult{
+		PollInterval: 15 * time.Second,
+	}
+
+	switch resp.Model.Status {
+	case azuresdkhacks.UpdateOperationStatusNotStarted, azuresdkhacks.UpdateOperationStatusRunning:
+		result.Status = pollers.PollingStatusInProgress
+		return result, nil
+
+	case azuresdkhacks.UpdateOperationStatusSucceeded:
+		result.Status = pollers.PollingStatusSucceeded
+		return result, nil
+
+	case azuresdkhacks.UpdateOperationStatusFailed:
+		message := "no error was returned"
+		if e := resp.Model.Error; e != nil {
+			message = fmt.Sprintf("%s: %s", e.Code, e.Message)
+			if e.Details != nil {
+				for _, detail := range *e.Details {
+					message += fmt.Sprintf("\n%s: %s", detail.Code, detail.Message)
+				}
+			}
+		}
+		return nil, pollers.PollingFailedError{
+			Message: fmt.Sprintf("Operation %q failed: %s", p.operationPath, message),
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected status %q for Operation %q", string(resp.Model.Status), p.operationPath)
+}
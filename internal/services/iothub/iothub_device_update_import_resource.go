@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type IotHubDeviceUpdateImportResource struct{}
+
+var _ sdk.Resource = IotHubDeviceUpdateImportResource{}
+
+type IotHubDeviceUpdateImportModel struct {
+	DeviceUpdateInstanceId string                      `tfschema:"device_update_instance_id"`
+	ImportManifest         []DeviceUpdateManifestModel `tfschema:"import_manifest"`
+	File                   []DeviceUpdateFileModel     `tfschema:"file"`
+	FriendlyName           string                      `tfschema:"friendly_name"`
+	UpdateProvider         string                      `tfschema:"update_provider"`
+	Name                   string                      `tfschema:"name"`
+	Version                string                      `tfschema:"version"`
+	Deployable             bool                        `tfschema:"deployable"`
+	UpdateType             string                      `tfschema:"update_type"`
+}
+
+type DeviceUpdateManifestModel struct {
+	Url         string `tfschema:"url"`
+	Sha256Hash  string `tfschema:"sha256_hash"`
+	SizeInBytes int64  `tfschema:"size_in_bytes"`
+}
+
+type DeviceUpdateFileModel struct {
+	Name string `tfschema:"name"`
+	Url  string `tfschema:"url"`
+}
+
+func (r IotHubDeviceUpdateImportResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"device_update_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: deviceupdates.ValidateInstanceID,
+		},
+
+		"import_manifest": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"sha256_hash": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"size_in_bytes": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"file": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+		},
+
+		"friendly_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+	}
+}
+
+func (r IotHubDeviceUpdateImportResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"update_provider": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"deployable": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"update_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r IotHubDeviceUpdateImportResource) ResourceType() string {
+	return "azurerm_iothub_device_update_import"
+}
+
+func (r IotHubDeviceUpdateImportResource) ModelObject() interface{} {
+	return &IotHubDeviceUpdateImportModel{}
+}
+
+func (r IotHubDeviceUpdateImportResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.DeviceUpdateImportID
+}
+
+func (r IotHubDeviceUpdateImportResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model IotHubDeviceUpdateImportModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			instanceId, err := deviceupdates.ParseInstanceID(model.DeviceUpdateInstanceId)
+			if err != nil {
+				return err
+			}
+
+			client, err := deviceUpdateDataPlaneClientForInstance(ctx, metadata, *instanceId)
+			if err != nil {
+				return err
+			}
+
+			// the Provider, Name and Version of the Update are defined within the Import Manifest, so aren't known until
+			// the Import has completed - as such an existing Update is surfaced by the Import Operation failing
+			input := []azuresdkhacks.ImportUpdateInputItem{
+				{
+					Files:          expandDeviceUpdateFiles(model.File),
+					ImportManifest: expandDeviceUpdateManifest(model.ImportManifest),
+				},
+			}
+			if model.FriendlyName != "" {
+				input[0].FriendlyName = pointer.To(model.FriendlyName)
+			}
+
+			resp, err := client.ImportUpdate(ctx, instanceId.InstanceName, input)
+			if err != nil {
+				return fmt.Errorf("importing Update into %s: %+v", *instanceId, err)
+			}
+
+			pollerType := custompollers.NewDeviceUpdateOperationPoller(*client, resp.OperationPath)
+			poller := pollers.NewPoller(pollerType, 15*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the Update to be imported into %s: %+v", *instanceId, err)
+			}
+
+			if pollerType.Operation == nil || pollerType.Operation.Update == nil {
+				return fmt.Errorf("importing Update into %s: the Operation didn't return the imported Update", *instanceId)
+			}
+
+			updateId := pollerType.Operation.Update.UpdateId
+			id := parse.NewDeviceUpdateImportID(*instanceId, updateId.Provider, updateId.Name, updateId.Version)
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r IotHubDeviceUpdateImportResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.DeviceUpdateImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Update is removed along with the Device Update Instance
+			instanceId := id.InstanceId()
+			instance, err := metadata.Client.IoTHub.DeviceUpdatesClient.InstancesGet(ctx, instanceId)
+			if err != nil {
+				if response.WasNotFound(instance.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", instanceId, err)
+			}
+
+			client, err := deviceUpdateDataPlaneClientForInstance(ctx, metadata, instanceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetUpdate(ctx, id.InstanceName, updateIdFromDeviceUpdateImportId(*id))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config IotHubDeviceUpdateImportModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Import Manifest and Files aren't returned by the API, so these are retained from the config
+			state := IotHubDeviceUpdateImportModel{
+				DeviceUpdateInstanceId: instanceId.ID(),
+				ImportManifest:         config.ImportManifest,
+				File:                   config.File,
+				UpdateProvider:         id.Provider,
+				Name:                   id.Name,
+				Version:                id.Version,
+			}
+
+			if model := resp.Model; model != nil {
+				state.FriendlyName = pointer.From(model.FriendlyName)
+				state.Deployable = pointer.From(model.IsDeployable)
+				state.UpdateType = pointer.From(model.UpdateType)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r IotHubDeviceUpdateImportResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.DeviceUpdateImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := deviceUpdateDataPlaneClientForInstance(ctx, metadata, id.InstanceId())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.DeleteUpdate(ctx, id.InstanceName, updateIdFromDeviceUpdateImportId(*id))
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			pollerType := custompollers.NewDeviceUpdateOperationPoller(*client, resp.OperationPath)
+			poller := pollers.NewPoller(pollerType, 15*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// deviceUpdateDataPlaneClientForInstance returns a Data Plane client using the host name of the Device Update Account
+// which contains the specified Instance.
+func deviceUpdateDataPlaneClientForInstance(ctx context.Context, metadata sdk.ResourceMetaData, instanceId deviceupdates.InstanceId) (*azuresdkhacks.DeviceUpdateClient, error) {
+	accountId := deviceupdates.NewAccountID(instanceId.SubscriptionId, instanceId.ResourceGroupName, instanceId.AccountName)
+	account, err := metadata.Client.IoTHub.DeviceUpdatesClient.AccountsGet(ctx, accountId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	hostName := ""
+	if model := account.Model; model != nil && model.Properties != nil {
+		hostName = pointer.From(model.Properties.HostName)
+	}
+	if hostName == "" {
+		return nil, fmt.Errorf("retrieving %s: `properties.hostName` was nil", accountId)
+	}
+
+	client, err := metadata.Client.IoTHub.DeviceUpdateDataPlaneClient(fmt.Sprintf("https://%s", hostName))
+	if err != nil {
+		return nil, fmt.Errorf("building Data Plane client for %s: %+v", accountId, err)
+	}
+
+	return client, nil
+}
+
+func updateIdFromDeviceUpdateImportId(id parse.DeviceUpdateImportId) azuresdkhacks.UpdateId {
+	return azuresdkhacks.UpdateId{
+		Provider: id.Provider,
+		Name:     id.Name,
+		Version:  id.Version,
+	}
+}
+
+func expandDeviceUpdateManifest(input []DeviceUpdateManifestModel) azuresdkhacks.ImportManifestMetadata {
+	if len(input) == 0 {
+		return azuresdkhacks.ImportManifestMetadata{}
+	}
+
+	return azuresdkhacks.ImportManifestMetadata{
+		Hashes: map[string]string{
+			"sha256": input[0].Sha256Hash,
+		},
+		SizeInBytes: input[0].SizeInBytes,
+		Url:         input[0].Url,
+	}
+}
+
+func expandDeviceUpdateFiles(input []DeviceUpdateFileModel) *[]azuresdkhacks.FileImportMetadata {
+	output := make([]azuresdkhacks.FileImportMetadata, 0)
+	for _, v := range input {
+		output = append(output, azuresdkhacks.FileImportMetadata{
+			Filename: v.Name,
+			Url:      v.Url,
+		})
+	}
+
+	return &output
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type IotHubDeviceUpdateImportResource struct{}
+
+func TestAccIotHubDeviceUpdateImport_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_device_update_import", "test")
+	r := IotHubDeviceUpdateImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("update_provider").HasValue("Contoso"),
+				check.That(data.ResourceName).Key("name").HasValue("Toaster"),
+				check.That(data.ResourceName).Key("version").HasValue("1.0.0"),
+			),
+		},
+		data.ImportStep("import_manifest", "file"),
+	})
+}
+
+func TestAccIotHubDeviceUpdateImport_friendlyName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_device_update_import", "test")
+	r := IotHubDeviceUpdateImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.friendlyName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("friendly_name").HasValue("Toaster Firmware"),
+			),
+		},
+		data.ImportStep("import_manifest", "file"),
+	})
+}
+
+func (r IotHubDeviceUpdateImportResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DeviceUpdateImportID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountId := deviceupdates.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName)
+	accountResp, err := clients.IoTHub.DeviceUpdatesClient.AccountsGet(ctx, accountId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+	if accountResp.Model == nil || accountResp.Model.Properties == nil || accountResp.Model.Properties.HostName == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.hostName` was nil", accountId)
+	}
+
+	client, err := clients.IoTHub.DeviceUpdateDataPlaneClient(fmt.Sprintf("https://%s", *accountResp.Model.Properties.HostName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetUpdate(ctx, id.InstanceName, azuresdkhacks.UpdateId{
+		Provider: id.Provider,
+		Name:     id.Name,
+		Version:  id.Version,
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r IotHubDeviceUpdateImportResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_iothub_device_update_account.test.id
+  role_definition_name = "Device Update Administrator"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "time_sleep" "wait_for_role_assignment" {
+  depends_on = [azurerm_role_assignment.test]
+
+  create_duration = "60s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "updates"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "manifest" {
+  name                   = "device_update_import_manifest.json"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "testdata/device_update_import_manifest.json"
+}
+
+resource "azurerm_storage_blob" "payload" {
+  name                   = "device_update_payload.swu"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "testdata/device_update_payload.swu"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "2025-01-01"
+  expiry = "2030-01-01"
+
+  permissions {
+    read   = true
+    add    = false
+    create = false
+    write  = false
+    delete = false
+    list   = false
+  }
+}
+
+resource "azurerm_iothub_device_update_instance" "test" {
+  name                     = "acc-dui-%[2]s"
+  device_update_account_id = azurerm_iothub_device_update_account.test.id
+  iothub_id                = azurerm_iothub.test.id
+}
+`, IotHubDeviceUpdateInstanceResource{}.template(data), data.RandomString)
+}
+
+func (r IotHubDeviceUpdateImportResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_device_update_import" "test" {
+  device_update_instance_id = azurerm_iothub_device_update_instance.test.id
+
+  import_manifest {
+    url           = "${azurerm_storage_blob.manifest.url}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+    sha256_hash   = filebase64sha256("testdata/device_update_import_manifest.json")
+    size_in_bytes = length(file("testdata/device_update_import_manifest.json"))
+  }
+
+  file {
+    name = azurerm_storage_blob.payload.name
+    url  = "${azurerm_storage_blob.payload.url}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+  }
+
+  depends_on = [time_sleep.wait_for_role_assignment]
+}
+`, r.template(data))
+}
+
+func (r IotHubDeviceUpdateImportResource) friendlyName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_device_update_import" "test" {
+  device_update_instance_id = azurerm_iothub_device_update_instance.test.id
+  friendly_name             = "Toaster Firmware"
+
+  import_manifest {
+    url           = "${azurerm_storage_blob.manifest.url}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+    sha256_hash   = filebase64sha256("testdata/device_update_import_manifest.json")
+    size_in_bytes = length(file("testdata/device_update_import_manifest.json"))
+  }
+
+  file {
+    name = azurerm_storage_blob.payload.name
+    url  = "${azurerm_storage_blob.payload.url}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+  }
+
+  depends_on = [time_sleep.wait_for_role_assignment]
+}
+`, r.template(data))
+}
@@ -258,7 +258,7 @@ func (r IotHubEndpointCosmosDBAccountResource) Create() sdk.ResourceFunc {
 			endpoints = append(endpoints, cosmosDBAccountEndpoint)
 			routing.Endpoints.CosmosDBSQLCollections = &endpoints
 
-			future, err := client.CreateOrUpdate(ctx, iotHubId.ResourceGroup, iotHubId.Name, iothub, "")
+			future, err := client.CreateOrUpdate(ctx, iotHubId.ResourceGroup, iotHubId.Name, iothub, pointer.From(iothub.Etag))
 			if err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
@@ -430,7 +430,7 @@ func (r IotHubEndpointCosmosDBAccountResource) Update() sdk.ResourceFunc {
 
 					(*iothub.Properties.Routing.Endpoints.CosmosDBSQLCollections)[i] = endpoint
 
-					future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "")
+					future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, pointer.From(iothub.Etag))
 					if err != nil {
 						return fmt.Errorf("updating %s: %+v", id, err)
 					}
@@ -481,7 +481,7 @@ func (r IotHubEndpointCosmosDBAccountResource) Delete() sdk.ResourceFunc {
 			}
 			iothub.Properties.Routing.Endpoints.CosmosDBSQLCollections = &updatedEndpoints
 
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "")
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, pointer.From(iothub.Etag))
 			if err != nil {
 				return fmt.Errorf("deleting %s: %+v", id, err)
 			}
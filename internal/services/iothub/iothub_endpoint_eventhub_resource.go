@@ -236,7 +236,7 @@ func resourceIotHubEndpointEventHubCreateUpdate(d *pluginsdk.ResourceData, meta
 	}
 	routing.Endpoints.EventHubs = &endpoints
 
-	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
@@ -368,7 +368,7 @@ func resourceIotHubEndpointEventHubDelete(d *pluginsdk.ResourceData, meta interf
 	}
 	iothub.Properties.Routing.Endpoints.EventHubs = &updatedEndpoints
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
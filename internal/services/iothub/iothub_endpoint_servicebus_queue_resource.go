@@ -238,7 +238,7 @@ func resourceIotHubEndpointServiceBusQueueCreateUpdate(d *pluginsdk.ResourceData
 	}
 	routing.Endpoints.ServiceBusQueues = &endpoints
 
-	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
@@ -374,7 +374,7 @@ func resourceIotHubEndpointServiceBusQueueDelete(d *pluginsdk.ResourceData, meta
 
 	iothub.Properties.Routing.Endpoints.ServiceBusQueues = &updatedEndpoints
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -236,7 +236,7 @@ func resourceIotHubEndpointServiceBusTopicCreateUpdate(d *pluginsdk.ResourceData
 	}
 	routing.Endpoints.ServiceBusTopics = &endpoints
 
-	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
@@ -371,7 +371,7 @@ func resourceIotHubEndpointServiceBusTopicDelete(d *pluginsdk.ResourceData, meta
 	}
 	iothub.Properties.Routing.Endpoints.ServiceBusTopics = &updatedEndpoints
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -278,7 +278,7 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 	}
 	routing.Endpoints.StorageContainers = &endpoints
 
-	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, iotHubRG, iotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
@@ -412,7 +412,7 @@ func resourceIotHubEndpointStorageContainerDelete(d *pluginsdk.ResourceData, met
 	}
 	iothub.Properties.Routing.Endpoints.StorageContainers = &updatedEndpoints
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -197,7 +197,7 @@ func (r IotHubFileUploadResource) Create() sdk.ResourceFunc {
 			iotHub.Properties.MessagingEndpoints = messagingEndpointProperties
 			iotHub.Properties.StorageEndpoints = storageEndpointProperties
 
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iotHub, "")
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iotHub, pointer.From(iotHub.Etag))
 			if err != nil {
 				return fmt.Errorf("creating %q: %+v", id, err)
 			}
@@ -379,7 +379,7 @@ func (r IotHubFileUploadResource) Update() sdk.ResourceFunc {
 				storageEndpoint.SasTTLAsIso8601 = pointer.To(state.SasTTL)
 			}
 
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing, "")
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing, pointer.From(existing.Etag))
 			if err != nil {
 				return fmt.Errorf("updating %q: %+v", id, err)
 			}
@@ -414,7 +414,7 @@ func (r IotHubFileUploadResource) Delete() sdk.ResourceFunc {
 				}
 			}
 
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing, "")
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing, pointer.From(existing.Etag))
 			if err != nil {
 				return fmt.Errorf("deleting %q: %+v", id, err)
 			}
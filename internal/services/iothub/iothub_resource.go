@@ -944,7 +944,7 @@ func resourceIotHubUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	iothub.Properties = &prop
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iothub, "")
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iothub, pointer.From(iothub.Etag))
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
 type IotHubRouteResource struct{}
@@ -73,6 +74,32 @@ func TestAccIotHubRoute_update(t *testing.T) {
 	})
 }
 
+func TestAccIotHubRoute_outOfBandChange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_route", "test")
+	r := IotHubRouteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// a write using an outdated ETag must be rejected, rather than overwriting the IoT Hub
+				data.CheckWithClient(r.staleETagIsRejected),
+				data.CheckWithClient(r.addOutOfBandEnrichment),
+			),
+		},
+		{
+			// the route is written using the ETag of the IoT Hub after the out-of-band change, which must be retained
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.outOfBandEnrichmentExists),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t IotHubRouteResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RouteID(state.ID)
 	if err != nil {
@@ -97,6 +124,88 @@ func (t IotHubRouteResource) Exists(ctx context.Context, clients *clients.Client
 	return pointer.To(false), nil
 }
 
+func (IotHubRouteResource) staleETagIsRejected(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.RouteID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	client := clients.IoTHub.ResourceClient
+	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
+	if err != nil {
+		return fmt.Errorf("retrieving IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+
+	if pointer.From(iothub.Etag) == "" {
+		return fmt.Errorf("expected IotHub %q (Resource Group %q) to have an ETag", id.IotHubName, id.ResourceGroup)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "AAAAAAAAAAA="); err == nil {
+		return fmt.Errorf("expected updating IotHub %q (Resource Group %q) using an outdated ETag to fail", id.IotHubName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func (IotHubRouteResource) addOutOfBandEnrichment(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.RouteID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	client := clients.IoTHub.ResourceClient
+	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
+	if err != nil {
+		return fmt.Errorf("retrieving IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+	if iothub.Properties == nil || iothub.Properties.Routing == nil {
+		return fmt.Errorf("retrieving IotHub %q (Resource Group %q): `properties.routing` was nil", id.IotHubName, id.ResourceGroup)
+	}
+
+	enrichments := make([]devices.EnrichmentProperties, 0)
+	if existing := iothub.Properties.Routing.Enrichments; existing != nil {
+		enrichments = append(enrichments, *existing...)
+	}
+	enrichments = append(enrichments, devices.EnrichmentProperties{
+		Key:           pointer.To("outofband"),
+		Value:         pointer.To("$twin.tags.outofband"),
+		EndpointNames: &[]string{"acctest"},
+	})
+	iothub.Properties.Routing.Enrichments = &enrichments
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, pointer.From(iothub.Etag))
+	if err != nil {
+		return fmt.Errorf("updating IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func (IotHubRouteResource) outOfBandEnrichmentExists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.RouteID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	iothub, err := clients.IoTHub.ResourceClient.Get(ctx, id.ResourceGroup, id.IotHubName)
+	if err != nil {
+		return fmt.Errorf("retrieving IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+
+	if props := iothub.Properties; props != nil && props.Routing != nil && props.Routing.Enrichments != nil {
+		for _, enrichment := range *props.Routing.Enrichments {
+			if pointer.From(enrichment.Key) == "outofband" {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("expected the out-of-band Enrichment on IotHub %q (Resource Group %q) to be retained", id.IotHubName, id.ResourceGroup)
+}
+
 func (r IotHubRouteResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
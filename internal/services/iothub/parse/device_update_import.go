@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+)
+
+var _ resourceids.Id = DeviceUpdateImportId{}
+
+// DeviceUpdateImportId is the ID of an Update which has been imported into a Device Update Instance. Updates are only
+// available from the Data Plane API, so this is made up of the Resource Manager ID of the Device Update Instance
+// followed by the Provider, Name and Version of the Update.
+type DeviceUpdateImportId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	AccountName       string
+	InstanceName      string
+	Provider          string
+	Name              string
+	Version           string
+}
+
+func NewDeviceUpdateImportID(instanceId deviceupdates.InstanceId, provider, name, version string) DeviceUpdateImportId {
+	return DeviceUpdateImportId{
+		SubscriptionId:    instanceId.SubscriptionId,
+		ResourceGroupName: instanceId.ResourceGroupName,
+		AccountName:       instanceId.AccountName,
+		InstanceName:      instanceId.InstanceName,
+		Provider:          provider,
+		Name:              name,
+		Version:           version,
+	}
+}
+
+func (id DeviceUpdateImportId) InstanceId() deviceupdates.InstanceId {
+	return deviceupdates.NewInstanceID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.InstanceName)
+}
+
+func (id DeviceUpdateImportId) ID() string {
+	fmtString := "%s/updates/providers/%s/names/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.InstanceId().ID(), id.Provider, id.Name, id.Version)
+}
+
+func (id DeviceUpdateImportId) String() string {
+	segments := []string{
+		fmt.Sprintf("Provider %q", id.Provider),
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Version %q", id.Version),
+		fmt.Sprintf("Instance Name %q", id.InstanceName),
+		fmt.Sprintf("Account Name %q", id.AccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Device Update Import", segmentsStr)
+}
+
+// DeviceUpdateImportID parses a Device Update Import ID into a DeviceUpdateImportId struct
+func DeviceUpdateImportID(input string) (*DeviceUpdateImportId, error) {
+	// the Update segments contain a second `providers` segment, so these are split off before parsing the Instance ID
+	index := strings.LastIndex(input, "/updates/providers/")
+	if index == -1 {
+		return nil, fmt.Errorf("parsing %q as a Device Update Import ID: expected the ID to contain the segment `/updates/providers/`", input)
+	}
+
+	instanceId, err := deviceupdates.ParseInstanceID(input[:index])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Device Update Import ID: %+v", input, err)
+	}
+
+	// {provider}/names/{name}/versions/{version}
+	components := strings.Split(input[index+len("/updates/providers/"):], "/")
+	if len(components) != 5 || components[1] != "names" || components[3] != "versions" {
+		return nil, fmt.Errorf("parsing %q as a Device Update Import ID: expected the Update segments in the format `updates/providers/{provider}/names/{name}/versions/{version}`", input)
+	}
+
+	for _, v := range []string{components[0], components[2], components[4]} {
+		if v == "" {
+			return nil, fmt.Errorf("parsing %q as a Device Update Import ID: the Provider, Name and Version of the Update must not be empty", input)
+		}
+	}
+
+	id := NewDeviceUpdateImportID(*instanceId, components[0], components[2], components[4])
+	return &id, nil
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+)
+
+var _ resourceids.Id = DeviceUpdateImportId{}
+
+func TestDeviceUpdateImportIDFormatter(t *testing.T) {
+	instanceId := deviceupdates.NewInstanceID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "instance1")
+	actual := NewDeviceUpdateImportID(instanceId, "contoso", "toaster", "1.0.0").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DeviceUpdate/accounts/account1/instances/instance1/updates/providers/contoso/names/toaster/versions/1.0.0"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDeviceUpdateImportID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DeviceUpdateImportId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// instance id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DeviceUpdate/accounts/account1/instances/instance1",
+			Error: true,
+		},
+
+		{
+			// missing instance
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DeviceUpdate/accounts/account1/updates/providers/contoso/names/toaster/versions/1.0.0",
+			Error: true,
+		},
+
+		{
+			// missing version value
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DeviceUpdate/accounts/account1/instances/instance1/updates/providers/contoso/names/toaster/versions/",
+			Error: true,
+		},
+
+		{
+			// wrong segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DeviceUpdate/accounts/account1/instances/instance1/updates/providers/contoso/name/toaster/versions/1.0.0",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DeviceUpdate/accounts/account1/instances/instance1/updates/providers/contoso/names/toaster/versions/1.0.0",
+			Expected: &DeviceUpdateImportId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroupName: "resGroup1",
+				AccountName:       "account1",
+				InstanceName:      "instance1",
+				Provider:          "contoso",
+				Name:              "toaster",
+				Version:           "1.0.0",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DeviceUpdateImportID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
@@ -71,6 +71,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		IotHubDeviceUpdateAccountResource{},
 		IotHubDeviceUpdateInstanceResource{},
+		IotHubDeviceUpdateImportResource{},
 		IotHubFileUploadResource{},
 		IotHubEndpointCosmosDBAccountResource{},
 	}
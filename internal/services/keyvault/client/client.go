@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/privateendpointconnections"
 	vaults20230701 "github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/vaults"
 	resources20151101 "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2015-11-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -24,6 +25,8 @@ type Client struct {
 
 	ManagementClient *dataplane.BaseClient // TODO: we should rename this DataPlaneClient in time
 
+	PrivateEndpointConnectionsClient *privateendpointconnections.PrivateEndpointConnectionsClient
+
 	// NOTE: @tombuildsstuff: this client is intentionally internal-only so that it's not used directly
 	resources20151101Client *resources20151101.ResourcesClient
 
@@ -45,6 +48,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(updatedVaultsClient.Client, o.Authorizers.ResourceManager)
 
+	privateEndpointConnectionsClient, err := privateendpointconnections.NewPrivateEndpointConnectionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Private Endpoint Connections client: %+v", err)
+	}
+	o.Configure(privateEndpointConnectionsClient.Client, o.Authorizers.ResourceManager)
+
 	resources20151101Client, err := resources20151101.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building legacy Resources client: %+v", err)
@@ -58,8 +67,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
 	return &Client{
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
+		ManagementClient:                 &managementClient,
+		PrivateEndpointConnectionsClient: privateEndpointConnectionsClient,
+		VaultsClient:                     &vaultsClient,
 
 		// intentionally internal to this package for now, see above.
 		resources20151101Client: resources20151101Client,
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/propagation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -74,6 +75,8 @@ func resourceKeyVaultAccessPolicy() *pluginsdk.Resource {
 			"secret_permissions": schemaSecretPermissions(),
 
 			"storage_permissions": schemaStoragePermissions(),
+
+			"propagation_wait": propagation.Schema(),
 		},
 	}
 }
@@ -180,7 +183,12 @@ func resourceKeyVaultAccessPolicyCreate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	d.SetId(id.ID())
-	return nil
+
+	propagationWait, err := propagation.Expand(d.Get("propagation_wait").([]interface{}))
+	if err != nil {
+		return err
+	}
+	return propagation.WaitFor(ctx, propagationWait, id.String(), accessPolicyPropagationCheckFunc(client, *keyVaultId, objectId, applicationId))
 }
 
 func resourceKeyVaultAccessPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -253,6 +261,14 @@ func resourceKeyVaultAccessPolicyUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("failed waiting for update of Access Policy (Object ID: %q) for %s: %+v", id.ObjectID(), keyVaultId, err)
 	}
 
+	if d.HasChanges("certificate_permissions", "key_permissions", "secret_permissions", "storage_permissions") {
+		propagationWait, err := propagation.Expand(d.Get("propagation_wait").([]interface{}))
+		if err != nil {
+			return err
+		}
+		return propagation.WaitFor(ctx, propagationWait, id.String(), accessPolicyPropagationCheckFunc(client, keyVaultId, id.ObjectID(), id.ApplicationId()))
+	}
+
 	return nil
 }
 
@@ -427,3 +443,21 @@ func accessPolicyRefreshFunc(ctx context.Context, client *vaults.VaultsClient, k
 		return "notfound", "notfound", nil
 	}
 }
+
+// accessPolicyPropagationCheckFunc checks that the Access Policy is present on the Key Vault, this is polled until it's
+// been returned consistently for the number of checks configured in the `propagation_wait` block
+func accessPolicyPropagationCheckFunc(client *vaults.VaultsClient, keyVaultId commonids.KeyVaultId, objectId string, applicationId string) propagation.CheckFunc {
+	return func(ctx context.Context) (bool, error) {
+		read, err := client.Get(ctx, keyVaultId)
+		if err != nil {
+			return false, fmt.Errorf("retrieving %s: %+v", keyVaultId, err)
+		}
+
+		var accessPolicy *vaults.AccessPolicyEntry
+		if model := read.Model; model != nil {
+			accessPolicy = findKeyVaultAccessPolicy(model.Properties.AccessPolicies, objectId, applicationId)
+		}
+
+		return accessPolicy != nil, nil
+	}
+}
@@ -38,6 +38,21 @@ func TestAccKeyVaultAccessPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultAccessPolicy_propagationWait(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_access_policy", "test")
+	r := KeyVaultAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.propagationWait(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("propagation_wait"),
+	})
+}
+
 func TestAccKeyVaultAccessPolicy_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_access_policy", "test")
 	r := KeyVaultAccessPolicyResource{}
@@ -188,6 +203,33 @@ resource "azurerm_key_vault_access_policy" "test" {
 `, template)
 }
 
+func (r KeyVaultAccessPolicyResource) propagationWait(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_access_policy" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+
+  secret_permissions = [
+    "Get",
+  ]
+
+  tenant_id = data.azurerm_client_config.current.tenant_id
+  object_id = data.azurerm_client_config.current.object_id
+
+  propagation_wait {
+    timeout  = "5m"
+    interval = "5s"
+  }
+}
+`, template)
+}
+
 func (r KeyVaultAccessPolicyResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+type KeyVaultCertificateMergeAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultCertificateMergeAction{}
+
+func newKeyVaultCertificateMergeAction() action.Action {
+	return &KeyVaultCertificateMergeAction{}
+}
+
+type KeyVaultCertificateMergeActionModel struct {
+	KeyVaultCertificateId types.String `tfsdk:"key_vault_certificate_id"`
+	SignedCertificate     types.String `tfsdk:"signed_certificate"`
+	Timeout               types.String `tfsdk:"timeout"`
+}
+
+func (a *KeyVaultCertificateMergeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_vault_certificate_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Key Vault Certificate with a pending Certificate Signing Request which the signed certificate should be merged into.",
+				MarkdownDescription: "The ID of the Key Vault Certificate with a pending Certificate Signing Request which the signed certificate should be merged into.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+				},
+			},
+
+			"signed_certificate": schema.StringAttribute{
+				Required:            true,
+				Description:         "The PEM encoded certificate signed by the Certificate Authority, optionally followed by the PEM encoded certificates of the rest of the certificate chain.",
+				MarkdownDescription: "The PEM encoded certificate signed by the Certificate Authority, optionally followed by the PEM encoded certificates of the rest of the certificate chain.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (a *KeyVaultCertificateMergeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_certificate_merge"
+}
+
+func (a *KeyVaultCertificateMergeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.KeyVault.ManagementClient
+
+	model := KeyVaultCertificateMergeActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(model.KeyVaultCertificateId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	if id.NestedItemType != parse.NestedItemTypeCertificate {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", fmt.Sprintf("expected a Key Vault Certificate ID but got an ID of type %q", id.NestedItemType))
+		return
+	}
+
+	certificates, err := expandKeyVaultCertificateChain(model.SignedCertificate.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing `signed_certificate`", err)
+		return
+	}
+
+	// the signed certificate can only be merged whilst the certificate operation is waiting for it, checking this first
+	// gives a clearer error than the API when the certificate wasn't created with an `Unknown` issuer or has already been merged
+	operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving Certificate Operation for Certificate %q in Key Vault at URI %q", id.Name, id.KeyVaultBaseUrl), err)
+		return
+	}
+
+	if status := pointer.From(operation.Status); !strings.EqualFold(status, "inProgress") {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("merging Certificate %q in Key Vault at URI %q", id.Name, id.KeyVaultBaseUrl), fmt.Sprintf("the certificate operation must be `inProgress` to merge a signed certificate but it was %q", status))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("merging the signed certificate into Certificate %q in Key Vault at URI %q", id.Name, id.KeyVaultBaseUrl),
+	})
+
+	parameters := keyvault.CertificateMergeParameters{
+		X509Certificates: pointer.To(certificates),
+	}
+	resp, err := client.MergeCertificate(ctx, id.KeyVaultBaseUrl, id.Name, parameters)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("merging Certificate %q in Key Vault at URI %q", id.Name, id.KeyVaultBaseUrl), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("merged the signed certificate into Certificate %q in Key Vault at URI %q, the certificate version is %q", id.Name, id.KeyVaultBaseUrl, pointer.From(resp.ID)),
+	})
+}
+
+func (a *KeyVaultCertificateMergeAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
+
+// expandKeyVaultCertificateChain converts one or more PEM encoded certificates into the DER encoded certificates expected
+// by the API, the signed certificate must be the first certificate in the chain
+func expandKeyVaultCertificateChain(input string) ([][]byte, error) {
+	certificates := make([][]byte, 0)
+
+	rest := []byte(input)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected only PEM blocks of type `CERTIFICATE` but got a block of type %q", block.Type)
+		}
+
+		certificates = append(certificates, block.Bytes)
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("no PEM encoded certificates were found")
+	}
+
+	return certificates, nil
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultCertificateMergeAction struct{}
+
+func TestAccKeyVaultCertificateMergeAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	a := KeyVaultCertificateMergeAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"tls": {
+				VersionConstraint: "=4.0.6",
+				Source:            "registry.terraform.io/hashicorp/tls",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func (a KeyVaultCertificateMergeAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "tls_private_key" "ca" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_self_signed_cert" "ca" {
+  private_key_pem       = tls_private_key.ca.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 24
+
+  subject {
+    common_name = "acctest-ca"
+  }
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "tls_locally_signed_cert" "test" {
+  cert_request_pem      = azurerm_key_vault_certificate.test.certificate_signing_request
+  ca_private_key_pem    = tls_private_key.ca.private_key_pem
+  ca_cert_pem           = tls_self_signed_cert.ca.cert_pem
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "digital_signature",
+    "key_encipherment",
+    "server_auth",
+  ]
+}
+
+resource "terraform_data" "trigger" {
+  input = tls_locally_signed_cert.test.cert_pem
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_certificate_merge.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_certificate_merge" "test" {
+  config {
+    key_vault_certificate_id = azurerm_key_vault_certificate.test.versionless_id
+    signed_certificate       = "${tls_locally_signed_cert.test.cert_pem}${tls_self_signed_cert.ca.cert_pem}"
+  }
+}
+`, KeyVaultCertificateResource{}.basicGenerateUnknownIssuer(data))
+}
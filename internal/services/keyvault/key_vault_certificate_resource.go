@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"math"
@@ -405,6 +406,11 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"certificate_signing_request": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -806,6 +812,23 @@ func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("thumbprint", thumbprint)
 
+	// the CSR is only available for certificates which were generated by Key Vault, certificates which were imported
+	// don't have a certificate operation
+	certificateSigningRequest := ""
+	operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(operation.Response) {
+			return fmt.Errorf("retrieving Certificate Operation for Certificate %q in Key Vault at URI %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+	if operation.Csr != nil && len(*operation.Csr) > 0 {
+		certificateSigningRequest = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE REQUEST",
+			Bytes: *operation.Csr,
+		}))
+	}
+	d.Set("certificate_signing_request", certificateSigningRequest)
+
 	return tags.FlattenAndSet(d, cert.Tags)
 }
 
@@ -139,6 +139,7 @@ func TestAccKeyVaultCertificate_basicGenerateUnknownIssuer(t *testing.T) {
 			Config: r.basicGenerateUnknownIssuer(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate_signing_request").IsNotEmpty(),
 			),
 		},
 		data.ImportStep(),
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/deletionprotection"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
@@ -44,10 +45,10 @@ func resourceKeyVault() *pluginsdk.Resource {
 		Update: resourceKeyVaultUpdate,
 		Delete: resourceKeyVaultDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := commonids.ParseKeyVaultID(id)
 			return err
-		}),
+		}, deletionprotection.Import),
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -203,6 +204,13 @@ func resourceKeyVault() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(7, 90),
 			},
 
+			"adopt_existing": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"deletion_protection_enabled": deletionprotection.Schema(),
+
 			"tags": commonschema.Tags(),
 
 			// Computed
@@ -272,11 +280,6 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	id := commonids.NewKeyVaultID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	location := location.Normalize(d.Get("location").(string))
 
-	// Locking this resource so we don't make modifications to it at the same time if there is a
-	// key vault access policy trying to update it as well
-	locks.ByName(id.VaultName, keyVaultResourceName)
-	defer locks.UnlockByName(id.VaultName, keyVaultResourceName)
-
 	isPublic := d.Get("public_network_access_enabled").(bool)
 	if !features.FivePointOh() {
 		if len(d.Get("contact").(*pluginsdk.Set).List()) > 0 {
@@ -295,9 +298,28 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	if !response.WasNotFound(existing.HttpResponse) {
-		return tf.ImportAsExistsError("azurerm_key_vault", id.ID())
+		if !d.Get("adopt_existing").(bool) {
+			return tf.ImportAsExistsError("azurerm_key_vault", id.ID())
+		}
+
+		// the location can't be changed, so only the other arguments from the configuration are applied as an update
+		// (which also takes the lock, hence this happening first)
+		if model := existing.Model; model != nil {
+			if mismatched := keyVaultAdoptExistingMismatches(d, *model); len(mismatched) > 0 {
+				return tf.AdoptExistingMismatchError("azurerm_key_vault", id.ID(), mismatched)
+			}
+		}
+
+		log.Printf("[DEBUG] `adopt_existing` is enabled - adopting the existing %s", id)
+		d.SetId(id.ID())
+		return resourceKeyVaultUpdateExisting(ctx, d, meta)
 	}
 
+	// Locking this resource so we don't make modifications to it at the same time if there is a
+	// key vault access policy trying to update it as well
+	locks.ByName(id.VaultName, keyVaultResourceName)
+	defer locks.UnlockByName(id.VaultName, keyVaultResourceName)
+
 	// before creating check to see if the key vault exists in the soft delete state
 	deletedVaultId := vaults.NewDeletedVaultID(id.SubscriptionId, location, id.VaultName)
 	softDeletedKeyVault, err := client.GetDeleted(ctx, deletedVaultId)
@@ -458,15 +480,39 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.Get("deletion_protection_enabled").(bool) {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceKeyVaultRead(d, meta)
 }
 
+// keyVaultAdoptExistingMismatches returns the arguments which can't be updated, where the existing Key Vault differs
+// from the configuration
+func keyVaultAdoptExistingMismatches(d *pluginsdk.ResourceData, vault vaults.Vault) []string {
+	mismatched := make([]string, 0)
+	if location.Normalize(pointer.From(vault.Location)) != location.Normalize(d.Get("location").(string)) {
+		mismatched = append(mismatched, "location")
+	}
+
+	return mismatched
+}
+
 func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).KeyVault.VaultsClient
-	managementClient := meta.(*clients.Client).KeyVault.ManagementClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	return resourceKeyVaultUpdateExisting(ctx, d, meta)
+}
+
+// resourceKeyVaultUpdateExisting applies the configuration to an existing Key Vault, which is used both when updating
+// and when adopting an existing Key Vault during creation
+func resourceKeyVaultUpdateExisting(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.VaultsClient
+	managementClient := meta.(*clients.Client).KeyVault.ManagementClient
+
 	id, err := commonids.ParseKeyVaultID(d.Id())
 	if err != nil {
 		return err
@@ -632,6 +678,12 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
+	if d.HasChange("deletion_protection_enabled") {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), d.Get("deletion_protection_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	if !features.FivePointOh() {
 		if d.HasChange("contact") {
 			contacts := dataplane.Contacts{
@@ -769,6 +821,10 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := deletionprotection.FlattenAndSet(ctx, d, meta.(*clients.Client).Resource.LocksClient, id.ID()); err != nil {
+		return fmt.Errorf("setting `deletion_protection_enabled`: %+v", err)
+	}
+
 	// If publicNetworkAccessEnabled is true, the data plane call should succeed.
 	// (if the caller has the 'ManageContacts' certificate permissions)
 	//
@@ -806,6 +862,10 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := deletionprotection.EnsureDeletable(d, id.ID()); err != nil {
+		return err
+	}
+
 	locks.ByName(id.VaultName, keyVaultResourceName)
 	defer locks.UnlockByName(id.VaultName, keyVaultResourceName)
 
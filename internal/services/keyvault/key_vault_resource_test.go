@@ -54,6 +54,37 @@ func TestAccKeyVault_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccKeyVault_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
+	r := KeyVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// remove the Key Vault from the State, leaving it in Azure
+			Config: r.adoptExistingRemoved(data),
+		},
+		{
+			Config: r.adoptExisting(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the Access Policy isn't specified, so is only present when the existing Key Vault wasn't overwritten
+				check.That(data.ResourceName).Key("access_policy.#").HasValue("1"),
+				check.That(data.ResourceName).Key("access_policy.0.key_permissions.#").HasValue("1"),
+				// the Tags are specified, so should be applied when adopting it
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		// `adopt_existing` is only used during creation, so isn't set when importing
+		data.ImportStep("adopt_existing"),
+	})
+}
+
 func TestAccKeyVault_networkAcls(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
 	r := KeyVaultResource{}
@@ -397,6 +428,35 @@ func TestAccKeyVault_purgeProtectionAttemptToDisable(t *testing.T) {
 	})
 }
 
+func TestAccKeyVault_deletionProtection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
+	r := KeyVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deletionProtection(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deletion_protection_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.deletionProtection(data, true),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile("`deletion_protection_enabled` is set to `true`"),
+		},
+		{
+			Config: r.deletionProtection(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deletion_protection_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (KeyVaultResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := commonids.ParseKeyVaultID(state.ID)
 	if err != nil {
@@ -466,6 +526,61 @@ resource "azurerm_key_vault" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (KeyVaultResource) adoptExistingRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+removed {
+  from = azurerm_key_vault.test
+
+  lifecycle {
+    destroy = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (KeyVaultResource) adoptExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctest%s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  adopt_existing = true
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r KeyVaultResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -1019,6 +1134,31 @@ resource "azurerm_key_vault" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, enabled)
 }
 
+func (KeyVaultResource) deletionProtection(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                        = "acctest%s"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  tenant_id                   = data.azurerm_client_config.current.tenant_id
+  sku_name                    = "standard"
+  soft_delete_retention_days  = 7
+  deletion_protection_enabled = %t
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, enabled)
+}
+
 func (KeyVaultResource) softDelete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
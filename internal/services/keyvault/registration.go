@@ -64,7 +64,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newKeyVaultCertificateMergeAction,
+	}
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
@@ -14,7 +14,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/attacheddatabaseconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/databases"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -50,6 +52,10 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 			if databaseName == "*" && databaseNameOverride != "" {
 				return fmt.Errorf("cannot set `database_name_override` when `database_name` is set to `*` (all databases)")
 			}
+
+			if databaseName == "*" && diff.Get("hot_cache_period").(string) != "" {
+				return fmt.Errorf("cannot set `hot_cache_period` when `database_name` is set to `*` (all databases)")
+			}
 			return nil
 		}),
 
@@ -122,6 +128,13 @@ func resourceKustoAttachedDatabaseConfiguration() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(attacheddatabaseconfigurations.PossibleValuesForDefaultPrincipalsModificationKind(), false),
 			},
 
+			"hot_cache_period": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: azValidate.ISO8601Duration,
+			},
+
 			"sharing": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -249,6 +262,23 @@ func resourceKustoAttachedDatabaseConfigurationCreateUpdate(d *pluginsdk.Resourc
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	// the caching policy of the follower database overrides the one inherited from the leader database, and can only
+	// be configured on the follower database once it has been attached
+	if v, ok := d.GetOk("hot_cache_period"); ok && d.HasChange("hot_cache_period") {
+		databasesClient := meta.(*clients.Client).Kusto.DatabasesClient
+		databaseId := commonids.NewKustoDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, kustoAttachedDatabaseConfigurationFollowerDatabaseName(d))
+
+		followerDatabase := databases.ReadOnlyFollowingDatabase{
+			Location: configurationRequest.Location,
+			Properties: &databases.ReadOnlyFollowingDatabaseProperties{
+				HotCachePeriod: pointer.To(v.(string)),
+			},
+		}
+		if err := databasesClient.UpdateThenPoll(ctx, databaseId, followerDatabase, databases.DefaultUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("updating `hot_cache_period` for %s: %+v", databaseId, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceKustoAttachedDatabaseConfigurationRead(d, meta)
 }
@@ -293,6 +323,22 @@ func resourceKustoAttachedDatabaseConfigurationRead(d *pluginsdk.ResourceData, m
 			d.Set("attached_database_names", props.AttachedDatabaseNames)
 			d.Set("sharing", flattenAttachedDatabaseConfigurationTableLevelSharingProperties(props.TableLevelSharingProperties))
 
+			hotCachePeriod := ""
+			if props.DatabaseName != "*" {
+				databasesClient := meta.(*clients.Client).Kusto.DatabasesClient
+				databaseId := commonids.NewKustoDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, kustoAttachedDatabaseConfigurationFollowerDatabaseName(d))
+
+				databaseResp, err := databasesClient.Get(ctx, databaseId)
+				if err != nil && !response.WasNotFound(databaseResp.HttpResponse) {
+					return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+				}
+
+				if followerDatabase, ok := databaseResp.Model.(databases.ReadOnlyFollowingDatabase); ok && followerDatabase.Properties != nil {
+					hotCachePeriod = pointer.From(followerDatabase.Properties.HotCachePeriod)
+				}
+			}
+			d.Set("hot_cache_period", hotCachePeriod)
+
 			if !features.FivePointOh() {
 				d.Set("cluster_resource_id", clusterResourceId.ID())
 			}
@@ -324,6 +370,15 @@ func resourceKustoAttachedDatabaseConfigurationDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+// kustoAttachedDatabaseConfigurationFollowerDatabaseName returns the name of the follower database on the cluster when a single database is attached
+func kustoAttachedDatabaseConfigurationFollowerDatabaseName(d *pluginsdk.ResourceData) string {
+	if v := d.Get("database_name_override").(string); v != "" {
+		return v
+	}
+
+	return d.Get("database_name_prefix").(string) + d.Get("database_name").(string)
+}
+
 func expandKustoAttachedDatabaseConfigurationProperties(d *pluginsdk.ResourceData) *attacheddatabaseconfigurations.AttachedDatabaseConfigurationProperties {
 	AttachedDatabaseConfigurationProperties := &attacheddatabaseconfigurations.AttachedDatabaseConfigurationProperties{}
 
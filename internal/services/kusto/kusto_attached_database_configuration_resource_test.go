@@ -48,6 +48,30 @@ func TestAccKustoAttachedDatabaseConfiguration_databaseNameOverride(t *testing.T
 	})
 }
 
+func TestAccKustoAttachedDatabaseConfiguration_hotCachePeriod(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_attached_database_configuration", "test")
+	r := KustoAttachedDatabaseConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hotCachePeriod(data, "P7D"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hot_cache_period").HasValue("P7D"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.hotCachePeriod(data, "P14D"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hot_cache_period").HasValue("P14D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKustoAttachedDatabaseConfiguration_databaseNamePrefix(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kusto_attached_database_configuration", "test")
 	r := KustoAttachedDatabaseConfigurationResource{}
@@ -215,6 +239,26 @@ resource "azurerm_kusto_attached_database_configuration" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r KustoAttachedDatabaseConfigurationResource) hotCachePeriod(data acceptance.TestData, hotCachePeriod string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_attached_database_configuration" "test" {
+  name                = "acctestka-%d"
+  resource_group_name = azurerm_resource_group.rg.name
+  location            = azurerm_resource_group.rg.location
+  cluster_name        = azurerm_kusto_cluster.cluster1.name
+  cluster_id          = azurerm_kusto_cluster.cluster2.id
+  database_name       = azurerm_kusto_database.test.name
+  hot_cache_period    = "%s"
+
+  sharing {
+    tables_to_include = ["Table1"]
+  }
+}
+`, r.template(data), data.RandomInteger, hotCachePeriod)
+}
+
 func (r KustoAttachedDatabaseConfigurationResource) databaseNamePrefix(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lighthouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2022-10-01/registrationassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2022-10-01/registrationdefinitions"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/lighthouse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate         = LighthouseBulkAssignmentResource{}
+	_ sdk.ResourceWithCustomImporter = LighthouseBulkAssignmentResource{}
+)
+
+type LighthouseBulkAssignmentResource struct{}
+
+type LighthouseBulkAssignmentModel struct {
+	Name                   string                               `tfschema:"name"`
+	LighthouseDefinitionId string                               `tfschema:"lighthouse_definition_id"`
+	Scopes                 []string                             `tfschema:"scopes"`
+	Assignments            []LighthouseBulkAssignmentScopeModel `tfschema:"assignment"`
+}
+
+type LighthouseBulkAssignmentScopeModel struct {
+	Id                string `tfschema:"id"`
+	Scope             string `tfschema:"scope"`
+	ProvisioningState string `tfschema:"provisioning_state"`
+}
+
+func (LighthouseBulkAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"lighthouse_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registrationdefinitions.ValidateScopedRegistrationDefinitionID,
+		},
+
+		"scopes": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.Any(commonids.ValidateSubscriptionID, commonids.ValidateResourceGroupID),
+			},
+		},
+	}
+}
+
+func (LighthouseBulkAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assignment": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"scope": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"provisioning_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (LighthouseBulkAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateLighthouseBulkAssignmentID
+}
+
+func (LighthouseBulkAssignmentResource) ModelObject() interface{} {
+	return &LighthouseBulkAssignmentModel{}
+}
+
+func (LighthouseBulkAssignmentResource) ResourceType() string {
+	return "azurerm_lighthouse_bulk_assignment"
+}
+
+func (r LighthouseBulkAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Lighthouse.AssignmentsClient
+
+			var model LighthouseBulkAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			definitionId, err := registrationdefinitions.ParseScopedRegistrationDefinitionID(model.LighthouseDefinitionId)
+			if err != nil {
+				return err
+			}
+
+			name := model.Name
+			if name == "" {
+				generated, err := uuid.GenerateUUID()
+				if err != nil {
+					return fmt.Errorf("generating UUID for Lighthouse Bulk Assignment: %+v", err)
+				}
+				name = generated
+			}
+
+			id := parse.NewLighthouseBulkAssignmentID(definitionId.Scope, definitionId.RegistrationDefinitionId, name)
+
+			options := registrationassignments.GetOperationOptions{
+				ExpandRegistrationDefinition: pointer.To(false),
+			}
+			for _, scope := range model.Scopes {
+				assignmentId := registrationassignments.NewScopedRegistrationAssignmentID(scope, name)
+				existing, err := client.Get(ctx, assignmentId, options)
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", assignmentId, err)
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s already exists - since a Lighthouse Bulk Assignment can't be imported, either the existing Lighthouse Assignment must be removed or its scope must be removed from `scopes`", assignmentId)
+				}
+			}
+
+			// the ID is set prior to creating the Assignments, and only those which have been created are tracked in the
+			// state, so that any Assignments created before a failure are removed when the resource is destroyed
+			metadata.SetID(id)
+			model.Name = name
+			requested := model.Scopes
+			model.Scopes = make([]string, 0)
+			for _, scope := range requested {
+				if err := createLighthouseBulkAssignmentScope(ctx, client, id, scope); err != nil {
+					if encodeErr := metadata.Encode(&model); encodeErr != nil {
+						return fmt.Errorf("encoding %s after failing to create an Assignment: %+v (%+v)", id, encodeErr, err)
+					}
+					return err
+				}
+				model.Scopes = append(model.Scopes, scope)
+			}
+
+			return nil
+		},
+	}
+}
+
+// CustomImporter returns an error, since Assignments can only be listed within a single scope - as such the scopes
+// which make up a Bulk Assignment can't be discovered when importing
+func (r LighthouseBulkAssignmentResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_lighthouse_bulk_assignment` can't be imported, since the scopes it's assigned to can't be determined from the ID")
+	}
+}
+
+func (r LighthouseBulkAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Lighthouse.AssignmentsClient
+
+			id, err := parse.LighthouseBulkAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config LighthouseBulkAssignmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := LighthouseBulkAssignmentModel{
+				Name:                   id.BulkAssignmentName,
+				LighthouseDefinitionId: id.RegistrationDefinitionID().ID(),
+				Scopes:                 make([]string, 0),
+				Assignments:            make([]LighthouseBulkAssignmentScopeModel, 0),
+			}
+
+			options := registrationassignments.GetOperationOptions{
+				ExpandRegistrationDefinition: pointer.To(false),
+			}
+			for _, scope := range config.Scopes {
+				assignmentId := registrationassignments.NewScopedRegistrationAssignmentID(scope, id.BulkAssignmentName)
+				resp, err := client.Get(ctx, assignmentId, options)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						continue
+					}
+					return fmt.Errorf("retrieving %s: %+v", assignmentId, err)
+				}
+
+				assignment := LighthouseBulkAssignmentScopeModel{
+					Id:    assignmentId.ID(),
+					Scope: scope,
+				}
+
+				if model := resp.Model; model != nil {
+					if props := model.Properties; props != nil {
+						// an assignment of another definition which happens to share the name isn't managed here
+						if !strings.EqualFold(props.RegistrationDefinitionId, state.LighthouseDefinitionId) {
+							continue
+						}
+						assignment.ProvisioningState = string(pointer.From(props.ProvisioningState))
+					}
+				}
+
+				state.Scopes = append(state.Scopes, scope)
+				state.Assignments = append(state.Assignments, assignment)
+			}
+
+			if len(config.Scopes) > 0 && len(state.Scopes) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LighthouseBulkAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Lighthouse.AssignmentsClient
+
+			id, err := parse.LighthouseBulkAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("scopes") {
+				o, n := metadata.ResourceData.GetChange("scopes")
+				oldScopes := o.(*pluginsdk.Set)
+				newScopes := n.(*pluginsdk.Set)
+
+				for _, scope := range oldScopes.Difference(newScopes).List() {
+					if err := deleteLighthouseBulkAssignmentScope(ctx, client, *id, scope.(string)); err != nil {
+						return err
+					}
+				}
+
+				for _, scope := range newScopes.Difference(oldScopes).List() {
+					if err := createLighthouseBulkAssignmentScope(ctx, client, *id, scope.(string)); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LighthouseBulkAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Lighthouse.AssignmentsClient
+
+			id, err := parse.LighthouseBulkAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LighthouseBulkAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, scope := range model.Scopes {
+				if err := deleteLighthouseBulkAssignmentScope(ctx, client, *id, scope); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func createLighthouseBulkAssignmentScope(ctx context.Context, client *registrationassignments.RegistrationAssignmentsClient, id parse.LighthouseBulkAssignmentId, scope string) error {
+	assignmentId := registrationassignments.NewScopedRegistrationAssignmentID(scope, id.BulkAssignmentName)
+	parameters := registrationassignments.RegistrationAssignment{
+		Properties: &registrationassignments.RegistrationAssignmentProperties{
+			RegistrationDefinitionId: id.RegistrationDefinitionID().ID(),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, assignmentId, parameters); err != nil {
+		return fmt.Errorf("creating %s for %s: %+v", assignmentId, id, err)
+	}
+
+	return nil
+}
+
+func deleteLighthouseBulkAssignmentScope(ctx context.Context, client *registrationassignments.RegistrationAssignmentsClient, id parse.LighthouseBulkAssignmentId, scope string) error {
+	assignmentId := registrationassignments.NewScopedRegistrationAssignmentID(scope, id.BulkAssignmentName)
+	if err := client.DeleteThenPoll(ctx, assignmentId); err != nil {
+		return fmt.Errorf("deleting %s for %s: %+v", assignmentId, id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Deleting"},
+		Target:     []string{"Deleted"},
+		Refresh:    lighthouseAssignmentDeleteRefreshFunc(ctx, client, assignmentId),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for deletion of %s for %s: %+v", assignmentId, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lighthouse_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2022-10-01/registrationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/lighthouse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LighthouseBulkAssignmentResource struct{}
+
+func TestAccLighthouseBulkAssignment_basic(t *testing.T) {
+	secondTenantID := os.Getenv("ARM_TENANT_ID_ALT")
+	principalID := os.Getenv("ARM_PRINCIPAL_ID_ALT_TENANT")
+	if secondTenantID == "" || principalID == "" {
+		t.Skip("Skipping as ARM_TENANT_ID_ALT and/or ARM_PRINCIPAL_ID_ALT_TENANT are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_lighthouse_bulk_assignment", "test")
+	r := LighthouseBulkAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(secondTenantID, principalID, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccLighthouseBulkAssignment_requiresImport(t *testing.T) {
+	secondTenantID := os.Getenv("ARM_TENANT_ID_ALT")
+	principalID := os.Getenv("ARM_PRINCIPAL_ID_ALT_TENANT")
+	if secondTenantID == "" || principalID == "" {
+		t.Skip("Skipping as ARM_TENANT_ID_ALT and/or ARM_PRINCIPAL_ID_ALT_TENANT are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_lighthouse_bulk_assignment", "test")
+	r := LighthouseBulkAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(secondTenantID, principalID, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(secondTenantID, principalID, data),
+			ExpectError: regexp.MustCompile("already exists"),
+		},
+	})
+}
+
+func TestAccLighthouseBulkAssignment_update(t *testing.T) {
+	secondTenantID := os.Getenv("ARM_TENANT_ID_ALT")
+	principalID := os.Getenv("ARM_PRINCIPAL_ID_ALT_TENANT")
+	if secondTenantID == "" || principalID == "" {
+		t.Skip("Skipping as ARM_TENANT_ID_ALT and/or ARM_PRINCIPAL_ID_ALT_TENANT are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_lighthouse_bulk_assignment", "test")
+	r := LighthouseBulkAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(secondTenantID, principalID, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.updated(secondTenantID, principalID, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.basic(secondTenantID, principalID, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (LighthouseBulkAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LighthouseBulkAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(state.Attributes["assignment.#"])
+	if err != nil || count == 0 {
+		return pointer.To(false), nil
+	}
+
+	options := registrationassignments.GetOperationOptions{
+		ExpandRegistrationDefinition: pointer.To(false),
+	}
+	for i := 0; i < count; i++ {
+		assignmentId := registrationassignments.NewScopedRegistrationAssignmentID(state.Attributes[fmt.Sprintf("assignment.%d.scope", i)], id.BulkAssignmentName)
+		resp, err := clients.Lighthouse.AssignmentsClient.Get(ctx, assignmentId, options)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", assignmentId, err)
+		}
+		if resp.Model == nil {
+			return pointer.To(false), nil
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r LighthouseBulkAssignmentResource) basic(secondTenantID string, principalID string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lighthouse_bulk_assignment" "test" {
+  lighthouse_definition_id = azurerm_lighthouse_definition.test.id
+  scopes = [
+    azurerm_resource_group.test[0].id,
+    azurerm_resource_group.test[1].id,
+  ]
+}
+`, r.template(secondTenantID, principalID, data))
+}
+
+func (r LighthouseBulkAssignmentResource) requiresImport(secondTenantID string, principalID string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lighthouse_bulk_assignment" "import" {
+  name                     = azurerm_lighthouse_bulk_assignment.test.name
+  lighthouse_definition_id = azurerm_lighthouse_bulk_assignment.test.lighthouse_definition_id
+  scopes                   = azurerm_lighthouse_bulk_assignment.test.scopes
+}
+`, r.basic(secondTenantID, principalID, data))
+}
+
+func (r LighthouseBulkAssignmentResource) updated(secondTenantID string, principalID string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lighthouse_bulk_assignment" "test" {
+  lighthouse_definition_id = azurerm_lighthouse_definition.test.id
+  scopes = [
+    azurerm_resource_group.test[1].id,
+    azurerm_resource_group.test[2].id,
+  ]
+}
+`, r.template(secondTenantID, principalID, data))
+}
+
+func (LighthouseBulkAssignmentResource) template(secondTenantID string, principalID string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_role_definition" "contributor" {
+  role_definition_id = "b24988ac-6180-42a0-ab88-20f7382dd24c"
+}
+
+resource "azurerm_resource_group" "test" {
+  count    = 3
+  name     = "acctestRG-lighthouse-%d-${count.index}"
+  location = "%s"
+}
+
+resource "azurerm_lighthouse_definition" "test" {
+  name               = "acctest-LD-%d"
+  description        = "Acceptance Test Lighthouse Definition"
+  managing_tenant_id = "%s"
+  scope              = data.azurerm_subscription.primary.id
+
+  authorization {
+    principal_id       = "%s"
+    role_definition_id = data.azurerm_role_definition.contributor.role_definition_id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, secondTenantID, principalID)
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2022-10-01/registrationdefinitions"
+)
+
+var _ resourceids.ResourceId = &LighthouseBulkAssignmentId{}
+
+// LighthouseBulkAssignmentId is a struct representing the Resource ID for a Lighthouse Bulk Assignment, which
+// doesn't exist in Azure and instead groups the Registration Assignments of a Registration Definition which share a name
+type LighthouseBulkAssignmentId struct {
+	Scope                    string
+	RegistrationDefinitionId string
+	BulkAssignmentName       string
+}
+
+// NewLighthouseBulkAssignmentID returns a new LighthouseBulkAssignmentId struct
+func NewLighthouseBulkAssignmentID(scope string, registrationDefinitionId string, bulkAssignmentName string) LighthouseBulkAssignmentId {
+	return LighthouseBulkAssignmentId{
+		Scope:                    scope,
+		RegistrationDefinitionId: registrationDefinitionId,
+		BulkAssignmentName:       bulkAssignmentName,
+	}
+}
+
+// LighthouseBulkAssignmentID parses 'input' into a LighthouseBulkAssignmentId
+func LighthouseBulkAssignmentID(input string) (*LighthouseBulkAssignmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&LighthouseBulkAssignmentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := LighthouseBulkAssignmentId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ValidateLighthouseBulkAssignmentID checks that 'input' can be parsed as a Lighthouse Bulk Assignment ID
+func ValidateLighthouseBulkAssignmentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := LighthouseBulkAssignmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id *LighthouseBulkAssignmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.Scope, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	if id.RegistrationDefinitionId, ok = input.Parsed["registrationDefinitionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "registrationDefinitionId", input)
+	}
+
+	if id.BulkAssignmentName, ok = input.Parsed["bulkAssignmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "bulkAssignmentName", input)
+	}
+
+	return nil
+}
+
+// RegistrationDefinitionID returns the ID of the Registration Definition which is assigned
+func (id LighthouseBulkAssignmentId) RegistrationDefinitionID() registrationdefinitions.ScopedRegistrationDefinitionId {
+	return registrationdefinitions.NewScopedRegistrationDefinitionID(id.Scope, id.RegistrationDefinitionId)
+}
+
+// ID returns the formatted Lighthouse Bulk Assignment ID
+func (id LighthouseBulkAssignmentId) ID() string {
+	fmtString := "/%s/providers/Microsoft.ManagedServices/registrationDefinitions/%s/bulkAssignments/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.RegistrationDefinitionId, id.BulkAssignmentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Lighthouse Bulk Assignment ID
+func (id LighthouseBulkAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftManagedServices", "Microsoft.ManagedServices", "Microsoft.ManagedServices"),
+		resourceids.StaticSegment("staticRegistrationDefinitions", "registrationDefinitions", "registrationDefinitions"),
+		resourceids.UserSpecifiedSegment("registrationDefinitionId", "registrationDefinitionId"),
+		resourceids.StaticSegment("staticBulkAssignments", "bulkAssignments", "bulkAssignments"),
+		resourceids.UserSpecifiedSegment("bulkAssignmentName", "bulkAssignmentName"),
+	}
+}
+
+// String returns a human-readable description of this Lighthouse Bulk Assignment ID
+func (id LighthouseBulkAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Registration Definition: %q", id.RegistrationDefinitionId),
+		fmt.Sprintf("Bulk Assignment Name: %q", id.BulkAssignmentName),
+	}
+	return fmt.Sprintf("Lighthouse Bulk Assignment (%s)", strings.Join(components, "\n"))
+}
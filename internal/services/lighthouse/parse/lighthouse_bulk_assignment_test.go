@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestLighthouseBulkAssignmentIDFormatter(t *testing.T) {
+	actual := NewLighthouseBulkAssignmentID("/subscriptions/12345678-1234-9876-4563-123456789012", "definition1", "assignment1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.ManagedServices/registrationDefinitions/definition1/bulkAssignments/assignment1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLighthouseBulkAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *LighthouseBulkAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing registrationDefinitions
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.ManagedServices",
+			Error: true,
+		},
+
+		{
+			// missing bulkAssignments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.ManagedServices/registrationDefinitions/definition1",
+			Error: true,
+		},
+
+		{
+			// missing value for bulkAssignments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.ManagedServices/registrationDefinitions/definition1/bulkAssignments/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.ManagedServices/registrationDefinitions/definition1/bulkAssignments/assignment1",
+			Expected: &LighthouseBulkAssignmentId{
+				Scope:                    "/subscriptions/12345678-1234-9876-4563-123456789012",
+				RegistrationDefinitionId: "definition1",
+				BulkAssignmentName:       "assignment1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.MANAGEDSERVICES/REGISTRATIONDEFINITIONS/DEFINITION1/BULKASSIGNMENTS/ASSIGNMENT1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := LighthouseBulkAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.RegistrationDefinitionId != v.Expected.RegistrationDefinitionId {
+			t.Fatalf("Expected %q but got %q for RegistrationDefinitionId", v.Expected.RegistrationDefinitionId, actual.RegistrationDefinitionId)
+		}
+		if actual.BulkAssignmentName != v.Expected.BulkAssignmentName {
+			t.Fatalf("Expected %q but got %q for BulkAssignmentName", v.Expected.BulkAssignmentName, actual.BulkAssignmentName)
+		}
+	}
+}
@@ -14,6 +14,7 @@ type Registration struct{}
 
 var (
 	_ sdk.FrameworkServiceRegistration               = Registration{}
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
 )
 
@@ -46,6 +47,18 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	}
 }
 
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		LighthouseBulkAssignmentResource{},
+	}
+}
+
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{}
 }
@@ -0,0 +1,596 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountagreements"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountmaps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountpartners"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountschemas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	integrationAccountArtifactKindMap       = "map"
+	integrationAccountArtifactKindSchema    = "schema"
+	integrationAccountArtifactKindPartner   = "partner"
+	integrationAccountArtifactKindAgreement = "agreement"
+)
+
+// integrationAccountArtifactKinds is the order artifacts are created in, since agreements reference partners.
+// Artifacts are deleted in the reverse order.
+var integrationAccountArtifactKinds = []string{
+	integrationAccountArtifactKindMap,
+	integrationAccountArtifactKindSchema,
+	integrationAccountArtifactKindPartner,
+	integrationAccountArtifactKindAgreement,
+}
+
+type LogicAppIntegrationAccountArtifactsResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogicAppIntegrationAccountArtifactsResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogicAppIntegrationAccountArtifactsResource{}
+)
+
+type LogicAppIntegrationAccountArtifactsResourceModel struct {
+	IntegrationAccountId string            `tfschema:"integration_account_id"`
+	MapDirectory         string            `tfschema:"map_directory"`
+	SchemaDirectory      string            `tfschema:"schema_directory"`
+	PartnerDirectory     string            `tfschema:"partner_directory"`
+	AgreementDirectory   string            `tfschema:"agreement_directory"`
+	XsltMapType          string            `tfschema:"xslt_map_type"`
+	ContentHashes        map[string]string `tfschema:"content_hashes"`
+}
+
+type integrationAccountArtifact struct {
+	Kind     string
+	Name     string
+	FileName string
+	Content  []byte
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) ResourceType() string {
+	return "azurerm_logic_app_integration_account_artifacts"
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) ModelObject() interface{} {
+	return &LogicAppIntegrationAccountArtifactsResourceModel{}
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.IntegrationAccountArtifactsID
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) Arguments() map[string]*pluginsdk.Schema {
+	directories := []string{"map_directory", "schema_directory", "partner_directory", "agreement_directory"}
+
+	return map[string]*pluginsdk.Schema{
+		"integration_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: integrationaccounts.ValidateIntegrationAccountID,
+		},
+
+		"map_directory": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			AtLeastOneOf: directories,
+		},
+
+		"schema_directory": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			AtLeastOneOf: directories,
+		},
+
+		"partner_directory": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			AtLeastOneOf: directories,
+		},
+
+		"agreement_directory": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			AtLeastOneOf: directories,
+		},
+
+		"xslt_map_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(integrationaccountmaps.MapTypeXslt),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(integrationaccountmaps.MapTypeXslt),
+				string(integrationaccountmaps.MapTypeXsltTwoZero),
+				string(integrationaccountmaps.MapTypeXsltThreeZero),
+			}, false),
+		},
+	}
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_hashes": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Logic.IntegrationAccountClient
+
+			var config LogicAppIntegrationAccountArtifactsResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			integrationAccountId, err := integrationaccounts.ParseIntegrationAccountID(config.IntegrationAccountId)
+			if err != nil {
+				return err
+			}
+			id := parse.NewIntegrationAccountArtifactsID(*integrationAccountId)
+
+			existing, err := client.Get(ctx, *integrationAccountId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", integrationAccountId)
+				}
+				return fmt.Errorf("retrieving %s: %+v", integrationAccountId, err)
+			}
+
+			artifacts, err := integrationAccountArtifactsFromDirectories(config)
+			if err != nil {
+				return err
+			}
+
+			if err := syncIntegrationAccountArtifacts(ctx, metadata, *integrationAccountId, config.XsltMapType, artifacts, map[string]string{}); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Logic.IntegrationAccountClient
+
+			artifactsId, err := parse.IntegrationAccountArtifactsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := &artifactsId.IntegrationAccountId
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(artifactsId)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the content of the artifacts isn't returned by the API, so the directories and hashes are retained from the
+			// state - artifacts which have been removed outside of Terraform are dropped so that they're recreated
+			var state LogicAppIntegrationAccountArtifactsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.IntegrationAccountId = id.ID()
+			if state.XsltMapType == "" {
+				state.XsltMapType = string(integrationaccountmaps.MapTypeXslt)
+			}
+
+			existingArtifacts, err := listIntegrationAccountArtifactKeys(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			contentHashes := make(map[string]string)
+			for key, hash := range state.ContentHashes {
+				if _, ok := existingArtifacts[key]; ok {
+					contentHashes[key] = hash
+				}
+			}
+			state.ContentHashes = contentHashes
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			artifactsId, err := parse.IntegrationAccountArtifactsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := &artifactsId.IntegrationAccountId
+
+			var config LogicAppIntegrationAccountArtifactsResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			artifacts, err := integrationAccountArtifactsFromDirectories(config)
+			if err != nil {
+				return err
+			}
+
+			deployed := make(map[string]string)
+			old, _ := metadata.ResourceData.GetChange("content_hashes")
+			for key, hash := range old.(map[string]interface{}) {
+				// the XSLT maps need to be uploaded again when the map type changes
+				if metadata.ResourceData.HasChange("xslt_map_type") && strings.HasPrefix(key, integrationAccountArtifactKindMap+"/") {
+					hash = ""
+				}
+				deployed[key] = hash.(string)
+			}
+
+			return syncIntegrationAccountArtifacts(ctx, metadata, *id, config.XsltMapType, artifacts, deployed)
+		},
+	}
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			artifactsId, err := parse.IntegrationAccountArtifactsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := &artifactsId.IntegrationAccountId
+
+			var state LogicAppIntegrationAccountArtifactsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for i := len(integrationAccountArtifactKinds) - 1; i >= 0; i-- {
+				for key := range state.ContentHashes {
+					kind, name, _ := strings.Cut(key, "/")
+					if kind != integrationAccountArtifactKinds[i] {
+						continue
+					}
+					if err := deleteIntegrationAccountArtifact(ctx, metadata, *id, kind, name); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			for _, key := range []string{"map_directory", "schema_directory", "partner_directory", "agreement_directory"} {
+				if !rd.NewValueKnown(key) {
+					return rd.SetNewComputed("content_hashes")
+				}
+			}
+
+			artifacts, err := integrationAccountArtifactsFromDirectories(LogicAppIntegrationAccountArtifactsResourceModel{
+				MapDirectory:       rd.Get("map_directory").(string),
+				SchemaDirectory:    rd.Get("schema_directory").(string),
+				PartnerDirectory:   rd.Get("partner_directory").(string),
+				AgreementDirectory: rd.Get("agreement_directory").(string),
+			})
+			if err != nil {
+				return err
+			}
+
+			// hashing each artifact means that only the artifacts which have changed are uploaded
+			hashes := integrationAccountArtifactHashes(artifacts)
+
+			old := rd.Get("content_hashes").(map[string]interface{})
+			changed := len(old) != len(hashes)
+			for key, hash := range hashes {
+				if v, ok := old[key]; !ok || v.(string) != hash {
+					changed = true
+					break
+				}
+			}
+
+			if changed {
+				return rd.SetNew("content_hashes", hashes)
+			}
+
+			return nil
+		},
+	}
+}
+
+// syncIntegrationAccountArtifacts uploads the artifacts which aren't in `deployed` or whose hash has changed, then
+// deletes the artifacts in `deployed` which are no longer present
+func syncIntegrationAccountArtifacts(ctx context.Context, metadata sdk.ResourceMetaData, id integrationaccounts.IntegrationAccountId, xsltMapType string, artifacts map[string]integrationAccountArtifact, deployed map[string]string) error {
+	hashes := integrationAccountArtifactHashes(artifacts)
+
+	for _, kind := range integrationAccountArtifactKinds {
+		for key, artifact := range artifacts {
+			if artifact.Kind != kind || deployed[key] == hashes[key] {
+				continue
+			}
+			if err := putIntegrationAccountArtifact(ctx, metadata, id, xsltMapType, artifact); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := len(integrationAccountArtifactKinds) - 1; i >= 0; i-- {
+		for key := range deployed {
+			if _, ok := artifacts[key]; ok {
+				continue
+			}
+			kind, name, _ := strings.Cut(key, "/")
+			if kind != integrationAccountArtifactKinds[i] {
+				continue
+			}
+			if err := deleteIntegrationAccountArtifact(ctx, metadata, id, kind, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func putIntegrationAccountArtifact(ctx context.Context, metadata sdk.ResourceMetaData, id integrationaccounts.IntegrationAccountId, xsltMapType string, artifact integrationAccountArtifact) error {
+	switch artifact.Kind {
+	case integrationAccountArtifactKindMap:
+		mapId := integrationaccountmaps.NewMapID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, artifact.Name)
+		payload := integrationaccountmaps.IntegrationAccountMap{
+			Properties: integrationaccountmaps.IntegrationAccountMapProperties{
+				MapType:     integrationaccountmaps.MapType(xsltMapType),
+				Content:     pointer.To(string(artifact.Content)),
+				ContentType: pointer.To("application/xml"),
+			},
+		}
+		if strings.EqualFold(filepath.Ext(artifact.FileName), ".liquid") {
+			payload.Properties.MapType = integrationaccountmaps.MapTypeLiquid
+			payload.Properties.ContentType = pointer.To("text/plain")
+		}
+
+		if _, err := metadata.Client.Logic.IntegrationAccountMapClient.CreateOrUpdate(ctx, mapId, payload); err != nil {
+			return fmt.Errorf("creating/updating %s from %q: %+v", mapId, artifact.FileName, err)
+		}
+
+	case integrationAccountArtifactKindSchema:
+		schemaId := integrationaccountschemas.NewSchemaID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, artifact.Name)
+		payload := integrationaccountschemas.IntegrationAccountSchema{
+			Properties: integrationaccountschemas.IntegrationAccountSchemaProperties{
+				SchemaType:  integrationaccountschemas.SchemaTypeXml,
+				Content:     pointer.To(string(artifact.Content)),
+				ContentType: pointer.To("application/xml"),
+				FileName:    pointer.To(filepath.Base(artifact.FileName)),
+			},
+		}
+
+		if _, err := metadata.Client.Logic.IntegrationAccountSchemaClient.CreateOrUpdate(ctx, schemaId, payload); err != nil {
+			return fmt.Errorf("creating/updating %s from %q: %+v", schemaId, artifact.FileName, err)
+		}
+
+	case integrationAccountArtifactKindPartner:
+		partnerId := integrationaccountpartners.NewPartnerID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, artifact.Name)
+		payload := integrationaccountpartners.IntegrationAccountPartner{}
+		if err := json.Unmarshal(artifact.Content, &payload.Properties); err != nil {
+			return fmt.Errorf("parsing the partner properties in %q: %+v", artifact.FileName, err)
+		}
+
+		if _, err := metadata.Client.Logic.IntegrationAccountPartnerClient.CreateOrUpdate(ctx, partnerId, payload); err != nil {
+			return fmt.Errorf("creating/updating %s from %q: %+v", partnerId, artifact.FileName, err)
+		}
+
+	case integrationAccountArtifactKindAgreement:
+		agreementId := integrationaccountagreements.NewAgreementID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, artifact.Name)
+		payload := integrationaccountagreements.IntegrationAccountAgreement{}
+		if err := json.Unmarshal(artifact.Content, &payload.Properties); err != nil {
+			return fmt.Errorf("parsing the agreement properties in %q: %+v", artifact.FileName, err)
+		}
+
+		if _, err := metadata.Client.Logic.IntegrationAccountAgreementClient.CreateOrUpdate(ctx, agreementId, payload); err != nil {
+			return fmt.Errorf("creating/updating %s from %q: %+v", agreementId, artifact.FileName, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteIntegrationAccountArtifact(ctx context.Context, metadata sdk.ResourceMetaData, id integrationaccounts.IntegrationAccountId, kind, name string) error {
+	switch kind {
+	case integrationAccountArtifactKindMap:
+		mapId := integrationaccountmaps.NewMapID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, name)
+		if resp, err := metadata.Client.Logic.IntegrationAccountMapClient.Delete(ctx, mapId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", mapId, err)
+		}
+
+	case integrationAccountArtifactKindSchema:
+		schemaId := integrationaccountschemas.NewSchemaID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, name)
+		if resp, err := metadata.Client.Logic.IntegrationAccountSchemaClient.Delete(ctx, schemaId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", schemaId, err)
+		}
+
+	case integrationAccountArtifactKindPartner:
+		partnerId := integrationaccountpartners.NewPartnerID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, name)
+		if resp, err := metadata.Client.Logic.IntegrationAccountPartnerClient.Delete(ctx, partnerId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", partnerId, err)
+		}
+
+	case integrationAccountArtifactKindAgreement:
+		agreementId := integrationaccountagreements.NewAgreementID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName, name)
+		if resp, err := metadata.Client.Logic.IntegrationAccountAgreementClient.Delete(ctx, agreementId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", agreementId, err)
+		}
+	}
+
+	return nil
+}
+
+// listIntegrationAccountArtifactKeys returns the keys of the maps, schemas, partners and agreements which exist within
+// the Integration Account, in the same `{kind}/{name}` format used for `content_hashes`
+func listIntegrationAccountArtifactKeys(ctx context.Context, metadata sdk.ResourceMetaData, id integrationaccounts.IntegrationAccountId) (map[string]struct{}, error) {
+	keys := make(map[string]struct{})
+
+	maps, err := metadata.Client.Logic.IntegrationAccountMapClient.ListComplete(ctx, integrationaccountmaps.NewIntegrationAccountID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName), integrationaccountmaps.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the maps within %s: %+v", id, err)
+	}
+	for _, item := range maps.Items {
+		keys[integrationAccountArtifactKindMap+"/"+pointer.From(item.Name)] = struct{}{}
+	}
+
+	schemas, err := metadata.Client.Logic.IntegrationAccountSchemaClient.ListComplete(ctx, integrationaccountschemas.NewIntegrationAccountID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName), integrationaccountschemas.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the schemas within %s: %+v", id, err)
+	}
+	for _, item := range schemas.Items {
+		keys[integrationAccountArtifactKindSchema+"/"+pointer.From(item.Name)] = struct{}{}
+	}
+
+	partners, err := metadata.Client.Logic.IntegrationAccountPartnerClient.ListComplete(ctx, integrationaccountpartners.NewIntegrationAccountID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName), integrationaccountpartners.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the partners within %s: %+v", id, err)
+	}
+	for _, item := range partners.Items {
+		keys[integrationAccountArtifactKindPartner+"/"+pointer.From(item.Name)] = struct{}{}
+	}
+
+	agreements, err := metadata.Client.Logic.IntegrationAccountAgreementClient.ListComplete(ctx, integrationaccountagreements.NewIntegrationAccountID(id.SubscriptionId, id.ResourceGroupName, id.IntegrationAccountName), integrationaccountagreements.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the agreements within %s: %+v", id, err)
+	}
+	for _, item := range agreements.Items {
+		keys[integrationAccountArtifactKindAgreement+"/"+pointer.From(item.Name)] = struct{}{}
+	}
+
+	return keys, nil
+}
+
+// integrationAccountArtifactsFromDirectories reads the artifacts from each of the directories, keyed by `{kind}/{name}`
+// where the name is the file name without its extension. Files with other extensions are ignored.
+func integrationAccountArtifactsFromDirectories(config LogicAppIntegrationAccountArtifactsResourceModel) (map[string]integrationAccountArtifact, error) {
+	artifacts := make(map[string]integrationAccountArtifact)
+
+	directories := []struct {
+		kind         string
+		key          string
+		directory    string
+		extensions   []string
+		validateName pluginsdk.SchemaValidateFunc
+	}{
+		{integrationAccountArtifactKindMap, "map_directory", config.MapDirectory, []string{".xslt", ".xsl", ".liquid"}, validate.IntegrationAccountMapName()},
+		{integrationAccountArtifactKindSchema, "schema_directory", config.SchemaDirectory, []string{".xsd"}, validate.IntegrationAccountSchemaName()},
+		{integrationAccountArtifactKindPartner, "partner_directory", config.PartnerDirectory, []string{".json"}, validate.IntegrationAccountPartnerName()},
+		{integrationAccountArtifactKindAgreement, "agreement_directory", config.AgreementDirectory, []string{".json"}, validate.IntegrationAccountAgreementName()},
+	}
+
+	for _, d := range directories {
+		if d.directory == "" {
+			continue
+		}
+
+		entries, err := os.ReadDir(d.directory)
+		if err != nil {
+			return nil, fmt.Errorf("reading `%s` %q: %+v", d.key, d.directory, err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || !integrationAccountArtifactHasExtension(entry.Name(), d.extensions) {
+				continue
+			}
+
+			name := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+			if _, errs := d.validateName(name, d.key); len(errs) > 0 {
+				return nil, fmt.Errorf("the file name %q in `%s` can't be used as a %s name: %+v", entry.Name(), d.key, d.kind, errors.Join(errs...))
+			}
+
+			key := d.kind + "/" + name
+			if existing, ok := artifacts[key]; ok {
+				return nil, fmt.Errorf("the files %q and %q in `%s` both define the %s %q", existing.FileName, entry.Name(), d.key, d.kind, name)
+			}
+
+			fileName := filepath.Join(d.directory, entry.Name())
+			content, err := os.ReadFile(fileName)
+			if err != nil {
+				return nil, fmt.Errorf("reading %q: %+v", fileName, err)
+			}
+
+			if d.kind == integrationAccountArtifactKindPartner || d.kind == integrationAccountArtifactKindAgreement {
+				if !json.Valid(content) {
+					return nil, fmt.Errorf("%q must contain the JSON properties of the %s", fileName, d.kind)
+				}
+			}
+
+			artifacts[key] = integrationAccountArtifact{
+				Kind:     d.kind,
+				Name:     name,
+				FileName: fileName,
+				Content:  content,
+			}
+		}
+	}
+
+	return artifacts, nil
+}
+
+func integrationAccountArtifactHasExtension(fileName string, extensions []string) bool {
+	for _, extension := range extensions {
+		if strings.EqualFold(filepath.Ext(fileName), extension) {
+			return true
+		}
+	}
+	return false
+}
+
+func integrationAccountArtifactHashes(artifacts map[string]integrationAccountArtifact) map[string]string {
+	hashes := make(map[string]string, len(artifacts))
+	for key, artifact := range artifacts {
+		// the extension is included since it determines the type of a map
+		hash := sha256.Sum256(append([]byte(strings.ToLower(filepath.Ext(artifact.FileName))+"\x00"), artifact.Content...))
+		hashes[key] = hex.EncodeToString(hash[:])
+	}
+	return hashes
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountmaps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogicAppIntegrationAccountArtifactsResource struct{}
+
+func TestAccLogicAppIntegrationAccountArtifacts_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_integration_account_artifacts", "test")
+	r := LogicAppIntegrationAccountArtifactsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hashes.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccLogicAppIntegrationAccountArtifacts_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_integration_account_artifacts", "test")
+	r := LogicAppIntegrationAccountArtifactsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hashes.%").HasValue("6"),
+			),
+		},
+	})
+}
+
+func TestAccLogicAppIntegrationAccountArtifacts_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_integration_account_artifacts", "test")
+	r := LogicAppIntegrationAccountArtifactsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hashes.%").HasValue("6"),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hashes.%").HasValue("1"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hashes.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	artifactsId, err := parse.IntegrationAccountArtifactsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	id := integrationaccountmaps.NewIntegrationAccountID(artifactsId.IntegrationAccountId.SubscriptionId, artifactsId.IntegrationAccountId.ResourceGroupName, artifactsId.IntegrationAccountId.IntegrationAccountName)
+
+	resp, err := client.Logic.IntegrationAccountMapClient.ListComplete(ctx, id, integrationaccountmaps.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the maps within %s: %+v", id, err)
+	}
+
+	return pointer.To(len(resp.Items) > 0), nil
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_integration_account" "test" {
+  name                = "acctest-ia-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_artifacts" "test" {
+  integration_account_id = azurerm_logic_app_integration_account.test.id
+  map_directory          = "testdata/logic_app_integration_account_artifacts/maps"
+}
+`, r.template(data))
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_artifacts" "test" {
+  integration_account_id = azurerm_logic_app_integration_account.test.id
+  map_directory          = "testdata/logic_app_integration_account_artifacts/maps"
+  schema_directory       = "testdata/logic_app_integration_account_artifacts/schemas"
+  partner_directory      = "testdata/logic_app_integration_account_artifacts/partners"
+  agreement_directory    = "testdata/logic_app_integration_account_artifacts/agreements"
+  xslt_map_type          = "Xslt30"
+}
+`, r.template(data))
+}
+
+func (r LogicAppIntegrationAccountArtifactsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account_artifacts" "test" {
+  integration_account_id = azurerm_logic_app_integration_account.test.id
+  map_directory          = "testdata/logic_app_integration_account_artifacts/maps_updated"
+}
+`, r.template(data))
+}
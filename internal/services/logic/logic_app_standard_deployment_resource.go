@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	logicAppStandardConnectionsFileName = "connections.json"
+	logicAppStandardParametersFileName  = "parameters.json"
+)
+
+type LogicAppStandardDeploymentResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogicAppStandardDeploymentResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogicAppStandardDeploymentResource{}
+)
+
+type LogicAppStandardDeploymentResourceModel struct {
+	LogicAppId      string `tfschema:"logic_app_id"`
+	SourceDirectory string `tfschema:"source_directory"`
+	Connections     string `tfschema:"connections"`
+	Parameters      string `tfschema:"parameters"`
+	ContentHash     string `tfschema:"content_hash"`
+}
+
+func (r LogicAppStandardDeploymentResource) ResourceType() string {
+	return "azurerm_logic_app_standard_deployment"
+}
+
+func (r LogicAppStandardDeploymentResource) ModelObject() interface{} {
+	return &LogicAppStandardDeploymentResourceModel{}
+}
+
+func (r LogicAppStandardDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	// This is a meta resource with a 1:1 relationship with the Logic App it's deployed to so we use the same ID
+	return commonids.ValidateLogicAppId
+}
+
+func (r LogicAppStandardDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"logic_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateLogicAppId,
+		},
+
+		"source_directory": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"connections": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"parameters": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r LogicAppStandardDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LogicAppStandardDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var config LogicAppStandardDeploymentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseLogicAppId(config.LogicAppId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := deployLogicAppStandardContent(ctx, metadata, *id, config); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// the deployed content can't be read back, so everything other than the Logic App ID is retained from the state
+			var state LogicAppStandardDeploymentResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.LogicAppId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogicAppStandardDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseLogicAppId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config LogicAppStandardDeploymentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("source_directory", "connections", "parameters", "content_hash") {
+				if err := deployLogicAppStandardContent(ctx, metadata, *id, config); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			log.Printf("[INFO] the deployed workflows are left in place on %s, they are removed when the Logic App is deleted or replaced by a subsequent deployment", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardDeploymentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("source_directory") || !rd.NewValueKnown("connections") || !rd.NewValueKnown("parameters") {
+				return rd.SetNewComputed("content_hash")
+			}
+
+			files, err := logicAppStandardDeploymentFiles(rd.Get("source_directory").(string), rd.Get("connections").(string), rd.Get("parameters").(string))
+			if err != nil {
+				return err
+			}
+
+			// hashing the content means changes to the workflow files are picked up, even though the path is unchanged
+			if hash := logicAppStandardDeploymentHash(files); hash != rd.Get("content_hash").(string) {
+				return rd.SetNew("content_hash", hash)
+			}
+
+			return nil
+		},
+	}
+}
+
+func deployLogicAppStandardContent(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.LogicAppId, config LogicAppStandardDeploymentResourceModel) error {
+	client := metadata.Client.AppService.WebAppsClient
+
+	files, err := logicAppStandardDeploymentFiles(config.SourceDirectory, config.Connections, config.Parameters)
+	if err != nil {
+		return err
+	}
+
+	zipFile, err := os.CreateTemp("", "azurerm-logic-app-standard-*.zip")
+	if err != nil {
+		return fmt.Errorf("creating the deployment package for %s: %+v", id, err)
+	}
+	defer os.Remove(zipFile.Name())
+	defer zipFile.Close()
+
+	if err := writeLogicAppStandardDeploymentPackage(zipFile, files); err != nil {
+		return fmt.Errorf("creating the deployment package for %s: %+v", id, err)
+	}
+
+	if err := helpers.GetCredentialsAndPublish(ctx, client, id, zipFile.Name()); err != nil {
+		return fmt.Errorf("deploying the workflows in %q to %s: %+v", config.SourceDirectory, id, err)
+	}
+
+	return nil
+}
+
+// logicAppStandardDeploymentFiles returns the content of each file in the source directory keyed by its relative
+// path, with connections.json and parameters.json replaced by the `connections` and `parameters` when specified
+func logicAppStandardDeploymentFiles(sourceDirectory, connections, parameters string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	err := filepath.WalkDir(sourceDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(relativePath)] = content
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading the workflows in `source_directory` %q: %+v", sourceDirectory, err)
+	}
+
+	if connections != "" {
+		files[logicAppStandardConnectionsFileName] = []byte(connections)
+	}
+	if parameters != "" {
+		files[logicAppStandardParametersFileName] = []byte(parameters)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("`source_directory` %q doesn't contain any files", sourceDirectory)
+	}
+
+	return files, nil
+}
+
+func logicAppStandardDeploymentHash(files map[string][]byte) string {
+	hash := sha256.New()
+	for _, name := range sortedLogicAppStandardDeploymentFileNames(files) {
+		hash.Write([]byte(name))
+		hash.Write([]byte{0})
+		hash.Write(files[name])
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func writeLogicAppStandardDeploymentPackage(output io.Writer, files map[string][]byte) error {
+	writer := zip.NewWriter(output)
+	for _, name := range sortedLogicAppStandardDeploymentFileNames(files) {
+		entry, err := writer.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := entry.Write(files[name]); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+func sortedLogicAppStandardDeploymentFileNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
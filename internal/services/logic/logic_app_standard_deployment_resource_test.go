@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogicAppStandardDeploymentResource struct{}
+
+func TestAccLogicAppStandardDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_deployment", "test")
+	r := LogicAppStandardDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccLogicAppStandardDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_deployment", "test")
+	r := LogicAppStandardDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.parameters(data, "hello again"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.parameters(data, "goodbye"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r LogicAppStandardDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseLogicAppId(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AppService.WebAppsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogicAppStandardDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_deployment" "test" {
+  logic_app_id     = azurerm_logic_app_standard.test.id
+  source_directory = "testdata/logic_app_standard_deployment"
+}
+`, LogicAppStandardResource{}.basic(data))
+}
+
+func (r LogicAppStandardDeploymentResource) parameters(data acceptance.TestData, greeting string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_deployment" "test" {
+  logic_app_id     = azurerm_logic_app_standard.test.id
+  source_directory = "testdata/logic_app_standard_deployment"
+
+  parameters = jsonencode({
+    greeting = {
+      type  = "String"
+      value = "%s"
+    }
+  })
+}
+`, LogicAppStandardResource{}.basic(data), greeting)
+}
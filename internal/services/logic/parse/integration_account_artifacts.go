@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccounts"
+)
+
+var _ resourceids.Id = IntegrationAccountArtifactsId{}
+
+const integrationAccountArtifactsSuffix = "/artifacts/default"
+
+// IntegrationAccountArtifactsId is a synthetic ID for the Artifacts managed within an Integration Account, since the
+// ID of the Integration Account is already used by azurerm_logic_app_integration_account.
+type IntegrationAccountArtifactsId struct {
+	IntegrationAccountId integrationaccounts.IntegrationAccountId
+}
+
+func NewIntegrationAccountArtifactsID(integrationAccountId integrationaccounts.IntegrationAccountId) IntegrationAccountArtifactsId {
+	return IntegrationAccountArtifactsId{
+		IntegrationAccountId: integrationAccountId,
+	}
+}
+
+func (id IntegrationAccountArtifactsId) ID() string {
+	return id.IntegrationAccountId.ID() + integrationAccountArtifactsSuffix
+}
+
+func (id IntegrationAccountArtifactsId) String() string {
+	components := []string{
+		fmt.Sprintf("Integration Account Name %q", id.IntegrationAccountId.IntegrationAccountName),
+		fmt.Sprintf("Resource Group %q", id.IntegrationAccountId.ResourceGroupName),
+	}
+	return fmt.Sprintf("Integration Account Artifacts: (%s)", strings.Join(components, " / "))
+}
+
+// IntegrationAccountArtifactsID parses an IntegrationAccountArtifacts ID into an IntegrationAccountArtifactsId struct
+func IntegrationAccountArtifactsID(input string) (*IntegrationAccountArtifactsId, error) {
+	integrationAccountIdRaw, ok := strings.CutSuffix(input, integrationAccountArtifactsSuffix)
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {integrationAccountId}%s but got %q", integrationAccountArtifactsSuffix, input)
+	}
+
+	integrationAccountId, err := integrationaccounts.ParseIntegrationAccountID(integrationAccountIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IntegrationAccountArtifactsId{
+		IntegrationAccountId: *integrationAccountId,
+	}, nil
+}
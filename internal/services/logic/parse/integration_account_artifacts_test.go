@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccounts"
+)
+
+func TestIntegrationAccountArtifactsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *IntegrationAccountArtifactsId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// integration account id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/integrationAccounts/account1",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/integrationAccounts/account1/artifacts",
+			Error: true,
+		},
+		{
+			// invalid integration account id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/artifacts/default",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Logic/integrationAccounts/account1/artifacts/default",
+			Expected: &IntegrationAccountArtifactsId{
+				IntegrationAccountId: integrationaccounts.NewIntegrationAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := IntegrationAccountArtifactsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.IntegrationAccountId != v.Expected.IntegrationAccountId {
+			t.Fatalf("Expected %+v but got %+v", v.Expected.IntegrationAccountId, actual.IntegrationAccountId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -18,7 +18,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		LogicAppIntegrationAccountArtifactsResource{},
 		LogicAppResource{},
+		LogicAppStandardDeploymentResource{},
 	}
 }
 
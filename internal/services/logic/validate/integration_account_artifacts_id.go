@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+)
+
+func IntegrationAccountArtifactsID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.IntegrationAccountArtifactsID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
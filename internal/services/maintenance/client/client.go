@@ -6,6 +6,7 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/applyupdates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/configurationassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/maintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/publicmaintenanceconfigurations"
@@ -13,12 +14,19 @@ import (
 )
 
 type Client struct {
+	ApplyUpdatesClient             *applyupdates.ApplyUpdatesClient
 	ConfigurationsClient           *maintenanceconfigurations.MaintenanceConfigurationsClient
 	ConfigurationAssignmentsClient *configurationassignments.ConfigurationAssignmentsClient
 	PublicConfigurationsClient     *publicmaintenanceconfigurations.PublicMaintenanceConfigurationsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	applyUpdatesClient, err := applyupdates.NewApplyUpdatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Apply Updates client : %+v", err)
+	}
+	o.Configure(applyUpdatesClient.Client, o.Authorizers.ResourceManager)
+
 	configurationsClient, err := maintenanceconfigurations.NewMaintenanceConfigurationsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Configurations client : %+v", err)
@@ -38,6 +46,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(publicConfigurationsClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
+		ApplyUpdatesClient:             applyUpdatesClient,
 		ConfigurationsClient:           configurationsClient,
 		ConfigurationAssignmentsClient: configurationAssignmentsClient,
 		PublicConfigurationsClient:     publicConfigurationsClient,
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/applyupdates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/configurationassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// latestApplyUpdateName is the name of the Apply Update which represents the most recent run against a resource
+const latestApplyUpdateName = "default"
+
+type MaintenanceConfigurationRunStatusDataSource struct{}
+
+var _ sdk.DataSource = MaintenanceConfigurationRunStatusDataSource{}
+
+type MaintenanceConfigurationRunStatusDataSourceModel struct {
+	MaintenanceConfigurationId string                                   `tfschema:"maintenance_configuration_id"`
+	ResourceIds                []string                                 `tfschema:"resource_ids"`
+	AllCompleted               bool                                     `tfschema:"all_completed"`
+	LastUpdateTime             string                                   `tfschema:"last_update_time"`
+	Resources                  []MaintenanceConfigurationRunStatusModel `tfschema:"resource"`
+}
+
+type MaintenanceConfigurationRunStatusModel struct {
+	ResourceId     string `tfschema:"resource_id"`
+	Status         string `tfschema:"status"`
+	LastUpdateTime string `tfschema:"last_update_time"`
+}
+
+func (MaintenanceConfigurationRunStatusDataSource) ResourceType() string {
+	return "azurerm_maintenance_configuration_run_status"
+}
+
+func (MaintenanceConfigurationRunStatusDataSource) ModelObject() interface{} {
+	return &MaintenanceConfigurationRunStatusDataSourceModel{}
+}
+
+func (MaintenanceConfigurationRunStatusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"maintenance_configuration_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: maintenanceconfigurations.ValidateMaintenanceConfigurationID,
+		},
+
+		"resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (MaintenanceConfigurationRunStatusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"all_completed": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"last_update_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"resource": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_update_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (MaintenanceConfigurationRunStatusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Maintenance.ApplyUpdatesClient
+			assignmentsClient := metadata.Client.Maintenance.ConfigurationAssignmentsClient
+
+			var state MaintenanceConfigurationRunStatusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationIDInsensitively(state.MaintenanceConfigurationId)
+			if err != nil {
+				return err
+			}
+
+			resourceIds := state.ResourceIds
+			if len(resourceIds) == 0 {
+				// resources matched by a Dynamic Scope aren't returned by the API, so when no resources are specified
+				// only those assigned directly to the Maintenance Configuration can be discovered
+				subscriptionId := commonids.NewSubscriptionID(id.SubscriptionId)
+				resp, err := assignmentsClient.WithinSubscriptionList(ctx, subscriptionId)
+				if err != nil {
+					return fmt.Errorf("listing Configuration Assignments within %s: %+v", subscriptionId, err)
+				}
+
+				if model := resp.Model; model != nil {
+					resourceIds = assignedResourceIds(*id, model.Value)
+				}
+			}
+
+			state.Resources = make([]MaintenanceConfigurationRunStatusModel, 0)
+			state.AllCompleted = len(resourceIds) > 0
+			var lastUpdateTime time.Time
+			for _, resourceId := range resourceIds {
+				applyUpdateId := applyupdates.NewScopedApplyUpdateID(resourceId, latestApplyUpdateName)
+				runStatus := MaintenanceConfigurationRunStatusModel{
+					ResourceId: resourceId,
+				}
+
+				resp, err := client.Get(ctx, applyUpdateId)
+				if err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("retrieving %s: %+v", applyUpdateId, err)
+				}
+
+				if model := resp.Model; model != nil {
+					if props := model.Properties; props != nil {
+						runStatus.Status = pointer.FromEnum(props.Status)
+						runStatus.LastUpdateTime = pointer.From(props.LastUpdateTime)
+
+						if t, err := props.GetLastUpdateTimeAsTime(); err == nil && t != nil && t.After(lastUpdateTime) {
+							lastUpdateTime = *t
+						}
+					}
+				}
+
+				if runStatus.Status != string(applyupdates.UpdateStatusCompleted) {
+					state.AllCompleted = false
+				}
+
+				state.Resources = append(state.Resources, runStatus)
+			}
+
+			state.ResourceIds = resourceIds
+			if !lastUpdateTime.IsZero() {
+				state.LastUpdateTime = lastUpdateTime.Format(time.RFC3339)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// assignedResourceIds returns the IDs of the resources which are assigned directly to the specified Maintenance Configuration
+func assignedResourceIds(id maintenanceconfigurations.MaintenanceConfigurationId, assignments *[]configurationassignments.ConfigurationAssignment) []string {
+	resourceIds := make([]string, 0)
+	if assignments == nil {
+		return resourceIds
+	}
+
+	for _, assignment := range *assignments {
+		props := assignment.Properties
+		if props == nil || props.ResourceId == nil || !strings.EqualFold(pointer.From(props.MaintenanceConfigurationId), id.ID()) {
+			continue
+		}
+
+		// Dynamic Scope assignments are scoped to the Subscription rather than an individual resource
+		if _, err := commonids.ParseSubscriptionIDInsensitively(*props.ResourceId); err == nil {
+			continue
+		}
+
+		resourceIds = append(resourceIds, *props.ResourceId)
+	}
+
+	sort.Strings(resourceIds)
+
+	return resourceIds
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package maintenance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MaintenanceConfigurationRunStatusDataSource struct{}
+
+func TestAccMaintenanceConfigurationRunStatusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_maintenance_configuration_run_status", "test")
+	r := MaintenanceConfigurationRunStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("resource.#").HasValue("1"),
+				check.That(data.ResourceName).Key("all_completed").HasValue("false"),
+			),
+		},
+	})
+}
+
+func (MaintenanceConfigurationRunStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_maintenance_configuration_run_status" "test" {
+  maintenance_configuration_id = azurerm_maintenance_assignment_virtual_machine.test.maintenance_configuration_id
+}
+`, MaintenanceAssignmentVirtualMachineResource{}.basic(data))
+}
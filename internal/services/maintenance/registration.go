@@ -33,7 +33,9 @@ func (r Registration) WebsiteCategories() []string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		MaintenanceConfigurationRunStatusDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
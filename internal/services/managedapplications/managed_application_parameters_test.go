@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedapplications
+
+import (
+	"testing"
+)
+
+func TestSuppressManagedApplicationParameterValuesDiff(t *testing.T) {
+	cases := []struct {
+		name     string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			name:     "identical",
+			old:      `{"stringParameter":{"value":"value_1"}}`,
+			new:      `{"stringParameter":{"value":"value_1"}}`,
+			suppress: true,
+		},
+		{
+			name:     "different ordering and whitespace",
+			old:      `{"a":{"value":"1"},"b":{"value":true}}`,
+			new:      `{ "b": { "value": true }, "a": { "value": "1" } }`,
+			suppress: true,
+		},
+		{
+			name:     "int specified as a string",
+			old:      `{"intParameter":{"value":100}}`,
+			new:      `{"intParameter":{"value":"100"}}`,
+			suppress: true,
+		},
+		{
+			name:     "bool specified as a string",
+			old:      `{"boolParameter":{"value":true}}`,
+			new:      `{"boolParameter":{"value":"true"}}`,
+			suppress: true,
+		},
+		{
+			name:     "parameter using its default value",
+			old:      `{"stringParameter":{"value":"value_1"},"defaultParameter":{"value":"default"}}`,
+			new:      `{"stringParameter":{"value":"value_1"}}`,
+			suppress: true,
+		},
+		{
+			name:     "nested objects",
+			old:      `{"objectParameter":{"value":{"nested_array":["value_1","value_2"],"nested_bool":true}}}`,
+			new:      `{"objectParameter":{"value":{"nested_bool":true,"nested_array":["value_1","value_2"]}}}`,
+			suppress: true,
+		},
+		{
+			name:     "changed value",
+			old:      `{"stringParameter":{"value":"value_1"}}`,
+			new:      `{"stringParameter":{"value":"value_2"}}`,
+			suppress: false,
+		},
+		{
+			name:     "changed nested value",
+			old:      `{"arrayParameter":{"value":["value_1","value_2"]}}`,
+			new:      `{"arrayParameter":{"value":["value_2","value_1"]}}`,
+			suppress: false,
+		},
+		{
+			name:     "added parameter",
+			old:      `{"stringParameter":{"value":"value_1"}}`,
+			new:      `{"stringParameter":{"value":"value_1"},"intParameter":{"value":1}}`,
+			suppress: false,
+		},
+		{
+			name:     "invalid json",
+			old:      `{"stringParameter":{"value":"value_1"}}`,
+			new:      `{"stringParameter":`,
+			suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := suppressManagedApplicationParameterValuesDiff("parameter_values", tc.old, tc.new, nil); actual != tc.suppress {
+				t.Fatalf("expected %t but got %t", tc.suppress, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenManagedApplicationTypedOutputs(t *testing.T) {
+	var input interface{} = map[string]interface{}{
+		"boolOutput":   map[string]interface{}{"type": "Bool", "value": true},
+		"intOutput":    map[string]interface{}{"type": "Int", "value": float64(100)},
+		"stringOutput": map[string]interface{}{"type": "String", "value": "stringOutputValue"},
+		"arrayOutput":  map[string]interface{}{"type": "Array", "value": []interface{}{"value_1"}},
+	}
+
+	boolOutputs, numberOutputs, stringOutputs := flattenManagedApplicationTypedOutputs(&input)
+
+	if len(boolOutputs) != 1 || boolOutputs["boolOutput"] != true {
+		t.Fatalf("unexpected bool outputs: %+v", boolOutputs)
+	}
+	if len(numberOutputs) != 1 || numberOutputs["intOutput"] != float64(100) {
+		t.Fatalf("unexpected number outputs: %+v", numberOutputs)
+	}
+	if len(stringOutputs) != 1 || stringOutputs["stringOutput"] != "stringOutputValue" {
+		t.Fatalf("unexpected string outputs: %+v", stringOutputs)
+	}
+}
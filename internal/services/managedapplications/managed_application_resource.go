@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applicationdefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applications"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/validate"
 	resourcesParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
@@ -87,7 +89,59 @@ func resourceManagedApplicationSchema() map[string]*pluginsdk.Schema {
 			Optional:         true,
 			Computed:         true,
 			ValidateFunc:     validation.StringIsJSON,
-			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			DiffSuppressFunc: suppressManagedApplicationParameterValuesDiff,
+		},
+
+		"jit_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"approval_mode": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(applications.JitApprovalModeAutoApprove),
+							string(applications.JitApprovalModeManualApprove),
+						}, false),
+					},
+
+					"approver": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(applications.JitApproverTypeUser),
+									ValidateFunc: validation.StringInSlice(applications.PossibleValuesForJitApproverType(), false),
+								},
+
+								"display_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"maximum_access_duration": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+				},
+			},
 		},
 
 		"plan": {
@@ -140,6 +194,30 @@ func resourceManagedApplicationSchema() map[string]*pluginsdk.Schema {
 				Type: pluginsdk.TypeString,
 			},
 		},
+
+		"bool_outputs": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeBool,
+			},
+		},
+
+		"number_outputs": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeFloat,
+			},
+		},
+
+		"string_outputs": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
 	}
 
 	return schema
@@ -186,6 +264,10 @@ func resourceManagedApplicationCreate(d *pluginsdk.ResourceData, meta interface{
 		parameters.Plan = expandManagedApplicationPlan(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("jit_configuration"); ok {
+		parameters.Properties.JitAccessPolicy = expandManagedApplicationJitAccessPolicy(v.([]interface{}))
+	}
+
 	params, err := expandManagedApplicationParameters(d)
 	if err != nil {
 		return fmt.Errorf("expanding `parameter_values`: %+v", err)
@@ -223,6 +305,10 @@ func resourceManagedApplicationUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Properties.ApplicationDefinitionId = pointer.To(d.Get("application_definition_id").(string))
 	}
 
+	if d.HasChange("jit_configuration") {
+		payload.Properties.JitAccessPolicy = expandManagedApplicationJitAccessPolicy(d.Get("jit_configuration").([]interface{}))
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -281,6 +367,10 @@ func resourceManagedApplicationRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("managed_resource_group_name", id.ResourceGroup)
 		d.Set("application_definition_id", p.ApplicationDefinitionId)
 
+		if err := d.Set("jit_configuration", flattenManagedApplicationJitAccessPolicy(p.JitAccessPolicy)); err != nil {
+			return fmt.Errorf("setting `jit_configuration`: %+v", err)
+		}
+
 		expendedParams, err := expandManagedApplicationParameters(d)
 		if err != nil {
 			return fmt.Errorf("expanding `parameter_values`: %+v", err)
@@ -300,6 +390,17 @@ func resourceManagedApplicationRead(d *pluginsdk.ResourceData, meta interface{})
 			return err
 		}
 
+		boolOutputs, numberOutputs, stringOutputs := flattenManagedApplicationTypedOutputs(p.Outputs)
+		if err = d.Set("bool_outputs", boolOutputs); err != nil {
+			return fmt.Errorf("setting `bool_outputs`: %+v", err)
+		}
+		if err = d.Set("number_outputs", numberOutputs); err != nil {
+			return fmt.Errorf("setting `number_outputs`: %+v", err)
+		}
+		if err = d.Set("string_outputs", stringOutputs); err != nil {
+			return fmt.Errorf("setting `string_outputs`: %+v", err)
+		}
+
 		if err = tags.FlattenAndSet(d, model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
@@ -340,6 +441,42 @@ func expandManagedApplicationPlan(input []interface{}) *applications.Plan {
 	}
 }
 
+func expandManagedApplicationJitAccessPolicy(input []interface{}) *applications.ApplicationJitAccessPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return &applications.ApplicationJitAccessPolicy{
+			JitAccessEnabled: false,
+		}
+	}
+	v := input[0].(map[string]interface{})
+
+	approvers := make([]applications.JitApproverDefinition, 0)
+	for _, item := range v["approver"].([]interface{}) {
+		approver := item.(map[string]interface{})
+
+		definition := applications.JitApproverDefinition{
+			Id:   approver["id"].(string),
+			Type: pointer.To(applications.JitApproverType(approver["type"].(string))),
+		}
+		if displayName := approver["display_name"].(string); displayName != "" {
+			definition.DisplayName = pointer.To(displayName)
+		}
+
+		approvers = append(approvers, definition)
+	}
+
+	policy := applications.ApplicationJitAccessPolicy{
+		JitAccessEnabled: true,
+		JitApprovalMode:  pointer.To(applications.JitApprovalMode(v["approval_mode"].(string))),
+		JitApprovers:     &approvers,
+	}
+
+	if duration := v["maximum_access_duration"].(string); duration != "" {
+		policy.MaximumJitAccessDuration = pointer.To(duration)
+	}
+
+	return &policy
+}
+
 func expandManagedApplicationParameters(d *pluginsdk.ResourceData) (*map[string]interface{}, error) {
 	newParams := make(map[string]interface{})
 
@@ -369,6 +506,31 @@ func flattenManagedApplicationPlan(input *applications.Plan) []interface{} {
 	return results
 }
 
+func flattenManagedApplicationJitAccessPolicy(input *applications.ApplicationJitAccessPolicy) []interface{} {
+	if input == nil || !input.JitAccessEnabled {
+		return []interface{}{}
+	}
+
+	approvers := make([]interface{}, 0)
+	if input.JitApprovers != nil {
+		for _, approver := range *input.JitApprovers {
+			approvers = append(approvers, map[string]interface{}{
+				"id":           approver.Id,
+				"type":         string(pointer.From(approver.Type)),
+				"display_name": pointer.From(approver.DisplayName),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"approval_mode":           string(pointer.From(input.JitApprovalMode)),
+			"approver":                approvers,
+			"maximum_access_duration": pointer.From(input.MaximumJitAccessDuration),
+		},
+	}
+}
+
 func flattenManagedApplicationOutputs(input *interface{}) (map[string]interface{}, error) {
 	results := make(map[string]interface{})
 	if input == nil {
@@ -400,6 +562,40 @@ func flattenManagedApplicationOutputs(input *interface{}) (map[string]interface{
 	return results, nil
 }
 
+// flattenManagedApplicationTypedOutputs splits the scalar outputs of the Managed Application by their type, so that
+// they can be referenced without being converted - object and array outputs are only available JSON-encoded in `outputs`
+func flattenManagedApplicationTypedOutputs(input *interface{}) (map[string]interface{}, map[string]interface{}, map[string]interface{}) {
+	boolOutputs := make(map[string]interface{})
+	numberOutputs := make(map[string]interface{})
+	stringOutputs := make(map[string]interface{})
+	if input == nil {
+		return boolOutputs, numberOutputs, stringOutputs
+	}
+
+	attrs, ok := (*input).(map[string]interface{})
+	if !ok {
+		return boolOutputs, numberOutputs, stringOutputs
+	}
+
+	for k, val := range attrs {
+		output, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch v := output["value"].(type) {
+		case bool:
+			boolOutputs[k] = v
+		case float64:
+			numberOutputs[k] = v
+		case string:
+			stringOutputs[k] = v
+		}
+	}
+
+	return boolOutputs, numberOutputs, stringOutputs
+}
+
 func flattenManagedApplicationParameterValuesValueToString(input *interface{}, localParameters map[string]interface{}) (string, error) {
 	if input == nil {
 		return "", nil
@@ -462,3 +658,69 @@ func compactParameterOrOutputValue(v interface{}) (string, error) {
 	}
 	return compactJson.String(), nil
 }
+
+// suppressManagedApplicationParameterValuesDiff compares the values of each parameter rather than the JSON documents,
+// since the API returns all parameters of the Managed Application Definition (including those using their default
+// value) and may return scalar values using a different type to the one they were specified with
+func suppressManagedApplicationParameterValuesDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	oldParameters, err := normalizeManagedApplicationParameterValues(old)
+	if err != nil {
+		return false
+	}
+	newParameters, err := normalizeManagedApplicationParameterValues(new)
+	if err != nil {
+		return false
+	}
+
+	for name, newValue := range newParameters {
+		oldValue, ok := oldParameters[name]
+		if !ok || !managedApplicationParameterValuesEqual(oldValue, newValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeManagedApplicationParameterValues(input string) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+	if input == "" {
+		return output, nil
+	}
+
+	parameters := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(input), &parameters); err != nil {
+		return nil, err
+	}
+
+	for name, parameter := range parameters {
+		if v, ok := parameter.(map[string]interface{}); ok {
+			output[name] = v["value"]
+			continue
+		}
+		output[name] = parameter
+	}
+
+	return output, nil
+}
+
+func managedApplicationParameterValuesEqual(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+
+	// scalar values can be specified as strings, e.g. `"100"` for an `int` parameter, which the API returns as `100`
+	aValue, aIsScalar := managedApplicationScalarParameterValue(a)
+	bValue, bIsScalar := managedApplicationScalarParameterValue(b)
+	return aIsScalar && bIsScalar && aValue == bValue
+}
+
+func managedApplicationScalarParameterValue(input interface{}) (string, bool) {
+	switch input.(type) {
+	case bool, float64, string:
+		v, err := extractParameterOrOutputValue(input)
+		return v, err == nil
+	default:
+		return "", false
+	}
+}
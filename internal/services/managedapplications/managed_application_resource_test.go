@@ -35,6 +35,9 @@ func TestAccManagedApplication_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("outputs.intOutput").HasValue("100"),
 				check.That(data.ResourceName).Key("outputs.objectOutput").HasValue("{\"nested_array\":[\"value_1\",\"value_2\"],\"nested_bool\":true,\"nested_object\":{\"key_0\":0}}"),
 				check.That(data.ResourceName).Key("outputs.stringOutput").HasValue("stringOutputValue"),
+				check.That(data.ResourceName).Key("bool_outputs.boolOutput").HasValue("true"),
+				check.That(data.ResourceName).Key("number_outputs.intOutput").HasValue("100"),
+				check.That(data.ResourceName).Key("string_outputs.stringOutput").HasValue("stringOutputValue"),
 			),
 		},
 		data.ImportStep(),
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2024-11-30/federatedidentitycredentials"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not yet include the `claimsMatchingExpression` property
+// for Federated Identity Credentials (known as Flexible Federated Identity Credentials), which is only available from
+// API Version `2025-01-31-preview`. Once the SDK supports a version of the Managed Identity API which includes this
+// property, this can be removed.
+
+const federatedIdentityCredentialApiVersion = "2025-01-31-preview"
+
+type FederatedIdentityCredentialsClient struct {
+	client *resourcemanager.Client
+}
+
+func NewFederatedIdentityCredentialsWorkaroundClient(client *federatedidentitycredentials.FederatedIdentityCredentialsClient) FederatedIdentityCredentialsClient {
+	return FederatedIdentityCredentialsClient{
+		client: client.Client,
+	}
+}
+
+type FederatedIdentityCredential struct {
+	Id         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties *FederatedIdentityCredentialProperties `json:"properties,omitempty"`
+	Type       *string                                `json:"type,omitempty"`
+}
+
+type FederatedIdentityCredentialProperties struct {
+	Audiences                []string                  `json:"audiences"`
+	ClaimsMatchingExpression *ClaimsMatchingExpression `json:"claimsMatchingExpression,omitempty"`
+	Issuer                   string                    `json:"issuer"`
+	Subject                  *string                   `json:"subject,omitempty"`
+}
+
+type ClaimsMatchingExpression struct {
+	LanguageVersion int64  `json:"languageVersion"`
+	Value           string `json:"value"`
+}
+
+type FederatedIdentityCredentialOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *FederatedIdentityCredential
+}
+
+type federatedIdentityCredentialOperationOptions struct{}
+
+func (o federatedIdentityCredentialOperationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o federatedIdentityCredentialOperationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o federatedIdentityCredentialOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", federatedIdentityCredentialApiVersion)
+	return &out
+}
+
+func (c FederatedIdentityCredentialsClient) Get(ctx context.Context, id federatedidentitycredentials.FederatedIdentityCredentialId) (result FederatedIdentityCredentialOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: federatedIdentityCredentialOperationOptions{},
+		Path:          id.ID(),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model FederatedIdentityCredential
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c FederatedIdentityCredentialsClient) CreateOrUpdate(ctx context.Context, id federatedidentitycredentials.FederatedIdentityCredentialId, input FederatedIdentityCredential) (result FederatedIdentityCredentialOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: federatedIdentityCredentialOperationOptions{},
+		Path:          id.ID(),
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model FederatedIdentityCredential
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
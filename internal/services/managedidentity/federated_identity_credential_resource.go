@@ -8,14 +8,18 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2024-11-30/federatedidentitycredentials"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/propagation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 var _ sdk.Resource = FederatedIdentityCredentialResource{}
@@ -34,9 +38,15 @@ type FederatedIdentityCredentialResourceSchema struct {
 	// TODO: Remove this in V5.0
 	ResourceGroupName string `tfschema:"resource_group_name,removedInNextMajorVersion"`
 
-	ParentId               string `tfschema:"parent_id,removedInNextMajorVersion"`
-	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
-	Subject                string `tfschema:"subject"`
+	ParentId                 string                                                `tfschema:"parent_id,removedInNextMajorVersion"`
+	UserAssignedIdentityId   string                                                `tfschema:"user_assigned_identity_id"`
+	Subject                  string                                                `tfschema:"subject"`
+	ClaimsMatchingExpression []FederatedIdentityCredentialClaimsMatchingExpression `tfschema:"claims_matching_expression"`
+}
+
+type FederatedIdentityCredentialClaimsMatchingExpression struct {
+	Value           string `tfschema:"value"`
+	LanguageVersion int64  `tfschema:"language_version"`
 }
 
 func (r FederatedIdentityCredentialResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -75,10 +85,34 @@ func (r FederatedIdentityCredentialResource) Arguments() map[string]*pluginsdk.S
 			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 		},
 		"subject": {
-			ForceNew: false,
-			Required: true,
-			Type:     pluginsdk.TypeString,
+			ForceNew:     false,
+			Optional:     true,
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"subject", "claims_matching_expression"},
+		},
+		"claims_matching_expression": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"subject", "claims_matching_expression"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"language_version": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntInSlice([]int{1}),
+					},
+				},
+			},
 		},
+		"propagation_wait": propagation.Schema(),
 	}
 
 	if !features.FivePointOh() {
@@ -114,7 +148,7 @@ func (r FederatedIdentityCredentialResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.ManagedIdentity.V20241130.FederatedIdentityCredentials
+			client := azuresdkhacks.NewFederatedIdentityCredentialsWorkaroundClient(metadata.Client.ManagedIdentity.V20241130.FederatedIdentityCredentials)
 
 			var config FederatedIdentityCredentialResourceSchema
 			if err := metadata.Decode(&config); err != nil {
@@ -149,7 +183,7 @@ func (r FederatedIdentityCredentialResource) Create() sdk.ResourceFunc {
 				}
 			}
 
-			var payload federatedidentitycredentials.FederatedIdentityCredential
+			var payload azuresdkhacks.FederatedIdentityCredential
 			r.mapFederatedIdentityCredentialResourceSchemaToFederatedIdentityCredential(config, &payload)
 
 			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
@@ -157,7 +191,26 @@ func (r FederatedIdentityCredentialResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+
+			propagationWait, err := propagation.Expand(metadata.ResourceData.Get("propagation_wait").([]interface{}))
+			if err != nil {
+				return err
+			}
+			return propagation.WaitFor(ctx, propagationWait, id.String(), func(ctx context.Context) (bool, error) {
+				resp, err := client.Get(ctx, id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return false, nil
+					}
+					return false, fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				var state FederatedIdentityCredentialResourceSchema
+				if resp.Model != nil {
+					r.mapFederatedIdentityCredentialToFederatedIdentityCredentialResourceSchema(*resp.Model, &state)
+				}
+				return state.Subject == config.Subject && claimsMatchingExpressionValue(state) == claimsMatchingExpressionValue(config), nil
+			})
 		},
 	}
 }
@@ -166,7 +219,7 @@ func (r FederatedIdentityCredentialResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.ManagedIdentity.V20241130.FederatedIdentityCredentials
+			client := azuresdkhacks.NewFederatedIdentityCredentialsWorkaroundClient(metadata.Client.ManagedIdentity.V20241130.FederatedIdentityCredentials)
 			schema := FederatedIdentityCredentialResourceSchema{}
 
 			id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(metadata.ResourceData.Id())
@@ -242,28 +295,54 @@ func (r FederatedIdentityCredentialResource) Delete() sdk.ResourceFunc {
 	}
 }
 
-func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialResourceSchemaToFederatedIdentityCredentialProperties(input FederatedIdentityCredentialResourceSchema, output *federatedidentitycredentials.FederatedIdentityCredentialProperties) {
+func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialResourceSchemaToFederatedIdentityCredentialProperties(input FederatedIdentityCredentialResourceSchema, output *azuresdkhacks.FederatedIdentityCredentialProperties) {
 	output.Audiences = input.Audience
 	output.Issuer = input.Issuer
-	output.Subject = input.Subject
+
+	if input.Subject != "" {
+		output.Subject = pointer.To(input.Subject)
+	}
+
+	if len(input.ClaimsMatchingExpression) > 0 {
+		output.ClaimsMatchingExpression = &azuresdkhacks.ClaimsMatchingExpression{
+			Value:           input.ClaimsMatchingExpression[0].Value,
+			LanguageVersion: input.ClaimsMatchingExpression[0].LanguageVersion,
+		}
+	}
 }
 
-func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialPropertiesToFederatedIdentityCredentialResourceSchema(input federatedidentitycredentials.FederatedIdentityCredentialProperties, output *FederatedIdentityCredentialResourceSchema) {
+func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialPropertiesToFederatedIdentityCredentialResourceSchema(input azuresdkhacks.FederatedIdentityCredentialProperties, output *FederatedIdentityCredentialResourceSchema) {
 	output.Audience = input.Audiences
 	output.Issuer = input.Issuer
-	output.Subject = input.Subject
+	output.Subject = pointer.From(input.Subject)
+
+	output.ClaimsMatchingExpression = make([]FederatedIdentityCredentialClaimsMatchingExpression, 0)
+	if v := input.ClaimsMatchingExpression; v != nil {
+		output.ClaimsMatchingExpression = append(output.ClaimsMatchingExpression, FederatedIdentityCredentialClaimsMatchingExpression{
+			Value:           v.Value,
+			LanguageVersion: v.LanguageVersion,
+		})
+	}
 }
 
-func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialResourceSchemaToFederatedIdentityCredential(input FederatedIdentityCredentialResourceSchema, output *federatedidentitycredentials.FederatedIdentityCredential) {
+func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialResourceSchemaToFederatedIdentityCredential(input FederatedIdentityCredentialResourceSchema, output *azuresdkhacks.FederatedIdentityCredential) {
 	if output.Properties == nil {
-		output.Properties = &federatedidentitycredentials.FederatedIdentityCredentialProperties{}
+		output.Properties = &azuresdkhacks.FederatedIdentityCredentialProperties{}
 	}
 	r.mapFederatedIdentityCredentialResourceSchemaToFederatedIdentityCredentialProperties(input, output.Properties)
 }
 
-func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialToFederatedIdentityCredentialResourceSchema(input federatedidentitycredentials.FederatedIdentityCredential, output *FederatedIdentityCredentialResourceSchema) {
+func (r FederatedIdentityCredentialResource) mapFederatedIdentityCredentialToFederatedIdentityCredentialResourceSchema(input azuresdkhacks.FederatedIdentityCredential, output *FederatedIdentityCredentialResourceSchema) {
 	if input.Properties == nil {
-		input.Properties = &federatedidentitycredentials.FederatedIdentityCredentialProperties{}
+		input.Properties = &azuresdkhacks.FederatedIdentityCredentialProperties{}
 	}
 	r.mapFederatedIdentityCredentialPropertiesToFederatedIdentityCredentialResourceSchema(*input.Properties, output)
 }
+
+func claimsMatchingExpressionValue(input FederatedIdentityCredentialResourceSchema) string {
+	if len(input.ClaimsMatchingExpression) == 0 {
+		return ""
+	}
+
+	return input.ClaimsMatchingExpression[0].Value
+}
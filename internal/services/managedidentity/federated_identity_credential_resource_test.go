@@ -50,6 +50,53 @@ func TestAccFederatedIdentityCredential_basic(t *testing.T) {
 	})
 }
 
+func TestAccFederatedIdentityCredential_claimsMatchingExpression(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credential", "test")
+	r := FederatedIdentityCredentialTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.claimsMatchingExpression(data, "refs/heads/*"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subject").IsEmpty(),
+				check.That(data.ResourceName).Key("claims_matching_expression.0.language_version").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.claimsMatchingExpression(data, "refs/heads/release/*"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_matching_expression.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFederatedIdentityCredential_propagationWait(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credential", "test")
+	r := FederatedIdentityCredentialTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.propagationWait(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("propagation_wait"),
+	})
+}
+
 func TestAccFederatedIdentityCredential_deprecated(t *testing.T) {
 	if features.FivePointOh() {
 		t.Skip("this test is only valid in versions prior to 5.0")
@@ -125,6 +172,40 @@ resource "azurerm_federated_identity_credential" "test" {
 `, r.template(data))
 }
 
+func (r FederatedIdentityCredentialTestResource) claimsMatchingExpression(data acceptance.TestData, ref string) string {
+	return fmt.Sprintf(`
+%s
+resource "azurerm_federated_identity_credential" "test" {
+  audience                  = ["api://AzureADTokenExchange"]
+  issuer                    = "https://token.actions.githubusercontent.com"
+  name                      = "acctest-${local.random_integer}"
+  user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+
+  claims_matching_expression {
+    value = "claims['sub'] matches 'repo:contoso/contoso-repo:ref:%s'"
+  }
+}
+`, r.template(data), ref)
+}
+
+func (r FederatedIdentityCredentialTestResource) propagationWait(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+resource "azurerm_federated_identity_credential" "test" {
+  audience                  = ["foo"]
+  issuer                    = "https://foo"
+  name                      = "acctest-${local.random_integer}"
+  user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+  subject                   = "foo"
+
+  propagation_wait {
+    timeout               = "5m"
+    consecutive_successes = 5
+  }
+}
+`, r.template(data))
+}
+
 func (r FederatedIdentityCredentialTestResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
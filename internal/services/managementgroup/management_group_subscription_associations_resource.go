@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/management/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceManagementGroupSubscriptionAssociations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceManagementGroupSubscriptionAssociationsCreateUpdate,
+		Read:   resourceManagementGroupSubscriptionAssociationsRead,
+		Update: resourceManagementGroupSubscriptionAssociationsCreateUpdate,
+		Delete: resourceManagementGroupSubscriptionAssociationsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagementGroupSubscriptionAssociationsID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagementGroupID,
+			},
+
+			"subscription_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: commonids.ValidateSubscriptionID,
+				},
+			},
+		},
+	}
+}
+
+func resourceManagementGroupSubscriptionAssociationsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managementGroupId, err := parse.ManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewManagementGroupSubscriptionAssociationsID(commonids.NewManagementGroupID(managementGroupId.Name))
+
+	locks.ByID(id.ManagementGroupId.ID())
+	defer locks.UnlockByID(id.ManagementGroupId.ID())
+
+	desired := make(map[string]struct{})
+	for _, v := range d.Get("subscription_ids").(*pluginsdk.Set).List() {
+		subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(v.(string))
+		if err != nil {
+			return err
+		}
+		desired[strings.ToLower(subscriptionId.SubscriptionId)] = struct{}{}
+	}
+
+	existing, err := listManagementGroupSubscriptionIds(ctx, client, id.ManagementGroupId)
+	if err != nil {
+		return err
+	}
+
+	unmanaged := make([]string, 0)
+	for _, subscriptionId := range existing {
+		if _, ok := desired[strings.ToLower(subscriptionId)]; !ok {
+			unmanaged = append(unmanaged, subscriptionId)
+		}
+	}
+
+	// this resource is authoritative, so any Subscription in the Management Group which isn't specified is removed - however
+	// to avoid an incomplete configuration moving Subscriptions out of the Management Group, this only happens once the
+	// resource is managed by Terraform, so a Management Group which already contains other Subscriptions must be imported
+	if d.IsNewResource() && len(unmanaged) > 0 {
+		return tf.ImportAsExistsError("azurerm_management_group_subscription_associations", id.ID())
+	}
+
+	for _, subscriptionId := range unmanaged {
+		associationId := managementgroups.NewSubscriptionID(id.ManagementGroupId.GroupId, subscriptionId)
+		log.Printf("[DEBUG] Removing %s", associationId)
+		if err := removeManagementGroupSubscriptionAssociation(ctx, client, associationId); err != nil {
+			return err
+		}
+	}
+
+	existingIds := make(map[string]struct{})
+	for _, subscriptionId := range existing {
+		existingIds[strings.ToLower(subscriptionId)] = struct{}{}
+	}
+
+	for subscriptionId := range desired {
+		if _, ok := existingIds[subscriptionId]; ok {
+			continue
+		}
+
+		associationId := managementgroups.NewSubscriptionID(id.ManagementGroupId.GroupId, subscriptionId)
+		log.Printf("[DEBUG] Creating %s", associationId)
+		if _, err := client.SubscriptionsCreate(ctx, associationId, managementgroups.SubscriptionsCreateOperationOptions{
+			CacheControl: &managementGroupCacheControl,
+		}); err != nil {
+			return fmt.Errorf("associating Subscription %q with Management Group %q: %+v", subscriptionId, id.ManagementGroupId.GroupId, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceManagementGroupSubscriptionAssociationsRead(d, meta)
+}
+
+func resourceManagementGroupSubscriptionAssociationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementGroupSubscriptionAssociationsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ManagementGroupId, managementgroups.GetOperationOptions{
+		CacheControl: &managementGroupCacheControl,
+		Expand:       pointer.To(managementgroups.ExpandChildren),
+		Recurse:      pointer.To(false),
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] Management Group %q was not found - removing from state", id.ManagementGroupId.GroupId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Management Group %q: %+v", id.ManagementGroupId.GroupId, err)
+	}
+
+	subscriptionIds := make([]string, 0)
+	if model := resp.Model; model != nil && model.Properties != nil {
+		for _, subscriptionId := range subscriptionIdsFromManagementGroupChildren(model.Properties.Children) {
+			subscriptionIds = append(subscriptionIds, commonids.NewSubscriptionID(subscriptionId).ID())
+		}
+	}
+
+	d.Set("management_group_id", parse.NewManagementGroupId(id.ManagementGroupId.GroupId).ID())
+	d.Set("subscription_ids", subscriptionIds)
+
+	return nil
+}
+
+func resourceManagementGroupSubscriptionAssociationsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ManagementGroups.GroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementGroupSubscriptionAssociationsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ManagementGroupId.ID())
+	defer locks.UnlockByID(id.ManagementGroupId.ID())
+
+	// only the Subscriptions managed by this resource are removed, which places them back in the Tenant Root Group
+	for _, v := range d.Get("subscription_ids").(*pluginsdk.Set).List() {
+		subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(v.(string))
+		if err != nil {
+			return err
+		}
+
+		associationId := managementgroups.NewSubscriptionID(id.ManagementGroupId.GroupId, subscriptionId.SubscriptionId)
+		if err := removeManagementGroupSubscriptionAssociation(ctx, client, associationId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listManagementGroupSubscriptionIds(ctx context.Context, client *managementgroups.ManagementGroupsClient, id commonids.ManagementGroupId) ([]string, error) {
+	resp, err := client.Get(ctx, id, managementgroups.GetOperationOptions{
+		CacheControl: &managementGroupCacheControl,
+		Expand:       pointer.To(managementgroups.ExpandChildren),
+		Recurse:      pointer.To(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Management Group %q: %+v", id.GroupId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		return subscriptionIdsFromManagementGroupChildren(model.Properties.Children), nil
+	}
+
+	return []string{}, nil
+}
+
+func subscriptionIdsFromManagementGroupChildren(input *[]managementgroups.ManagementGroupChildInfo) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, child := range *input {
+		if child.Type == nil || *child.Type != managementgroups.ManagementGroupChildTypeSubscriptions || child.Name == nil {
+			continue
+		}
+		output = append(output, *child.Name)
+	}
+
+	return output
+}
+
+func removeManagementGroupSubscriptionAssociation(ctx context.Context, client *managementgroups.ManagementGroupsClient, id managementgroups.SubscriptionId) error {
+	resp, err := client.SubscriptionsDelete(ctx, id, managementgroups.SubscriptionsDeleteOperationOptions{
+		CacheControl: &managementGroupCacheControl,
+	})
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("removing Subscription %q from Management Group %q: %+v", id.SubscriptionId, id.GroupId, err)
+		}
+	}
+
+	// the removal is replicated between regions asynchronously, so wait for the Subscription to no longer be listed
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Exists"},
+		Target:                    []string{"NotFound"},
+		Refresh:                   subscriptionAssociationRefreshFunc(ctx, client, id),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 10,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Subscription %q to be removed from Management Group %q: %+v", id.SubscriptionId, id.GroupId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/management/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupSubscriptionAssociations struct{}
+
+func TestAccManagementGroupSubscriptionAssociations_basic(t *testing.T) {
+	if os.Getenv("ARM_SUBSCRIPTION_ID_ALT") == "" {
+		t.Skip("skipping test as ARM_SUBSCRIPTION_ID_ALT is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_management_group_subscription_associations", "test")
+	r := ManagementGroupSubscriptionAssociations{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupSubscriptionAssociations_unmanagedSubscription(t *testing.T) {
+	if os.Getenv("ARM_SUBSCRIPTION_ID_ALT") == "" {
+		t.Skip("skipping test as ARM_SUBSCRIPTION_ID_ALT is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_management_group_subscription_associations", "test")
+	r := ManagementGroupSubscriptionAssociations{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unmanagedSubscriptionTemplate(),
+		},
+		{
+			// the Subscription which isn't specified must not be removed when creating this resource
+			Config:      r.unmanagedSubscription(),
+			ExpectError: acceptance.RequiresImportError("azurerm_management_group_subscription_associations"),
+		},
+	})
+}
+
+func (r ManagementGroupSubscriptionAssociations) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {
+  subscription_id = %q
+}
+
+resource "azurerm_management_group" "test" {
+}
+
+resource "azurerm_management_group_subscription_associations" "test" {
+  management_group_id = azurerm_management_group.test.id
+  subscription_ids    = [data.azurerm_subscription.test.id]
+}
+`, os.Getenv("ARM_SUBSCRIPTION_ID_ALT"))
+}
+
+func (r ManagementGroupSubscriptionAssociations) unmanagedSubscriptionTemplate() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {
+  subscription_id = %q
+}
+
+resource "azurerm_management_group" "test" {
+}
+
+resource "azurerm_management_group_subscription_association" "test" {
+  management_group_id = azurerm_management_group.test.id
+  subscription_id     = data.azurerm_subscription.test.id
+}
+`, os.Getenv("ARM_SUBSCRIPTION_ID_ALT"))
+}
+
+func (r ManagementGroupSubscriptionAssociations) unmanagedSubscription() string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subscription" "other" {
+  subscription_id = %q
+}
+
+resource "azurerm_management_group_subscription_associations" "test" {
+  management_group_id = azurerm_management_group.test.id
+  subscription_ids    = [data.azurerm_subscription.other.id]
+
+  depends_on = [azurerm_management_group_subscription_association.test]
+}
+`, r.unmanagedSubscriptionTemplate(), os.Getenv("ARM_SUBSCRIPTION_ID"))
+}
+
+func (r ManagementGroupSubscriptionAssociations) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagementGroupSubscriptionAssociationsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ManagementGroups.GroupsClient.Get(ctx, id.ManagementGroupId, managementgroups.GetOperationOptions{
+		CacheControl: pointer.To("no-cache"),
+		Expand:       pointer.To(managementgroups.ExpandChildren),
+		Recurse:      pointer.To(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Management Group to check for Subscription Associations: %+v", err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Children == nil {
+		return pointer.To(false), nil
+	}
+
+	expected := state.Attributes["subscription_ids.#"]
+	count := 0
+	for _, v := range *resp.Model.Properties.Children {
+		if v.Type != nil && *v.Type == managementgroups.ManagementGroupChildTypeSubscriptions {
+			count++
+		}
+	}
+
+	return pointer.To(strconv.Itoa(count) == expected), nil
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/management/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.Sweeper = ManagementGroupSweeper{}
+
+// ManagementGroupSweeper removes Management Groups which have been leaked by the Acceptance Tests.
+//
+// NOTE: Management Groups are shared by every Subscription within the Tenant, as such these are only
+// swept when Tenant resources are explicitly included. Management Groups expose neither Tags nor a
+// creation time - so these are never matched when a Tag filter is specified, and the last updated
+// time (which can only be later than the creation time) is used to determine their age.
+type ManagementGroupSweeper struct{}
+
+func (ManagementGroupSweeper) Name() string {
+	return "Management Groups"
+}
+
+func (ManagementGroupSweeper) Sweep(ctx context.Context, client *clients.Client, filter sdk.SweepFilter) ([]string, error) {
+	if !filter.IncludeTenantResources {
+		log.Printf("[DEBUG] Skipping Management Groups since Tenant resources weren't included")
+		return nil, nil
+	}
+
+	if !filter.MatchesTags(nil) {
+		log.Printf("[DEBUG] Skipping Management Groups since these can't be filtered by Tags")
+		return nil, nil
+	}
+
+	groupsClient := client.ManagementGroups.GroupsClient
+	resp, err := groupsClient.ListComplete(ctx, managementgroups.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Management Groups: %+v", err)
+	}
+
+	swept := make([]string, 0)
+	for _, group := range resp.Items {
+		name := pointer.From(group.Name)
+		displayName := ""
+		if props := group.Properties; props != nil {
+			displayName = pointer.From(props.DisplayName)
+		}
+		if !filter.MatchesName(name) && !filter.MatchesName(displayName) {
+			continue
+		}
+
+		id := commonids.NewManagementGroupID(name)
+		updatedAt, err := managementGroupUpdatedTime(ctx, groupsClient, id)
+		if err != nil {
+			return swept, err
+		}
+		if !filter.MatchesAge(updatedAt) {
+			continue
+		}
+
+		swept = append(swept, id.ID())
+		if filter.DryRun {
+			log.Printf("[DEBUG] Dry Run: would delete %s", id)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting %s..", id)
+		if err := groupsClient.DeleteThenPoll(ctx, id, managementgroups.DefaultDeleteOperationOptions()); err != nil {
+			return swept, fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return swept, nil
+}
+
+// managementGroupUpdatedTime returns the time the Management Group was last updated, which isn't included
+// when listing the Management Groups within the Tenant.
+func managementGroupUpdatedTime(ctx context.Context, client *managementgroups.ManagementGroupsClient, id commonids.ManagementGroupId) (*time.Time, error) {
+	resp, err := client.Get(ctx, id, managementgroups.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Details != nil {
+		updatedAt, err := model.Properties.Details.GetUpdatedTimeAsTime()
+		if err != nil {
+			return nil, fmt.Errorf("parsing `updatedTime` for %s: %+v", id, err)
+		}
+		return updatedAt, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ManagementGroupSubscriptionAssociationsId{}
+
+const managementGroupSubscriptionAssociationsSuffix = "/subscriptionAssociations/default"
+
+// ManagementGroupSubscriptionAssociationsId is a synthetic ID for the full set of Subscriptions associated with a
+// Management Group, since the ID of the Management Group is already used by azurerm_management_group.
+type ManagementGroupSubscriptionAssociationsId struct {
+	ManagementGroupId commonids.ManagementGroupId
+}
+
+func NewManagementGroupSubscriptionAssociationsID(managementGroupId commonids.ManagementGroupId) ManagementGroupSubscriptionAssociationsId {
+	return ManagementGroupSubscriptionAssociationsId{
+		ManagementGroupId: managementGroupId,
+	}
+}
+
+func (id ManagementGroupSubscriptionAssociationsId) ID() string {
+	return id.ManagementGroupId.ID() + managementGroupSubscriptionAssociationsSuffix
+}
+
+func (id ManagementGroupSubscriptionAssociationsId) String() string {
+	return fmt.Sprintf("Management Group Subscription Associations: (Management Group %q)", id.ManagementGroupId.GroupId)
+}
+
+// ManagementGroupSubscriptionAssociationsID parses a ManagementGroupSubscriptionAssociations ID into a ManagementGroupSubscriptionAssociationsId struct
+func ManagementGroupSubscriptionAssociationsID(input string) (*ManagementGroupSubscriptionAssociationsId, error) {
+	managementGroupIdRaw, ok := strings.CutSuffix(input, managementGroupSubscriptionAssociationsSuffix)
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {managementGroupId}%s but got %q", managementGroupSubscriptionAssociationsSuffix, input)
+	}
+
+	managementGroupId, err := commonids.ParseManagementGroupID(managementGroupIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagementGroupSubscriptionAssociationsId{
+		ManagementGroupId: *managementGroupId,
+	}, nil
+}
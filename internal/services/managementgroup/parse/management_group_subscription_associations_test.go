@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestManagementGroupSubscriptionAssociationsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected string
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// management group id
+			Input: "/providers/Microsoft.Management/managementGroups/group1",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/providers/Microsoft.Management/managementGroups/group1/subscriptionAssociations",
+			Error: true,
+		},
+		{
+			// missing management group
+			Input: "/subscriptionAssociations/default",
+			Error: true,
+		},
+		{
+			// valid
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/subscriptionAssociations/default",
+			Expected: "group1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagementGroupSubscriptionAssociationsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ManagementGroupId.GroupId != v.Expected {
+			t.Fatalf("Expected %q but got %q for GroupId", v.Expected, actual.ManagementGroupId.GroupId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
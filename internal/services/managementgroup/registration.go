@@ -15,6 +15,7 @@ type Registration struct{}
 var (
 	_ sdk.FrameworkServiceRegistration               = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.ServiceRegistrationWithSweepers            = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -43,8 +44,16 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_management_group":                          resourceManagementGroup(),
-		"azurerm_management_group_subscription_association": resourceManagementGroupSubscriptionAssociation(),
+		"azurerm_management_group":                           resourceManagementGroup(),
+		"azurerm_management_group_subscription_association":  resourceManagementGroupSubscriptionAssociation(),
+		"azurerm_management_group_subscription_associations": resourceManagementGroupSubscriptionAssociations(),
+	}
+}
+
+// Sweepers returns a list of Sweepers supported by this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		ManagementGroupSweeper{},
 	}
 }
 
@@ -0,0 +1,739 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = CustomLogPipelineResource{}
+	_ sdk.ResourceWithCustomizeDiff = CustomLogPipelineResource{}
+)
+
+// monitoringMetricsPublisherRoleDefinitionId is the ID of the built-in `Monitoring Metrics Publisher` Role, which
+// is required to send data to a Data Collection Rule
+const monitoringMetricsPublisherRoleDefinitionId = "3913510d-42f4-4e42-8a64-420c390055eb"
+
+const customLogPipelineDestinationName = "workspace"
+
+type CustomLogPipelineResource struct{}
+
+type CustomLogPipelineModel struct {
+	Name                          string                         `tfschema:"name"`
+	ResourceGroupName             string                         `tfschema:"resource_group_name"`
+	Location                      string                         `tfschema:"location"`
+	WorkspaceId                   string                         `tfschema:"workspace_id"`
+	TableName                     string                         `tfschema:"table_name"`
+	Columns                       []CustomLogPipelineColumnModel `tfschema:"column"`
+	TransformKql                  string                         `tfschema:"transform_kql"`
+	SenderPrincipalId             string                         `tfschema:"sender_principal_id"`
+	Tags                          map[string]interface{}         `tfschema:"tags"`
+	DataCollectionEndpointId      string                         `tfschema:"data_collection_endpoint_id"`
+	DataCollectionRuleImmutableId string                         `tfschema:"data_collection_rule_immutable_id"`
+	LogsIngestionEndpoint         string                         `tfschema:"logs_ingestion_endpoint"`
+	RoleAssignmentId              string                         `tfschema:"role_assignment_id"`
+	StreamName                    string                         `tfschema:"stream_name"`
+	TableId                       string                         `tfschema:"table_id"`
+}
+
+type CustomLogPipelineColumnModel struct {
+	Name string `tfschema:"name"`
+	Type string `tfschema:"type"`
+}
+
+func (r CustomLogPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$`),
+				"name must be between 3 and 44 characters, can only contain letters, numbers and hyphens, and must start and end with a letter or number",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"table_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+_CL$`), "must only contain letters, numbers and underscores, and must end with '_CL'."),
+		},
+
+		"column": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(datacollectionrules.PossibleValuesForKnownColumnDefinitionType(), false),
+					},
+				},
+			},
+		},
+
+		"transform_kql": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "source",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sender_principal_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r CustomLogPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"data_collection_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"data_collection_rule_immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"logs_ingestion_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"role_assignment_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"stream_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"table_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CustomLogPipelineResource) ModelObject() interface{} {
+	return &CustomLogPipelineModel{}
+}
+
+func (r CustomLogPipelineResource) ResourceType() string {
+	return "azurerm_monitor_custom_log_pipeline"
+}
+
+func (r CustomLogPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return datacollectionrules.ValidateDataCollectionRuleID
+}
+
+func (r CustomLogPipelineResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config CustomLogPipelineModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if len(config.Columns) == 0 {
+				return nil
+			}
+
+			for _, column := range config.Columns {
+				if column.Name == "TimeGenerated" && column.Type == string(datacollectionrules.KnownColumnDefinitionTypeDatetime) {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("a `column` named `TimeGenerated` with the type `datetime` must be specified")
+		},
+	}
+}
+
+func (r CustomLogPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			tablesClient := metadata.Client.LogAnalytics.TablesClient
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config CustomLogPipelineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(config.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := datacollectionrules.NewDataCollectionRuleID(subscriptionId, config.ResourceGroupName, config.Name)
+			existing, err := rulesClient.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			tableId := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, config.TableName)
+			existingTable, err := tablesClient.Get(ctx, tableId)
+			if err != nil && !response.WasNotFound(existingTable.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", tableId, err)
+			}
+			if !response.WasNotFound(existingTable.HttpResponse) {
+				return fmt.Errorf("%s already exists - it must be deleted, or another `table_name` used, for it to be managed by %s", tableId, r.ResourceType())
+			}
+
+			table := tables.Table{
+				Properties: &tables.TableProperties{
+					RetentionInDays:      pointer.To(int64(-1)),
+					TotalRetentionInDays: pointer.To(int64(-1)),
+					Schema: &tables.Schema{
+						Columns:      expandCustomLogPipelineTableColumns(config.Columns),
+						Name:         pointer.To(config.TableName),
+						TableSubType: pointer.To(tables.TableSubTypeEnumDataCollectionRuleBased),
+						TableType:    pointer.To(tables.TableTypeEnumCustomLog),
+					},
+				},
+			}
+			if err := tablesClient.CreateOrUpdateThenPoll(ctx, tableId, table); err != nil {
+				return fmt.Errorf("creating %s: %+v", tableId, err)
+			}
+
+			// the Table and the Data Collection Endpoint are only tracked through the Data Collection Rule, so if a
+			// later step fails they're removed again rather than being left behind outside of the state
+			cleanup := []func() error{
+				func() error {
+					if err := tablesClient.DeleteThenPoll(ctx, tableId); err != nil {
+						return fmt.Errorf("deleting %s: %+v", tableId, err)
+					}
+					return nil
+				},
+			}
+			rollback := func(err error) error {
+				for i := len(cleanup) - 1; i >= 0; i-- {
+					if cleanupErr := cleanup[i](); cleanupErr != nil {
+						return fmt.Errorf("%+v\n\nadditionally, removing the partially created resources failed: %+v", err, cleanupErr)
+					}
+				}
+				return err
+			}
+
+			endpointId := datacollectionendpoints.NewDataCollectionEndpointID(subscriptionId, config.ResourceGroupName, config.Name)
+			endpoint := datacollectionendpoints.DataCollectionEndpointResource{
+				Location:   location.Normalize(config.Location),
+				Properties: &datacollectionendpoints.DataCollectionEndpoint{},
+				Tags:       tags.Expand(config.Tags),
+			}
+			if _, err := endpointsClient.Create(ctx, endpointId, endpoint); err != nil {
+				return rollback(fmt.Errorf("creating %s: %+v", endpointId, err))
+			}
+			cleanup = append(cleanup, func() error {
+				if resp, err := endpointsClient.Delete(ctx, endpointId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", endpointId, err)
+				}
+				return nil
+			})
+
+			streamName := customLogPipelineStreamName(config.TableName)
+			rule := datacollectionrules.DataCollectionRuleResource{
+				Location: location.Normalize(config.Location),
+				Properties: &datacollectionrules.DataCollectionRule{
+					DataCollectionEndpointId: pointer.To(endpointId.ID()),
+					DataFlows: &[]datacollectionrules.DataFlow{
+						{
+							Destinations: &[]string{customLogPipelineDestinationName},
+							OutputStream: pointer.To(streamName),
+							Streams:      &[]datacollectionrules.KnownDataFlowStreams{datacollectionrules.KnownDataFlowStreams(streamName)},
+							TransformKql: pointer.To(config.TransformKql),
+						},
+					},
+					Destinations: &datacollectionrules.DestinationsSpec{
+						LogAnalytics: &[]datacollectionrules.LogAnalyticsDestination{
+							{
+								Name:                pointer.To(customLogPipelineDestinationName),
+								WorkspaceResourceId: pointer.To(workspaceId.ID()),
+							},
+						},
+					},
+					StreamDeclarations: &map[string]datacollectionrules.StreamDeclaration{
+						streamName: {
+							Columns: expandCustomLogPipelineStreamColumns(config.Columns),
+						},
+					},
+				},
+				Tags: tags.Expand(config.Tags),
+			}
+			if _, err := rulesClient.Create(ctx, id, rule); err != nil {
+				return rollback(fmt.Errorf("creating %s: %+v", id, err))
+			}
+
+			metadata.SetID(id)
+
+			if config.SenderPrincipalId != "" {
+				roleAssignmentId, err := createCustomLogPipelineRoleAssignment(ctx, metadata, id, config.SenderPrincipalId)
+				if err != nil {
+					return err
+				}
+
+				if err := metadata.ResourceData.Set("role_assignment_id", roleAssignmentId.ID()); err != nil {
+					return fmt.Errorf("setting `role_assignment_id`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CustomLogPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			roleAssignmentsClient := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+			id, err := datacollectionrules.ParseDataCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := rulesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CustomLogPipelineModel{
+				Name:              id.DataCollectionRuleName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DataCollectionRuleImmutableId = pointer.From(props.ImmutableId)
+
+					if props.DataCollectionEndpointId != nil {
+						endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointIDInsensitively(*props.DataCollectionEndpointId)
+						if err != nil {
+							return err
+						}
+						state.DataCollectionEndpointId = endpointId.ID()
+
+						endpoint, err := endpointsClient.Get(ctx, *endpointId)
+						if err != nil && !response.WasNotFound(endpoint.HttpResponse) {
+							return fmt.Errorf("retrieving %s: %+v", *endpointId, err)
+						}
+						if endpointModel := endpoint.Model; endpointModel != nil && endpointModel.Properties != nil && endpointModel.Properties.LogsIngestion != nil {
+							state.LogsIngestionEndpoint = pointer.From(endpointModel.Properties.LogsIngestion.Endpoint)
+						}
+					}
+
+					if destinations := props.Destinations; destinations != nil && destinations.LogAnalytics != nil {
+						for _, destination := range *destinations.LogAnalytics {
+							if pointer.From(destination.Name) != customLogPipelineDestinationName || destination.WorkspaceResourceId == nil {
+								continue
+							}
+
+							workspaceId, err := workspaces.ParseWorkspaceIDInsensitively(*destination.WorkspaceResourceId)
+							if err != nil {
+								return err
+							}
+							state.WorkspaceId = workspaceId.ID()
+						}
+					}
+
+					if dataFlows := props.DataFlows; dataFlows != nil && len(*dataFlows) > 0 {
+						dataFlow := (*dataFlows)[0]
+						state.StreamName = pointer.From(dataFlow.OutputStream)
+						state.TableName = strings.TrimPrefix(state.StreamName, "Custom-")
+						state.TransformKql = pointer.From(dataFlow.TransformKql)
+					}
+
+					if declarations := props.StreamDeclarations; declarations != nil {
+						if declaration, ok := (*declarations)[state.StreamName]; ok {
+							state.Columns = flattenCustomLogPipelineStreamColumns(declaration.Columns)
+						}
+					}
+				}
+			}
+
+			if state.WorkspaceId != "" && state.TableName != "" {
+				workspaceId, err := workspaces.ParseWorkspaceID(state.WorkspaceId)
+				if err != nil {
+					return err
+				}
+				state.TableId = tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, state.TableName).ID()
+			}
+
+			if v := metadata.ResourceData.Get("role_assignment_id").(string); v != "" {
+				roleAssignmentId, err := roleassignments.ParseScopedRoleAssignmentID(v)
+				if err != nil {
+					return err
+				}
+
+				roleAssignment, err := roleAssignmentsClient.Get(ctx, *roleAssignmentId, roleassignments.DefaultGetOperationOptions())
+				if err != nil && !response.WasNotFound(roleAssignment.HttpResponse) {
+					return fmt.Errorf("retrieving %s: %+v", *roleAssignmentId, err)
+				}
+
+				// if the Role Assignment has been removed both fields are left empty, so that it's recreated
+				if model := roleAssignment.Model; model != nil && model.Properties != nil {
+					state.RoleAssignmentId = roleAssignmentId.ID()
+					state.SenderPrincipalId = model.Properties.PrincipalId
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CustomLogPipelineResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			tablesClient := metadata.Client.LogAnalytics.TablesClient
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			roleAssignmentsClient := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+			id, err := datacollectionrules.ParseDataCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config CustomLogPipelineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("column") {
+				tableId, err := tables.ParseTableID(metadata.ResourceData.Get("table_id").(string))
+				if err != nil {
+					return err
+				}
+
+				existing, err := tablesClient.Get(ctx, *tableId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *tableId, err)
+				}
+				if existing.Model == nil || existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving %s: `properties` was nil", *tableId)
+				}
+
+				props := existing.Model.Properties
+				if props.Schema == nil {
+					props.Schema = &tables.Schema{}
+				}
+
+				// Create / Update requests MUST have a nil value for `StandardColumns` or they will get a 400 response
+				props.Schema.StandardColumns = nil
+
+				// the retention values returned when using the workspace defaults must not be sent back, else
+				// the table stops using the workspace defaults
+				if pointer.From(props.RetentionInDaysAsDefault) {
+					props.RetentionInDays = pointer.To(int64(-1))
+				}
+				if pointer.From(props.TotalRetentionInDaysAsDefault) {
+					props.TotalRetentionInDays = pointer.To(int64(-1))
+				}
+
+				props.Schema.Columns = expandCustomLogPipelineTableColumns(config.Columns)
+
+				if err := tablesClient.CreateOrUpdateThenPoll(ctx, *tableId, *existing.Model); err != nil {
+					return fmt.Errorf("updating %s: %+v", *tableId, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointID(metadata.ResourceData.Get("data_collection_endpoint_id").(string))
+				if err != nil {
+					return err
+				}
+
+				existing, err := endpointsClient.Get(ctx, *endpointId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *endpointId, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *endpointId)
+				}
+
+				existing.Model.Tags = tags.Expand(config.Tags)
+
+				if _, err := endpointsClient.Create(ctx, *endpointId, *existing.Model); err != nil {
+					return fmt.Errorf("updating %s: %+v", *endpointId, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("column", "transform_kql", "tags") {
+				existing, err := rulesClient.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil || existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+				}
+
+				payload := existing.Model
+				streamName := customLogPipelineStreamName(config.TableName)
+
+				if metadata.ResourceData.HasChange("column") {
+					payload.Properties.StreamDeclarations = &map[string]datacollectionrules.StreamDeclaration{
+						streamName: {
+							Columns: expandCustomLogPipelineStreamColumns(config.Columns),
+						},
+					}
+				}
+
+				if metadata.ResourceData.HasChange("transform_kql") && payload.Properties.DataFlows != nil && len(*payload.Properties.DataFlows) > 0 {
+					(*payload.Properties.DataFlows)[0].TransformKql = pointer.To(config.TransformKql)
+				}
+
+				if metadata.ResourceData.HasChange("tags") {
+					payload.Tags = tags.Expand(config.Tags)
+				}
+
+				if _, err := rulesClient.Create(ctx, *id, *payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("sender_principal_id") {
+				if v := metadata.ResourceData.Get("role_assignment_id").(string); v != "" {
+					roleAssignmentId, err := roleassignments.ParseScopedRoleAssignmentID(v)
+					if err != nil {
+						return err
+					}
+
+					if resp, err := roleAssignmentsClient.Delete(ctx, *roleAssignmentId, roleassignments.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("deleting %s: %+v", *roleAssignmentId, err)
+					}
+				}
+
+				roleAssignmentId := ""
+				if config.SenderPrincipalId != "" {
+					newRoleAssignmentId, err := createCustomLogPipelineRoleAssignment(ctx, metadata, *id, config.SenderPrincipalId)
+					if err != nil {
+						return err
+					}
+					roleAssignmentId = newRoleAssignmentId.ID()
+				}
+
+				if err := metadata.ResourceData.Set("role_assignment_id", roleAssignmentId); err != nil {
+					return fmt.Errorf("setting `role_assignment_id`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CustomLogPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			tablesClient := metadata.Client.LogAnalytics.TablesClient
+			endpointsClient := metadata.Client.Monitor.DataCollectionEndpointsClient
+			rulesClient := metadata.Client.Monitor.DataCollectionRulesClient
+			roleAssignmentsClient := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+			id, err := datacollectionrules.ParseDataCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if v := metadata.ResourceData.Get("role_assignment_id").(string); v != "" {
+				roleAssignmentId, err := roleassignments.ParseScopedRoleAssignmentID(v)
+				if err != nil {
+					return err
+				}
+
+				if resp, err := roleAssignmentsClient.Delete(ctx, *roleAssignmentId, roleassignments.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *roleAssignmentId, err)
+				}
+			}
+
+			if resp, err := rulesClient.Delete(ctx, *id, datacollectionrules.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if v := metadata.ResourceData.Get("data_collection_endpoint_id").(string); v != "" {
+				endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointID(v)
+				if err != nil {
+					return err
+				}
+
+				if resp, err := endpointsClient.Delete(ctx, *endpointId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *endpointId, err)
+				}
+			}
+
+			if v := metadata.ResourceData.Get("table_id").(string); v != "" {
+				tableId, err := tables.ParseTableID(v)
+				if err != nil {
+					return err
+				}
+
+				if err := tablesClient.DeleteThenPoll(ctx, *tableId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *tableId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func createCustomLogPipelineRoleAssignment(ctx context.Context, metadata sdk.ResourceMetaData, id datacollectionrules.DataCollectionRuleId, principalId string) (*roleassignments.ScopedRoleAssignmentId, error) {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("generating UUID for Role Assignment: %+v", err)
+	}
+
+	roleAssignmentId := roleassignments.NewScopedRoleAssignmentID(id.ID(), name)
+	params := roleassignments.RoleAssignmentCreateParameters{
+		Properties: roleassignments.RoleAssignmentProperties{
+			PrincipalId:      principalId,
+			RoleDefinitionId: fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s", id.SubscriptionId, monitoringMetricsPublisherRoleDefinitionId),
+		},
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	err = pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+		resp, err := client.Create(ctx, roleAssignmentId, params)
+		if err != nil {
+			switch {
+			case utils.ResponseErrorIsRetryable(err):
+				return pluginsdk.RetryableError(err)
+			case response.WasStatusCode(resp.HttpResponse, 400) && strings.Contains(err.Error(), "PrincipalNotFound"):
+				// When waiting for the Principal to become available
+				return pluginsdk.RetryableError(err)
+			default:
+				return pluginsdk.NonRetryableError(err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating %s for %s: %+v", roleAssignmentId, id, err)
+	}
+
+	return &roleAssignmentId, nil
+}
+
+func customLogPipelineStreamName(tableName string) string {
+	return fmt.Sprintf("Custom-%s", tableName)
+}
+
+func expandCustomLogPipelineStreamColumns(input []CustomLogPipelineColumnModel) *[]datacollectionrules.ColumnDefinition {
+	result := make([]datacollectionrules.ColumnDefinition, 0)
+	for _, column := range input {
+		result = append(result, datacollectionrules.ColumnDefinition{
+			Name: pointer.To(column.Name),
+			Type: pointer.To(datacollectionrules.KnownColumnDefinitionType(column.Type)),
+		})
+	}
+	return &result
+}
+
+func expandCustomLogPipelineTableColumns(input []CustomLogPipelineColumnModel) *[]tables.Column {
+	result := make([]tables.Column, 0)
+	for _, column := range input {
+		// the Stream and the Table use different casing for the `datetime` type
+		columnType := tables.ColumnTypeEnum(column.Type)
+		if column.Type == string(datacollectionrules.KnownColumnDefinitionTypeDatetime) {
+			columnType = tables.ColumnTypeEnumDateTime
+		}
+
+		result = append(result, tables.Column{
+			Name: pointer.To(column.Name),
+			Type: pointer.To(columnType),
+		})
+	}
+	return &result
+}
+
+func flattenCustomLogPipelineStreamColumns(input *[]datacollectionrules.ColumnDefinition) []CustomLogPipelineColumnModel {
+	result := make([]CustomLogPipelineColumnModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, column := range *input {
+		result = append(result, CustomLogPipelineColumnModel{
+			Name: pointer.From(column.Name),
+			Type: string(pointer.From(column.Type)),
+		})
+	}
+	return result
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MonitorCustomLogPipelineResource struct{}
+
+func (r MonitorCustomLogPipelineResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := datacollectionrules.ParseDataCollectionRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.DataCollectionRulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return pointer.To(true), nil
+}
+
+func TestAccMonitorCustomLogPipeline_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_custom_log_pipeline", "test")
+	r := MonitorCustomLogPipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_collection_endpoint_id").Exists(),
+				check.That(data.ResourceName).Key("data_collection_rule_immutable_id").Exists(),
+				check.That(data.ResourceName).Key("logs_ingestion_endpoint").Exists(),
+				check.That(data.ResourceName).Key("table_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorCustomLogPipeline_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_custom_log_pipeline", "test")
+	r := MonitorCustomLogPipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorCustomLogPipeline_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_custom_log_pipeline", "test")
+	r := MonitorCustomLogPipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_id").Exists(),
+			),
+		},
+		data.ImportStep("sender_principal_id", "role_assignment_id"),
+	})
+}
+
+func TestAccMonitorCustomLogPipeline_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_custom_log_pipeline", "test")
+	r := MonitorCustomLogPipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sender_principal_id", "role_assignment_id"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_id").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorCustomLogPipelineResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_custom_log_pipeline" "test" {
+  name                = "acctest-clp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  workspace_id        = azurerm_log_analytics_workspace.test.id
+  table_name          = "acctest%d_CL"
+
+  column {
+    name = "TimeGenerated"
+    type = "datetime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r MonitorCustomLogPipelineResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_custom_log_pipeline" "import" {
+  name                = azurerm_monitor_custom_log_pipeline.test.name
+  resource_group_name = azurerm_monitor_custom_log_pipeline.test.resource_group_name
+  location            = azurerm_monitor_custom_log_pipeline.test.location
+  workspace_id        = azurerm_monitor_custom_log_pipeline.test.workspace_id
+  table_name          = azurerm_monitor_custom_log_pipeline.test.table_name
+
+  column {
+    name = "TimeGenerated"
+    type = "datetime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MonitorCustomLogPipelineResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_monitor_custom_log_pipeline" "test" {
+  name                = "acctest-clp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  workspace_id        = azurerm_log_analytics_workspace.test.id
+  table_name          = "acctest%d_CL"
+  transform_kql       = "source | extend Level = tostring(Properties.level)"
+  sender_principal_id = azurerm_user_assigned_identity.test.principal_id
+
+  column {
+    name = "TimeGenerated"
+    type = "datetime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+
+  column {
+    name = "Properties"
+    type = "dynamic"
+  }
+
+  column {
+    name = "Level"
+    type = "string"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r MonitorCustomLogPipelineResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-clp-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
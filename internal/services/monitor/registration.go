@@ -34,6 +34,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AlertProcessingRuleActionGroupResource{},
 		AlertProcessingRuleSuppressionResource{},
+		CustomLogPipelineResource{},
 		DataCollectionEndpointResource{},
 		DataCollectionRuleAssociationResource{},
 		DataCollectionRuleResource{},
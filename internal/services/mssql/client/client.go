@@ -6,6 +6,7 @@ package client
 import (
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/backupshorttermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/blobauditing"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databases"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/longtermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/outboundfirewallrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/privateendpointconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/replicationlinks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/restorabledroppeddatabases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serverazureadadministrators"
@@ -65,6 +67,7 @@ type Client struct {
 	JobTargetGroupsClient                              *jobtargetgroups.JobTargetGroupsClient
 	LongTermRetentionPoliciesClient                    *longtermretentionpolicies.LongTermRetentionPoliciesClient
 	OutboundFirewallRulesClient                        *outboundfirewallrules.OutboundFirewallRulesClient
+	PrivateEndpointConnectionsClient                   *privateendpointconnections.PrivateEndpointConnectionsClient
 	ReplicationLinksClient                             *replicationlinks.ReplicationLinksClient
 	RestorableDroppedDatabasesClient                   *restorabledroppeddatabases.RestorableDroppedDatabasesClient
 	ServerAzureADAdministratorsClient                  *serverazureadadministrators.ServerAzureADAdministratorsClient
@@ -82,6 +85,8 @@ type Client struct {
 	VirtualMachinesClient                              *sqlvirtualmachines.SqlVirtualMachinesClient
 	VirtualMachineGroupsClient                         *sqlvirtualmachinegroups.SqlVirtualMachineGroupsClient
 	VirtualNetworkRulesClient                          *virtualnetworkrules.VirtualNetworkRulesClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -199,6 +204,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(outboundFirewallRulesClient.Client, o.Authorizers.ResourceManager)
 
+	privateEndpointConnectionsClient, err := privateendpointconnections.NewPrivateEndpointConnectionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Private Endpoint Connections Client: %+v", err)
+	}
+	o.Configure(privateEndpointConnectionsClient.Client, o.Authorizers.ResourceManager)
+
 	replicationLinksClient, err := replicationlinks.NewReplicationLinksClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Replication Links Client: %+v", err)
@@ -311,6 +322,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		JobCredentialsClient:                               jobCredentialsClient,
 		JobExecutionsClient:                                jobExecutionsClient,
 		OutboundFirewallRulesClient:                        outboundFirewallRulesClient,
+		PrivateEndpointConnectionsClient:                   privateEndpointConnectionsClient,
 		ServerDNSAliasClient:                               serverDNSAliasClient,
 		ServerDevOpsAuditSettingsClient:                    serverDevOpsAuditSettingsClient,
 		ServerKeysClient:                                   serverKeysClient,
@@ -338,5 +350,14 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SqlVulnerabilityAssessmentSettingsClient:           sqlVulnerabilityAssessmentsSettingsClient,
 		TransparentDataEncryptionsClient:                   transparentDataEncryptionsClient,
 		ServersClient:                                      serversClient,
+
+		options: o,
 	}, nil
 }
+
+func (c Client) CapabilitiesClientForSubscription(subscriptionID string) *sql.CapabilitiesClient {
+	// TODO: this method can be removed once the Capabilities API is available in `hashicorp/go-azure-sdk`
+	capabilitiesClient := sql.NewCapabilitiesClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&capabilitiesClient.Client, c.options.ResourceManagerAuthorizer)
+	return &capabilitiesClient
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -109,6 +110,11 @@ func dataSourceMsSqlElasticpool() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"availability_zone": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -165,6 +171,7 @@ func dataSourceMsSqlElasticpoolRead(d *pluginsdk.ResourceData, meta interface{})
 			}
 			d.Set("enclave_type", enclaveType)
 			d.Set("high_availability_replica_count", props.HighAvailabilityReplicaCount)
+			d.Set("availability_zone", string(pointer.From(props.AvailabilityZone)))
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
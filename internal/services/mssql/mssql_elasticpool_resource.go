@@ -212,6 +212,13 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 4),
 			},
 
+			"availability_zone": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(elasticpools.PossibleValuesForAvailabilityZoneType(), false),
+			},
+
 			"tags": commonschema.Tags(),
 		},
 
@@ -295,6 +302,10 @@ func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		elasticPool.Properties.HighAvailabilityReplicaCount = pointer.To(int64(d.Get("high_availability_replica_count").(int)))
 	}
 
+	if v, ok := d.GetOk("availability_zone"); ok {
+		elasticPool.Properties.AvailabilityZone = pointer.To(elasticpools.AvailabilityZoneType(v.(string)))
+	}
+
 	// NOTE: The service default is actually nil/empty which indicates enclave is disabled. the value `Default` is NOT the default.
 	if v, ok := d.GetOk("enclave_type"); ok && v.(string) != "" {
 		elasticPool.Properties.PreferredEnclaveType = pointer.To(elasticpools.AlwaysEncryptedEnclaveType(v.(string)))
@@ -370,6 +381,7 @@ func resourceMssqlElasticPoolSetFlatten(d *pluginsdk.ResourceData, id *commonids
 			}
 			d.Set("license_type", licenseType)
 			d.Set("high_availability_replica_count", pointer.From(props.HighAvailabilityReplicaCount))
+			d.Set("availability_zone", string(pointer.From(props.AvailabilityZone)))
 
 			if err := d.Set("per_database_settings", flattenMsSqlElasticPoolPerDatabaseSettings(props.PerDatabaseSettings)); err != nil {
 				return fmt.Errorf("setting `per_database_settings`: %+v", err)
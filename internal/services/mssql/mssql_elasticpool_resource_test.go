@@ -446,6 +446,30 @@ func TestAccMsSqlElasticPool_replicaCount(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlElasticPool_hyperscaleAvailabilityZone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MssqlElasticpoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hyperscaleAvailabilityZone(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("availability_zone").HasValue("1"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+		{
+			Config: r.hyperscaleAvailabilityZone(data, "NoPreference"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("availability_zone").HasValue("NoPreference"),
+			),
+		},
+		data.ImportStep("max_size_gb"),
+	})
+}
+
 func TestAccMsSqlElasticPool_highAvailabilityReplicaCountNonHyperscaleError(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
 	r := MssqlElasticpoolResource{}
@@ -926,6 +950,49 @@ resource "azurerm_mssql_elasticpool" "test" {
 `, data.RandomInteger, data.Locations.Primary, replicaCount)
 }
 
+func (MssqlElasticpoolResource) hyperscaleAvailabilityZone(data acceptance.TestData, zone string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                            = "acctest-pool-vcore-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  server_name                     = azurerm_mssql_server.test.name
+  high_availability_replica_count = 1
+  availability_zone               = "%[3]s"
+
+  sku {
+    name     = "HS_Gen5"
+    tier     = "Hyperscale"
+    capacity = 4
+    family   = "Gen5"
+  }
+
+  per_database_settings {
+    min_capacity = 0.25
+    max_capacity = 4
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, zone)
+}
+
 func (r MssqlElasticpoolResource) highAvailabilityReplicaCountNonHyperscale(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,310 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlElasticPoolSkusDataSourceModel struct {
+	Location string                     `tfschema:"location"`
+	Tier     string                     `tfschema:"tier"`
+	Family   string                     `tfschema:"family"`
+	Skus     []MsSqlElasticPoolSkuModel `tfschema:"skus"`
+}
+
+type MsSqlElasticPoolSkuModel struct {
+	Name                   string                                `tfschema:"name"`
+	Tier                   string                                `tfschema:"tier"`
+	Family                 string                                `tfschema:"family"`
+	Capacity               int64                                 `tfschema:"capacity"`
+	Unit                   string                                `tfschema:"unit"`
+	MaxDatabaseCount       int64                                 `tfschema:"max_database_count"`
+	MaxSizeGB              float64                               `tfschema:"max_size_gb"`
+	ZoneRedundantSupported bool                                  `tfschema:"zone_redundant_supported"`
+	LicenseTypes           []string                              `tfschema:"license_types"`
+	PerDatabaseSettings    []MsSqlElasticPoolSkuPerDatabaseModel `tfschema:"per_database_settings"`
+}
+
+type MsSqlElasticPoolSkuPerDatabaseModel struct {
+	MaxCapacity   float64   `tfschema:"max_capacity"`
+	MinCapacities []float64 `tfschema:"min_capacities"`
+}
+
+var _ sdk.DataSource = MsSqlElasticPoolSkusDataSource{}
+
+type MsSqlElasticPoolSkusDataSource struct{}
+
+func (d MsSqlElasticPoolSkusDataSource) ResourceType() string {
+	return "azurerm_mssql_elasticpool_skus"
+}
+
+func (d MsSqlElasticPoolSkusDataSource) ModelObject() interface{} {
+	return &MsSqlElasticPoolSkusDataSourceModel{}
+}
+
+func (d MsSqlElasticPoolSkusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"tier": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Basic",
+				"Standard",
+				"Premium",
+				"GeneralPurpose",
+				"BusinessCritical",
+				"Hyperscale",
+			}, false),
+		},
+
+		"family": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d MsSqlElasticPoolSkusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"skus": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tier": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"family": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"unit": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"max_database_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"max_size_gb": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"zone_redundant_supported": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"license_types": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"per_database_settings": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"max_capacity": {
+									Type:     pluginsdk.TypeFloat,
+									Computed: true,
+								},
+
+								"min_capacities": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeFloat,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d MsSqlElasticPoolSkusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			client := metadata.Client.MSSQL.CapabilitiesClientForSubscription(subscriptionId)
+
+			var state MsSqlElasticPoolSkusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locationName := location.Normalize(state.Location)
+			id := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Sql/locations/%s/elasticPoolSkus", subscriptionId, locationName)
+
+			resp, err := client.ListByLocation(ctx, locationName, sql.CapabilityGroupSupportedElasticPoolEditions)
+			if err != nil {
+				return fmt.Errorf("retrieving the Elastic Pool capabilities for the location %q: %+v", locationName, err)
+			}
+
+			// the capabilities are returned per server version, a SKU can be listed for multiple versions
+			seen := make(map[string]struct{})
+			skus := make([]MsSqlElasticPoolSkuModel, 0)
+			for _, version := range pointer.From(resp.SupportedServerVersions) {
+				if !msSqlCapabilityIsAvailable(version.Status) {
+					continue
+				}
+
+				for _, edition := range pointer.From(version.SupportedElasticPoolEditions) {
+					if !msSqlCapabilityIsAvailable(edition.Status) {
+						continue
+					}
+
+					for _, level := range pointer.From(edition.SupportedElasticPoolPerformanceLevels) {
+						if !msSqlCapabilityIsAvailable(level.Status) || level.Sku == nil {
+							continue
+						}
+
+						sku := flattenMsSqlElasticPoolSkuCapability(level)
+						if state.Tier != "" && !strings.EqualFold(sku.Tier, state.Tier) {
+							continue
+						}
+						if state.Family != "" && !strings.EqualFold(sku.Family, state.Family) {
+							continue
+						}
+
+						key := fmt.Sprintf("%s/%s/%d", sku.Name, sku.Family, sku.Capacity)
+						if _, ok := seen[key]; ok {
+							continue
+						}
+						seen[key] = struct{}{}
+
+						skus = append(skus, sku)
+					}
+				}
+			}
+
+			sort.SliceStable(skus, func(i, j int) bool {
+				if skus[i].Name != skus[j].Name {
+					return skus[i].Name < skus[j].Name
+				}
+				return skus[i].Capacity < skus[j].Capacity
+			})
+
+			state.Location = locationName
+			state.Skus = skus
+
+			metadata.ResourceData.SetId(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func msSqlCapabilityIsAvailable(status sql.CapabilityStatus) bool {
+	return status == sql.CapabilityStatusAvailable || status == sql.CapabilityStatusDefault
+}
+
+func flattenMsSqlElasticPoolSkuCapability(input sql.ElasticPoolPerformanceLevelCapability) MsSqlElasticPoolSkuModel {
+	output := MsSqlElasticPoolSkuModel{
+		Name:                   pointer.From(input.Sku.Name),
+		Tier:                   pointer.From(input.Sku.Tier),
+		Family:                 pointer.From(input.Sku.Family),
+		Capacity:               int64(pointer.From(input.Sku.Capacity)),
+		MaxDatabaseCount:       int64(pointer.From(input.MaxDatabaseCount)),
+		ZoneRedundantSupported: pointer.From(input.ZoneRedundant),
+		LicenseTypes:           make([]string, 0),
+		PerDatabaseSettings:    make([]MsSqlElasticPoolSkuPerDatabaseModel, 0),
+	}
+
+	if level := input.PerformanceLevel; level != nil {
+		output.Unit = string(level.Unit)
+	}
+
+	for _, licenseType := range pointer.From(input.SupportedLicenseTypes) {
+		if msSqlCapabilityIsAvailable(licenseType.Status) && licenseType.Name != nil {
+			output.LicenseTypes = append(output.LicenseTypes, *licenseType.Name)
+		}
+	}
+
+	for _, size := range pointer.From(input.SupportedMaxSizes) {
+		if !msSqlCapabilityIsAvailable(size.Status) {
+			continue
+		}
+		if v := msSqlMaxSizeCapabilityInGB(size.MaxValue); v > output.MaxSizeGB {
+			output.MaxSizeGB = v
+		}
+	}
+
+	for _, maxLevel := range pointer.From(input.SupportedPerDatabaseMaxPerformanceLevels) {
+		if !msSqlCapabilityIsAvailable(maxLevel.Status) {
+			continue
+		}
+
+		perDatabase := MsSqlElasticPoolSkuPerDatabaseModel{
+			MaxCapacity:   pointer.From(maxLevel.Limit),
+			MinCapacities: make([]float64, 0),
+		}
+		for _, minLevel := range pointer.From(maxLevel.SupportedPerDatabaseMinPerformanceLevels) {
+			if msSqlCapabilityIsAvailable(minLevel.Status) {
+				perDatabase.MinCapacities = append(perDatabase.MinCapacities, pointer.From(minLevel.Limit))
+			}
+		}
+		output.PerDatabaseSettings = append(output.PerDatabaseSettings, perDatabase)
+	}
+
+	return output
+}
+
+func msSqlMaxSizeCapabilityInGB(input *sql.MaxSizeCapability) float64 {
+	if input == nil || input.Limit == nil {
+		return 0
+	}
+
+	limit := float64(*input.Limit)
+	switch input.Unit {
+	case sql.MaxSizeUnitMegabytes:
+		return limit / 1024
+	case sql.MaxSizeUnitTerabytes:
+		return limit * 1024
+	case sql.MaxSizeUnitPetabytes:
+		return limit * 1024 * 1024
+	}
+
+	return limit
+}
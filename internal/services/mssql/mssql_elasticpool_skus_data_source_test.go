@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlElasticPoolSkusDataSource struct{}
+
+func TestAccMsSqlElasticPoolSkusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_elasticpool_skus", "test")
+	r := MsSqlElasticPoolSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.0.name").Exists(),
+				check.That(data.ResourceName).Key("skus.0.capacity").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccMsSqlElasticPoolSkusDataSource_hyperscale(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_elasticpool_skus", "test")
+	r := MsSqlElasticPoolSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.hyperscale(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.0.tier").HasValue("Hyperscale"),
+				check.That(data.ResourceName).Key("skus.0.family").HasValue("Gen5"),
+				check.That(data.ResourceName).Key("skus.0.unit").HasValue("VCores"),
+				check.That(data.ResourceName).Key("skus.0.per_database_settings.0.max_capacity").Exists(),
+			),
+		},
+	})
+}
+
+func (MsSqlElasticPoolSkusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_mssql_elasticpool_skus" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (MsSqlElasticPoolSkusDataSource) hyperscale(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_mssql_elasticpool_skus" "test" {
+  location = "%s"
+  tier     = "Hyperscale"
+  family   = "Gen5"
+}
+`, data.Locations.Primary)
+}
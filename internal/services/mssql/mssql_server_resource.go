@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/deletionprotection"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/custompollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
@@ -45,7 +46,7 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 		Update: resourceMsSqlServerUpdate,
 		Delete: resourceMsSqlServerDelete,
 
-		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.SqlServerId{}),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&commonids.SqlServerId{}, deletionprotection.Import),
 		Identity: &schema.ResourceIdentity{
 			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.SqlServerId{}),
 		},
@@ -213,6 +214,8 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				},
 			},
 
+			"deletion_protection_enabled": deletionprotection.Schema(),
+
 			"tags": commonschema.Tags(),
 		},
 
@@ -367,6 +370,12 @@ func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("creating Express Vulnerability Assessment Settings for %s: %+v", id, err)
 	}
 
+	if d.Get("deletion_protection_enabled").(bool) {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceMsSqlServerRead(d, meta)
 }
 
@@ -531,6 +540,12 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("deletion_protection_enabled") {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), d.Get("deletion_protection_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	return resourceMsSqlServerRead(d, meta)
 }
 
@@ -643,6 +658,10 @@ func resourceMssqlServerSetFlatten(ctx context.Context, d *pluginsdk.ResourceDat
 		d.Set("express_vulnerability_assessment_enabled", pointer.From(model.Properties.State) == sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateEnabled)
 	}
 
+	if err := deletionprotection.FlattenAndSet(ctx, d, metaClient.Resource.LocksClient, id.ID()); err != nil {
+		return fmt.Errorf("setting `deletion_protection_enabled`: %+v", err)
+	}
+
 	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
@@ -656,6 +675,10 @@ func resourceMsSqlServerDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if err := deletionprotection.EnsureDeletable(d, id.ID()); err != nil {
+		return err
+	}
+
 	err = client.DeleteThenPoll(ctx, pointer.From(id))
 	if err != nil {
 		return fmt.Errorf("deleting SQL Server %s: %+v", id, err)
@@ -62,7 +62,10 @@ type ReplicaMsSqlVirtualMachineAvailabilityGroupListener struct {
 	ReadableSecondary   string `tfschema:"readable_secondary"`
 }
 
-var _ sdk.Resource = MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+var (
+	_ sdk.Resource                  = MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+	_ sdk.ResourceWithCustomizeDiff = MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+)
 
 func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) ModelObject() interface{} {
 	return &MsSqlVirtualMachineAvailabilityGroupListenerModel{}
@@ -236,6 +239,61 @@ func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Attributes() map[s
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config MsSqlVirtualMachineAvailabilityGroupListenerModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if len(config.MultiSubnetIpConfiguration) == 0 {
+				return nil
+			}
+
+			// a multi-subnet listener bypasses the load balancer, so the SQL IaaS Agent requires an IP address in a
+			// separate subnet for each replica - values which are not yet known are validated by the API instead
+			replicas := make(map[string]struct{})
+			for _, replica := range config.Replica {
+				if replica.SqlVirtualMachineId != "" {
+					replicas[strings.ToLower(replica.SqlVirtualMachineId)] = struct{}{}
+				}
+			}
+
+			sqlVirtualMachineIds := make(map[string]struct{})
+			subnetIds := make(map[string]struct{})
+			for _, item := range config.MultiSubnetIpConfiguration {
+				if item.SqlVirtualMachineId != "" {
+					sqlVirtualMachineId := strings.ToLower(item.SqlVirtualMachineId)
+					if _, ok := sqlVirtualMachineIds[sqlVirtualMachineId]; ok {
+						return fmt.Errorf("only one `multi_subnet_ip_configuration` can be specified per SQL Virtual Machine, got multiple for %q", item.SqlVirtualMachineId)
+					}
+					sqlVirtualMachineIds[sqlVirtualMachineId] = struct{}{}
+
+					if _, ok := replicas[sqlVirtualMachineId]; !ok && len(replicas) == len(config.Replica) {
+						return fmt.Errorf("the SQL Virtual Machine %q specified in `multi_subnet_ip_configuration` must also be specified as a `replica`", item.SqlVirtualMachineId)
+					}
+				}
+
+				if item.SubnetId != "" {
+					subnetId := strings.ToLower(item.SubnetId)
+					if _, ok := subnetIds[subnetId]; ok {
+						return fmt.Errorf("each `multi_subnet_ip_configuration` must use a different subnet, got multiple for %q", item.SubnetId)
+					}
+					subnetIds[subnetId] = struct{}{}
+				}
+			}
+
+			if len(config.MultiSubnetIpConfiguration) != len(config.Replica) {
+				return fmt.Errorf("a `multi_subnet_ip_configuration` must be specified for each `replica`, got %d `multi_subnet_ip_configuration` and %d `replica` blocks", len(config.MultiSubnetIpConfiguration), len(config.Replica))
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
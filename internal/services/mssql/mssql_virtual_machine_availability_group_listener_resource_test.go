@@ -6,6 +6,7 @@ package mssql_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -64,6 +65,18 @@ func TestAccMsSqlVirtualMachineAvailabilityGroupListener_multiSubnetIpConfigurat
 	})
 }
 
+func TestAccMsSqlVirtualMachineAvailabilityGroupListener_multiSubnetIpConfigurationSameSubnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_availability_group_listener", "test")
+	r := MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.multiSubnetIpConfigurationSameSubnet(data),
+			ExpectError: regexp.MustCompile("each `multi_subnet_ip_configuration` must use a different subnet"),
+		},
+	})
+}
+
 func (MsSqlVirtualMachineAvailabilityGroupListenerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := availabilitygrouplisteners.ParseAvailabilityGroupListenerID(state.ID)
 	if err != nil {
@@ -473,3 +486,51 @@ resource "azurerm_windows_virtual_machine" "domain_controller" {
 }
 `, data.Locations.Primary, data.RandomInteger, data.RandomString)
 }
+
+func (MsSqlVirtualMachineAvailabilityGroupListenerResource) multiSubnetIpConfigurationSameSubnet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  resource_group_id = "/subscriptions/%[1]s/resourceGroups/acctestRG-%[2]d"
+  subnet_id         = "${local.resource_group_id}/providers/Microsoft.Network/virtualNetworks/acctest-vnet/subnets/subnet1"
+}
+
+resource "azurerm_mssql_virtual_machine_availability_group_listener" "test" {
+  name                         = "acctestli-%[3]s"
+  availability_group_name      = "availabilitygroup1"
+  port                         = 1433
+  sql_virtual_machine_group_id = "${local.resource_group_id}/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachineGroups/acctestgr"
+
+  multi_subnet_ip_configuration {
+    private_ip_address     = "10.0.1.10"
+    sql_virtual_machine_id = "${local.resource_group_id}/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/acctestvm0"
+    subnet_id              = local.subnet_id
+  }
+
+  multi_subnet_ip_configuration {
+    private_ip_address     = "10.0.1.11"
+    sql_virtual_machine_id = "${local.resource_group_id}/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/acctestvm1"
+    subnet_id              = local.subnet_id
+  }
+
+  replica {
+    sql_virtual_machine_id = "${local.resource_group_id}/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/acctestvm0"
+    role                   = "Primary"
+    commit                 = "Synchronous_Commit"
+    failover_mode          = "Automatic"
+    readable_secondary     = "All"
+  }
+
+  replica {
+    sql_virtual_machine_id = "${local.resource_group_id}/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/acctestvm1"
+    role                   = "Secondary"
+    commit                 = "Synchronous_Commit"
+    failover_mode          = "Automatic"
+    readable_secondary     = "All"
+  }
+}
+`, data.Client().SubscriptionID, data.RandomInteger, data.RandomString)
+}
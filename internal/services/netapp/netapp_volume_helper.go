@@ -466,13 +466,11 @@ func expandNetAppVolumeDataProtectionAdvancedRansomwareProtectionPatch(input []i
 }
 
 func flattenNetAppVolumeGroupSAPHanaVolumes(ctx context.Context, input *[]volumegroups.VolumeGroupVolumeProperties, metadata sdk.ResourceMetaData) ([]netAppModels.NetAppVolumeGroupSAPHanaVolume, error) {
-	results := make([]netAppModels.NetAppVolumeGroupSAPHanaVolume, 0)
-
 	if input == nil || len(pointer.From(input)) == 0 {
-		return results, fmt.Errorf("received empty volumegroups.VolumeGroupVolumeProperties slice")
+		return []netAppModels.NetAppVolumeGroupSAPHanaVolume{}, fmt.Errorf("received empty volumegroups.VolumeGroupVolumeProperties slice")
 	}
 
-	for _, item := range *input {
+	results, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, *input, func(ctx context.Context, item volumegroups.VolumeGroupVolumeProperties) (netAppModels.NetAppVolumeGroupSAPHanaVolume, error) {
 		volumeGroupVolume := netAppModels.NetAppVolumeGroupSAPHanaVolume{}
 
 		props := item.Properties
@@ -524,12 +522,12 @@ func flattenNetAppVolumeGroupSAPHanaVolumes(ctx context.Context, input *[]volume
 		volumeClient := metadata.Client.NetApp.VolumeClient
 		id, err := volumes.ParseVolumeID(pointer.From(item.Id))
 		if err != nil {
-			return []netAppModels.NetAppVolumeGroupSAPHanaVolume{}, err
+			return netAppModels.NetAppVolumeGroupSAPHanaVolume{}, err
 		}
 
 		standaloneVol, err := volumeClient.Get(ctx, pointer.From(id))
 		if err != nil {
-			return []netAppModels.NetAppVolumeGroupSAPHanaVolume{}, fmt.Errorf("retrieving %s: %v", id, err)
+			return netAppModels.NetAppVolumeGroupSAPHanaVolume{}, fmt.Errorf("retrieving %s: %v", id, err)
 		}
 
 		if standaloneVol.Model.Properties.DataProtection != nil && standaloneVol.Model.Properties.DataProtection.Replication != nil {
@@ -542,20 +540,21 @@ func flattenNetAppVolumeGroupSAPHanaVolumes(ctx context.Context, input *[]volume
 
 		volumeGroupVolume.Id = pointer.From(standaloneVol.Model.Id)
 
-		results = append(results, volumeGroupVolume)
+		return volumeGroupVolume, nil
+	})
+	if err != nil {
+		return []netAppModels.NetAppVolumeGroupSAPHanaVolume{}, err
 	}
 
 	return results, nil
 }
 
 func flattenNetAppVolumeGroupOracleVolumes(ctx context.Context, input *[]volumegroups.VolumeGroupVolumeProperties, metadata sdk.ResourceMetaData) ([]netAppModels.NetAppVolumeGroupOracleVolume, error) {
-	results := make([]netAppModels.NetAppVolumeGroupOracleVolume, 0)
-
 	if input == nil || len(pointer.From(input)) == 0 {
-		return results, fmt.Errorf("received empty volumegroups.VolumeGroupVolumeProperties slice")
+		return []netAppModels.NetAppVolumeGroupOracleVolume{}, fmt.Errorf("received empty volumegroups.VolumeGroupVolumeProperties slice")
 	}
 
-	for _, item := range *input {
+	results, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, *input, func(ctx context.Context, item volumegroups.VolumeGroupVolumeProperties) (netAppModels.NetAppVolumeGroupOracleVolume, error) {
 		volumeGroupVolume := netAppModels.NetAppVolumeGroupOracleVolume{}
 
 		props := item.Properties
@@ -607,12 +606,12 @@ func flattenNetAppVolumeGroupOracleVolumes(ctx context.Context, input *[]volumeg
 		volumeClient := metadata.Client.NetApp.VolumeClient
 		id, err := volumes.ParseVolumeID(pointer.From(item.Id))
 		if err != nil {
-			return []netAppModels.NetAppVolumeGroupOracleVolume{}, err
+			return netAppModels.NetAppVolumeGroupOracleVolume{}, err
 		}
 
 		standaloneVol, err := volumeClient.Get(ctx, pointer.From(id))
 		if err != nil {
-			return []netAppModels.NetAppVolumeGroupOracleVolume{}, fmt.Errorf("retrieving %s: %v", id, err)
+			return netAppModels.NetAppVolumeGroupOracleVolume{}, fmt.Errorf("retrieving %s: %v", id, err)
 		}
 
 		if standaloneVol.Model.Properties.DataProtection != nil && standaloneVol.Model.Properties.DataProtection.Replication != nil {
@@ -625,7 +624,10 @@ func flattenNetAppVolumeGroupOracleVolumes(ctx context.Context, input *[]volumeg
 
 		volumeGroupVolume.Id = pointer.From(standaloneVol.Model.Id)
 
-		results = append(results, volumeGroupVolume)
+		return volumeGroupVolume, nil
+	})
+	if err != nil {
+		return []netAppModels.NetAppVolumeGroupOracleVolume{}, err
 	}
 
 	return results, nil
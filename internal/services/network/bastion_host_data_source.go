@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
@@ -105,6 +107,11 @@ func dataSourceBastionHost() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"virtual_network_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"location": commonschema.LocationComputed(),
 
 			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
@@ -153,6 +160,16 @@ func dataSourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			d.Set("session_recording_enabled", props.EnableSessionRecording)
 			d.Set("private_only_enabled", props.EnablePrivateOnlyBastion)
 
+			virtualNetworkId := ""
+			if vnet := props.VirtualNetwork; vnet != nil {
+				vnetId, err := commonids.ParseVirtualNetworkIDInsensitively(pointer.From(vnet.Id))
+				if err != nil {
+					return err
+				}
+				virtualNetworkId = vnetId.ID()
+			}
+			d.Set("virtual_network_id", virtualNetworkId)
+
 			copyPasteEnabled := true
 			if props.DisableCopyPaste != nil {
 				copyPasteEnabled = !*props.DisableCopyPaste
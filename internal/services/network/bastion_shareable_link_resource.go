@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/bastionhosts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/bastionshareablelink"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = BastionShareableLinkResource{}
+
+type BastionShareableLinkResource struct{}
+
+type BastionShareableLinkResourceModel struct {
+	BastionHostId    string `tfschema:"bastion_host_id"`
+	VirtualMachineId string `tfschema:"virtual_machine_id"`
+	CreatedAt        string `tfschema:"created_at"`
+	Link             string `tfschema:"link"`
+}
+
+func (BastionShareableLinkResource) ResourceType() string {
+	return "azurerm_bastion_shareable_link"
+}
+
+func (BastionShareableLinkResource) ModelObject() interface{} {
+	return &BastionShareableLinkResourceModel{}
+}
+
+func (BastionShareableLinkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.BastionShareableLinkIDValidation
+}
+
+func (BastionShareableLinkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"bastion_host_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: bastionhosts.ValidateBastionHostID,
+		},
+
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineID,
+		},
+	}
+}
+
+func (BastionShareableLinkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"created_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"link": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r BastionShareableLinkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.BastionShareableLink
+			bastionHostsClient := metadata.Client.Network.BastionHostsClient
+
+			var config BastionShareableLinkResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			bastionHostId, err := bastionhosts.ParseBastionHostID(config.BastionHostId)
+			if err != nil {
+				return err
+			}
+
+			virtualMachineId, err := commonids.ParseVirtualMachineID(config.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewBastionShareableLinkId(*bastionHostId, *virtualMachineId)
+
+			locks.ByName(bastionHostId.BastionHostName, "azurerm_bastion_host")
+			defer locks.UnlockByName(bastionHostId.BastionHostName, "azurerm_bastion_host")
+
+			bastionHost, err := bastionHostsClient.Get(ctx, *bastionHostId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", bastionHostId, err)
+			}
+			if bastionHost.Model == nil || bastionHost.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model` or `properties` was nil", bastionHostId)
+			}
+			if !pointer.From(bastionHost.Model.Properties.EnableShareableLink) {
+				return fmt.Errorf("`shareable_link_enabled` must be enabled on %s before a Shareable Link can be created", bastionHostId)
+			}
+
+			linkBastionHostId := bastionshareablelink.NewBastionHostID(bastionHostId.SubscriptionId, bastionHostId.ResourceGroupName, bastionHostId.BastionHostName)
+
+			existing, err := findBastionShareableLink(ctx, client, linkBastionHostId, virtualMachineId.ID())
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := bastionshareablelink.BastionShareableLinkListRequest{
+				VMs: &[]bastionshareablelink.BastionShareableLink{
+					{
+						VM: bastionshareablelink.Resource{
+							Id: pointer.To(virtualMachineId.ID()),
+						},
+					},
+				},
+			}
+
+			if err := client.PutBastionShareableLinkThenPoll(ctx, linkBastionHostId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r BastionShareableLinkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.BastionShareableLink
+			bastionHostsClient := metadata.Client.Network.BastionHostsClient
+
+			id, err := parse.BastionShareableLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			bastionHost, err := bastionHostsClient.Get(ctx, id.BastionHostId)
+			if err != nil {
+				if response.WasNotFound(bastionHost.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id.BastionHostId, err)
+			}
+
+			linkBastionHostId := bastionshareablelink.NewBastionHostID(id.BastionHostId.SubscriptionId, id.BastionHostId.ResourceGroupName, id.BastionHostId.BastionHostName)
+
+			link, err := findBastionShareableLink(ctx, client, linkBastionHostId, id.VirtualMachineId.ID())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if link == nil {
+				// the link is revoked when the Virtual Machine is deleted or shareable links are disabled on the Bastion Host
+				return metadata.MarkAsGone(id)
+			}
+
+			state := BastionShareableLinkResourceModel{
+				BastionHostId:    id.BastionHostId.ID(),
+				VirtualMachineId: id.VirtualMachineId.ID(),
+				CreatedAt:        pointer.From(link.CreatedAt),
+				Link:             pointer.From(link.Bsl),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r BastionShareableLinkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.BastionShareableLink
+
+			id, err := parse.BastionShareableLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.BastionHostId.BastionHostName, "azurerm_bastion_host")
+			defer locks.UnlockByName(id.BastionHostId.BastionHostName, "azurerm_bastion_host")
+
+			linkBastionHostId := bastionshareablelink.NewBastionHostID(id.BastionHostId.SubscriptionId, id.BastionHostId.ResourceGroupName, id.BastionHostId.BastionHostName)
+
+			payload := bastionshareablelink.BastionShareableLinkListRequest{
+				VMs: &[]bastionshareablelink.BastionShareableLink{
+					{
+						VM: bastionshareablelink.Resource{
+							Id: pointer.To(id.VirtualMachineId.ID()),
+						},
+					},
+				},
+			}
+
+			if err := client.DeleteBastionShareableLinkThenPoll(ctx, linkBastionHostId, payload); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findBastionShareableLink returns the Shareable Link for the specified Virtual Machine, or nil if one doesn't exist
+func findBastionShareableLink(ctx context.Context, client *bastionshareablelink.BastionShareableLinkClient, bastionHostId bastionshareablelink.BastionHostId, virtualMachineId string) (*bastionshareablelink.BastionShareableLink, error) {
+	payload := bastionshareablelink.BastionShareableLinkListRequest{
+		VMs: &[]bastionshareablelink.BastionShareableLink{
+			{
+				VM: bastionshareablelink.Resource{
+					Id: pointer.To(virtualMachineId),
+				},
+			},
+		},
+	}
+
+	resp, err := client.GetBastionShareableLinkComplete(ctx, bastionHostId, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range resp.Items {
+		if strings.EqualFold(pointer.From(item.VM.Id), virtualMachineId) {
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
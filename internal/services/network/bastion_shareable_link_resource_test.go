@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/bastionshareablelink"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type BastionShareableLinkResource struct{}
+
+func TestAccBastionShareableLink_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_bastion_shareable_link", "test")
+	r := BastionShareableLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("link").IsSet(),
+				check.That(data.ResourceName).Key("created_at").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBastionShareableLink_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_bastion_shareable_link", "test")
+	r := BastionShareableLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (BastionShareableLinkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BastionShareableLinkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bastionHostId := bastionshareablelink.NewBastionHostID(id.BastionHostId.SubscriptionId, id.BastionHostId.ResourceGroupName, id.BastionHostId.BastionHostName)
+	resp, err := clients.Network.BastionShareableLink.GetBastionShareableLinkComplete(ctx, bastionHostId, bastionshareablelink.BastionShareableLinkListRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	for _, item := range resp.Items {
+		if strings.EqualFold(pointer.From(item.VM.Id), id.VirtualMachineId.ID()) {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r BastionShareableLinkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_shareable_link" "test" {
+  bastion_host_id    = azurerm_bastion_host.test.id
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+}
+`, r.template(data))
+}
+
+func (r BastionShareableLinkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_bastion_shareable_link" "import" {
+  bastion_host_id    = azurerm_bastion_shareable_link.test.bastion_host_id
+  virtual_machine_id = azurerm_bastion_shareable_link.test.virtual_machine_id
+}
+`, r.basic(data))
+}
+
+func (BastionShareableLinkResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-bastion-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestVNet%[3]s"
+  address_space       = ["192.168.1.0/24"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "bastion" {
+  name                 = "AzureBastionSubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["192.168.1.224/27"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%[3]s"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["192.168.1.0/27"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestBastionPIP%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_bastion_host" "test" {
+  name                   = "acctestBastion%[3]s"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  sku                    = "Standard"
+  shareable_link_enabled = true
+
+  ip_configuration {
+    name                 = "ip-configuration"
+    subnet_id            = azurerm_subnet.bastion.id
+    public_ip_address_id = azurerm_public_ip.test.id
+  }
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/reachabilityanalysisruns"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = &networkManagerReachabilityAnalysisRunPoller{}
+
+type networkManagerReachabilityAnalysisRunPoller struct {
+	client *reachabilityanalysisruns.ReachabilityAnalysisRunsClient
+	id     reachabilityanalysisruns.ReachabilityAnalysisRunId
+}
+
+func NewNetworkManagerReachabilityAnalysisRunPoller(client *reachabilityanalysisruns.ReachabilityAnalysisRunsClient, id reachabilityanalysisruns.ReachabilityAnalysisRunId) *networkManagerReachabilityAnalysisRunPoller {
+	return &networkManagerReachabilityAnalysisRunPoller{
+		client: client,
+		id:     id,
+	}
+}
+
+func (p networkManagerReachabilityAnalysisRunPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.Get(ctx, p.id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", p.id, err)
+	}
+
+	if resp.Model == nil {
+		return &pollingInProgress, nil
+	}
+
+	switch pointer.From(resp.Model.Properties.ProvisioningState) {
+	case reachabilityanalysisruns.ProvisioningStateSucceeded:
+		return &pollingSuccess, nil
+
+	case reachabilityanalysisruns.ProvisioningStateCanceled, reachabilityanalysisruns.ProvisioningStateFailed:
+		return &pollers.PollResult{
+			Status: pollers.PollingStatusFailed,
+		}, pollers.PollingFailedError{
+			Message: fmt.Sprintf("%s finished with provisioning state %q: %s", p.id, pointer.From(resp.Model.Properties.ProvisioningState), pointer.From(resp.Model.Properties.ErrorMessage)),
+		}
+	}
+
+	return &pollingInProgress, nil
+}
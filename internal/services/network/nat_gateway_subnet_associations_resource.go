@@ -0,0 +1,328 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/natgateways"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/subnets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceNatGatewaySubnetAssociations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNatGatewaySubnetAssociationsCreate,
+		Read:   resourceNatGatewaySubnetAssociationsRead,
+		Update: resourceNatGatewaySubnetAssociationsUpdate,
+		Delete: resourceNatGatewaySubnetAssociationsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NatGatewaySubnetAssociationsID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"nat_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: natgateways.ValidateNatGatewayID,
+			},
+
+			"subnet_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: commonids.ValidateSubnetID,
+				},
+			},
+		},
+	}
+}
+
+func resourceNatGatewaySubnetAssociationsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NatGateways
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := natgateways.ParseNatGatewayID(d.Get("nat_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// This is a virtual resource so the last segment is hardcoded
+	id := parse.NewNatGatewaySubnetAssociationsID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.NatGatewayName, "default")
+
+	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
+
+	gateway, err := client.Get(ctx, *gatewayId, natgateways.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(gateway.HttpResponse) {
+			return fmt.Errorf("%s was not found", *gatewayId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+
+	if model := gateway.Model; model != nil && model.Properties != nil && model.Properties.Subnets != nil && len(*model.Properties.Subnets) > 0 {
+		return tf.ImportAsExistsError("azurerm_nat_gateway_subnet_associations", id.ID())
+	}
+
+	for _, v := range d.Get("subnet_ids").(*pluginsdk.Set).List() {
+		subnetId, err := commonids.ParseSubnetID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		if err := updateSubnetNatGateway(ctx, meta, *subnetId, pointer.To(gatewayId.ID())); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNatGatewaySubnetAssociationsRead(d, meta)
+}
+
+func resourceNatGatewaySubnetAssociationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NatGateways
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NatGatewaySubnetAssociationsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := natgateways.NewNatGatewayID(id.SubscriptionId, id.ResourceGroup, id.NatGatewayName)
+
+	resp, err := client.Get(ctx, gatewayId, natgateways.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s could not be found - removing from state!", gatewayId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	subnetIds := make([]interface{}, 0)
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil && props.Subnets != nil {
+			for _, v := range *props.Subnets {
+				if v.Id == nil {
+					continue
+				}
+
+				subnetId, err := commonids.ParseSubnetIDInsensitively(*v.Id)
+				if err != nil {
+					return err
+				}
+				subnetIds = append(subnetIds, subnetId.ID())
+			}
+		}
+	}
+
+	if len(subnetIds) == 0 {
+		log.Printf("[DEBUG] %s has no associated Subnets - removing from state!", gatewayId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("nat_gateway_id", gatewayId.ID())
+	if err := d.Set("subnet_ids", subnetIds); err != nil {
+		return fmt.Errorf("setting `subnet_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceNatGatewaySubnetAssociationsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NatGatewaySubnetAssociationsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := natgateways.NewNatGatewayID(id.SubscriptionId, id.ResourceGroup, id.NatGatewayName)
+
+	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
+
+	if d.HasChange("subnet_ids") {
+		oldRaw, newRaw := d.GetChange("subnet_ids")
+		oldSet := oldRaw.(*pluginsdk.Set)
+		newSet := newRaw.(*pluginsdk.Set)
+
+		for _, v := range oldSet.Difference(newSet).List() {
+			subnetId, err := commonids.ParseSubnetID(v.(string))
+			if err != nil {
+				return err
+			}
+
+			if err := updateSubnetNatGateway(ctx, meta, *subnetId, nil); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+		}
+
+		for _, v := range newSet.Difference(oldSet).List() {
+			subnetId, err := commonids.ParseSubnetID(v.(string))
+			if err != nil {
+				return err
+			}
+
+			if err := updateSubnetNatGateway(ctx, meta, *subnetId, pointer.To(gatewayId.ID())); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+		}
+	}
+
+	return resourceNatGatewaySubnetAssociationsRead(d, meta)
+}
+
+func resourceNatGatewaySubnetAssociationsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NatGateways
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NatGatewaySubnetAssociationsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := natgateways.NewNatGatewayID(id.SubscriptionId, id.ResourceGroup, id.NatGatewayName)
+
+	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
+
+	resp, err := client.Get(ctx, gatewayId, natgateways.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s could not be found - removing %s from state!", gatewayId, id)
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil && props.Subnets != nil {
+			for _, v := range *props.Subnets {
+				if v.Id == nil {
+					continue
+				}
+
+				subnetId, err := commonids.ParseSubnetIDInsensitively(*v.Id)
+				if err != nil {
+					return err
+				}
+
+				if err := updateSubnetNatGateway(ctx, meta, *subnetId, nil); err != nil {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateSubnetNatGateway sets the NAT Gateway of the Subnet to the specified ID, or removes it when `gatewayId` is nil.
+func updateSubnetNatGateway(ctx context.Context, meta interface{}, subnetId commonids.SubnetId, gatewayId *string) error {
+	client := meta.(*clients.Client).Network.Subnets
+	vnetClient := meta.(*clients.Client).Network.VirtualNetworks
+
+	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	locks.ByName(subnetId.SubnetName, SubnetResourceName)
+	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
+
+	subnet, err := client.Get(ctx, subnetId, subnets.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(subnet.HttpResponse) {
+			if gatewayId == nil {
+				return nil
+			}
+			return fmt.Errorf("%s was not found", subnetId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", subnetId, err)
+	}
+
+	if subnet.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", subnetId)
+	}
+	if subnet.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", subnetId)
+	}
+
+	props := subnet.Model.Properties
+	if gatewayId == nil {
+		if props.NatGateway == nil {
+			return nil
+		}
+		props.NatGateway = nil
+	} else {
+		if props.NatGateway != nil && props.NatGateway.Id != nil && strings.EqualFold(*props.NatGateway.Id, *gatewayId) {
+			return nil
+		}
+		props.NatGateway = &subnets.SubResource{
+			Id: gatewayId,
+		}
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, subnetId, *subnet.Model); err != nil {
+		return fmt.Errorf("updating NAT Gateway for %s: %+v", subnetId, err)
+	}
+
+	timeout, _ := ctx.Deadline()
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(subnets.ProvisioningStateUpdating)},
+		Target:     []string{string(subnets.ProvisioningStateSucceeded)},
+		Refresh:    SubnetProvisioningStateRefreshFunc(ctx, client, subnetId),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for provisioning state of %s: %+v", subnetId, err)
+	}
+
+	vnetId := commonids.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroupName, subnetId.VirtualNetworkName)
+	vnetStateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(virtualnetworks.ProvisioningStateUpdating)},
+		Target:     []string{string(virtualnetworks.ProvisioningStateSucceeded)},
+		Refresh:    VirtualNetworkProvisioningStateRefreshFunc(ctx, vnetClient, vnetId),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err = vnetStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for provisioning state of %s: %+v", vnetId, err)
+	}
+
+	return nil
+}
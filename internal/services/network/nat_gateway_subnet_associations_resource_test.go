@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/natgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NatGatewaySubnetAssociationsResource struct{}
+
+func TestAccNatGatewaySubnetAssociations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_nat_gateway_subnet_associations", "test")
+	r := NatGatewaySubnetAssociationsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subnet_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNatGatewaySubnetAssociations_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_nat_gateway_subnet_associations", "test")
+	r := NatGatewaySubnetAssociationsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError(data.ResourceType),
+		},
+	})
+}
+
+func TestAccNatGatewaySubnetAssociations_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_nat_gateway_subnet_associations", "test")
+	r := NatGatewaySubnetAssociationsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subnet_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subnet_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.swapped(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subnet_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NatGatewaySubnetAssociationsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NatGatewaySubnetAssociationsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayId := natgateways.NewNatGatewayID(id.SubscriptionId, id.ResourceGroup, id.NatGatewayName)
+
+	resp, err := clients.Network.NatGateways.Get(ctx, gatewayId, natgateways.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	found := false
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil && props.Subnets != nil {
+			found = len(*props.Subnets) > 0
+		}
+	}
+
+	return pointer.To(found), nil
+}
+
+func (r NatGatewaySubnetAssociationsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway_subnet_associations" "test" {
+  nat_gateway_id = azurerm_nat_gateway.test.id
+  subnet_ids     = [azurerm_subnet.first.id]
+}
+`, r.template(data))
+}
+
+func (r NatGatewaySubnetAssociationsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway_subnet_associations" "import" {
+  nat_gateway_id = azurerm_nat_gateway_subnet_associations.test.nat_gateway_id
+  subnet_ids     = azurerm_nat_gateway_subnet_associations.test.subnet_ids
+}
+`, r.basic(data))
+}
+
+func (r NatGatewaySubnetAssociationsResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway_subnet_associations" "test" {
+  nat_gateway_id = azurerm_nat_gateway.test.id
+  subnet_ids     = [azurerm_subnet.first.id, azurerm_subnet.second.id]
+}
+`, r.template(data))
+}
+
+func (r NatGatewaySubnetAssociationsResource) swapped(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_nat_gateway_subnet_associations" "test" {
+  nat_gateway_id = azurerm_nat_gateway.test.id
+  subnet_ids     = [azurerm_subnet.second.id]
+}
+`, r.template(data))
+}
+
+func (NatGatewaySubnetAssociationsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "first" {
+  name                 = "first"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -112,6 +112,11 @@ func TestAccNetworkManager(t *testing.T) {
 			"complete":       testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_complete,
 			"requiresImport": testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_requiresImport,
 		},
+		"VerifierWorkspaceReachabilityAnalysisRun": {
+			"basic":          testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_basic,
+			"complete":       testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_complete,
+			"requiresImport": testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_requiresImport,
+		},
 		"RoutingConfiguration": {
 			"basic":          testAccNetworkManagerRoutingConfiguration_basic,
 			"complete":       testAccNetworkManagerRoutingConfiguration_complete,
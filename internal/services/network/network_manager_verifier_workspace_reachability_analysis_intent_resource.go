@@ -239,7 +239,7 @@ func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Read() sdk.R
 
 			workspaceId := reachabilityanalysisintents.NewVerifierWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.VerifierWorkspaceName).ID()
 			schema := ManagerVerifierWorkspaceReachabilityAnalysisIntentResourceModel{
-				Name:                id.VerifierWorkspaceName,
+				Name:                id.ReachabilityAnalysisIntentName,
 				VerifierWorkspaceId: workspaceId,
 			}
 
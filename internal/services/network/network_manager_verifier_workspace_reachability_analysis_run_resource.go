@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/reachabilityanalysisintents"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/reachabilityanalysisruns"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+
+type ManagerVerifierWorkspaceReachabilityAnalysisRunResource struct{}
+
+func (ManagerVerifierWorkspaceReachabilityAnalysisRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return reachabilityanalysisruns.ValidateReachabilityAnalysisRunID
+}
+
+func (ManagerVerifierWorkspaceReachabilityAnalysisRunResource) ResourceType() string {
+	return "azurerm_network_manager_verifier_workspace_reachability_analysis_run"
+}
+
+func (ManagerVerifierWorkspaceReachabilityAnalysisRunResource) ModelObject() interface{} {
+	return &ManagerVerifierWorkspaceReachabilityAnalysisRunResourceModel{}
+}
+
+type ManagerVerifierWorkspaceReachabilityAnalysisRunResourceModel struct {
+	AnalysisResult      string `tfschema:"analysis_result"`
+	Description         string `tfschema:"description"`
+	ErrorMessage        string `tfschema:"error_message"`
+	IntentId            string `tfschema:"intent_id"`
+	Name                string `tfschema:"name"`
+	VerifierWorkspaceId string `tfschema:"verifier_workspace_id"`
+}
+
+func (ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9\_\.\-]{1,64}$`),
+				"`name` must be between 1 and 64 characters long and can only contain letters, numbers, underscores(_), periods(.), and hyphens(-).",
+			),
+		},
+
+		"verifier_workspace_id": commonschema.ResourceIDReferenceRequiredForceNew(&reachabilityanalysisruns.VerifierWorkspaceId{}),
+
+		"intent_id": commonschema.ResourceIDReferenceRequiredForceNew(&reachabilityanalysisintents.ReachabilityAnalysisIntentId{}),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"analysis_result": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ReachabilityAnalysisRuns
+
+			var config ManagerVerifierWorkspaceReachabilityAnalysisRunResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := reachabilityanalysisruns.ParseVerifierWorkspaceID(config.VerifierWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			intentId, err := reachabilityanalysisintents.ParseReachabilityAnalysisIntentID(config.IntentId)
+			if err != nil {
+				return err
+			}
+
+			intentWorkspaceId := reachabilityanalysisruns.NewVerifierWorkspaceID(intentId.SubscriptionId, intentId.ResourceGroupName, intentId.NetworkManagerName, intentId.VerifierWorkspaceName)
+			if intentWorkspaceId.ID() != workspaceId.ID() {
+				return fmt.Errorf("`intent_id` must belong to the Verifier Workspace specified in `verifier_workspace_id` (%s)", workspaceId)
+			}
+
+			id := reachabilityanalysisruns.NewReachabilityAnalysisRunID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.NetworkManagerName, workspaceId.VerifierWorkspaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := reachabilityanalysisruns.ReachabilityAnalysisRun{
+				Name: pointer.To(config.Name),
+				Properties: reachabilityanalysisruns.ReachabilityAnalysisRunProperties{
+					IntentId: intentId.ID(),
+				},
+			}
+
+			if config.Description != "" {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if _, err := client.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the analysis is performed asynchronously once the run has been created
+			pollerType := custompollers.NewNetworkManagerReachabilityAnalysisRunPoller(client, id)
+			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the analysis of %s to complete: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ReachabilityAnalysisRuns
+
+			id, err := reachabilityanalysisruns.ParseReachabilityAnalysisRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ManagerVerifierWorkspaceReachabilityAnalysisRunResourceModel{
+				Name:                id.ReachabilityAnalysisRunName,
+				VerifierWorkspaceId: reachabilityanalysisruns.NewVerifierWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.VerifierWorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+
+				intentId, err := reachabilityanalysisintents.ParseReachabilityAnalysisIntentIDInsensitively(props.IntentId)
+				if err != nil {
+					return err
+				}
+				state.IntentId = intentId.ID()
+
+				state.AnalysisResult = pointer.From(props.AnalysisResult)
+				state.Description = pointer.From(props.Description)
+				state.ErrorMessage = pointer.From(props.ErrorMessage)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ReachabilityAnalysisRuns
+
+			id, err := reachabilityanalysisruns.ParseReachabilityAnalysisRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
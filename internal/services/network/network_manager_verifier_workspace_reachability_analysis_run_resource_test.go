@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/reachabilityanalysisruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagerVerifierWorkspaceReachabilityAnalysisRunResource struct{}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_run", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("analysis_result").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_run", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_run", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("analysis_result").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := reachabilityanalysisruns.ParseReachabilityAnalysisRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.ReachabilityAnalysisRuns.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_run" "test" {
+  name                  = "acctest-run-%[2]d"
+  verifier_workspace_id = azurerm_network_manager_verifier_workspace.test.id
+  intent_id             = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_run" "import" {
+  name                  = azurerm_network_manager_verifier_workspace_reachability_analysis_run.test.name
+  verifier_workspace_id = azurerm_network_manager_verifier_workspace_reachability_analysis_run.test.verifier_workspace_id
+  intent_id             = azurerm_network_manager_verifier_workspace_reachability_analysis_run.test.intent_id
+}
+`, r.basic(data))
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_run" "test" {
+  name                  = "acctest-run-%[2]d"
+  verifier_workspace_id = azurerm_network_manager_verifier_workspace.test.id
+  intent_id             = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.id
+  description           = "test"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) template(data acceptance.TestData) string {
+	return ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}.basic(data)
+}
@@ -245,6 +245,19 @@ func resourceNetworkSecurityGroupUpdate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
+	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+
+	if pluginsdk.HasOnlyTagsChange(d) {
+		updated, err := pluginsdk.UpdateTagsOnly(ctx, meta.(*clients.Client).Resource.TagsClient, id.ID(), d)
+		if err != nil {
+			return err
+		}
+		if updated {
+			return resourceNetworkSecurityGroupRead(d, meta)
+		}
+	}
+
 	existing, err := client.Get(ctx, *id, networksecuritygroups.DefaultGetOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -272,9 +285,6 @@ func resourceNetworkSecurityGroupUpdate(d *pluginsdk.ResourceData, meta interfac
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
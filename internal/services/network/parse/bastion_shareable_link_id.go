@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/bastionhosts"
+)
+
+var _ resourceids.Id = BastionShareableLinkId{}
+
+type BastionShareableLinkId struct {
+	BastionHostId    bastionhosts.BastionHostId
+	VirtualMachineId commonids.VirtualMachineId
+}
+
+func (b BastionShareableLinkId) ID() string {
+	return fmt.Sprintf("%s|%s", b.BastionHostId.ID(), b.VirtualMachineId.ID())
+}
+
+func (b BastionShareableLinkId) String() string {
+	components := []string{
+		fmt.Sprintf("BastionHostId %s", b.BastionHostId.ID()),
+		fmt.Sprintf("VirtualMachineId %s", b.VirtualMachineId.ID()),
+	}
+	return fmt.Sprintf("Bastion Shareable Link: %s", strings.Join(components, " / "))
+}
+
+func NewBastionShareableLinkId(bastionHostId bastionhosts.BastionHostId, virtualMachineId commonids.VirtualMachineId) BastionShareableLinkId {
+	return BastionShareableLinkId{
+		BastionHostId:    bastionHostId,
+		VirtualMachineId: virtualMachineId,
+	}
+}
+
+func BastionShareableLinkID(input string) (BastionShareableLinkId, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return BastionShareableLinkId{}, fmt.Errorf("expected ID to be in the format {BastionHostId}|{VirtualMachineId} but got %q", input)
+	}
+
+	bastionHostId, err := bastionhosts.ParseBastionHostID(splitId[0])
+	if err != nil {
+		return BastionShareableLinkId{}, err
+	}
+
+	virtualMachineId, err := commonids.ParseVirtualMachineID(splitId[1])
+	if err != nil {
+		return BastionShareableLinkId{}, err
+	}
+
+	return BastionShareableLinkId{
+		BastionHostId:    *bastionHostId,
+		VirtualMachineId: *virtualMachineId,
+	}, nil
+}
+
+func BastionShareableLinkIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := BastionShareableLinkID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/bastionhosts"
+)
+
+func TestBastionShareableLinkID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Expect *BastionShareableLinkId
+		Error  bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Bastion Host ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/bastionHosts/bastion1",
+			Error: true,
+		},
+		{
+			Name:  "Virtual Machine ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+			Error: true,
+		},
+		{
+			Name:  "Swapped Segments",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/bastionHosts/bastion1",
+			Error: true,
+		},
+		{
+			Name:  "Bastion Shareable Link ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/bastionHosts/bastion1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/virtualMachines/machine1",
+			Error: false,
+			Expect: &BastionShareableLinkId{
+				BastionHostId:    bastionhosts.NewBastionHostID("00000000-0000-0000-0000-000000000000", "group1", "bastion1"),
+				VirtualMachineId: commonids.NewVirtualMachineID("00000000-0000-0000-0000-000000000000", "group2", "machine1"),
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := BastionShareableLinkID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual.BastionHostId.ID() != v.Expect.BastionHostId.ID() {
+			t.Fatalf("Expected %q but got %q for Bastion Host ID", v.Expect.BastionHostId.ID(), actual.BastionHostId.ID())
+		}
+
+		if actual.VirtualMachineId.ID() != v.Expect.VirtualMachineId.ID() {
+			t.Fatalf("Expected %q but got %q for Virtual Machine ID", v.Expect.VirtualMachineId.ID(), actual.VirtualMachineId.ID())
+		}
+	}
+}
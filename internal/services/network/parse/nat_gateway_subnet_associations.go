@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NatGatewaySubnetAssociationsId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	NatGatewayName        string
+	SubnetAssociationName string
+}
+
+func NewNatGatewaySubnetAssociationsID(subscriptionId, resourceGroup, natGatewayName, subnetAssociationName string) NatGatewaySubnetAssociationsId {
+	return NatGatewaySubnetAssociationsId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		NatGatewayName:        natGatewayName,
+		SubnetAssociationName: subnetAssociationName,
+	}
+}
+
+func (id NatGatewaySubnetAssociationsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Subnet Association Name %q", id.SubnetAssociationName),
+		fmt.Sprintf("Nat Gateway Name %q", id.NatGatewayName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Nat Gateway Subnet Associations", segmentsStr)
+}
+
+func (id NatGatewaySubnetAssociationsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/natGateways/%s/subnetAssociations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NatGatewayName, id.SubnetAssociationName)
+}
+
+// NatGatewaySubnetAssociationsID parses a NatGatewaySubnetAssociations ID into an NatGatewaySubnetAssociationsId struct
+func NatGatewaySubnetAssociationsID(input string) (*NatGatewaySubnetAssociationsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NatGatewaySubnetAssociations ID: %+v", input, err)
+	}
+
+	resourceId := NatGatewaySubnetAssociationsId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NatGatewayName, err = id.PopSegment("natGateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.SubnetAssociationName, err = id.PopSegment("subnetAssociations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NatGatewaySubnetAssociationsIDInsensitively parses an NatGatewaySubnetAssociations ID into an NatGatewaySubnetAssociationsId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NatGatewaySubnetAssociationsID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NatGatewaySubnetAssociationsIDInsensitively(input string) (*NatGatewaySubnetAssociationsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NatGatewaySubnetAssociationsId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'natGateways' segment
+	natGatewaysKey := "natGateways"
+	for key := range id.Path {
+		if strings.EqualFold(key, natGatewaysKey) {
+			natGatewaysKey = key
+			break
+		}
+	}
+	if resourceId.NatGatewayName, err = id.PopSegment(natGatewaysKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'subnetAssociations' segment
+	subnetAssociationsKey := "subnetAssociations"
+	for key := range id.Path {
+		if strings.EqualFold(key, subnetAssociationsKey) {
+			subnetAssociationsKey = key
+			break
+		}
+	}
+	if resourceId.SubnetAssociationName, err = id.PopSegment(subnetAssociationsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = PrivateEndpointConnectionApproverId{}
+
+// PrivateEndpointConnectionApproverId is a synthetic ID for the approver of the Private Endpoint Connections on a
+// target resource, which is distinct from the ID of the target resource so that the two can't be confused when importing.
+type PrivateEndpointConnectionApproverId struct {
+	TargetResourceId string
+}
+
+func (id PrivateEndpointConnectionApproverId) ID() string {
+	return fmt.Sprintf("%s/privateEndpointConnectionApprover", id.TargetResourceId)
+}
+
+func (id PrivateEndpointConnectionApproverId) String() string {
+	components := []string{
+		fmt.Sprintf("TargetResourceId %s", id.TargetResourceId),
+	}
+	return fmt.Sprintf("Private Endpoint Connection Approver: %s", strings.Join(components, " / "))
+}
+
+func NewPrivateEndpointConnectionApproverID(targetResourceId string) PrivateEndpointConnectionApproverId {
+	return PrivateEndpointConnectionApproverId{
+		TargetResourceId: targetResourceId,
+	}
+}
+
+// PrivateEndpointConnectionApproverID parses a Private Endpoint Connection Approver ID. The type of the target
+// resource isn't validated here, since the supported target resources are defined within the network package.
+func PrivateEndpointConnectionApproverID(input string) (*PrivateEndpointConnectionApproverId, error) {
+	targetResourceId, ok := strings.CutSuffix(input, "/privateEndpointConnectionApprover")
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {TargetResourceId}/privateEndpointConnectionApprover but got %q", input)
+	}
+
+	if _, err := resourceids.ParseAzureResourceID(targetResourceId); err != nil {
+		return nil, fmt.Errorf("parsing %q as a Private Endpoint Connection Approver ID: %+v", input, err)
+	}
+
+	return &PrivateEndpointConnectionApproverId{
+		TargetResourceId: targetResourceId,
+	}, nil
+}
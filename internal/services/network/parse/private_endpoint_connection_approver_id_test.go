@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestPrivateEndpointConnectionApproverId(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Expect *PrivateEndpointConnectionApproverId
+		Error  bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Target Resource ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Error: true,
+		},
+		{
+			Name:  "Invalid Target Resource ID",
+			Input: "hello/privateEndpointConnectionApprover",
+			Error: true,
+		},
+		{
+			Name:  "Private Endpoint Connection Approver ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnectionApprover",
+			Error: false,
+			Expect: &PrivateEndpointConnectionApproverId{
+				TargetResourceId: "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := PrivateEndpointConnectionApproverID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TargetResourceId != v.Expect.TargetResourceId {
+			t.Fatalf("Expected %q but got %q for TargetResourceId", v.Expect.TargetResourceId, actual.TargetResourceId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -156,6 +156,15 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"configuration_policy_group_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: virtualwans.ValidateConfigurationPolicyGroupID,
+							},
+						},
 					},
 				},
 			},
@@ -316,7 +325,10 @@ func resourcePointToSiteVPNGatewayRead(d *pluginsdk.ResourceData, meta interface
 
 		if props := model.Properties; props != nil {
 			d.Set("dns_servers", utils.FlattenStringSlice(props.CustomDnsServers))
-			flattenedConfigurations := flattenPointToSiteVPNGatewayConnectionConfiguration(props.P2SConnectionConfigurations)
+			flattenedConfigurations, err := flattenPointToSiteVPNGatewayConnectionConfiguration(props.P2SConnectionConfigurations)
+			if err != nil {
+				return fmt.Errorf("flattening `connection_configuration`: %+v", err)
+			}
 			if err := d.Set("connection_configuration", flattenedConfigurations); err != nil {
 				return fmt.Errorf("setting `connection_configuration`: %+v", err)
 			}
@@ -388,14 +400,22 @@ func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]
 			}
 		}
 
+		policyGroupAssociations := make([]virtualwans.SubResource, 0)
+		for _, policyGroupId := range raw["configuration_policy_group_ids"].(*pluginsdk.Set).List() {
+			policyGroupAssociations = append(policyGroupAssociations, virtualwans.SubResource{
+				Id: pointer.To(policyGroupId.(string)),
+			})
+		}
+
 		configurations = append(configurations, virtualwans.P2SConnectionConfiguration{
 			Name: pointer.To(name),
 			Properties: &virtualwans.P2SConnectionConfigurationProperties{
 				VpnClientAddressPool: &virtualwans.AddressSpace{
 					AddressPrefixes: &addressPrefixes,
 				},
-				RoutingConfiguration:   expandPointToSiteVPNGatewayConnectionRouteConfiguration(raw["route"].([]interface{})),
-				EnableInternetSecurity: pointer.To(raw["internet_security_enabled"].(bool)),
+				RoutingConfiguration:                 expandPointToSiteVPNGatewayConnectionRouteConfiguration(raw["route"].([]interface{})),
+				EnableInternetSecurity:               pointer.To(raw["internet_security_enabled"].(bool)),
+				ConfigurationPolicyGroupAssociations: &policyGroupAssociations,
 			},
 		})
 	}
@@ -450,9 +470,9 @@ func expandPointToSiteVPNGatewayConnectionRouteConfigurationPropagatedRouteTable
 	}
 }
 
-func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2SConnectionConfiguration) []interface{} {
+func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2SConnectionConfiguration) ([]interface{}, error) {
 	if input == nil {
-		return []interface{}{}
+		return []interface{}{}, nil
 	}
 
 	output := make([]interface{}, 0)
@@ -465,6 +485,7 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2
 
 		route := make([]interface{}, 0)
 		addressPrefixes := make([]interface{}, 0)
+		policyGroupIds := make([]interface{}, 0)
 		enableInternetSecurity := false
 		if props := v.Properties; props != nil {
 			if props.VpnClientAddressPool == nil {
@@ -484,6 +505,20 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2
 			if props.RoutingConfiguration != nil {
 				route = flattenPointToSiteVPNGatewayConnectionRouteConfiguration(props.RoutingConfiguration)
 			}
+
+			if props.ConfigurationPolicyGroupAssociations != nil {
+				for _, association := range *props.ConfigurationPolicyGroupAssociations {
+					if association.Id == nil {
+						continue
+					}
+
+					policyGroupId, err := virtualwans.ParseConfigurationPolicyGroupIDInsensitively(*association.Id)
+					if err != nil {
+						return nil, err
+					}
+					policyGroupIds = append(policyGroupIds, policyGroupId.ID())
+				}
+			}
 		}
 
 		output = append(output, map[string]interface{}{
@@ -493,12 +528,13 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2
 					"address_prefixes": addressPrefixes,
 				},
 			},
-			"route":                     route,
-			"internet_security_enabled": enableInternetSecurity,
+			"route":                          route,
+			"internet_security_enabled":      enableInternetSecurity,
+			"configuration_policy_group_ids": policyGroupIds,
 		})
 	}
 
-	return output
+	return output, nil
 }
 
 func flattenPointToSiteVPNGatewayConnectionRouteConfiguration(input *virtualwans.RoutingConfiguration) []interface{} {
@@ -62,6 +62,35 @@ func TestAccPointToSiteVPNGateway_connectionConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccPointToSiteVPNGateway_configurationPolicyGroups(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_point_to_site_vpn_gateway", "test")
+	r := PointToSiteVPNGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.singleConnectionConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.configurationPolicyGroups(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.singleConnectionConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPointToSiteVPNGateway_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_point_to_site_vpn_gateway", "test")
 	r := PointToSiteVPNGatewayResource{}
@@ -264,6 +293,64 @@ resource "azurerm_point_to_site_vpn_gateway" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r PointToSiteVPNGatewayResource) configurationPolicyGroups(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_vpn_server_configuration_policy_group" "default" {
+  name                        = "acctestVPNSCPG-default-%[2]d"
+  vpn_server_configuration_id = azurerm_vpn_server_configuration.test.id
+  is_default                  = true
+  priority                    = 0
+
+  policy {
+    name  = "policy1"
+    type  = "CertificateGroupId"
+    value = "default.example.com"
+  }
+}
+
+resource "azurerm_vpn_server_configuration_policy_group" "test" {
+  name                        = "acctestVPNSCPG-%[2]d"
+  vpn_server_configuration_id = azurerm_vpn_server_configuration.test.id
+  priority                    = 1
+
+  policy {
+    name  = "policy1"
+    type  = "CertificateGroupId"
+    value = "engineering.example.com"
+  }
+}
+
+resource "azurerm_point_to_site_vpn_gateway" "test" {
+  name                        = "acctestp2sVPNG-%[2]d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  virtual_hub_id              = azurerm_virtual_hub.test.id
+  vpn_server_configuration_id = azurerm_vpn_server_configuration.test.id
+  scale_unit                  = 1
+
+  connection_configuration {
+    name                           = "first"
+    configuration_policy_group_ids = [azurerm_vpn_server_configuration_policy_group.default.id]
+
+    vpn_client_address_pool {
+      address_prefixes = ["172.100.0.0/25"]
+    }
+  }
+
+  connection_configuration {
+    name                           = "second"
+    configuration_policy_group_ids = [azurerm_vpn_server_configuration_policy_group.test.id]
+
+    vpn_client_address_pool {
+      address_prefixes = ["172.100.128.0/25"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r PointToSiteVPNGatewayResource) enableInternetSecurity(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
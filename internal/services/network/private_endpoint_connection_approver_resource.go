@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	privateEndpointConnectionApproverActionApprove = "Approve"
+	privateEndpointConnectionApproverActionReject  = "Reject"
+)
+
+type PrivateEndpointConnectionApproverResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = PrivateEndpointConnectionApproverResource{}
+	_ sdk.ResourceWithCustomizeDiff = PrivateEndpointConnectionApproverResource{}
+)
+
+type PrivateEndpointConnectionApproverModel struct {
+	TargetResourceId        string                                  `tfschema:"target_resource_id"`
+	Rule                    []PrivateEndpointConnectionApproverRule `tfschema:"rule"`
+	StatusDescription       string                                  `tfschema:"status_description"`
+	ApprovedConnectionNames []string                                `tfschema:"approved_connection_names"`
+	PendingConnectionNames  []string                                `tfschema:"pending_connection_names"`
+	RejectedConnectionNames []string                                `tfschema:"rejected_connection_names"`
+}
+
+type PrivateEndpointConnectionApproverRule struct {
+	Action                 string `tfschema:"action"`
+	DescriptionRegex       string `tfschema:"description_regex"`
+	PrivateEndpointIdRegex string `tfschema:"private_endpoint_id_regex"`
+}
+
+func (r PrivateEndpointConnectionApproverResource) ResourceType() string {
+	return "azurerm_private_endpoint_connection_approver"
+}
+
+func (r PrivateEndpointConnectionApproverResource) ModelObject() interface{} {
+	return &PrivateEndpointConnectionApproverModel{}
+}
+
+func (r PrivateEndpointConnectionApproverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		id, err := parse.PrivateEndpointConnectionApproverID(v)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		return ValidatePrivateEndpointConnectionTargetID(id.TargetResourceId, k)
+	}
+}
+
+func (r PrivateEndpointConnectionApproverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: ValidatePrivateEndpointConnectionTargetID,
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							privateEndpointConnectionApproverActionApprove,
+							privateEndpointConnectionApproverActionReject,
+						}, false),
+					},
+
+					"description_regex": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsValidRegExp,
+					},
+
+					"private_endpoint_id_regex": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsValidRegExp,
+					},
+				},
+			},
+		},
+
+		"status_description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "Managed by Terraform",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApproverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"approved_connection_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"pending_connection_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"rejected_connection_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApproverResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// pending connections matching a rule are found during the refresh, as such an update is planned
+			// so that they're reconciled on every apply rather than only when the configuration changes
+			if rd.Id() != "" {
+				if pending, ok := rd.Get("pending_connection_names").([]interface{}); ok && len(pending) > 0 {
+					if err := rd.SetNewComputed("pending_connection_names"); err != nil {
+						return fmt.Errorf("setting `pending_connection_names` to computed: %+v", err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApproverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateEndpointConnectionApproverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := ParsePrivateEndpointConnectionTargetID(model.TargetResourceId); err != nil {
+				return err
+			}
+
+			if err := reconcilePrivateEndpointConnections(ctx, metadata.Client, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(parse.NewPrivateEndpointConnectionApproverID(model.TargetResourceId))
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApproverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PrivateEndpointConnectionApproverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			targetId := id.TargetResourceId
+
+			var state PrivateEndpointConnectionApproverModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			connections, err := ListTargetPrivateEndpointConnections(ctx, metadata.Client, targetId)
+			if err != nil {
+				return err
+			}
+			if connections == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			rules, err := compilePrivateEndpointConnectionApproverRules(state.Rule)
+			if err != nil {
+				return err
+			}
+
+			state.TargetResourceId = targetId
+			state.ApprovedConnectionNames = make([]string, 0)
+			state.PendingConnectionNames = make([]string, 0)
+			state.RejectedConnectionNames = make([]string, 0)
+			if state.StatusDescription == "" {
+				state.StatusDescription = "Managed by Terraform"
+			}
+
+			for _, connection := range *connections {
+				if rules.match(connection) == "" {
+					continue
+				}
+
+				switch connection.Status {
+				case TargetPrivateEndpointConnectionStatusApproved:
+					state.ApprovedConnectionNames = append(state.ApprovedConnectionNames, connection.Name)
+				case TargetPrivateEndpointConnectionStatusPending:
+					state.PendingConnectionNames = append(state.PendingConnectionNames, connection.Name)
+				case TargetPrivateEndpointConnectionStatusRejected:
+					state.RejectedConnectionNames = append(state.RejectedConnectionNames, connection.Name)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApproverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateEndpointConnectionApproverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.PrivateEndpointConnectionApproverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			model.TargetResourceId = id.TargetResourceId
+
+			return reconcilePrivateEndpointConnections(ctx, metadata.Client, model)
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApproverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// connections which have been approved or rejected are intentionally left as-is, since changing
+			// their status would impact the Private Endpoints using them
+			metadata.Logger.Infof("removing %q from state", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+type privateEndpointConnectionApproverRule struct {
+	action            string
+	description       *regexp.Regexp
+	privateEndpointId *regexp.Regexp
+}
+
+type privateEndpointConnectionApproverRules []privateEndpointConnectionApproverRule
+
+func compilePrivateEndpointConnectionApproverRules(input []PrivateEndpointConnectionApproverRule) (privateEndpointConnectionApproverRules, error) {
+	output := make(privateEndpointConnectionApproverRules, 0)
+	for i, v := range input {
+		rule := privateEndpointConnectionApproverRule{
+			action: v.Action,
+		}
+
+		if v.DescriptionRegex != "" {
+			expr, err := regexp.Compile(v.DescriptionRegex)
+			if err != nil {
+				return nil, fmt.Errorf("compiling `rule.%d.description_regex`: %+v", i, err)
+			}
+			rule.description = expr
+		}
+
+		if v.PrivateEndpointIdRegex != "" {
+			expr, err := regexp.Compile(v.PrivateEndpointIdRegex)
+			if err != nil {
+				return nil, fmt.Errorf("compiling `rule.%d.private_endpoint_id_regex`: %+v", i, err)
+			}
+			rule.privateEndpointId = expr
+		}
+
+		output = append(output, rule)
+	}
+
+	return output, nil
+}
+
+// match returns the action of the first rule matching the connection, or an empty string when no rule matches
+func (rules privateEndpointConnectionApproverRules) match(connection TargetPrivateEndpointConnection) string {
+	for _, rule := range rules {
+		if rule.description != nil && !rule.description.MatchString(connection.Description) {
+			continue
+		}
+		if rule.privateEndpointId != nil && !rule.privateEndpointId.MatchString(connection.PrivateEndpointId) {
+			continue
+		}
+		return rule.action
+	}
+
+	return ""
+}
+
+func reconcilePrivateEndpointConnections(ctx context.Context, client *clients.Client, model PrivateEndpointConnectionApproverModel) error {
+	rules, err := compilePrivateEndpointConnectionApproverRules(model.Rule)
+	if err != nil {
+		return err
+	}
+
+	connections, err := ListTargetPrivateEndpointConnections(ctx, client, model.TargetResourceId)
+	if err != nil {
+		return err
+	}
+	if connections == nil {
+		return fmt.Errorf("%q was not found", model.TargetResourceId)
+	}
+
+	for _, connection := range *connections {
+		if connection.Status != TargetPrivateEndpointConnectionStatusPending {
+			continue
+		}
+
+		status := ""
+		switch rules.match(connection) {
+		case privateEndpointConnectionApproverActionApprove:
+			status = TargetPrivateEndpointConnectionStatusApproved
+		case privateEndpointConnectionApproverActionReject:
+			status = TargetPrivateEndpointConnectionStatusRejected
+		default:
+			continue
+		}
+
+		if err := UpdateTargetPrivateEndpointConnectionStatus(ctx, client, model.TargetResourceId, connection.Name, status, model.StatusDescription); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
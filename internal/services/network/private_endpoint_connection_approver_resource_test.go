@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateEndpointConnectionApproverResource struct{}
+
+func TestAccPrivateEndpointConnectionApprover_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approver", "test")
+	r := PrivateEndpointConnectionApproverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:             r.basic(data),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			// the connections are created after the approver, so they're reconciled on the subsequent apply
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("approved_connection_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rejected_connection_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("pending_connection_names.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PrivateEndpointConnectionApproverResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateEndpointConnectionApproverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	connections, err := network.ListTargetPrivateEndpointConnections(ctx, client, id.TargetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(connections != nil), nil
+}
+
+func (r PrivateEndpointConnectionApproverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pec-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.5.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.1.0/24"]
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_private_endpoint_connection_approver" "test" {
+  target_resource_id = azurerm_storage_account.test.id
+
+  rule {
+    action            = "Approve"
+    description_regex = "^approve-"
+  }
+
+  rule {
+    action = "Reject"
+  }
+}
+
+resource "azurerm_private_endpoint" "approve" {
+  name                = "acctest-pe-approve-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctest-psc-approve-%[1]d"
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+    is_manual_connection           = true
+    request_message                = "approve-%[1]d"
+  }
+
+  depends_on = [azurerm_private_endpoint_connection_approver.test]
+}
+
+resource "azurerm_private_endpoint" "reject" {
+  name                = "acctest-pe-reject-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctest-psc-reject-%[1]d"
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["file"]
+    is_manual_connection           = true
+    request_message                = "reject-%[1]d"
+  }
+
+  depends_on = [azurerm_private_endpoint_connection_approver.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	keyVaultPrivateEndpointConnections "github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/privateendpointconnections"
+	sqlPrivateEndpointConnections "github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/privateendpointconnections"
+	storagePrivateEndpointConnections "github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// TargetPrivateEndpointConnection is a common representation of a Private Endpoint Connection on one of the
+// supported target resources, since each Resource Provider exposes these via its own API
+type TargetPrivateEndpointConnection struct {
+	Name              string
+	Description       string
+	PrivateEndpointId string
+	Status            string
+}
+
+const (
+	TargetPrivateEndpointConnectionStatusApproved = "Approved"
+	TargetPrivateEndpointConnectionStatusPending  = "Pending"
+	TargetPrivateEndpointConnectionStatusRejected = "Rejected"
+)
+
+// ParsePrivateEndpointConnectionTargetID parses the ID of a resource whose Private Endpoint Connections can be
+// managed using ListTargetPrivateEndpointConnections and UpdateTargetPrivateEndpointConnectionStatus
+func ParsePrivateEndpointConnectionTargetID(input string) (interface{}, error) {
+	if id, err := commonids.ParseStorageAccountIDInsensitively(input); err == nil {
+		return id, nil
+	}
+
+	if id, err := commonids.ParseKeyVaultIDInsensitively(input); err == nil {
+		return id, nil
+	}
+
+	if id, err := commonids.ParseSqlServerIDInsensitively(input); err == nil {
+		return id, nil
+	}
+
+	return nil, fmt.Errorf("expected %q to be the ID of a Storage Account, Key Vault or SQL Server", input)
+}
+
+func ValidatePrivateEndpointConnectionTargetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParsePrivateEndpointConnectionTargetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %+v", k, err))
+	}
+
+	return
+}
+
+// ListTargetPrivateEndpointConnections returns the Private Endpoint Connections on the target resource, or nil
+// when the target resource doesn't exist
+func ListTargetPrivateEndpointConnections(ctx context.Context, client *clients.Client, targetId string) (*[]TargetPrivateEndpointConnection, error) {
+	id, err := ParsePrivateEndpointConnectionTargetID(targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]TargetPrivateEndpointConnection, 0)
+
+	switch id := id.(type) {
+	case *commonids.StorageAccountId:
+		resp, err := client.Storage.ResourceManager.PrivateEndpointConnections.List(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", id, err)
+		}
+
+		if resp.Model != nil {
+			for _, v := range *resp.Model {
+				connection := TargetPrivateEndpointConnection{
+					Name: pointer.From(v.Name),
+				}
+				if props := v.Properties; props != nil {
+					connection.Description = pointer.From(props.PrivateLinkServiceConnectionState.Description)
+					connection.Status = string(pointer.From(props.PrivateLinkServiceConnectionState.Status))
+					if props.PrivateEndpoint != nil {
+						connection.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+					}
+				}
+				output = append(output, connection)
+			}
+		}
+
+	case *commonids.KeyVaultId:
+		resp, err := client.KeyVault.PrivateEndpointConnectionsClient.ListByResourceComplete(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.LatestHttpResponse) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", id, err)
+		}
+
+		for _, v := range resp.Items {
+			connection := TargetPrivateEndpointConnection{
+				Name: pointer.From(v.Name),
+			}
+			if props := v.Properties; props != nil {
+				if state := props.PrivateLinkServiceConnectionState; state != nil {
+					connection.Description = pointer.From(state.Description)
+					connection.Status = string(pointer.From(state.Status))
+				}
+				if props.PrivateEndpoint != nil {
+					connection.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+				}
+			}
+			output = append(output, connection)
+		}
+
+	case *commonids.SqlServerId:
+		resp, err := client.MSSQL.PrivateEndpointConnectionsClient.ListByServerComplete(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.LatestHttpResponse) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", id, err)
+		}
+
+		for _, v := range resp.Items {
+			connection := TargetPrivateEndpointConnection{
+				Name: pointer.From(v.Name),
+			}
+			if props := v.Properties; props != nil {
+				if state := props.PrivateLinkServiceConnectionState; state != nil {
+					connection.Description = state.Description
+					connection.Status = string(state.Status)
+				}
+				if props.PrivateEndpoint != nil {
+					connection.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+				}
+			}
+			output = append(output, connection)
+		}
+	}
+
+	return &output, nil
+}
+
+// UpdateTargetPrivateEndpointConnectionStatus approves or rejects the named Private Endpoint Connection on the target resource
+func UpdateTargetPrivateEndpointConnectionStatus(ctx context.Context, client *clients.Client, targetId string, name string, status string, description string) error {
+	id, err := ParsePrivateEndpointConnectionTargetID(targetId)
+	if err != nil {
+		return err
+	}
+
+	switch id := id.(type) {
+	case *commonids.StorageAccountId:
+		connectionId := storagePrivateEndpointConnections.NewPrivateEndpointConnectionID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, name)
+
+		payload := storagePrivateEndpointConnections.PrivateEndpointConnection{
+			Properties: &storagePrivateEndpointConnections.PrivateEndpointConnectionProperties{
+				PrivateLinkServiceConnectionState: storagePrivateEndpointConnections.PrivateLinkServiceConnectionState{
+					Description: pointer.To(description),
+					Status:      pointer.To(storagePrivateEndpointConnections.PrivateEndpointServiceConnectionStatus(status)),
+				},
+			},
+		}
+		if _, err := client.Storage.ResourceManager.PrivateEndpointConnections.Put(ctx, connectionId, payload); err != nil {
+			return fmt.Errorf("updating status of %s to %q: %+v", connectionId, status, err)
+		}
+
+	case *commonids.KeyVaultId:
+		connectionId := commonids.NewKeyVaultPrivateEndpointConnectionID(id.SubscriptionId, id.ResourceGroupName, id.VaultName, name)
+
+		payload := keyVaultPrivateEndpointConnections.PrivateEndpointConnection{
+			Properties: &keyVaultPrivateEndpointConnections.PrivateEndpointConnectionProperties{
+				PrivateLinkServiceConnectionState: &keyVaultPrivateEndpointConnections.PrivateLinkServiceConnectionState{
+					Description: pointer.To(description),
+					Status:      pointer.To(keyVaultPrivateEndpointConnections.PrivateEndpointServiceConnectionStatus(status)),
+				},
+			},
+		}
+		if _, err := client.KeyVault.PrivateEndpointConnectionsClient.Put(ctx, connectionId, payload); err != nil {
+			return fmt.Errorf("updating status of %s to %q: %+v", connectionId, status, err)
+		}
+
+	case *commonids.SqlServerId:
+		connectionId := sqlPrivateEndpointConnections.NewPrivateEndpointConnectionID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, name)
+
+		payload := sqlPrivateEndpointConnections.PrivateEndpointConnection{
+			Properties: &sqlPrivateEndpointConnections.PrivateEndpointConnectionProperties{
+				PrivateLinkServiceConnectionState: &sqlPrivateEndpointConnections.PrivateLinkServiceConnectionStateProperty{
+					Description: description,
+					Status:      sqlPrivateEndpointConnections.PrivateLinkServiceConnectionStateStatus(status),
+				},
+			},
+		}
+		if err := client.MSSQL.PrivateEndpointConnectionsClient.CreateOrUpdateThenPoll(ctx, connectionId, payload); err != nil {
+			return fmt.Errorf("updating status of %s to %q: %+v", connectionId, status, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = PrivateEndpointRequiredDnsZonesDataSource{}
+
+type PrivateEndpointRequiredDnsZonesDataSource struct{}
+
+type PrivateEndpointRequiredDnsZonesDataSourceModel struct {
+	ResourceType        string                           `tfschema:"resource_type"`
+	SubresourceName     string                           `tfschema:"subresource_name"`
+	Location            string                           `tfschema:"location"`
+	PrivateDnsZones     []PrivateEndpointRequiredDnsZone `tfschema:"private_dns_zones"`
+	PrivateDnsZoneNames []string                         `tfschema:"private_dns_zone_names"`
+}
+
+type PrivateEndpointRequiredDnsZone struct {
+	ResourceType        string   `tfschema:"resource_type"`
+	SubresourceName     string   `tfschema:"subresource_name"`
+	PrivateDnsZoneNames []string `tfschema:"private_dns_zone_names"`
+}
+
+// privateEndpointDnsZone is an entry in the catalog of the Private DNS Zones which Private Endpoints for a given
+// subresource (group ID) register their records in, keyed by the name of the cloud environment. Zone names containing
+// `{regionName}` are regional and are only fully resolved when a `location` is specified.
+// (https://learn.microsoft.com/azure/private-link/private-endpoint-dns)
+type privateEndpointDnsZone struct {
+	resourceType    string
+	subresourceName string
+	zoneNames       map[string][]string
+}
+
+func privateEndpointStorageDnsZone(subresourceName string) privateEndpointDnsZone {
+	return privateEndpointDnsZone{
+		resourceType:    "Microsoft.Storage/storageAccounts",
+		subresourceName: subresourceName,
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {fmt.Sprintf("privatelink.%s.core.windows.net", subresourceName)},
+			environments.AzureUSGovernmentCloud: {fmt.Sprintf("privatelink.%s.core.usgovcloudapi.net", subresourceName)},
+			environments.AzureChinaCloud:        {fmt.Sprintf("privatelink.%s.core.chinacloudapi.cn", subresourceName)},
+		},
+	}
+}
+
+func privateEndpointCosmosDBDnsZone(subresourceName, zonePrefix string) privateEndpointDnsZone {
+	return privateEndpointDnsZone{
+		resourceType:    "Microsoft.DocumentDB/databaseAccounts",
+		subresourceName: subresourceName,
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {fmt.Sprintf("privatelink.%s.azure.com", zonePrefix)},
+			environments.AzureUSGovernmentCloud: {fmt.Sprintf("privatelink.%s.azure.us", zonePrefix)},
+			environments.AzureChinaCloud:        {fmt.Sprintf("privatelink.%s.azure.cn", zonePrefix)},
+		},
+	}
+}
+
+func privateEndpointServiceBusDnsZone(resourceType string) privateEndpointDnsZone {
+	return privateEndpointDnsZone{
+		resourceType:    resourceType,
+		subresourceName: "namespace",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.servicebus.windows.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.servicebus.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.servicebus.chinacloudapi.cn"},
+		},
+	}
+}
+
+var privateEndpointDnsZones = []privateEndpointDnsZone{
+	privateEndpointStorageDnsZone("blob"),
+	privateEndpointStorageDnsZone("dfs"),
+	privateEndpointStorageDnsZone("file"),
+	privateEndpointStorageDnsZone("queue"),
+	privateEndpointStorageDnsZone("table"),
+	privateEndpointStorageDnsZone("web"),
+	privateEndpointCosmosDBDnsZone("Sql", "documents"),
+	privateEndpointCosmosDBDnsZone("MongoDB", "mongo.cosmos"),
+	privateEndpointCosmosDBDnsZone("Cassandra", "cassandra.cosmos"),
+	privateEndpointCosmosDBDnsZone("Gremlin", "gremlin.cosmos"),
+	privateEndpointCosmosDBDnsZone("Table", "table.cosmos"),
+	privateEndpointServiceBusDnsZone("Microsoft.EventHub/namespaces"),
+	privateEndpointServiceBusDnsZone("Microsoft.Relay/namespaces"),
+	privateEndpointServiceBusDnsZone("Microsoft.ServiceBus/namespaces"),
+	{
+		resourceType:    "Microsoft.AppConfiguration/configurationStores",
+		subresourceName: "configurationStores",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.azconfig.io"},
+			environments.AzureUSGovernmentCloud: {"privatelink.azconfig.azure.us"},
+			environments.AzureChinaCloud:        {"privatelink.azconfig.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Automation/automationAccounts",
+		subresourceName: "Webhook",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.azure-automation.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.azure-automation.us"},
+			environments.AzureChinaCloud:        {"privatelink.azure-automation.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Automation/automationAccounts",
+		subresourceName: "DSCAndHybridWorker",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.azure-automation.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.azure-automation.us"},
+			environments.AzureChinaCloud:        {"privatelink.azure-automation.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Batch/batchAccounts",
+		subresourceName: "batchAccount",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.batch.azure.com"},
+			environments.AzureUSGovernmentCloud: {"privatelink.batch.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.batch.chinacloudapi.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Cache/Redis",
+		subresourceName: "redisCache",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.redis.cache.windows.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.redis.cache.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.redis.cache.chinacloudapi.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.CognitiveServices/accounts",
+		subresourceName: "account",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.cognitiveservices.azure.com", "privatelink.openai.azure.com"},
+			environments.AzureUSGovernmentCloud: {"privatelink.cognitiveservices.azure.us", "privatelink.openai.azure.us"},
+			environments.AzureChinaCloud:        {"privatelink.cognitiveservices.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.ContainerRegistry/registries",
+		subresourceName: "registry",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.azurecr.io"},
+			environments.AzureUSGovernmentCloud: {"privatelink.azurecr.us"},
+			environments.AzureChinaCloud:        {"privatelink.azurecr.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.ContainerService/managedClusters",
+		subresourceName: "management",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.{regionName}.azmk8s.io"},
+			environments.AzureUSGovernmentCloud: {"privatelink.{regionName}.cx.aks.containerservice.azure.us"},
+			environments.AzureChinaCloud:        {"privatelink.{regionName}.cx.prod.service.azk8s.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.DataFactory/factories",
+		subresourceName: "dataFactory",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.datafactory.azure.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.datafactory.azure.us"},
+			environments.AzureChinaCloud:        {"privatelink.datafactory.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.DBforMySQL/flexibleServers",
+		subresourceName: "mysqlServer",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.mysql.database.azure.com"},
+			environments.AzureUSGovernmentCloud: {"privatelink.mysql.database.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.mysql.database.chinacloudapi.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.DBforPostgreSQL/flexibleServers",
+		subresourceName: "postgresqlServer",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.postgres.database.azure.com"},
+			environments.AzureUSGovernmentCloud: {"privatelink.postgres.database.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.postgres.database.chinacloudapi.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.EventGrid/domains",
+		subresourceName: "domain",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.eventgrid.azure.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.eventgrid.azure.us"},
+			environments.AzureChinaCloud:        {"privatelink.eventgrid.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.EventGrid/topics",
+		subresourceName: "topic",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.eventgrid.azure.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.eventgrid.azure.us"},
+			environments.AzureChinaCloud:        {"privatelink.eventgrid.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Insights/privateLinkScopes",
+		subresourceName: "azuremonitor",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud: {
+				"privatelink.agentsvc.azure-automation.net",
+				"privatelink.blob.core.windows.net",
+				"privatelink.monitor.azure.com",
+				"privatelink.ods.opinsights.azure.com",
+				"privatelink.oms.opinsights.azure.com",
+			},
+			environments.AzureUSGovernmentCloud: {
+				"privatelink.adx.monitor.azure.us",
+				"privatelink.agentsvc.azure-automation.us",
+				"privatelink.blob.core.usgovcloudapi.net",
+				"privatelink.monitor.azure.us",
+				"privatelink.ods.opinsights.azure.us",
+				"privatelink.oms.opinsights.azure.us",
+			},
+			environments.AzureChinaCloud: {
+				"privatelink.agentsvc.azure-automation.cn",
+				"privatelink.blob.core.chinacloudapi.cn",
+				"privatelink.monitor.azure.cn",
+				"privatelink.ods.opinsights.azure.cn",
+				"privatelink.oms.opinsights.azure.cn",
+			},
+		},
+	},
+	{
+		resourceType:    "Microsoft.KeyVault/managedHSMs",
+		subresourceName: "managedhsm",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.managedhsm.azure.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.managedhsm.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.managedhsm.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.KeyVault/vaults",
+		subresourceName: "vault",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.vaultcore.azure.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.vaultcore.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.vaultcore.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Search/searchServices",
+		subresourceName: "searchService",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.search.windows.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.search.windows.us"},
+			environments.AzureChinaCloud:        {"privatelink.search.azure.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Sql/servers",
+		subresourceName: "sqlServer",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.database.windows.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.database.usgovcloudapi.net"},
+			environments.AzureChinaCloud:        {"privatelink.database.chinacloudapi.cn"},
+		},
+	},
+	{
+		resourceType:    "Microsoft.Web/sites",
+		subresourceName: "sites",
+		zoneNames: map[string][]string{
+			environments.AzurePublicCloud:       {"privatelink.azurewebsites.net"},
+			environments.AzureUSGovernmentCloud: {"privatelink.azurewebsites.us"},
+			environments.AzureChinaCloud:        {"privatelink.chinacloudsites.cn"},
+		},
+	},
+}
+
+// privateEndpointDnsZonesForEnvironment returns the entries in the catalog for the specified cloud environment which
+// match the optional resource type and subresource name filters, resolving any regional zone names for the location
+func privateEndpointDnsZonesForEnvironment(environment, resourceType, subresourceName, regionName string) []PrivateEndpointRequiredDnsZone {
+	result := make([]PrivateEndpointRequiredDnsZone, 0)
+	for _, v := range privateEndpointDnsZones {
+		if resourceType != "" && !strings.EqualFold(v.resourceType, resourceType) {
+			continue
+		}
+		if subresourceName != "" && !strings.EqualFold(v.subresourceName, subresourceName) {
+			continue
+		}
+
+		zoneNames, ok := v.zoneNames[environment]
+		if !ok {
+			continue
+		}
+
+		resolved := make([]string, 0, len(zoneNames))
+		for _, zoneName := range zoneNames {
+			if regionName != "" {
+				zoneName = strings.ReplaceAll(zoneName, "{regionName}", regionName)
+			}
+			resolved = append(resolved, zoneName)
+		}
+
+		result = append(result, PrivateEndpointRequiredDnsZone{
+			ResourceType:        v.resourceType,
+			SubresourceName:     v.subresourceName,
+			PrivateDnsZoneNames: resolved,
+		})
+	}
+
+	return result
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subresource_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+	}
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_dns_zones": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"subresource_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"private_dns_zone_names": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"private_dns_zone_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) ModelObject() interface{} {
+	return &PrivateEndpointRequiredDnsZonesDataSourceModel{}
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) ResourceType() string {
+	return "azurerm_private_endpoint_required_dns_zones"
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state PrivateEndpointRequiredDnsZonesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environment := metadata.Client.Account.Environment.Name
+			if _, ok := privateEndpointDnsZones[0].zoneNames[environment]; !ok {
+				return fmt.Errorf("the Private DNS Zones used by Private Endpoints aren't known for the environment %q", environment)
+			}
+
+			state.Location = location.Normalize(state.Location)
+			state.PrivateDnsZones = privateEndpointDnsZonesForEnvironment(environment, state.ResourceType, state.SubresourceName, state.Location)
+
+			zoneNames := make(map[string]struct{})
+			for _, v := range state.PrivateDnsZones {
+				for _, zoneName := range v.PrivateDnsZoneNames {
+					zoneNames[zoneName] = struct{}{}
+				}
+			}
+			state.PrivateDnsZoneNames = make([]string, 0, len(zoneNames))
+			for zoneName := range zoneNames {
+				state.PrivateDnsZoneNames = append(state.PrivateDnsZoneNames, zoneName)
+			}
+			sort.Strings(state.PrivateDnsZoneNames)
+
+			metadata.ResourceData.SetId(fmt.Sprintf("privateEndpointRequiredDnsZones-%x", sha256.Sum256([]byte(strings.Join([]string{environment, state.ResourceType, state.SubresourceName, state.Location}, "|")))))
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateEndpointRequiredDnsZonesDataSource struct{}
+
+func TestAccPrivateEndpointRequiredDnsZonesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_required_dns_zones", "test")
+	d := PrivateEndpointRequiredDnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("private_dns_zones.#").IsSet(),
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccPrivateEndpointRequiredDnsZonesDataSource_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_required_dns_zones", "test")
+	d := PrivateEndpointRequiredDnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.filtered(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("private_dns_zones.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zones.0.subresource_name").HasValue("blob"),
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccPrivateEndpointRequiredDnsZonesDataSource_regional(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_required_dns_zones", "test")
+	d := PrivateEndpointRequiredDnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.regional(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("private_dns_zones.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_names.0").MatchesRegex(regexp.MustCompile(`^privatelink\.[a-z0-9]+\.`)),
+			),
+		},
+	})
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_required_dns_zones" "test" {}
+`
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) filtered() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_required_dns_zones" "test" {
+  resource_type    = "Microsoft.Storage/storageAccounts"
+  subresource_name = "blob"
+}
+`
+}
+
+func (PrivateEndpointRequiredDnsZonesDataSource) regional(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_required_dns_zones" "test" {
+  resource_type = "Microsoft.ContainerService/managedClusters"
+  location      = "%s"
+}
+`, data.Locations.Primary)
+}
@@ -340,6 +340,16 @@ func resourcePublicIpUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if pluginsdk.HasOnlyTagsChange(d) {
+		updated, err := pluginsdk.UpdateTagsOnly(ctx, meta.(*clients.Client).Resource.TagsClient, id.ID(), d)
+		if err != nil {
+			return err
+		}
+		if updated {
+			return resourcePublicIpRead(d, meta)
+		}
+	}
+
 	existing, err := client.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -43,6 +43,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 		ManagerIpamPoolDataSource{},
 		NetworkSecurityPerimeterProfileDataSource{},
 		NetworkSecurityPerimeterDataSource{},
+		PrivateEndpointRequiredDnsZonesDataSource{},
 		VPNServerConfigurationDataSource{},
 		VirtualNetworkPeeringDataSource{},
 	}
@@ -50,6 +51,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		BastionShareableLinkResource{},
 		CustomIpPrefixResource{},
 		ManagerAdminRuleResource{},
 		ManagerAdminRuleCollectionResource{},
@@ -69,11 +71,13 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerSubscriptionConnectionResource{},
 		ManagerVerifierWorkspaceResource{},
 		ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{},
+		ManagerVerifierWorkspaceReachabilityAnalysisRunResource{},
 		NetworkSecurityPerimeterAccessRuleResource{},
 		NetworkSecurityPerimeterAssociationResource{},
 		NetworkSecurityPerimeterResource{},
 		NetworkSecurityPerimeterProfileResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
+		PrivateEndpointConnectionApproverResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
 	}
@@ -137,6 +141,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_nat_gateway":                              resourceNatGateway(),
 		"azurerm_nat_gateway_public_ip_association":        resourceNATGatewayPublicIpAssociation(),
 		"azurerm_nat_gateway_public_ip_prefix_association": resourceNATGatewayPublicIpPrefixAssociation(),
+		"azurerm_nat_gateway_subnet_associations":          resourceNatGatewaySubnetAssociations(),
 		"azurerm_network_connection_monitor":               resourceNetworkConnectionMonitor(),
 		"azurerm_network_ddos_protection_plan":             resourceNetworkDDoSProtectionPlan(),
 		"azurerm_network_interface":                        resourceNetworkInterface(),
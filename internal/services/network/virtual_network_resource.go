@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/ddosprotectionplans"
@@ -328,6 +329,11 @@ func resourceVirtualNetworkSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.StringInSlice(virtualnetworks.PossibleValuesForPrivateEndpointVNetPolicies(), false),
 		},
 
+		"adopt_existing": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -347,7 +353,27 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	if !response.WasNotFound(existing.HttpResponse) {
-		return tf.ImportAsExistsError("azurerm_virtual_network", id.ID())
+		if !d.Get("adopt_existing").(bool) {
+			return tf.ImportAsExistsError("azurerm_virtual_network", id.ID())
+		}
+
+		// the location and edge zone can't be changed, so only the other arguments from the configuration are applied as an update
+		if model := existing.Model; model != nil {
+			mismatched := make([]string, 0)
+			if location.Normalize(pointer.From(model.Location)) != location.Normalize(d.Get("location").(string)) {
+				mismatched = append(mismatched, "location")
+			}
+			if flattenEdgeZoneModel(model.ExtendedLocation) != edgezones.Normalize(d.Get("edge_zone").(string)) {
+				mismatched = append(mismatched, "edge_zone")
+			}
+			if len(mismatched) > 0 {
+				return tf.AdoptExistingMismatchError("azurerm_virtual_network", id.ID(), mismatched)
+			}
+		}
+
+		log.Printf("[DEBUG] `adopt_existing` is enabled - adopting the existing %s", id)
+		d.SetId(id.ID())
+		return resourceVirtualNetworkUpdateExisting(ctx, d, meta)
 	}
 
 	vnetProperties, routeTables, err := expandVirtualNetworkProperties(ctx, *client, id, d)
@@ -499,15 +525,32 @@ func resourceVirtualNetworkFlatten(d *pluginsdk.ResourceData, id commonids.Virtu
 }
 
 func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.VirtualNetworks
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	return resourceVirtualNetworkUpdateExisting(ctx, d, meta)
+}
+
+// resourceVirtualNetworkUpdateExisting applies the configuration to an existing Virtual Network, which is used both
+// when updating and when adopting an existing Virtual Network during creation
+func resourceVirtualNetworkUpdateExisting(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualNetworks
+
 	id, err := commonids.ParseVirtualNetworkID(d.Id())
 	if err != nil {
 		return err
 	}
 
+	if pluginsdk.HasOnlyTagsChange(d) {
+		updated, err := pluginsdk.UpdateTagsOnly(ctx, meta.(*clients.Client).Resource.TagsClient, id.ID(), d)
+		if err != nil {
+			return err
+		}
+		if updated {
+			return resourceVirtualNetworkRead(d, meta)
+		}
+	}
+
 	existing, err := client.Get(ctx, *id, virtualnetworks.DefaultGetOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -728,6 +771,13 @@ func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.Vir
 		return &subnets, &routeTables, nil
 	}
 
+	// since subnets can also be created outside of vNet definition (as root objects)
+	// do a GET on subnet properties from the server before setting them
+	existing, err := getExistingSubnets(ctx, client, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	for _, subnetRaw := range input {
 		if subnetRaw == nil {
 			continue
@@ -736,13 +786,7 @@ func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.Vir
 
 		name := subnet["name"].(string)
 		log.Printf("[INFO] setting subnets inside vNet, processing %q", name)
-		// since subnets can also be created outside of vNet definition (as root objects)
-		// do a GET on subnet properties from the server before setting them
-		subnetObj, err := getExistingSubnet(ctx, client, id, name)
-		if err != nil {
-			return nil, nil, err
-		}
-		log.Printf("[INFO] Completed GET of Subnet props")
+		subnetObj := existing[name]
 
 		// set the props from config and leave the rest intact
 		subnetObj.Name = pointer.To(name)
@@ -799,7 +843,7 @@ func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.Vir
 			subnetObj.Properties.NetworkSecurityGroup = nil
 		}
 
-		subnets = append(subnets, *subnetObj)
+		subnets = append(subnets, subnetObj)
 	}
 
 	return &subnets, &routeTables, nil
@@ -809,18 +853,19 @@ func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.
 	subnets := make([]virtualnetworks.Subnet, 0)
 	routeTables := make([]string, 0)
 	if subs := d.Get("subnet").(*pluginsdk.Set); subs.Len() > 0 {
+		// since subnets can also be created outside of vNet definition (as root objects)
+		// do a GET on subnet properties from the server before setting them
+		existing, err := getExistingSubnets(ctx, client, id)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		for _, subnet := range subs.List() {
 			subnet := subnet.(map[string]interface{})
 
 			name := subnet["name"].(string)
 			log.Printf("[INFO] setting subnets inside vNet, processing %q", name)
-			// since subnets can also be created outside of vNet definition (as root objects)
-			// do a GET on subnet properties from the server before setting them
-			subnetObj, err := getExistingSubnet(ctx, client, id, name)
-			if err != nil {
-				return nil, nil, err
-			}
-			log.Printf("[INFO] Completed GET of Subnet props")
+			subnetObj := existing[name]
 
 			// set the props from config and leave the rest intact
 			subnetObj.Name = pointer.To(name)
@@ -873,7 +918,7 @@ func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.
 				subnetObj.Properties.NetworkSecurityGroup = nil
 			}
 
-			subnets = append(subnets, *subnetObj)
+			subnets = append(subnets, subnetObj)
 		}
 	}
 
@@ -1092,12 +1137,16 @@ func resourceAzureSubnetHash(v interface{}) int {
 	return pluginsdk.HashString(buf.String())
 }
 
-func getExistingSubnet(ctx context.Context, client virtualnetworks.VirtualNetworksClient, id commonids.VirtualNetworkId, subnetName string) (*virtualnetworks.Subnet, error) {
+// getExistingSubnets retrieves the Virtual Network once and returns its Subnets keyed by name, so that the properties
+// of each Subnet can be looked up without retrieving the Virtual Network again
+func getExistingSubnets(ctx context.Context, client virtualnetworks.VirtualNetworksClient, id commonids.VirtualNetworkId) (map[string]virtualnetworks.Subnet, error) {
+	existing := make(map[string]virtualnetworks.Subnet)
+
 	resp, err := client.Get(ctx, id, virtualnetworks.DefaultGetOperationOptions())
 	if err != nil {
-		// The Subnet doesn't exist when the Virtual Network doesn't exist
+		// The Subnets don't exist when the Virtual Network doesn't exist
 		if response.WasNotFound(resp.HttpResponse) {
-			return pointer.To(virtualnetworks.Subnet{}), nil
+			return existing, nil
 		}
 		// raise an error if there was an issue other than 404 in getting subnet properties
 		return nil, err
@@ -1106,15 +1155,13 @@ func getExistingSubnet(ctx context.Context, client virtualnetworks.VirtualNetwor
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			for _, subnet := range pointer.From(props.Subnets) {
-				if subnetName == pointer.From(subnet.Name) {
-					// Return it directly rather than copy the fields to prevent potential uncovered properties (for example, `ServiceEndpoints` mentioned in #1619)
-					return pointer.To(subnet), nil
-				}
+				// Keep the Subnet as-is rather than copy the fields to prevent potential uncovered properties (for example, `ServiceEndpoints` mentioned in #1619)
+				existing[pointer.From(subnet.Name)] = subnet
 			}
 		}
 	}
 
-	return pointer.To(virtualnetworks.Subnet{}), nil
+	return existing, nil
 }
 
 func expandResourcesForLocking(d *pluginsdk.ResourceData) ([]string, []string, error) {
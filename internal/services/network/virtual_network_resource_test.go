@@ -145,6 +145,36 @@ func TestAccVirtualNetwork_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetwork_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network", "test")
+	r := VirtualNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// remove the Virtual Network from the State, leaving it in Azure
+			Config: r.adoptExistingRemoved(data),
+		},
+		{
+			Config: r.adoptExisting(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the Subnet isn't specified, so is only present when the existing Virtual Network wasn't overwritten
+				check.That(data.ResourceName).Key("subnet.#").HasValue("1"),
+				// whereas the Tags are specified, so should be applied as a part of adopting it
+				check.That(data.ResourceName).Key("tags.environment").HasValue("Staging"),
+			),
+		},
+		// `adopt_existing` is only used during creation, so isn't set when importing
+		data.ImportStep("adopt_existing"),
+	})
+}
+
 func TestAccVirtualNetwork_ddosProtectionPlan(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_network", "test")
 	r := VirtualNetworkResource{}
@@ -491,6 +521,52 @@ resource "azurerm_virtual_network" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (VirtualNetworkResource) adoptExistingRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+removed {
+  from = azurerm_virtual_network.test
+
+  lifecycle {
+    destroy = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (VirtualNetworkResource) adoptExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  adopt_existing      = true
+
+  tags = {
+    environment = "Staging"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r VirtualNetworkResource) tagCount(data acceptance.TestData) string {
 	tags := ""
 	for i := 0; i < 50; i++ {
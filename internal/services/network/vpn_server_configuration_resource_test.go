@@ -33,6 +33,29 @@ func TestAccVPNServerConfiguration_azureAD(t *testing.T) {
 	})
 }
 
+func TestAccVPNServerConfiguration_azureADAudienceUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_server_configuration", "test")
+	r := VPNServerConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureADWithAudience(data, "41b23e61-6c1e-4545-b367-cd054e0ed4b4"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.azureADWithAudience(data, "c632b3df-fb67-4d84-bdcf-b95ad541b5c8"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("azure_active_directory_authentication.0.audience").HasValue("c632b3df-fb67-4d84-bdcf-b95ad541b5c8"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccVPNServerConfiguration_certificate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_vpn_server_configuration", "test")
 	r := VPNServerConfigurationResource{}
@@ -234,6 +257,27 @@ resource "azurerm_vpn_server_configuration" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r VPNServerConfigurationResource) azureADWithAudience(data acceptance.TestData, audience string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_vpn_server_configuration" "test" {
+  name                     = "acctestVPNSC-%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  vpn_authentication_types = ["AAD"]
+
+  azure_active_directory_authentication {
+    audience = "%s"
+    issuer   = "https://sts.windows.net/${data.azurerm_subscription.current.tenant_id}/"
+    tenant   = "https://login.microsoftonline.com/${data.azurerm_subscription.current.tenant_id}"
+  }
+}
+`, r.template(data), data.RandomInteger, audience)
+}
+
 func (r VPNServerConfigurationResource) certificate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
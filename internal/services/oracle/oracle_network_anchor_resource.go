@@ -0,0 +1,404 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/networkanchors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/resourceanchors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = NetworkAnchorResource{}
+
+type NetworkAnchorResource struct{}
+
+type NetworkAnchorResourceModel struct {
+	Name                               string                       `tfschema:"name"`
+	ResourceGroupName                  string                       `tfschema:"resource_group_name"`
+	Location                           string                       `tfschema:"location"`
+	Zones                              zones.Schema                 `tfschema:"zones"`
+	ResourceAnchorId                   string                       `tfschema:"resource_anchor_id"`
+	SubnetId                           string                       `tfschema:"subnet_id"`
+	OciBackupCidrBlock                 string                       `tfschema:"oci_backup_cidr_block"`
+	OciVcnDnsLabel                     string                       `tfschema:"oci_vcn_dns_label"`
+	DnsForwardingRule                  []NetworkAnchorDnsForwarding `tfschema:"dns_forwarding_rule"`
+	DnsListeningEndpointAllowedCidrs   string                       `tfschema:"dns_listening_endpoint_allowed_cidrs"`
+	OracleDnsForwardingEndpointEnabled bool                         `tfschema:"oracle_dns_forwarding_endpoint_enabled"`
+	OracleDnsListeningEndpointEnabled  bool                         `tfschema:"oracle_dns_listening_endpoint_enabled"`
+	OracleToAzureDnsZoneSyncEnabled    bool                         `tfschema:"oracle_to_azure_dns_zone_sync_enabled"`
+	Tags                               map[string]string            `tfschema:"tags"`
+
+	CidrBlock                      string `tfschema:"cidr_block"`
+	DnsForwardingEndpointIPAddress string `tfschema:"dns_forwarding_endpoint_ip_address"`
+	DnsListeningEndpointIPAddress  string `tfschema:"dns_listening_endpoint_ip_address"`
+	OciSubnetId                    string `tfschema:"oci_subnet_id"`
+	OciVcnId                       string `tfschema:"oci_vcn_id"`
+	VirtualNetworkId               string `tfschema:"virtual_network_id"`
+}
+
+type NetworkAnchorDnsForwarding struct {
+	DomainNames         string `tfschema:"domain_names"`
+	ForwardingIPAddress string `tfschema:"forwarding_ip_address"`
+}
+
+func (NetworkAnchorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkAnchorName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"zones": commonschema.ZonesMultipleRequiredForceNew(),
+
+		"resource_anchor_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: resourceanchors.ValidateResourceAnchorID,
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"oci_backup_cidr_block": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsCIDR,
+		},
+
+		"oci_vcn_dns_label": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_forwarding_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"domain_names": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"forwarding_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPAddress,
+					},
+				},
+			},
+		},
+
+		"dns_listening_endpoint_allowed_cidrs": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"oracle_dns_forwarding_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"oracle_dns_listening_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"oracle_to_azure_dns_zone_sync_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (NetworkAnchorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cidr_block": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"dns_forwarding_endpoint_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"dns_listening_endpoint_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"oci_subnet_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"oci_vcn_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_network_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (NetworkAnchorResource) ModelObject() interface{} {
+	return &NetworkAnchorResourceModel{}
+}
+
+func (NetworkAnchorResource) ResourceType() string {
+	return "azurerm_oracle_network_anchor"
+}
+
+func (r NetworkAnchorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.NetworkAnchors
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model NetworkAnchorResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := networkanchors.NewNetworkAnchorID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := networkanchors.NetworkAnchor{
+				Location: location.Normalize(model.Location),
+				Zones:    pointer.To(model.Zones),
+				Tags:     pointer.To(model.Tags),
+				Properties: &networkanchors.NetworkAnchorProperties{
+					ResourceAnchorId:                     model.ResourceAnchorId,
+					SubnetId:                             model.SubnetId,
+					DnsForwardingRules:                   expandNetworkAnchorDnsForwardingRules(model.DnsForwardingRule),
+					IsOracleDnsForwardingEndpointEnabled: pointer.To(model.OracleDnsForwardingEndpointEnabled),
+					IsOracleDnsListeningEndpointEnabled:  pointer.To(model.OracleDnsListeningEndpointEnabled),
+					IsOracleToAzureDnsZoneSyncEnabled:    pointer.To(model.OracleToAzureDnsZoneSyncEnabled),
+				},
+			}
+
+			if model.OciBackupCidrBlock != "" {
+				param.Properties.OciBackupCidrBlock = pointer.To(model.OciBackupCidrBlock)
+			}
+			if model.OciVcnDnsLabel != "" {
+				param.Properties.OciVcnDnsLabel = pointer.To(model.OciVcnDnsLabel)
+			}
+			if model.DnsListeningEndpointAllowedCidrs != "" {
+				param.Properties.DnsListeningEndpointAllowedCidrs = pointer.To(model.DnsListeningEndpointAllowedCidrs)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (NetworkAnchorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.NetworkAnchors
+
+			id, err := networkanchors.ParseNetworkAnchorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := NetworkAnchorResourceModel{
+				Name:              id.NetworkAnchorName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Zones = pointer.From(model.Zones)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ResourceAnchorId = props.ResourceAnchorId
+					state.SubnetId = props.SubnetId
+					state.OciBackupCidrBlock = pointer.From(props.OciBackupCidrBlock)
+					state.OciVcnDnsLabel = pointer.From(props.OciVcnDnsLabel)
+					state.DnsForwardingRule = flattenNetworkAnchorDnsForwardingRules(props.DnsForwardingRules)
+					state.DnsListeningEndpointAllowedCidrs = pointer.From(props.DnsListeningEndpointAllowedCidrs)
+					state.OracleDnsForwardingEndpointEnabled = pointer.From(props.IsOracleDnsForwardingEndpointEnabled)
+					state.OracleDnsListeningEndpointEnabled = pointer.From(props.IsOracleDnsListeningEndpointEnabled)
+					state.OracleToAzureDnsZoneSyncEnabled = pointer.From(props.IsOracleToAzureDnsZoneSyncEnabled)
+
+					state.CidrBlock = pointer.From(props.CidrBlock)
+					state.DnsForwardingEndpointIPAddress = pointer.From(props.DnsForwardingEndpointIPAddress)
+					state.DnsListeningEndpointIPAddress = pointer.From(props.DnsListeningEndpointIPAddress)
+					state.OciSubnetId = pointer.From(props.OciSubnetId)
+					state.OciVcnId = pointer.From(props.OciVcnId)
+					state.VirtualNetworkId = pointer.From(props.VnetId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (NetworkAnchorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.NetworkAnchors
+
+			id, err := networkanchors.ParseNetworkAnchorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NetworkAnchorResourceModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			update := networkanchors.NetworkAnchorUpdate{
+				Properties: &networkanchors.NetworkAnchorUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("oci_backup_cidr_block") {
+				update.Properties.OciBackupCidrBlock = pointer.To(model.OciBackupCidrBlock)
+			}
+			if metadata.ResourceData.HasChange("oracle_dns_forwarding_endpoint_enabled") {
+				update.Properties.IsOracleDnsForwardingEndpointEnabled = pointer.To(model.OracleDnsForwardingEndpointEnabled)
+			}
+			if metadata.ResourceData.HasChange("oracle_dns_listening_endpoint_enabled") {
+				update.Properties.IsOracleDnsListeningEndpointEnabled = pointer.To(model.OracleDnsListeningEndpointEnabled)
+			}
+			if metadata.ResourceData.HasChange("oracle_to_azure_dns_zone_sync_enabled") {
+				update.Properties.IsOracleToAzureDnsZoneSyncEnabled = pointer.To(model.OracleToAzureDnsZoneSyncEnabled)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				update.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (NetworkAnchorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.NetworkAnchors
+
+			id, err := networkanchors.ParseNetworkAnchorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (NetworkAnchorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkanchors.ValidateNetworkAnchorID
+}
+
+func expandNetworkAnchorDnsForwardingRules(input []NetworkAnchorDnsForwarding) *[]networkanchors.DnsForwardingRule {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]networkanchors.DnsForwardingRule, 0, len(input))
+	for _, v := range input {
+		result = append(result, networkanchors.DnsForwardingRule{
+			DomainNames:         v.DomainNames,
+			ForwardingIPAddress: v.ForwardingIPAddress,
+		})
+	}
+
+	return &result
+}
+
+func flattenNetworkAnchorDnsForwardingRules(input *[]networkanchors.DnsForwardingRule) []NetworkAnchorDnsForwarding {
+	result := make([]NetworkAnchorDnsForwarding, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, NetworkAnchorDnsForwarding{
+			DomainNames:         v.DomainNames,
+			ForwardingIPAddress: v.ForwardingIPAddress,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/networkanchors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NetworkAnchorResource struct{}
+
+func (a NetworkAnchorResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkanchors.ParseNetworkAnchorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Oracle.OracleClient.NetworkAnchors.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestNetworkAnchorResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.NetworkAnchorResource{}.ResourceType(), "test")
+	r := NetworkAnchorResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestNetworkAnchorResource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.NetworkAnchorResource{}.ResourceType(), "test")
+	r := NetworkAnchorResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestNetworkAnchorResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.NetworkAnchorResource{}.ResourceType(), "test")
+	r := NetworkAnchorResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestNetworkAnchorResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.NetworkAnchorResource{}.ResourceType(), "test")
+	r := NetworkAnchorResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (a NetworkAnchorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_network_anchor" "test" {
+  name                = "na%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  zones               = ["2"]
+  resource_anchor_id  = azurerm_oracle_resource_anchor.test.id
+  subnet_id           = azurerm_subnet.test.id
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a NetworkAnchorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_network_anchor" "test" {
+  name                                   = "na%[2]d"
+  resource_group_name                    = azurerm_resource_group.test.name
+  location                               = azurerm_resource_group.test.location
+  zones                                  = ["2"]
+  resource_anchor_id                     = azurerm_oracle_resource_anchor.test.id
+  subnet_id                              = azurerm_subnet.test.id
+  oci_vcn_dns_label                      = "vcn%[2]d"
+  oracle_dns_forwarding_endpoint_enabled = true
+  oracle_dns_listening_endpoint_enabled  = true
+
+  dns_forwarding_rule {
+    domain_names          = "example.com"
+    forwarding_ip_address = "10.0.1.10"
+  }
+
+  tags = {
+    env = "test"
+  }
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a NetworkAnchorResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_network_anchor" "test" {
+  name                                  = "na%[2]d"
+  resource_group_name                   = azurerm_resource_group.test.name
+  location                              = azurerm_resource_group.test.location
+  zones                                 = ["2"]
+  resource_anchor_id                    = azurerm_oracle_resource_anchor.test.id
+  subnet_id                             = azurerm_subnet.test.id
+  oracle_to_azure_dns_zone_sync_enabled = true
+
+  tags = {
+    newtag = "newvalue"
+  }
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a NetworkAnchorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_network_anchor" "import" {
+  name                = azurerm_oracle_network_anchor.test.name
+  resource_group_name = azurerm_oracle_network_anchor.test.resource_group_name
+  location            = azurerm_oracle_network_anchor.test.location
+  zones               = azurerm_oracle_network_anchor.test.zones
+  resource_anchor_id  = azurerm_oracle_network_anchor.test.resource_anchor_id
+  subnet_id           = azurerm_oracle_network_anchor.test.subnet_id
+}
+`, a.basic(data))
+}
+
+func (a NetworkAnchorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-netanchor-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_oracle_resource_anchor" "test" {
+  name                = "ra%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctest-subnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      actions = [
+        "Microsoft.Network/networkinterfaces/*",
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+      ]
+      name = "Oracle.Database/networkAttachments"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"unicode"
+)
+
+func NetworkAnchorName(i interface{}, k string) (warnings []string, errors []error) {
+	var validationErrors []error
+
+	v, ok := i.(string)
+	if !ok {
+		validationErrors = append(validationErrors, fmt.Errorf("expected type of %s to be string", k))
+		return []string{}, validationErrors
+	}
+
+	if len(v) == 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("%v must not be empty", k))
+		return []string{}, validationErrors
+	}
+
+	if !unicode.IsLetter(rune(v[0])) {
+		validationErrors = append(validationErrors, fmt.Errorf("%v must start with a letter", k))
+	}
+
+	for _, r := range v {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			validationErrors = append(validationErrors, fmt.Errorf("%v must contain only letters and numbers", k))
+			break
+		}
+	}
+
+	if len(v) > 24 {
+		validationErrors = append(validationErrors, fmt.Errorf("%v must be 24 characters max", k))
+	}
+
+	return []string{}, validationErrors
+}
@@ -104,6 +104,15 @@ func resourceArmManagementGroupPolicyRemediation() *pluginsdk.Resource {
 				DiffSuppressFunc:      suppress.CaseDifference,
 				DiffSuppressOnRefresh: true,
 			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 
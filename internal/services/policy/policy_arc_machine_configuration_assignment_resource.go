@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/guestconfiguration/2024-04-05/guestconfigurationhcrpassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePolicyArcMachineConfigurationAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePolicyArcMachineConfigurationAssignmentCreateUpdate,
+		Read:   resourcePolicyArcMachineConfigurationAssignmentRead,
+		Update: resourcePolicyArcMachineConfigurationAssignmentCreateUpdate,
+		Delete: resourcePolicyArcMachineConfigurationAssignmentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := guestconfigurationhcrpassignments.ParseProviders2GuestConfigurationAssignmentID(id)
+			return err
+		}),
+
+		Schema: resourcePolicyArcMachineConfigurationAssignmentSchema(),
+	}
+}
+
+func resourcePolicyArcMachineConfigurationAssignmentSchema() map[string]*pluginsdk.Schema {
+	// the schema matches the Virtual Machine Configuration Assignment, other than the machine being assigned to
+	schema := resourcePolicyVirtualMachineConfigurationAssignmentSchema()
+	delete(schema, "virtual_machine_id")
+
+	schema["arc_machine_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: guestconfigurationhcrpassignments.ValidateMachineID,
+	}
+
+	return schema
+}
+
+func resourcePolicyArcMachineConfigurationAssignmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.GuestConfigurationHCRPAssignmentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	machineId, err := guestconfigurationhcrpassignments.ParseMachineID(d.Get("arc_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := guestconfigurationhcrpassignments.NewProviders2GuestConfigurationAssignmentID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_policy_arc_machine_configuration_assignment", id.ID())
+		}
+	}
+
+	guestConfiguration := expandArcMachineGuestConfigurationAssignment(d.Get("configuration").([]interface{}), id.GuestConfigurationAssignmentName)
+	assignment := guestconfigurationhcrpassignments.GuestConfigurationAssignment{
+		Name:     id.GuestConfigurationAssignmentName,
+		Location: pointer.To(location.Normalize(d.Get("location").(string))),
+		Properties: &guestconfigurationhcrpassignments.GuestConfigurationAssignmentProperties{
+			GuestConfiguration: guestConfiguration,
+		},
+	}
+
+	// built-in guest configurations are always hosted on a storage account owned by the service team, in which
+	// case the content hash and URI which are returned by the API mustn't be sent
+	if guestConfiguration.ContentUri != nil && strings.Contains(strings.ToLower(*guestConfiguration.ContentUri), "oaasguestconfig") {
+		assignment.Properties.GuestConfiguration.ContentHash = nil
+		assignment.Properties.GuestConfiguration.ContentUri = nil
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, assignment); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePolicyArcMachineConfigurationAssignmentRead(d, meta)
+}
+
+func resourcePolicyArcMachineConfigurationAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.GuestConfigurationHCRPAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := guestconfigurationhcrpassignments.ParseProviders2GuestConfigurationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.GuestConfigurationAssignmentName)
+	d.Set("arc_machine_id", guestconfigurationhcrpassignments.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("configuration", flattenArcMachineGuestConfigurationAssignment(props.GuestConfiguration)); err != nil {
+				return fmt.Errorf("setting `configuration`: %+v", err)
+			}
+
+			d.Set("assignment_hash", pointer.From(props.AssignmentHash))
+			d.Set("compliance_status", string(pointer.From(props.ComplianceStatus)))
+			d.Set("last_compliance_status_checked", pointer.From(props.LastComplianceStatusChecked))
+			d.Set("latest_report_id", pointer.From(props.LatestReportId))
+		}
+	}
+	return nil
+}
+
+func resourcePolicyArcMachineConfigurationAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.GuestConfigurationHCRPAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := guestconfigurationhcrpassignments.ParseProviders2GuestConfigurationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandArcMachineGuestConfigurationAssignment(input []interface{}, name string) *guestconfigurationhcrpassignments.GuestConfigurationNavigation {
+	if len(input) == 0 {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := guestconfigurationhcrpassignments.GuestConfigurationNavigation{
+		Name:                   pointer.To(name),
+		Version:                pointer.To(v["version"].(string)),
+		ConfigurationParameter: expandArcMachineGuestConfigurationAssignmentConfigurationParameters(v["parameter"].(*pluginsdk.Set).List()),
+	}
+
+	if v, ok := v["assignment_type"]; ok {
+		result.AssignmentType = pointer.To(guestconfigurationhcrpassignments.AssignmentType(v.(string)))
+	}
+
+	if v, ok := v["content_hash"]; ok {
+		result.ContentHash = pointer.To(v.(string))
+	}
+
+	if v, ok := v["content_uri"]; ok {
+		result.ContentUri = pointer.To(v.(string))
+	}
+
+	return &result
+}
+
+func expandArcMachineGuestConfigurationAssignmentConfigurationParameters(input []interface{}) *[]guestconfigurationhcrpassignments.ConfigurationParameter {
+	results := make([]guestconfigurationhcrpassignments.ConfigurationParameter, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, guestconfigurationhcrpassignments.ConfigurationParameter{
+			Name:  pointer.To(v["name"].(string)),
+			Value: pointer.To(v["value"].(string)),
+		})
+	}
+	return &results
+}
+
+func flattenArcMachineGuestConfigurationAssignment(input *guestconfigurationhcrpassignments.GuestConfigurationNavigation) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"assignment_type": string(pointer.From(input.AssignmentType)),
+			"content_hash":    pointer.From(input.ContentHash),
+			"content_uri":     pointer.From(input.ContentUri),
+			"parameter":       flattenArcMachineGuestConfigurationAssignmentConfigurationParameters(input.ConfigurationParameter),
+			"version":         pointer.From(input.Version),
+		},
+	}
+}
+
+func flattenArcMachineGuestConfigurationAssignmentConfigurationParameters(input *[]guestconfigurationhcrpassignments.ConfigurationParameter) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"name":  pointer.From(item.Name),
+			"value": pointer.From(item.Value),
+		})
+	}
+	return results
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/guestconfiguration/2024-04-05/guestconfigurationhcrpassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PolicyArcMachineConfigurationAssignmentResource struct{}
+
+// Arc Machines require the Connected Machine agent to be installed on an existing machine, which can't be done
+// as part of the test - as such an existing Arc Machine has to be provided
+func TestAccPolicyArcMachineConfigurationAssignment_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_MACHINE_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_ARC_MACHINE_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_policy_arc_machine_configuration_assignment", "test")
+	r := PolicyArcMachineConfigurationAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("compliance_status").Exists(),
+			),
+		},
+		data.ImportStep("compliance_status", "last_compliance_status_checked", "latest_report_id"),
+	})
+}
+
+func TestAccPolicyArcMachineConfigurationAssignment_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_MACHINE_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_ARC_MACHINE_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_policy_arc_machine_configuration_assignment", "test")
+	r := PolicyArcMachineConfigurationAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPolicyArcMachineConfigurationAssignment_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_ARC_MACHINE_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_ARC_MACHINE_ID` was not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_policy_arc_machine_configuration_assignment", "test")
+	r := PolicyArcMachineConfigurationAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "ApplyAndAutoCorrect"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("compliance_status", "last_compliance_status_checked", "latest_report_id"),
+		{
+			Config: r.complete(data, "Audit"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("compliance_status", "last_compliance_status_checked", "latest_report_id"),
+	})
+}
+
+func (r PolicyArcMachineConfigurationAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := guestconfigurationhcrpassignments.ParseProviders2GuestConfigurationAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Policy.GuestConfigurationHCRPAssignmentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r PolicyArcMachineConfigurationAssignmentResource) template() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  arc_machine_id = "%s"
+}
+
+data "azurerm_arc_machine" "test" {
+  name                = element(split("/", local.arc_machine_id), 8)
+  resource_group_name = element(split("/", local.arc_machine_id), 4)
+}
+`, os.Getenv("ARM_TEST_ARC_MACHINE_ID"))
+}
+
+func (r PolicyArcMachineConfigurationAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_arc_machine_configuration_assignment" "test" {
+  name           = "acctest-gca-%d"
+  location       = data.azurerm_arc_machine.test.location
+  arc_machine_id = data.azurerm_arc_machine.test.id
+
+  configuration {
+    version = "1.*"
+
+    parameter {
+      name  = "[InstalledApplication]bwhitelistedapp;Name"
+      value = "NotePad,sql"
+    }
+  }
+}
+`, r.template(), data.RandomInteger)
+}
+
+func (r PolicyArcMachineConfigurationAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_arc_machine_configuration_assignment" "import" {
+  name           = azurerm_policy_arc_machine_configuration_assignment.test.name
+  location       = azurerm_policy_arc_machine_configuration_assignment.test.location
+  arc_machine_id = azurerm_policy_arc_machine_configuration_assignment.test.arc_machine_id
+
+  configuration {
+    version = "1.*"
+
+    parameter {
+      name  = "[InstalledApplication]bwhitelistedapp;Name"
+      value = "NotePad,sql"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r PolicyArcMachineConfigurationAssignmentResource) complete(data acceptance.TestData, assignmentType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_arc_machine_configuration_assignment" "test" {
+  name           = "acctest-gca-%d"
+  location       = data.azurerm_arc_machine.test.location
+  arc_machine_id = data.azurerm_arc_machine.test.id
+
+  configuration {
+    version         = "1.1.1.1"
+    assignment_type = "%s"
+    content_hash    = upper("db4d5cd43c59c756f9beb1f029c858bc341587bf75332288270a26493565f058")
+    content_uri     = "https://testcontenturi/package"
+
+    parameter {
+      name  = "[InstalledApplication]bwhitelistedapp;Name"
+      value = "NotePad,sql"
+    }
+  }
+}
+`, r.template(), data.RandomInteger, assignmentType)
+}
@@ -39,8 +39,10 @@ type AssignmentDataSourceModel struct {
 	Metadata             string                                     `tfschema:"metadata"`
 	NotScopes            []string                                   `tfschema:"not_scopes"`
 	NonComplianceMessage []NonComplianceMessage                     `tfschema:"non_compliance_message"`
+	Overrides            []AssignmentOverride                       `tfschema:"overrides"`
 	Parameters           string                                     `tfschema:"parameters"`
 	PolicyDefinitionId   string                                     `tfschema:"policy_definition_id"`
+	ResourceSelectors    []AssignmentResourceSelector               `tfschema:"resource_selectors"`
 }
 
 type NonComplianceMessage struct {
@@ -48,6 +50,22 @@ type NonComplianceMessage struct {
 	PolicyDefinitionReferenceId string `tfschema:"policy_definition_reference_id"`
 }
 
+type AssignmentOverride struct {
+	Selectors []AssignmentSelector `tfschema:"selectors"`
+	Value     string               `tfschema:"value"`
+}
+
+type AssignmentResourceSelector struct {
+	Name      string               `tfschema:"name"`
+	Selectors []AssignmentSelector `tfschema:"selectors"`
+}
+
+type AssignmentSelector struct {
+	In    []string `tfschema:"in"`
+	Kind  string   `tfschema:"kind"`
+	NotIn []string `tfschema:"not_in"`
+}
+
 func (AssignmentDataSource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -122,6 +140,21 @@ func (AssignmentDataSource) Attributes() map[string]*schema.Schema {
 			},
 		},
 
+		"overrides": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"selectors": assignmentSelectorsDataSourceSchema(),
+
+					"value": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
 		"parameters": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
@@ -131,6 +164,52 @@ func (AssignmentDataSource) Attributes() map[string]*schema.Schema {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
 		},
+
+		"resource_selectors": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"selectors": assignmentSelectorsDataSourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func assignmentSelectorsDataSourceSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"in": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"kind": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"not_in": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -191,12 +270,14 @@ func (AssignmentDataSource) Read() sdk.ResourceFunc {
 					model.NotScopes = *v
 				}
 				model.flattenNonComplianceMessages(props.NonComplianceMessages)
+				model.Overrides = flattenAssignmentOverrides(props.Overrides)
 				if err := model.flattenParameter(props.Parameters); err != nil {
 					return fmt.Errorf("flatten `parameters`: %v", err)
 				}
 				if v := props.PolicyDefinitionId; v != nil {
 					model.PolicyDefinitionId = *v
 				}
+				model.ResourceSelectors = flattenAssignmentResourceSelectors(props.ResourceSelectors)
 			}
 
 			if err := metadata.Encode(&model); err != nil {
@@ -224,6 +305,55 @@ func (m *AssignmentDataSourceModel) flattenNonComplianceMessages(input *[]assign
 	}
 }
 
+func flattenAssignmentOverrides(input *[]assignments.Override) []AssignmentOverride {
+	output := make([]AssignmentOverride, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, AssignmentOverride{
+			Selectors: flattenAssignmentSelectors(v.Selectors),
+			Value:     pointer.From(v.Value),
+		})
+	}
+
+	return output
+}
+
+func flattenAssignmentResourceSelectors(input *[]assignments.ResourceSelector) []AssignmentResourceSelector {
+	output := make([]AssignmentResourceSelector, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, AssignmentResourceSelector{
+			Name:      pointer.From(v.Name),
+			Selectors: flattenAssignmentSelectors(v.Selectors),
+		})
+	}
+
+	return output
+}
+
+func flattenAssignmentSelectors(input *[]assignments.Selector) []AssignmentSelector {
+	output := make([]AssignmentSelector, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, AssignmentSelector{
+			In:    pointer.From(v.In),
+			Kind:  string(pointer.From(v.Kind)),
+			NotIn: pointer.From(v.NotIn),
+		})
+	}
+
+	return output
+}
+
 func (m *AssignmentDataSourceModel) flattenParameter(input *map[string]assignments.ParameterValuesValue) error {
 	if input == nil || len(*input) == 0 {
 		return nil
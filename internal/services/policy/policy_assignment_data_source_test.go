@@ -47,6 +47,9 @@ func TestAccDataSourceAssignment_builtinPolicyComplete(t *testing.T) {
 				check.That(data.ResourceName).Key("non_compliance_message.0.policy_definition_reference_id").HasValue("AINE_MinimumPasswordLength"),
 				check.That(data.ResourceName).Key("not_scopes.#").HasValue("1"),
 				check.That(data.ResourceName).Key("metadata").Exists(),
+				check.That(data.ResourceName).Key("resource_selectors.#").HasValue("1"),
+				check.That(data.ResourceName).Key("resource_selectors.0.name").HasValue("primary-location"),
+				check.That(data.ResourceName).Key("resource_selectors.0.selectors.0.kind").HasValue("resourceLocation"),
 			),
 		},
 	})
@@ -125,6 +128,14 @@ resource "azurerm_resource_group_policy_assignment" "test" {
   metadata = jsonencode({
     "category" : "Testing"
   })
+
+  resource_selectors {
+    name = "primary-location"
+    selectors {
+      kind = "resourceLocation"
+      in   = [azurerm_resource_group.test.location]
+    }
+  }
 }
 
 data "azurerm_policy_assignment" "test" {
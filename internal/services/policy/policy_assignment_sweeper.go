@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+	assignments "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.Sweeper = PolicyAssignmentSweeper{}
+
+// PolicyAssignmentSweeper removes Policy Assignments at the Subscription scope which have been leaked by the
+// Acceptance Tests - Policy Assignments scoped to a Resource Group (or a resource within it) are removed along with
+// the Resource Group.
+//
+// NOTE: Policy Assignments don't support Tags, so are never matched when a Tag filter is specified.
+type PolicyAssignmentSweeper struct{}
+
+func (PolicyAssignmentSweeper) Name() string {
+	return "Policy Assignments"
+}
+
+func (PolicyAssignmentSweeper) Sweep(ctx context.Context, client *clients.Client, filter sdk.SweepFilter) ([]string, error) {
+	if !filter.MatchesTags(nil) {
+		log.Printf("[DEBUG] Skipping Policy Assignments since these can't be filtered by Tags")
+		return nil, nil
+	}
+
+	assignmentsClient := client.Policy.AssignmentsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	// `atScope()` returns the Policy Assignments at the Subscription and the Management Groups above it, the latter
+	// of which are filtered out below
+	options := assignments.ListOperationOptions{
+		Filter: pointer.To("atScope()"),
+	}
+	resp, err := assignmentsClient.ListComplete(ctx, subscriptionId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Policy Assignments within %s: %+v", subscriptionId, err)
+	}
+
+	swept := make([]string, 0)
+	for _, assignment := range resp.Items {
+		name := pointer.From(assignment.Name)
+		displayName := ""
+		if props := assignment.Properties; props != nil {
+			if !strings.EqualFold(pointer.From(props.Scope), subscriptionId.ID()) {
+				continue
+			}
+			displayName = pointer.From(props.DisplayName)
+		}
+		if !filter.MatchesName(name) && !filter.MatchesName(displayName) {
+			continue
+		}
+
+		createdAt, err := sweeperCreatedTime(assignment.SystemData)
+		if err != nil {
+			return swept, fmt.Errorf("parsing `systemData.createdAt` for %s: %+v", pointer.From(assignment.Id), err)
+		}
+		if !filter.MatchesAge(createdAt) {
+			continue
+		}
+
+		id := assignments.NewScopedPolicyAssignmentID(subscriptionId.ID(), name)
+		swept = append(swept, id.ID())
+		if filter.DryRun {
+			log.Printf("[DEBUG] Dry Run: would delete %s", id)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting %s..", id)
+		if _, err := assignmentsClient.Delete(ctx, id); err != nil {
+			return swept, fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return swept, nil
+}
+
+// sweeperCreatedTime returns the creation time from the System Data for a Policy resource, which is nil when this
+// isn't returned by the API.
+func sweeperCreatedTime(input *systemdata.SystemData) (*time.Time, error) {
+	if input == nil || input.CreatedAt == "" {
+		return nil, nil
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, input.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createdAt, nil
+}
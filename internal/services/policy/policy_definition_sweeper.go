@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.Sweeper = PolicyDefinitionSweeper{}
+
+// PolicyDefinitionSweeper removes Custom Policy Definitions at the Subscription scope which have been leaked by the
+// Acceptance Tests. These must be removed after the Policy Assignments and Policy Set Definitions which reference
+// them.
+//
+// NOTE: Policy Definitions don't support Tags, so are never matched when a Tag filter is specified.
+type PolicyDefinitionSweeper struct{}
+
+func (PolicyDefinitionSweeper) Name() string {
+	return "Policy Definitions"
+}
+
+func (PolicyDefinitionSweeper) Sweep(ctx context.Context, client *clients.Client, filter sdk.SweepFilter) ([]string, error) {
+	if !filter.MatchesTags(nil) {
+		log.Printf("[DEBUG] Skipping Policy Definitions since these can't be filtered by Tags")
+		return nil, nil
+	}
+
+	definitionsClient := client.Policy.DefinitionsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	definitions, err := definitionsClient.ListComplete(ctx, "policyType eq 'Custom'", nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing Custom Policy Definitions within %s: %+v", subscriptionId, err)
+	}
+
+	// the Policy Definitions are retrieved up-front, since removing these whilst paging could skip items
+	candidates := make([]policy.Definition, 0)
+	for definitions.NotDone() {
+		candidates = append(candidates, definitions.Value())
+
+		if err := definitions.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Custom Policy Definitions within %s: %+v", subscriptionId, err)
+		}
+	}
+
+	swept := make([]string, 0)
+	for _, definition := range candidates {
+		// Policy Definitions created at a Management Group are also returned, but are left for the Management
+		// Group to be swept
+		if !strings.HasPrefix(strings.ToLower(pointer.From(definition.ID)), strings.ToLower(subscriptionId.ID()+"/")) {
+			continue
+		}
+
+		name := pointer.From(definition.Name)
+		displayName := ""
+		if definition.DefinitionProperties != nil {
+			displayName = pointer.From(definition.DisplayName)
+		}
+		if !filter.MatchesName(name) && !filter.MatchesName(displayName) {
+			continue
+		}
+
+		var createdAt *time.Time
+		if definition.SystemData != nil && definition.SystemData.CreatedAt != nil {
+			createdAt = &definition.SystemData.CreatedAt.Time
+		}
+		if !filter.MatchesAge(createdAt) {
+			continue
+		}
+
+		id := pointer.From(definition.ID)
+		swept = append(swept, id)
+		if filter.DryRun {
+			log.Printf("[DEBUG] Dry Run: would delete Policy Definition %q", id)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Policy Definition %q..", id)
+		if _, err := definitionsClient.Delete(ctx, name); err != nil {
+			return swept, fmt.Errorf("deleting Policy Definition %q: %+v", id, err)
+		}
+	}
+
+	return swept, nil
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2025-01-01/policysetdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.Sweeper = PolicySetDefinitionSweeper{}
+
+// PolicySetDefinitionSweeper removes Custom Policy Set Definitions at the Subscription scope which have been leaked
+// by the Acceptance Tests. These must be removed after the Policy Assignments which reference them, and before the
+// Policy Definitions which they reference.
+//
+// NOTE: Policy Set Definitions don't support Tags, so are never matched when a Tag filter is specified.
+type PolicySetDefinitionSweeper struct{}
+
+func (PolicySetDefinitionSweeper) Name() string {
+	return "Policy Set Definitions"
+}
+
+func (PolicySetDefinitionSweeper) Sweep(ctx context.Context, client *clients.Client, filter sdk.SweepFilter) ([]string, error) {
+	if !filter.MatchesTags(nil) {
+		log.Printf("[DEBUG] Skipping Policy Set Definitions since these can't be filtered by Tags")
+		return nil, nil
+	}
+
+	setDefinitionsClient := client.Policy.PolicySetDefinitionsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	options := policysetdefinitions.ListOperationOptions{
+		Filter: pointer.To("policyType eq 'Custom'"),
+	}
+	resp, err := setDefinitionsClient.ListComplete(ctx, subscriptionId, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Custom Policy Set Definitions within %s: %+v", subscriptionId, err)
+	}
+
+	swept := make([]string, 0)
+	for _, definition := range resp.Items {
+		// Policy Set Definitions created at a Management Group are also returned, but are left for the Management
+		// Group to be swept
+		if !strings.HasPrefix(strings.ToLower(pointer.From(definition.Id)), strings.ToLower(subscriptionId.ID()+"/")) {
+			continue
+		}
+
+		name := pointer.From(definition.Name)
+		displayName := ""
+		if props := definition.Properties; props != nil {
+			displayName = pointer.From(props.DisplayName)
+		}
+		if !filter.MatchesName(name) && !filter.MatchesName(displayName) {
+			continue
+		}
+
+		createdAt, err := sweeperCreatedTime(definition.SystemData)
+		if err != nil {
+			return swept, fmt.Errorf("parsing `systemData.createdAt` for %s: %+v", pointer.From(definition.Id), err)
+		}
+		if !filter.MatchesAge(createdAt) {
+			continue
+		}
+
+		id := policysetdefinitions.NewProviderPolicySetDefinitionID(subscriptionId.SubscriptionId, name)
+		swept = append(swept, id.ID())
+		if filter.DryRun {
+			log.Printf("[DEBUG] Dry Run: would delete %s", id)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting %s..", id)
+		if _, err := setDefinitionsClient.Delete(ctx, id); err != nil {
+			return swept, fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return swept, nil
+}
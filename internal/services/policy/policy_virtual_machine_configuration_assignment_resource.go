@@ -120,6 +120,26 @@ func resourcePolicyVirtualMachineConfigurationAssignmentSchema() map[string]*plu
 				},
 			},
 		},
+
+		"assignment_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"compliance_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_compliance_status_checked": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_report_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -209,6 +229,11 @@ func resourcePolicyVirtualMachineConfigurationAssignmentRead(d *pluginsdk.Resour
 			if err := d.Set("configuration", flattenGuestConfigurationAssignment(props.GuestConfiguration)); err != nil {
 				return fmt.Errorf("setting `configuration`: %+v", err)
 			}
+
+			d.Set("assignment_hash", pointer.From(props.AssignmentHash))
+			d.Set("compliance_status", string(pointer.From(props.ComplianceStatus)))
+			d.Set("last_compliance_status_checked", pointer.From(props.LastComplianceStatusChecked))
+			d.Set("latest_report_id", pointer.From(props.LatestReportId))
 		}
 	}
 	return nil
@@ -29,9 +29,10 @@ func TestAccPolicyVirtualMachineConfigurationAssignment_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("compliance_status").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("compliance_status", "last_compliance_status_checked", "latest_report_id"),
 	})
 }
 
@@ -15,6 +15,7 @@ var (
 	_ sdk.FrameworkServiceRegistration             = Registration{}
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
 	_ sdk.UntypedServiceRegistration               = Registration{}
+	_ sdk.ServiceRegistrationWithSweepers          = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -80,6 +81,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_resource_group_policy_remediation":               resourceArmResourceGroupPolicyRemediation(),
 		"azurerm_subscription_policy_remediation":                 resourceArmSubscriptionPolicyRemediation(),
 		"azurerm_policy_virtual_machine_configuration_assignment": resourcePolicyVirtualMachineConfigurationAssignment(),
+		"azurerm_policy_arc_machine_configuration_assignment":     resourcePolicyArcMachineConfigurationAssignment(),
 	}
 
 	if !features.FivePointOh() {
@@ -90,6 +92,15 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return resources
 }
 
+// Sweepers returns a list of Sweepers supported by this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		PolicyAssignmentSweeper{},
+		PolicySetDefinitionSweeper{},
+		PolicyDefinitionSweeper{},
+	}
+}
+
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{}
 }
@@ -111,6 +111,15 @@ func resourceArmResourceGroupPolicyRemediation() *pluginsdk.Resource {
 					string(remediations.ResourceDiscoveryModeReEvaluateCompliance),
 				}, false),
 			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 
@@ -49,6 +49,28 @@ func TestAccAzureRMResourceGroupPolicyRemediation_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMResourceGroupPolicyRemediation_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_remediation", "test")
+	r := ResourceGroupPolicyRemediationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.triggers(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.triggers(data, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
 func (r ResourceGroupPolicyRemediationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := remediations.ParseProviderRemediationID(state.ID)
 	if err != nil {
@@ -158,3 +180,19 @@ resource "azurerm_resource_group_policy_remediation" "test" {
 }
 `, r.template(data), data.RandomString)
 }
+
+func (r ResourceGroupPolicyRemediationResource) triggers(data acceptance.TestData, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_policy_remediation" "test" {
+  name                 = "acctestremediation-%[2]s"
+  resource_group_id    = azurerm_resource_group_policy_assignment.test.resource_group_id
+  policy_assignment_id = azurerm_resource_group_policy_assignment.test.id
+
+  triggers = {
+    policy_version = "%[3]s"
+  }
+}
+`, r.template(data), data.RandomString, version)
+}
@@ -112,6 +112,15 @@ func resourceArmResourcePolicyRemediation() *pluginsdk.Resource {
 					string(remediations.ResourceDiscoveryModeReEvaluateCompliance),
 				}, false),
 			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 
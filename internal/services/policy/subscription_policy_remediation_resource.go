@@ -110,6 +110,15 @@ func resourceArmSubscriptionPolicyRemediation() *pluginsdk.Resource {
 					string(remediations.ResourceDiscoveryModeReEvaluateCompliance),
 				}, false),
 			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 
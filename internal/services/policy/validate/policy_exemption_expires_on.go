@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"time"
+
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+)
+
+func PolicyExemptionExpiresOn(i interface{}, k string) (warnings []string, errors []error) {
+	warnings, errors = azValidate.ISO8601DateTime(i, k)
+	if len(errors) > 0 {
+		return warnings, errors
+	}
+
+	// the service accepts an expiry in the past, however the exemption then no longer applies and the resources it
+	// covers are evaluated (and potentially remediated) against the assignment again - so this is surfaced at plan time
+	if expiresOn, err := time.Parse(time.RFC3339, i.(string)); err == nil && expiresOn.Before(time.Now()) {
+		warnings = append(warnings, fmt.Sprintf("%q is set to %q which is in the past, the exemption has expired and no longer applies", k, i))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyExemptionExpiresOn(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Valid    bool
+		Warnings int
+	}{
+		{
+			Name:  "empty",
+			Input: "",
+			Valid: false,
+		},
+		{
+			Name:  "invalid date",
+			Input: "not-a-date",
+			Valid: false,
+		},
+		{
+			Name:     "in the future",
+			Input:    time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339),
+			Valid:    true,
+			Warnings: 0,
+		},
+		{
+			Name:     "in the past",
+			Input:    "2020-01-01T00:00:00Z",
+			Valid:    true,
+			Warnings: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		warnings, errors := PolicyExemptionExpiresOn(v.Input, "expires_on")
+		if actual := len(errors) == 0; actual != v.Valid {
+			t.Fatalf("expected %q to be valid %t but got %t: %+v", v.Input, v.Valid, actual, errors)
+		}
+		if v.Valid && len(warnings) != v.Warnings {
+			t.Fatalf("expected %d warnings for %q but got %d: %+v", v.Warnings, v.Input, len(warnings), warnings)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2025-08-01/servers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PostgresqlFlexibleServerReplicaPromoteAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &PostgresqlFlexibleServerReplicaPromoteAction{}
+
+func newPostgresqlFlexibleServerReplicaPromoteAction() action.Action {
+	return &PostgresqlFlexibleServerReplicaPromoteAction{}
+}
+
+type PostgresqlFlexibleServerReplicaPromoteActionModel struct {
+	ReplicaServerId types.String `tfsdk:"replica_server_id"`
+	PromoteMode     types.String `tfsdk:"promote_mode"`
+	PromoteOption   types.String `tfsdk:"promote_option"`
+	Timeout         types.String `tfsdk:"timeout"`
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"replica_server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the PostgreSQL Flexible Server read replica to promote.",
+				MarkdownDescription: "The ID of the PostgreSQL Flexible Server read replica to promote.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"promote_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Whether the replica becomes a `Standalone` server, or swaps roles with the primary server using `Switchover`. Defaults to `Standalone`.",
+				MarkdownDescription: "Whether the replica becomes a `Standalone` server, or swaps roles with the primary server using `Switchover`. Defaults to `Standalone`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(servers.PossibleValuesForReadReplicaPromoteMode()...),
+				},
+			},
+
+			"promote_option": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Whether the promotion is `Planned`, waiting for the replica to be in sync with the primary server before promoting it, or `Forced`, promoting it immediately and potentially losing data. Defaults to `Planned`.",
+				MarkdownDescription: "Whether the promotion is `Planned`, waiting for the replica to be in sync with the primary server before promoting it, or `Forced`, promoting it immediately and potentially losing data. Defaults to `Planned`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(servers.PossibleValuesForReadReplicaPromoteOption()...),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_postgresql_flexible_server_replica_promote"
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Postgres.FlexibleServersClient
+
+	model := PostgresqlFlexibleServerReplicaPromoteActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := servers.ParseFlexibleServerID(model.ReplicaServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	promoteMode := servers.ReadReplicaPromoteModeStandalone
+	if v := model.PromoteMode; !v.IsNull() && v.ValueString() != "" {
+		promoteMode = servers.ReadReplicaPromoteMode(v.ValueString())
+	}
+
+	promoteOption := servers.ReadReplicaPromoteOptionPlanned
+	if v := model.PromoteOption; !v.IsNull() && v.ValueString() != "" {
+		promoteOption = servers.ReadReplicaPromoteOption(v.ValueString())
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+
+	if existing.Model == nil || existing.Model.Properties == nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving %s", id), "`properties` was nil")
+		return
+	}
+
+	role := pointer.From(existing.Model.Properties.ReplicationRole)
+	if role != servers.ReplicationRoleAsyncReplica && role != servers.ReplicationRoleGeoAsyncReplica {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("promoting %s", id), fmt.Sprintf("only a read replica can be promoted but the server has the replication role %q", role))
+		return
+	}
+
+	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+	// a planned promotion is rejected unless replication is healthy, so wait for the replica to catch up first rather
+	// than failing when it's still catching up after a recent write-heavy period or a scale operation
+	if promoteOption == servers.ReadReplicaPromoteOptionPlanned {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("waiting for replication to %s to be active", id),
+		})
+
+		if err := postgresqlFlexibleServerWaitForReplicationState(ctx, client, *id, servers.ReplicationStateActive); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("waiting for replication to %s to be active", id), err)
+			return
+		}
+	}
+
+	payload := servers.ServerForPatch{
+		Properties: &servers.ServerPropertiesForPatch{
+			Replica: &servers.Replica{
+				PromoteMode:   pointer.To(promoteMode),
+				PromoteOption: pointer.To(promoteOption),
+			},
+		},
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("promoting %s (mode %q, option %q)", id, promoteMode, promoteOption),
+	})
+
+	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("promoting %s", id), err)
+		return
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving %s after promotion", id), err)
+		return
+	}
+
+	newRole := servers.ReplicationRoleNone
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ReplicationRole != nil {
+		newRole = *model.Properties.ReplicationRole
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("promotion of %s completed, the server now has the replication role %q", id, newRole),
+	})
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
+
+func postgresqlFlexibleServerWaitForReplicationState(ctx context.Context, client *servers.ServersClient, id servers.FlexibleServerId, target servers.ReplicationState) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(servers.ReplicationStateCatchup),
+			string(servers.ReplicationStateProvisioning),
+			string(servers.ReplicationStateReconfiguring),
+			string(servers.ReplicationStateUpdating),
+		},
+		Target:     []string{string(target)},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return nil, "error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Replica != nil {
+				state := pointer.From(model.Properties.Replica.ReplicationState)
+				if state == servers.ReplicationStateBroken {
+					return resp, string(state), fmt.Errorf("replication to %s is broken, a `Forced` promotion is required", id)
+				}
+				return resp, string(state), nil
+			}
+
+			return resp, "", fmt.Errorf("retrieving %s: `properties.replica` was nil", id)
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type PostgresqlFlexibleServerReplicaPromoteAction struct{}
+
+func TestAccPostgresqlFlexibleServerReplicaPromoteAction_standalone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_replica_promote", "test")
+	a := PostgresqlFlexibleServerReplicaPromoteAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.standalone(data),
+			},
+		},
+	})
+}
+
+func (a PostgresqlFlexibleServerReplicaPromoteAction) standalone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_postgresql_flexible_server.replica.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_postgresql_flexible_server_replica_promote.test]
+    }
+  }
+}
+
+action "azurerm_postgresql_flexible_server_replica_promote" "test" {
+  config {
+    replica_server_id = azurerm_postgresql_flexible_server.replica.id
+    promote_mode      = "Standalone"
+    promote_option    = "Planned"
+  }
+}
+`, PostgresqlFlexibleServerResource{}.replica(data))
+}
@@ -83,7 +83,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newPostgresqlFlexibleServerReplicaPromoteAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/virtualnetworklinks"
+)
+
+var _ resourceids.Id = PrivateDnsZoneVirtualNetworkLinksId{}
+
+// the `virtualNetworkLinks` segment isn't used here, since `{zoneId}/virtualNetworkLinks/default` is the ID of a
+// Virtual Network Link named `default`
+const privateDnsZoneVirtualNetworkLinksSuffix = "/virtualNetworkLinkSet/default"
+
+// PrivateDnsZoneVirtualNetworkLinksId is a synthetic ID for the full set of Virtual Network Links within a Private DNS
+// Zone, since the ID of the Private DNS Zone is already used by azurerm_private_dns_zone.
+type PrivateDnsZoneVirtualNetworkLinksId struct {
+	PrivateDnsZoneId virtualnetworklinks.PrivateDnsZoneId
+}
+
+func NewPrivateDnsZoneVirtualNetworkLinksID(privateDnsZoneId virtualnetworklinks.PrivateDnsZoneId) PrivateDnsZoneVirtualNetworkLinksId {
+	return PrivateDnsZoneVirtualNetworkLinksId{
+		PrivateDnsZoneId: privateDnsZoneId,
+	}
+}
+
+func (id PrivateDnsZoneVirtualNetworkLinksId) ID() string {
+	return id.PrivateDnsZoneId.ID() + privateDnsZoneVirtualNetworkLinksSuffix
+}
+
+func (id PrivateDnsZoneVirtualNetworkLinksId) String() string {
+	components := []string{
+		fmt.Sprintf("Private Dns Zone Name %q", id.PrivateDnsZoneId.PrivateDnsZoneName),
+		fmt.Sprintf("Resource Group %q", id.PrivateDnsZoneId.ResourceGroupName),
+	}
+	return fmt.Sprintf("Private DNS Zone Virtual Network Links: (%s)", strings.Join(components, " / "))
+}
+
+// PrivateDnsZoneVirtualNetworkLinksID parses a PrivateDnsZoneVirtualNetworkLinks ID into a PrivateDnsZoneVirtualNetworkLinksId struct
+func PrivateDnsZoneVirtualNetworkLinksID(input string) (*PrivateDnsZoneVirtualNetworkLinksId, error) {
+	privateDnsZoneIdRaw, ok := strings.CutSuffix(input, privateDnsZoneVirtualNetworkLinksSuffix)
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {privateDnsZoneId}%s but got %q", privateDnsZoneVirtualNetworkLinksSuffix, input)
+	}
+
+	privateDnsZoneId, err := virtualnetworklinks.ParsePrivateDnsZoneID(privateDnsZoneIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsZoneVirtualNetworkLinksId{
+		PrivateDnsZoneId: *privateDnsZoneId,
+	}, nil
+}
+
+func ValidatePrivateDnsZoneVirtualNetworkLinksID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := PrivateDnsZoneVirtualNetworkLinksID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
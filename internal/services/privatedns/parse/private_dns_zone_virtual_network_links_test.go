@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/virtualnetworklinks"
+)
+
+func TestPrivateDnsZoneVirtualNetworkLinksID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateDnsZoneVirtualNetworkLinksId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// private dns zone id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/zone1.com",
+			Error: true,
+		},
+		{
+			// virtual network link id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/zone1.com/virtualNetworkLinks/default",
+			Error: true,
+		},
+		{
+			// invalid private dns zone id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/virtualNetworkLinkSet/default",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/zone1.com/virtualNetworkLinkSet/default",
+			Expected: &PrivateDnsZoneVirtualNetworkLinksId{
+				PrivateDnsZoneId: virtualnetworklinks.NewPrivateDnsZoneID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1.com"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PrivateDnsZoneVirtualNetworkLinksID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateDnsZoneId != v.Expected.PrivateDnsZoneId {
+			t.Fatalf("Expected %+v but got %+v", v.Expected.PrivateDnsZoneId, actual.PrivateDnsZoneId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package privatedns
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -202,35 +203,40 @@ func resourcePrivateDnsZoneVirtualNetworkLinkDelete(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	// whilst the Delete above returns a Future, the Azure API's broken such that even though it's marked as "gone"
-	// it's still kicking around - so we have to poll until this is actually gone
-	log.Printf("[DEBUG] Waiting for %s to be deleted", *id)
+	return waitForPrivateDnsZoneVirtualNetworkLinkToBeDeleted(ctx, client, *id, d.Timeout(pluginsdk.TimeoutDelete))
+}
+
+// waitForPrivateDnsZoneVirtualNetworkLinkToBeDeleted waits until the Virtual Network Link is actually gone, since
+// whilst the Delete returns a Future, the Azure API's broken such that even though it's marked as "gone"
+// it's still kicking around - so we have to poll until this is actually gone
+func waitForPrivateDnsZoneVirtualNetworkLinkToBeDeleted(ctx context.Context, client *virtualnetworklinks.VirtualNetworkLinksClient, id virtualnetworklinks.VirtualNetworkLinkId, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for %s to be deleted", id)
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending: []string{"Available"},
 		Target:  []string{"NotFound"},
 		Refresh: func() (interface{}, string, error) {
-			log.Printf("[DEBUG] Checking to see if %s is still available", *id)
-			resp, err := client.Get(ctx, *id)
+			log.Printf("[DEBUG] Checking to see if %s is still available", id)
+			resp, err := client.Get(ctx, id)
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
-					log.Printf("[DEBUG] %s was not found", *id)
+					log.Printf("[DEBUG] %s was not found", id)
 					return "NotFound", "NotFound", nil
 				}
 
 				return "", "error", err
 			}
 
-			log.Printf("[DEBUG] %s still exists", *id)
+			log.Printf("[DEBUG] %s still exists", id)
 			return "Available", "Available", nil
 		},
 		Delay:                     30 * time.Second,
 		PollInterval:              10 * time.Second,
 		ContinuousTargetOccurence: 10,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeout,
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
 	}
 
 	return nil
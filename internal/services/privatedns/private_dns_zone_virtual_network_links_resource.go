@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/virtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+type privateDnsZoneVirtualNetworkLink struct {
+	Name                string
+	VirtualNetworkId    string
+	RegistrationEnabled bool
+	ResolutionPolicy    string
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinks() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsZoneVirtualNetworkLinksCreate,
+		Read:   resourcePrivateDnsZoneVirtualNetworkLinksRead,
+		Update: resourcePrivateDnsZoneVirtualNetworkLinksUpdate,
+		Delete: resourcePrivateDnsZoneVirtualNetworkLinksDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PrivateDnsZoneVirtualNetworkLinksID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"private_dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: virtualnetworklinks.ValidatePrivateDnsZoneID,
+			},
+
+			"virtual_network_link": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"virtual_network_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: commonids.ValidateVirtualNetworkID,
+						},
+
+						"registration_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"resolution_policy": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(virtualnetworklinks.ResolutionPolicyDefault),
+							ValidateFunc: validation.StringInSlice(virtualnetworklinks.PossibleValuesForResolutionPolicy(), false),
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := virtualnetworklinks.ParsePrivateDnsZoneID(d.Get("private_dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewPrivateDnsZoneVirtualNetworkLinksID(*zoneId)
+
+	existing, err := client.ListComplete(ctx, *zoneId, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing Virtual Network Links for %s: %+v", *zoneId, err)
+	}
+	if len(existing.Items) > 0 {
+		return tf.ImportAsExistsError("azurerm_private_dns_zone_virtual_network_links", id.ID())
+	}
+
+	links, err := expandPrivateDnsZoneVirtualNetworkLinks(d.Get("virtual_network_link").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		linkId := virtualnetworklinks.NewVirtualNetworkLinkID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, link.Name)
+		options := virtualnetworklinks.CreateOrUpdateOperationOptions{
+			IfMatch:     pointer.To(""),
+			IfNoneMatch: pointer.To(""),
+		}
+		if err := client.CreateOrUpdateThenPoll(ctx, linkId, link.toModel(d.Get("tags").(map[string]interface{})), options); err != nil {
+			return fmt.Errorf("creating %s: %+v", linkId, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePrivateDnsZoneVirtualNetworkLinksRead(d, meta)
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	linksId, err := parse.PrivateDnsZoneVirtualNetworkLinksID(d.Id())
+	if err != nil {
+		return err
+	}
+	id := linksId.PrivateDnsZoneId
+
+	resp, err := client.ListComplete(ctx, id, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+	}
+
+	if len(resp.Items) == 0 {
+		log.Printf("[DEBUG] %s has no Virtual Network Links - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("private_dns_zone_id", id.ID())
+
+	links := make([]interface{}, 0)
+	var linkTags *map[string]string
+	for _, item := range resp.Items {
+		if item.Name == nil {
+			continue
+		}
+
+		virtualNetworkId := ""
+		registrationEnabled := false
+		resolutionPolicy := string(virtualnetworklinks.ResolutionPolicyDefault)
+		if props := item.Properties; props != nil {
+			if props.VirtualNetwork != nil && props.VirtualNetwork.Id != nil {
+				vnetId, err := commonids.ParseVirtualNetworkIDInsensitively(*props.VirtualNetwork.Id)
+				if err != nil {
+					return err
+				}
+				virtualNetworkId = vnetId.ID()
+			}
+			registrationEnabled = pointer.From(props.RegistrationEnabled)
+			if props.ResolutionPolicy != nil {
+				resolutionPolicy = string(*props.ResolutionPolicy)
+			}
+		}
+
+		links = append(links, map[string]interface{}{
+			"name":                 *item.Name,
+			"virtual_network_id":   virtualNetworkId,
+			"registration_enabled": registrationEnabled,
+			"resolution_policy":    resolutionPolicy,
+		})
+
+		// tags are applied uniformly to each Link, so the first Link is used as the source of truth
+		if linkTags == nil {
+			linkTags = item.Tags
+		}
+	}
+
+	if err := d.Set("virtual_network_link", links); err != nil {
+		return fmt.Errorf("setting `virtual_network_link`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, linkTags)
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	linksId, err := parse.PrivateDnsZoneVirtualNetworkLinksID(d.Id())
+	if err != nil {
+		return err
+	}
+	id := linksId.PrivateDnsZoneId
+
+	oldRaw, newRaw := d.GetChange("virtual_network_link")
+	oldLinks, err := expandPrivateDnsZoneVirtualNetworkLinks(oldRaw.(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	newLinks, err := expandPrivateDnsZoneVirtualNetworkLinks(newRaw.(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]privateDnsZoneVirtualNetworkLink)
+	for _, link := range oldLinks {
+		existing[strings.ToLower(link.Name)] = link
+	}
+
+	// the Virtual Network of a Link can't be changed, so Links which are removed or point to a different Virtual Network are deleted first
+	deleted := make([]virtualnetworklinks.VirtualNetworkLinkId, 0)
+	for key, link := range existing {
+		replacement, ok := findPrivateDnsZoneVirtualNetworkLink(newLinks, key)
+		if ok && strings.EqualFold(replacement.VirtualNetworkId, link.VirtualNetworkId) {
+			continue
+		}
+
+		linkId := virtualnetworklinks.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, link.Name)
+		if err := client.DeleteThenPoll(ctx, linkId, virtualnetworklinks.DeleteOperationOptions{IfMatch: pointer.To("")}); err != nil {
+			return fmt.Errorf("deleting %s: %+v", linkId, err)
+		}
+		deleted = append(deleted, linkId)
+		delete(existing, key)
+	}
+
+	timeout, _ := ctx.Deadline()
+	for _, linkId := range deleted {
+		if err := waitForPrivateDnsZoneVirtualNetworkLinkToBeDeleted(ctx, client, linkId, time.Until(timeout)); err != nil {
+			return err
+		}
+	}
+
+	for _, link := range newLinks {
+		if current, ok := existing[strings.ToLower(link.Name)]; ok && current == link && !d.HasChange("tags") {
+			continue
+		}
+
+		linkId := virtualnetworklinks.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, link.Name)
+		options := virtualnetworklinks.CreateOrUpdateOperationOptions{
+			IfMatch:     pointer.To(""),
+			IfNoneMatch: pointer.To(""),
+		}
+		if err := client.CreateOrUpdateThenPoll(ctx, linkId, link.toModel(d.Get("tags").(map[string]interface{})), options); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", linkId, err)
+		}
+	}
+
+	return resourcePrivateDnsZoneVirtualNetworkLinksRead(d, meta)
+}
+
+func resourcePrivateDnsZoneVirtualNetworkLinksDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	linksId, err := parse.PrivateDnsZoneVirtualNetworkLinksID(d.Id())
+	if err != nil {
+		return err
+	}
+	id := linksId.PrivateDnsZoneId
+
+	links, err := expandPrivateDnsZoneVirtualNetworkLinks(d.Get("virtual_network_link").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	deleted := make([]virtualnetworklinks.VirtualNetworkLinkId, 0)
+	for _, link := range links {
+		linkId := virtualnetworklinks.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, link.Name)
+		if err := client.DeleteThenPoll(ctx, linkId, virtualnetworklinks.DeleteOperationOptions{IfMatch: pointer.To("")}); err != nil {
+			return fmt.Errorf("deleting %s: %+v", linkId, err)
+		}
+		deleted = append(deleted, linkId)
+	}
+
+	timeout, _ := ctx.Deadline()
+	for _, linkId := range deleted {
+		if err := waitForPrivateDnsZoneVirtualNetworkLinkToBeDeleted(ctx, client, linkId, time.Until(timeout)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandPrivateDnsZoneVirtualNetworkLinks(input []interface{}) ([]privateDnsZoneVirtualNetworkLink, error) {
+	links := make([]privateDnsZoneVirtualNetworkLink, 0)
+	names := make(map[string]struct{})
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		link := privateDnsZoneVirtualNetworkLink{
+			Name:                v["name"].(string),
+			VirtualNetworkId:    v["virtual_network_id"].(string),
+			RegistrationEnabled: v["registration_enabled"].(bool),
+			ResolutionPolicy:    v["resolution_policy"].(string),
+		}
+
+		key := strings.ToLower(link.Name)
+		if _, ok := names[key]; ok {
+			return nil, fmt.Errorf("each `virtual_network_link` must have a unique `name`, but %q is used more than once", link.Name)
+		}
+		names[key] = struct{}{}
+
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+func findPrivateDnsZoneVirtualNetworkLink(input []privateDnsZoneVirtualNetworkLink, name string) (privateDnsZoneVirtualNetworkLink, bool) {
+	for _, link := range input {
+		if strings.EqualFold(link.Name, name) {
+			return link, true
+		}
+	}
+
+	return privateDnsZoneVirtualNetworkLink{}, false
+}
+
+func (l privateDnsZoneVirtualNetworkLink) toModel(input map[string]interface{}) virtualnetworklinks.VirtualNetworkLink {
+	return virtualnetworklinks.VirtualNetworkLink{
+		Location: pointer.To("global"),
+		Tags:     tags.Expand(input),
+		Properties: &virtualnetworklinks.VirtualNetworkLinkProperties{
+			VirtualNetwork: &virtualnetworklinks.SubResource{
+				Id: pointer.To(l.VirtualNetworkId),
+			},
+			RegistrationEnabled: pointer.To(l.RegistrationEnabled),
+			ResolutionPolicy:    pointer.To(virtualnetworklinks.ResolutionPolicy(l.ResolutionPolicy)),
+		},
+	}
+}
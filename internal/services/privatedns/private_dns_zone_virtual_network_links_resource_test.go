@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/virtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateDnsZoneVirtualNetworkLinksResource struct{}
+
+func TestAccPrivateDnsZoneVirtualNetworkLinks_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_links", "test")
+	r := PrivateDnsZoneVirtualNetworkLinksResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_link.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsZoneVirtualNetworkLinks_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_links", "test")
+	r := PrivateDnsZoneVirtualNetworkLinksResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsZoneVirtualNetworkLinks_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_links", "test")
+	r := PrivateDnsZoneVirtualNetworkLinksResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_link.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_link.#").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_link.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsZoneVirtualNetworkLinks_resolutionPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_links", "test")
+	r := PrivateDnsZoneVirtualNetworkLinksResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resolutionPolicy(data, "NxDomainRedirect"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resolutionPolicy(data, "Default"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t PrivateDnsZoneVirtualNetworkLinksResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateDnsZoneVirtualNetworkLinksID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.VirtualNetworkLinksClient.ListComplete(ctx, id.PrivateDnsZoneId, virtualnetworklinks.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+	}
+
+	return pointer.To(len(resp.Items) > 0), nil
+}
+
+func (r PrivateDnsZoneVirtualNetworkLinksResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_virtual_network_links" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+
+  virtual_network_link {
+    name               = "acctestlink1-%d"
+    virtual_network_id = azurerm_virtual_network.first.id
+  }
+}
+`, r.template(data, fmt.Sprintf("acctestzone%d.com", data.RandomInteger)), data.RandomInteger)
+}
+
+func (r PrivateDnsZoneVirtualNetworkLinksResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_virtual_network_links" "import" {
+  private_dns_zone_id = azurerm_private_dns_zone_virtual_network_links.test.private_dns_zone_id
+
+  virtual_network_link {
+    name               = "acctestlink1-%d"
+    virtual_network_id = azurerm_virtual_network.first.id
+  }
+}
+`, r.basic(data), data.RandomInteger)
+}
+
+func (r PrivateDnsZoneVirtualNetworkLinksResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_private_dns_zone_virtual_network_links" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+
+  virtual_network_link {
+    name                 = "acctestlink1-%[2]d"
+    virtual_network_id   = azurerm_virtual_network.first.id
+    registration_enabled = true
+  }
+
+  virtual_network_link {
+    name               = "acctestlink2-%[2]d"
+    virtual_network_id = azurerm_virtual_network.second.id
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data, fmt.Sprintf("acctestzone%d.com", data.RandomInteger)), data.RandomInteger)
+}
+
+func (r PrivateDnsZoneVirtualNetworkLinksResource) resolutionPolicy(data acceptance.TestData, policy string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_private_dns_zone_virtual_network_links" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+
+  virtual_network_link {
+    name               = "acctestlink1-%[2]d"
+    virtual_network_id = azurerm_virtual_network.first.id
+    resolution_policy  = "%[3]s"
+  }
+
+  virtual_network_link {
+    name               = "acctestlink2-%[2]d"
+    virtual_network_id = azurerm_virtual_network.second.id
+    resolution_policy  = "%[3]s"
+  }
+}
+`, r.template(data, "privatelink.blob.core.windows.net"), data.RandomInteger, policy)
+}
+
+func (PrivateDnsZoneVirtualNetworkLinksResource) template(data acceptance.TestData, zoneName string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "first" {
+  name                = "vnet1-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_virtual_network" "second" {
+  name                = "vnet2-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.1.0.0/16"]
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, zoneName)
+}
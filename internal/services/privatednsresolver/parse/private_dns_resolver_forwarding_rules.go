@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+)
+
+var _ resourceids.Id = PrivateDNSResolverForwardingRulesId{}
+
+// the `forwardingRules` segment isn't used here, since `{rulesetId}/forwardingRules/default` is the ID of a Forwarding
+// Rule named `default`
+const privateDNSResolverForwardingRulesSuffix = "/forwardingRuleSet/default"
+
+// PrivateDNSResolverForwardingRulesId is a synthetic ID for every Forwarding Rule within a Forwarding Ruleset, since the
+// ID of the Forwarding Ruleset is already used by azurerm_private_dns_resolver_dns_forwarding_ruleset.
+type PrivateDNSResolverForwardingRulesId struct {
+	DnsForwardingRulesetId forwardingrules.DnsForwardingRulesetId
+}
+
+func NewPrivateDNSResolverForwardingRulesID(rulesetId forwardingrules.DnsForwardingRulesetId) PrivateDNSResolverForwardingRulesId {
+	return PrivateDNSResolverForwardingRulesId{
+		DnsForwardingRulesetId: rulesetId,
+	}
+}
+
+func (id PrivateDNSResolverForwardingRulesId) ID() string {
+	return id.DnsForwardingRulesetId.ID() + privateDNSResolverForwardingRulesSuffix
+}
+
+func (id PrivateDNSResolverForwardingRulesId) String() string {
+	components := []string{
+		fmt.Sprintf("Dns Forwarding Ruleset Name %q", id.DnsForwardingRulesetId.DnsForwardingRulesetName),
+		fmt.Sprintf("Resource Group %q", id.DnsForwardingRulesetId.ResourceGroupName),
+	}
+	return fmt.Sprintf("Private DNS Resolver Forwarding Rules: (%s)", strings.Join(components, " / "))
+}
+
+// PrivateDNSResolverForwardingRulesID parses a PrivateDNSResolverForwardingRules ID into a PrivateDNSResolverForwardingRulesId struct
+func PrivateDNSResolverForwardingRulesID(input string) (*PrivateDNSResolverForwardingRulesId, error) {
+	rulesetIdRaw, ok := strings.CutSuffix(input, privateDNSResolverForwardingRulesSuffix)
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {dnsForwardingRulesetId}%s but got %q", privateDNSResolverForwardingRulesSuffix, input)
+	}
+
+	rulesetId, err := forwardingrules.ParseDnsForwardingRulesetID(rulesetIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDNSResolverForwardingRulesId{
+		DnsForwardingRulesetId: *rulesetId,
+	}, nil
+}
+
+func ValidatePrivateDNSResolverForwardingRulesID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := PrivateDNSResolverForwardingRulesID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
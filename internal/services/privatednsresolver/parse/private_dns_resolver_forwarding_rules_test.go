@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+)
+
+func TestPrivateDNSResolverForwardingRulesID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateDNSResolverForwardingRulesId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// forwarding ruleset id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsForwardingRulesets/ruleset1",
+			Error: true,
+		},
+		{
+			// forwarding rule id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsForwardingRulesets/ruleset1/forwardingRules/default",
+			Error: true,
+		},
+		{
+			// invalid forwarding ruleset id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/forwardingRuleSet/default",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsForwardingRulesets/ruleset1/forwardingRuleSet/default",
+			Expected: &PrivateDNSResolverForwardingRulesId{
+				DnsForwardingRulesetId: forwardingrules.NewDnsForwardingRulesetID("12345678-1234-9876-4563-123456789012", "resGroup1", "ruleset1"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PrivateDNSResolverForwardingRulesID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.DnsForwardingRulesetId != v.Expected.DnsForwardingRulesetId {
+			t.Fatalf("Expected %+v but got %+v", v.Expected.DnsForwardingRulesetId, actual.DnsForwardingRulesetId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
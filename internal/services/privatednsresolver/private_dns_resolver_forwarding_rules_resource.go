@@ -0,0 +1,428 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatednsresolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateDNSResolverForwardingRulesModel struct {
+	DnsForwardingRulesetId string                                       `tfschema:"dns_forwarding_ruleset_id"`
+	Rule                   []PrivateDNSResolverForwardingRulesRuleModel `tfschema:"rule"`
+}
+
+type PrivateDNSResolverForwardingRulesRuleModel struct {
+	Name             string                 `tfschema:"name"`
+	DomainName       string                 `tfschema:"domain_name"`
+	Enabled          bool                   `tfschema:"enabled"`
+	Metadata         map[string]string      `tfschema:"metadata"`
+	TargetDnsServers []TargetDnsServerModel `tfschema:"target_dns_servers"`
+}
+
+type PrivateDNSResolverForwardingRulesResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = PrivateDNSResolverForwardingRulesResource{}
+	_ sdk.ResourceWithCustomizeDiff = PrivateDNSResolverForwardingRulesResource{}
+)
+
+func (r PrivateDNSResolverForwardingRulesResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rules"
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) ModelObject() interface{} {
+	return &PrivateDNSResolverForwardingRulesModel{}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidatePrivateDNSResolverForwardingRulesID
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: forwardingrules.ValidateDnsForwardingRulesetID,
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"domain_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_dns_servers": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"ip_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsIPAddress,
+								},
+
+								"port": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      53,
+									ValidateFunc: validation.IsPortNumber,
+								},
+							},
+						},
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"metadata": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config PrivateDNSResolverForwardingRulesModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// values can be unknown during the plan when the rules are decoded from a file which is yet to be created
+			names := make(map[string]int)
+			domainNames := make(map[string]int)
+			for i, rule := range config.Rule {
+				if rule.Name != "" {
+					if j, ok := names[strings.ToLower(rule.Name)]; ok {
+						return fmt.Errorf("`rule.%d` and `rule.%d` have the same `name` %q", j, i, rule.Name)
+					}
+					names[strings.ToLower(rule.Name)] = i
+				}
+
+				if rule.DomainName != "" {
+					if !strings.HasSuffix(rule.DomainName, ".") {
+						return fmt.Errorf("the `domain_name` %q for `rule.%d` must end with a `.`", rule.DomainName, i)
+					}
+
+					// rules are matched on the longest domain suffix rather than their order, so each domain can only be forwarded by a single rule
+					if j, ok := domainNames[strings.ToLower(rule.DomainName)]; ok {
+						return fmt.Errorf("`rule.%d` and `rule.%d` forward the same `domain_name` %q", j, i, rule.DomainName)
+					}
+					domainNames[strings.ToLower(rule.DomainName)] = i
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			var model PrivateDNSResolverForwardingRulesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			rulesetId, err := forwardingrules.ParseDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+			id := parse.NewPrivateDNSResolverForwardingRulesID(*rulesetId)
+
+			// this resource manages every rule within the ruleset, so it can only be created for an empty ruleset
+			existing, err := client.ListComplete(ctx, *rulesetId, forwardingrules.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing the Forwarding Rules for %s: %+v", *rulesetId, err)
+			}
+
+			if len(existing.Items) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			for _, rule := range model.Rule {
+				if err := createOrUpdateForwardingRule(ctx, client, *rulesetId, rule); err != nil {
+					return err
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			rulesId, err := parse.PrivateDNSResolverForwardingRulesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := &rulesId.DnsForwardingRulesetId
+
+			resp, err := client.ListComplete(ctx, *id, forwardingrules.DefaultListOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.LatestHttpResponse) {
+					return metadata.MarkAsGone(rulesId)
+				}
+
+				return fmt.Errorf("listing the Forwarding Rules for %s: %+v", *id, err)
+			}
+
+			var state PrivateDNSResolverForwardingRulesModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			rules := make(map[string]PrivateDNSResolverForwardingRulesRuleModel)
+			for _, item := range resp.Items {
+				if item.Name == nil {
+					continue
+				}
+
+				rules[strings.ToLower(*item.Name)] = flattenForwardingRule(*item.Name, item.Properties)
+			}
+
+			// the API doesn't return the rules in a stable order, so the rules are kept in the order of the configuration
+			// with any rules added outside of Terraform appended afterwards (sorted by name)
+			flattened := make([]PrivateDNSResolverForwardingRulesRuleModel, 0, len(rules))
+			for _, rule := range state.Rule {
+				if v, ok := rules[strings.ToLower(rule.Name)]; ok {
+					flattened = append(flattened, v)
+					delete(rules, strings.ToLower(rule.Name))
+				}
+			}
+
+			remaining := make([]string, 0, len(rules))
+			for name := range rules {
+				remaining = append(remaining, name)
+			}
+			sort.Strings(remaining)
+			for _, name := range remaining {
+				flattened = append(flattened, rules[name])
+			}
+
+			state.DnsForwardingRulesetId = id.ID()
+			state.Rule = flattened
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			rulesId, err := parse.PrivateDNSResolverForwardingRulesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := &rulesId.DnsForwardingRulesetId
+
+			var model PrivateDNSResolverForwardingRulesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("rule") {
+				oldRaw, _ := metadata.ResourceData.GetChange("rule")
+				existing := make(map[string]PrivateDNSResolverForwardingRulesRuleModel)
+				for _, rule := range expandForwardingRulesRuleModels(oldRaw.([]interface{})) {
+					existing[strings.ToLower(rule.Name)] = rule
+				}
+
+				desired := make(map[string]PrivateDNSResolverForwardingRulesRuleModel)
+				for _, rule := range model.Rule {
+					desired[strings.ToLower(rule.Name)] = rule
+				}
+
+				// rules are removed first so that their domains can be taken over by the rules being added
+				for name, rule := range existing {
+					if v, ok := desired[name]; ok && strings.EqualFold(v.DomainName, rule.DomainName) {
+						continue
+					}
+
+					ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName, rule.Name)
+					if _, err := client.Delete(ctx, ruleId, forwardingrules.DefaultDeleteOperationOptions()); err != nil {
+						return fmt.Errorf("deleting %s: %+v", ruleId, err)
+					}
+					delete(existing, name)
+				}
+
+				for _, rule := range model.Rule {
+					if v, ok := existing[strings.ToLower(rule.Name)]; ok && reflect.DeepEqual(v, rule) {
+						continue
+					}
+
+					if err := createOrUpdateForwardingRule(ctx, client, *id, rule); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDnsResolver.ForwardingRulesClient
+
+			rulesId, err := parse.PrivateDNSResolverForwardingRulesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := &rulesId.DnsForwardingRulesetId
+
+			var model PrivateDNSResolverForwardingRulesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, rule := range model.Rule {
+				ruleId := forwardingrules.NewForwardingRuleID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName, rule.Name)
+				if resp, err := client.Delete(ctx, ruleId, forwardingrules.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", ruleId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func createOrUpdateForwardingRule(ctx context.Context, client *forwardingrules.ForwardingRulesClient, rulesetId forwardingrules.DnsForwardingRulesetId, rule PrivateDNSResolverForwardingRulesRuleModel) error {
+	id := forwardingrules.NewForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, rule.Name)
+
+	forwardingRuleState := forwardingrules.ForwardingRuleStateEnabled
+	if !rule.Enabled {
+		forwardingRuleState = forwardingrules.ForwardingRuleStateDisabled
+	}
+
+	payload := forwardingrules.ForwardingRule{
+		Properties: forwardingrules.ForwardingRuleProperties{
+			DomainName:          rule.DomainName,
+			ForwardingRuleState: &forwardingRuleState,
+			Metadata:            &rule.Metadata,
+			TargetDnsServers:    *expandTargetDnsServerModel(rule.TargetDnsServers),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating/Updating %s", id)
+	if _, err := client.CreateOrUpdate(ctx, id, payload, forwardingrules.CreateOrUpdateOperationOptions{}); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func flattenForwardingRule(name string, input forwardingrules.ForwardingRuleProperties) PrivateDNSResolverForwardingRulesRuleModel {
+	output := PrivateDNSResolverForwardingRulesRuleModel{
+		Name:             name,
+		DomainName:       input.DomainName,
+		Enabled:          input.ForwardingRuleState != nil && *input.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled,
+		TargetDnsServers: flattenTargetDnsServerModel(&input.TargetDnsServers),
+	}
+
+	if input.Metadata != nil {
+		output.Metadata = *input.Metadata
+	}
+
+	return output
+}
+
+func expandForwardingRulesRuleModels(input []interface{}) []PrivateDNSResolverForwardingRulesRuleModel {
+	output := make([]PrivateDNSResolverForwardingRulesRuleModel, 0, len(input))
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := PrivateDNSResolverForwardingRulesRuleModel{
+			Name:             v["name"].(string),
+			DomainName:       v["domain_name"].(string),
+			Enabled:          v["enabled"].(bool),
+			Metadata:         make(map[string]string),
+			TargetDnsServers: make([]TargetDnsServerModel, 0),
+		}
+
+		for k, m := range v["metadata"].(map[string]interface{}) {
+			rule.Metadata[k] = m.(string)
+		}
+
+		for _, s := range v["target_dns_servers"].([]interface{}) {
+			server, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule.TargetDnsServers = append(rule.TargetDnsServers, TargetDnsServerModel{
+				IPAddress: server["ip_address"].(string),
+				Port:      int64(server["port"].(int)),
+			})
+		}
+
+		output = append(output, rule)
+	}
+
+	return output
+}
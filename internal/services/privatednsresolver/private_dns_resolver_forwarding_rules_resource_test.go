@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatednsresolver_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatednsresolver/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateDNSResolverForwardingRulesResource struct{}
+
+func TestAccPrivateDNSResolverForwardingRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rules", "test")
+	r := PrivateDNSResolverForwardingRulesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDNSResolverForwardingRules_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rules", "test")
+	r := PrivateDNSResolverForwardingRulesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDNSResolverForwardingRules_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rules", "test")
+	r := PrivateDNSResolverForwardingRulesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.decoded(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDNSResolverForwardingRules_duplicateDomainName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_forwarding_rules", "test")
+	r := PrivateDNSResolverForwardingRulesResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateDomainName(data),
+			ExpectError: regexp.MustCompile("forward the same `domain_name`"),
+		},
+	})
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateDNSResolverForwardingRulesID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDnsResolver.ForwardingRulesClient.ListComplete(ctx, id.DnsForwardingRulesetId, forwardingrules.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the Forwarding Rules for %s: %+v", id, err)
+	}
+	return pointer.To(len(resp.Items) > 0), nil
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-rg-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "outbounddns"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.64/28"]
+
+  delegation {
+    name = "Microsoft.Network.dnsResolvers"
+    service_delegation {
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action"]
+      name    = "Microsoft.Network/dnsResolvers"
+    }
+  }
+}
+
+resource "azurerm_private_dns_resolver" "test" {
+  name                = "acctest-dr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  virtual_network_id  = azurerm_virtual_network.test.id
+}
+
+resource "azurerm_private_dns_resolver_outbound_endpoint" "test" {
+  name                    = "acctest-droe-%[2]d"
+  private_dns_resolver_id = azurerm_private_dns_resolver.test.id
+  location                = azurerm_private_dns_resolver.test.location
+  subnet_id               = azurerm_subnet.test.id
+}
+
+resource "azurerm_private_dns_resolver_dns_forwarding_ruleset" "test" {
+  name                                       = "acctest-drdfr-%[2]d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  location                                   = azurerm_resource_group.test.location
+  private_dns_resolver_outbound_endpoint_ids = [azurerm_private_dns_resolver_outbound_endpoint.test.id]
+}
+`, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rules" "test" {
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+
+  rule {
+    name        = "onprem"
+    domain_name = "onprem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+    }
+  }
+
+  rule {
+    name        = "partner"
+    domain_name = "partner.local."
+    enabled     = false
+
+    target_dns_servers {
+      ip_address = "10.20.0.1"
+      port       = 53
+    }
+
+    target_dns_servers {
+      ip_address = "10.20.0.2"
+      port       = 5353
+    }
+  }
+}
+`, template)
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rules" "import" {
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_forwarding_rules.test.dns_forwarding_ruleset_id
+
+  rule {
+    name        = "onprem"
+    domain_name = "onprem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+    }
+  }
+}
+`, config)
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) decoded(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+locals {
+  rules = csvdecode(<<CSV
+name,domain_name,ip_address
+onprem,onprem.local.,10.10.0.2
+partner,partner.local.,10.20.0.1
+branch,branch.onprem.local.,10.30.0.1
+CSV
+  )
+}
+
+resource "azurerm_private_dns_resolver_forwarding_rules" "test" {
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+
+  dynamic "rule" {
+    for_each = local.rules
+    content {
+      name        = rule.value.name
+      domain_name = rule.value.domain_name
+
+      target_dns_servers {
+        ip_address = rule.value.ip_address
+      }
+
+      metadata = {
+        source = "csv"
+      }
+    }
+  }
+}
+`, template)
+}
+
+func (r PrivateDNSResolverForwardingRulesResource) duplicateDomainName(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_forwarding_rules" "test" {
+  dns_forwarding_ruleset_id = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.id
+
+  rule {
+    name        = "first"
+    domain_name = "onprem.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.1"
+    }
+  }
+
+  rule {
+    name        = "second"
+    domain_name = "ONPREM.local."
+
+    target_dns_servers {
+      ip_address = "10.10.0.2"
+    }
+  }
+}
+`, template)
+}
@@ -0,0 +1,280 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package purview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = KafkaConfigurationResource{}
+	_ sdk.ResourceWithUpdate = KafkaConfigurationResource{}
+)
+
+type KafkaConfigurationResource struct{}
+
+type KafkaConfigurationResourceModel struct {
+	Name                   string `tfschema:"name"`
+	PurviewAccountId       string `tfschema:"purview_account_id"`
+	EventHubId             string `tfschema:"eventhub_id"`
+	EventHubType           string `tfschema:"event_hub_type"`
+	ConsumerGroupName      string `tfschema:"consumer_group_name"`
+	EventHubPartitionId    string `tfschema:"event_hub_partition_id"`
+	EventStreamingEnabled  bool   `tfschema:"event_streaming_enabled"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+}
+
+func (r KafkaConfigurationResource) ModelObject() interface{} {
+	return &KafkaConfigurationResourceModel{}
+}
+
+func (r KafkaConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return kafkaconfiguration.ValidateKafkaConfigurationID
+}
+
+func (r KafkaConfigurationResource) ResourceType() string {
+	return "azurerm_purview_kafka_configuration"
+}
+
+func (r KafkaConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"purview_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: kafkaconfiguration.ValidateAccountID,
+		},
+
+		"eventhub_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: eventhubs.ValidateEventhubID,
+		},
+
+		"event_hub_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(kafkaconfiguration.PossibleValuesForEventHubType(), false),
+		},
+
+		"consumer_group_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"event_hub_partition_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"event_streaming_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+	}
+}
+
+func (r KafkaConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KafkaConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Purview.KafkaConfigurationsClient
+
+			var model KafkaConfigurationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := kafkaconfiguration.ParseAccountID(model.PurviewAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := kafkaconfiguration.NewKafkaConfigurationID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandKafkaConfiguration(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KafkaConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Purview.KafkaConfigurationsClient
+
+			id, err := kafkaconfiguration.ParseKafkaConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KafkaConfigurationResourceModel{
+				Name:             id.KafkaConfigurationName,
+				PurviewAccountId: kafkaconfiguration.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ConsumerGroupName = pointer.From(props.ConsumerGroup)
+					state.EventHubPartitionId = pointer.From(props.EventHubPartitionId)
+					state.EventHubType = pointer.FromEnum(props.EventHubType)
+					state.EventStreamingEnabled = pointer.From(props.EventStreamingState) == kafkaconfiguration.EventStreamingStateEnabled
+
+					eventHubId := ""
+					if v := pointer.From(props.EventHubResourceId); v != "" {
+						parsed, err := eventhubs.ParseEventhubIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						eventHubId = parsed.ID()
+					}
+					state.EventHubId = eventHubId
+
+					if creds := props.Credentials; creds != nil && pointer.From(creds.Type) == kafkaconfiguration.CredentialsTypeUserAssigned {
+						state.UserAssignedIdentityId = pointer.From(creds.IdentityId)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KafkaConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Purview.KafkaConfigurationsClient
+
+			id, err := kafkaconfiguration.ParseKafkaConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KafkaConfigurationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandKafkaConfiguration(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KafkaConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Purview.KafkaConfigurationsClient
+
+			id, err := kafkaconfiguration.ParseKafkaConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKafkaConfiguration(input KafkaConfigurationResourceModel) kafkaconfiguration.KafkaConfiguration {
+	eventStreamingState := kafkaconfiguration.EventStreamingStateDisabled
+	if input.EventStreamingEnabled {
+		eventStreamingState = kafkaconfiguration.EventStreamingStateEnabled
+	}
+
+	credentials := &kafkaconfiguration.Credentials{
+		Type: pointer.To(kafkaconfiguration.CredentialsTypeSystemAssigned),
+	}
+	if input.UserAssignedIdentityId != "" {
+		credentials = &kafkaconfiguration.Credentials{
+			IdentityId: pointer.To(input.UserAssignedIdentityId),
+			Type:       pointer.To(kafkaconfiguration.CredentialsTypeUserAssigned),
+		}
+	}
+
+	output := kafkaconfiguration.KafkaConfiguration{
+		Properties: &kafkaconfiguration.KafkaConfigurationProperties{
+			Credentials:         credentials,
+			EventHubResourceId:  pointer.To(input.EventHubId),
+			EventHubType:        pointer.To(kafkaconfiguration.EventHubType(input.EventHubType)),
+			EventStreamingState: pointer.To(eventStreamingState),
+			EventStreamingType:  pointer.To(kafkaconfiguration.EventStreamingTypeAzure),
+		},
+	}
+
+	if input.ConsumerGroupName != "" {
+		output.Properties.ConsumerGroup = pointer.To(input.ConsumerGroupName)
+	}
+
+	if input.EventHubPartitionId != "" {
+		output.Properties.EventHubPartitionId = pointer.To(input.EventHubPartitionId)
+	}
+
+	return output
+}
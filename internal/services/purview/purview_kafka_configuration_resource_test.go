@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package purview_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PurviewKafkaConfigurationResource struct{}
+
+func TestAccPurviewKafkaConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_kafka_configuration", "test")
+	r := PurviewKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewKafkaConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_kafka_configuration", "test")
+	r := PurviewKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPurviewKafkaConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_kafka_configuration", "test")
+	r := PurviewKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PurviewKafkaConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Purview.KafkaConfigurationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r PurviewKafkaConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_kafka_configuration" "test" {
+  name               = "acctest-kc-%d"
+  purview_account_id = azurerm_purview_account.test.id
+  eventhub_id        = azurerm_eventhub.test.id
+  event_hub_type     = "Notification"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewKafkaConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_kafka_configuration" "import" {
+  name               = azurerm_purview_kafka_configuration.test.name
+  purview_account_id = azurerm_purview_kafka_configuration.test.purview_account_id
+  eventhub_id        = azurerm_purview_kafka_configuration.test.eventhub_id
+  event_hub_type     = azurerm_purview_kafka_configuration.test.event_hub_type
+}
+`, r.basic(data))
+}
+
+func (r PurviewKafkaConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctest-cg-%[2]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_purview_kafka_configuration" "test" {
+  name                    = "acctest-kc-%[2]d"
+  purview_account_id      = azurerm_purview_account.test.id
+  eventhub_id             = azurerm_eventhub.test.id
+  event_hub_type          = "Hook"
+  consumer_group_name     = azurerm_eventhub_consumer_group.test.name
+  event_hub_partition_id  = "0"
+  event_streaming_enabled = false
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewKafkaConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-purview-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_purview_account" "test" {
+  name                = "acctestpurview%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctest-ehn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name              = "acctest-eh-%[1]d"
+  namespace_id      = azurerm_eventhub_namespace.test.id
+  partition_count   = 2
+  message_retention = 1
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub_namespace.test.id
+  role_definition_name = "Azure Event Hubs Data Owner"
+  principal_id         = azurerm_purview_account.test.identity[0].principal_id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
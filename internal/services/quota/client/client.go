@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2025-07-15/quotainformation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2025-07-15/quotarequests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	QuotaInformationClient *quotainformation.QuotaInformationClient
+	QuotaRequestsClient    *quotarequests.QuotaRequestsClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	quotaInformationClient, err := quotainformation.NewQuotaInformationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building QuotaInformation client: %+v", err)
+	}
+	o.Configure(quotaInformationClient.Client, o.Authorizers.ResourceManager)
+
+	quotaRequestsClient, err := quotarequests.NewQuotaRequestsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building QuotaRequests client: %+v", err)
+	}
+	o.Configure(quotaRequestsClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		QuotaInformationClient: quotaInformationClient,
+		QuotaRequestsClient:    quotaRequestsClient,
+	}, nil
+}
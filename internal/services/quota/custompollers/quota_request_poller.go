@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2025-07-15/quotarequests"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var (
+	_ pollers.PollerType = &QuotaRequestPoller{}
+
+	pollingSuccess = pollers.PollResult{
+		Status: pollers.PollingStatusSucceeded,
+	}
+
+	pollingFailed = pollers.PollResult{
+		Status: pollers.PollingStatusFailed,
+	}
+
+	pollingInProgress = pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type QuotaRequestPoller struct {
+	client *quotarequests.QuotaRequestsClient
+	id     quotarequests.ScopedQuotaRequestId
+}
+
+func NewQuotaRequestPoller(client *quotarequests.QuotaRequestsClient, id quotarequests.ScopedQuotaRequestId) *QuotaRequestPoller {
+	return &QuotaRequestPoller{
+		client: client,
+		id:     id,
+	}
+}
+
+func (p QuotaRequestPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.TatusGet(ctx, p.id)
+	if err != nil {
+		return &pollingFailed, fmt.Errorf("retrieving %s: %w", p.id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return &pollingInProgress, nil
+	}
+
+	props := resp.Model.Properties
+	switch pointer.From(props.ProvisioningState) {
+	case quotarequests.QuotaRequestStateSucceeded:
+		return &pollingSuccess, nil
+	case quotarequests.QuotaRequestStateFailed, quotarequests.QuotaRequestStateInvalid:
+		return &pollingFailed, pollers.PollingFailedError{
+			Message: fmt.Sprintf("%s finished in state %q: %s", p.id, pointer.From(props.ProvisioningState), quotaRequestFailureDetails(*props)),
+		}
+	}
+
+	return &pollingInProgress, nil
+}
+
+// quotaRequestFailureDetails combines the messages of the request and its sub requests, since
+// the reason a quota increase was rejected is usually only returned on the sub request
+func quotaRequestFailureDetails(input quotarequests.QuotaRequestProperties) string {
+	details := make([]string, 0)
+	if input.Error != nil {
+		details = append(details, fmt.Sprintf("%s: %s", pointer.From(input.Error.Code), pointer.From(input.Error.Message)))
+	}
+	if input.Message != nil {
+		details = append(details, *input.Message)
+	}
+	for _, subRequest := range pointer.From(input.Value) {
+		if subRequest.Message != nil {
+			details = append(details, *subRequest.Message)
+		}
+	}
+
+	if len(details) == 0 {
+		return "no details were returned"
+	}
+
+	return strings.Join(details, "; ")
+}
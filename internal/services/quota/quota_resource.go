@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2025-07-15/quotainformation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2025-07-15/quotarequests"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/quota/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = QuotaResource{}
+
+type QuotaResource struct{}
+
+type QuotaResourceModel struct {
+	Name             string `tfschema:"name"`
+	ResourceProvider string `tfschema:"resource_provider"`
+	Location         string `tfschema:"location"`
+	Limit            int64  `tfschema:"limit"`
+	ResourceType     string `tfschema:"resource_type"`
+	DisplayName      string `tfschema:"display_name"`
+	Unit             string `tfschema:"unit"`
+}
+
+func (r QuotaResource) ResourceType() string {
+	return "azurerm_quota"
+}
+
+func (r QuotaResource) ModelObject() interface{} {
+	return &QuotaResourceModel{}
+}
+
+func (r QuotaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return quotainformation.ValidateScopedQuotaID
+}
+
+func (r QuotaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_provider": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Za-z]+\.[A-Za-z]+$`),
+				"`resource_provider` must be the namespace of a Resource Provider, for example `Microsoft.Compute`",
+			),
+		},
+
+		"location": commonschema.Location(),
+
+		"limit": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"resource_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r QuotaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"unit": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r QuotaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// quota increases can take a while to be approved
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.QuotaInformationClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config QuotaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scope := fmt.Sprintf("/subscriptions/%s/providers/%s/locations/%s", subscriptionId, config.ResourceProvider, location.Normalize(config.Location))
+			id := quotainformation.NewScopedQuotaID(scope, config.Name)
+
+			// every quota always exists with its default limit, so requesting a limit is what "creates" this resource
+			existing, err := client.QuotaGet(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found - check that `name` is a quota which is available for this Resource Provider and Location", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := requestQuotaLimit(ctx, metadata, id, config); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r QuotaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Quota.QuotaInformationClient
+
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resourceProvider, locationName, err := parseQuotaScope(id.Scope)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.QuotaGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := QuotaResourceModel{
+				Name:             id.QuotaName,
+				ResourceProvider: resourceProvider,
+				Location:         location.Normalize(locationName),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if limit, ok := props.Limit.(quotainformation.LimitObject); ok {
+						state.Limit = limit.Value
+					}
+					if name := props.Name; name != nil {
+						state.DisplayName = pointer.From(name.LocalizedValue)
+					}
+					state.ResourceType = pointer.From(props.ResourceType)
+					state.Unit = pointer.From(props.Unit)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r QuotaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := quotainformation.ParseScopedQuotaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config QuotaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("limit", "resource_type") {
+				if err := requestQuotaLimit(ctx, metadata, *id, config); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r QuotaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			log.Printf(`[INFO] Quotas cannot be deleted, the limit which was requested will remain in place. To lower the limit, update the "limit" before removing the resource`)
+			return nil
+		},
+	}
+}
+
+// requestQuotaLimit submits a quota request and waits for it to be processed. Requests are polled via the
+// Quota Requests API rather than the generic poller so that the reason for a rejected request can be surfaced.
+func requestQuotaLimit(ctx context.Context, metadata sdk.ResourceMetaData, id quotainformation.ScopedQuotaId, config QuotaResourceModel) error {
+	client := metadata.Client.Quota.QuotaInformationClient
+	requestsClient := metadata.Client.Quota.QuotaRequestsClient
+
+	payload := quotainformation.CurrentQuotaLimitBase{
+		Properties: &quotainformation.QuotaProperties{
+			Limit: quotainformation.LimitObject{
+				Value: config.Limit,
+			},
+			Name: &quotainformation.ResourceName{
+				Value: pointer.To(id.QuotaName),
+			},
+		},
+	}
+
+	if config.ResourceType != "" {
+		payload.Properties.ResourceType = pointer.To(config.ResourceType)
+	}
+
+	resp, err := client.QuotaCreateOrUpdate(ctx, id, payload)
+	if err != nil {
+		return fmt.Errorf("requesting a limit of %d for %s: %+v", config.Limit, id, err)
+	}
+
+	if requestId := quotaRequestIdFromResponse(resp.HttpResponse); requestId != nil {
+		poller := pollers.NewPoller(custompollers.NewQuotaRequestPoller(requestsClient, *requestId), 30*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			return fmt.Errorf("waiting for the limit of %d to be granted for %s: %+v", config.Limit, id, err)
+		}
+		return nil
+	}
+
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for the limit of %d to be granted for %s: %+v", config.Limit, id, err)
+	}
+
+	return nil
+}
+
+// quotaRequestIdFromResponse returns the ID of the Quota Request which is returned in the `Location` header when a
+// request is accepted for asynchronous processing
+func quotaRequestIdFromResponse(resp *http.Response) *quotarequests.ScopedQuotaRequestId {
+	if resp == nil || resp.StatusCode != http.StatusAccepted {
+		return nil
+	}
+
+	u, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || u.Path == "" {
+		return nil
+	}
+
+	id, err := quotarequests.ParseScopedQuotaRequestIDInsensitively(u.Path)
+	if err != nil {
+		return nil
+	}
+
+	return id
+}
+
+// parseQuotaScope returns the Resource Provider and Location from a quota scope in the format
+// `/subscriptions/{subscriptionId}/providers/{resourceProvider}/locations/{location}`
+func parseQuotaScope(input string) (string, string, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 6 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "providers") || !strings.EqualFold(segments[4], "locations") {
+		return "", "", fmt.Errorf("expected the quota scope %q to be in the format `/subscriptions/{subscriptionId}/providers/{resourceProvider}/locations/{location}`", input)
+	}
+
+	return segments[3], segments[5], nil
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package quota_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2025-07-15/quotainformation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type QuotaResource struct{}
+
+// quotas are shared across the subscription, so these tests run sequentially and the limits are never lowered
+// below the defaults - quotas can't be deleted, so the destroy check is skipped
+
+func TestAccQuota_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_quota", "test")
+	r := QuotaResource{}
+
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1010),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("unit").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccQuota_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_quota", "test")
+	r := QuotaResource{}
+
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1010),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 1020),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("limit").HasValue("1020"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r QuotaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := quotainformation.ParseScopedQuotaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Quota.QuotaInformationClient.QuotaGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r QuotaResource) basic(data acceptance.TestData, limit int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_quota" "test" {
+  name              = "PublicIPAddresses"
+  resource_provider = "Microsoft.Network"
+  location          = "%s"
+  limit             = %d
+}
+`, data.Locations.Primary, limit)
+}
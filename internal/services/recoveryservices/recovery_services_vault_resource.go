@@ -142,6 +142,20 @@ func resourceRecoveryServicesVault() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			// cross subscription restore is enabled by the service unless it's explicitly disabled, so this is Computed
+			"cross_subscription_restore_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"soft_delete_retention_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(14, 180),
+			},
+
 			"monitoring": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -284,6 +298,17 @@ func resourceRecoveryServicesVaultCreate(d *pluginsdk.ResourceData, meta interfa
 		vault.Properties.SecuritySettings = expandRecoveryServicesVaultSecuritySettings(immutability)
 	}
 
+	if v, ok := d.GetOk("soft_delete_retention_days"); ok {
+		if vault.Properties.SecuritySettings == nil {
+			vault.Properties.SecuritySettings = &vaults.SecuritySettings{}
+		}
+		vault.Properties.SecuritySettings.SoftDeleteSettings = expandRecoveryServicesVaultSoftDeleteSettings(v.(int))
+	}
+
+	if !d.GetRawConfig().AsValueMap()["cross_subscription_restore_enabled"].IsNull() {
+		vault.Properties.RestoreSettings = expandRecoveryServicesVaultRestoreSettings(d.Get("cross_subscription_restore_enabled").(bool))
+	}
+
 	// Async Operaation of creation with `UserAssigned` identity is returned with 404
 	// Tracked on https://github.com/Azure/azure-rest-api-specs/issues/27869
 	// `SystemAssigned, UserAssigned` Identity require an additional update to work
@@ -522,6 +547,17 @@ func resourceRecoveryServicesVaultUpdate(d *pluginsdk.ResourceData, meta interfa
 		vault.Properties.SecuritySettings = expandRecoveryServicesVaultSecuritySettings(immutability)
 	}
 
+	if d.HasChange("soft_delete_retention_days") {
+		if vault.Properties.SecuritySettings == nil {
+			vault.Properties.SecuritySettings = &vaults.SecuritySettings{}
+		}
+		vault.Properties.SecuritySettings.SoftDeleteSettings = expandRecoveryServicesVaultSoftDeleteSettings(d.Get("soft_delete_retention_days").(int))
+	}
+
+	if d.HasChange("cross_subscription_restore_enabled") {
+		vault.Properties.RestoreSettings = expandRecoveryServicesVaultRestoreSettings(d.Get("cross_subscription_restore_enabled").(bool))
+	}
+
 	crossRegionRestoreEnabled := vaults.CrossRegionRestoreDisabled
 	if crossRegionRestore {
 		crossRegionRestoreEnabled = vaults.CrossRegionRestoreEnabled
@@ -646,6 +682,18 @@ func resourceRecoveryServicesVaultRead(d *pluginsdk.ResourceData, meta interface
 			}
 			d.Set("immutability", string(immutability))
 
+			softDeleteRetentionDays := 0
+			if prop.SecuritySettings != nil && prop.SecuritySettings.SoftDeleteSettings != nil {
+				softDeleteRetentionDays = int(pointer.From(prop.SecuritySettings.SoftDeleteSettings.SoftDeleteRetentionPeriodInDays))
+			}
+			d.Set("soft_delete_retention_days", softDeleteRetentionDays)
+
+			crossSubscriptionRestoreEnabled := true
+			if prop.RestoreSettings != nil && prop.RestoreSettings.CrossSubscriptionRestoreSettings != nil && prop.RestoreSettings.CrossSubscriptionRestoreSettings.CrossSubscriptionRestoreState != nil {
+				crossSubscriptionRestoreEnabled = *prop.RestoreSettings.CrossSubscriptionRestoreSettings.CrossSubscriptionRestoreState == vaults.CrossSubscriptionRestoreStateEnabled
+			}
+			d.Set("cross_subscription_restore_enabled", crossSubscriptionRestoreEnabled)
+
 			d.Set("public_network_access_enabled", flattenRecoveryServicesVaultPublicNetworkAccess(model.Properties.PublicNetworkAccess))
 
 			d.Set("monitoring", flattenRecoveryServicesVaultMonitorSettings(prop.MonitoringSettings))
@@ -858,6 +906,27 @@ func expandRecoveryServicesVaultSecuritySettings(input interface{}) *vaults.Secu
 	}
 }
 
+func expandRecoveryServicesVaultSoftDeleteSettings(input int) *vaults.SoftDeleteSettings {
+	if input == 0 {
+		return nil
+	}
+	return &vaults.SoftDeleteSettings{
+		SoftDeleteRetentionPeriodInDays: pointer.To(int64(input)),
+	}
+}
+
+func expandRecoveryServicesVaultRestoreSettings(input bool) *vaults.RestoreSettings {
+	state := vaults.CrossSubscriptionRestoreStateDisabled
+	if input {
+		state = vaults.CrossSubscriptionRestoreStateEnabled
+	}
+	return &vaults.RestoreSettings{
+		CrossSubscriptionRestoreSettings: &vaults.CrossSubscriptionRestoreSettings{
+			CrossSubscriptionRestoreState: &state,
+		},
+	}
+}
+
 func expandRecoveryServicesVaultPublicNetworkAccess(input bool) *vaults.PublicNetworkAccess {
 	out := vaults.PublicNetworkAccessDisabled
 	if input {
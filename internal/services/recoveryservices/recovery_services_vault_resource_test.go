@@ -578,6 +578,28 @@ func TestAccRecoveryServicesVault_TogglePublicNetworkAccessEnabled(t *testing.T)
 	})
 }
 
+func TestAccRecoveryServicesVault_crossSubscriptionRestoreAndSoftDeleteRetention(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_recovery_services_vault", "test")
+	r := RecoveryServicesVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossSubscriptionRestoreAndSoftDeleteRetention(data, false, 14),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossSubscriptionRestoreAndSoftDeleteRetention(data, true, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccRecoveryServicesVault_basicWithClassicVmwareReplicateEnabled(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_recovery_services_vault", "test")
 	r := RecoveryServicesVaultResource{}
@@ -1514,6 +1536,28 @@ resource "azurerm_recovery_services_vault" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, sku)
 }
 
+func (RecoveryServicesVaultResource) crossSubscriptionRestoreAndSoftDeleteRetention(data acceptance.TestData, crossSubscriptionRestoreEnabled bool, retentionDays int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-recovery-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                               = "acctest-Vault-%d"
+  location                           = azurerm_resource_group.test.location
+  resource_group_name                = azurerm_resource_group.test.name
+  sku                                = "Standard"
+  cross_subscription_restore_enabled = %t
+  soft_delete_retention_days         = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, crossSubscriptionRestoreEnabled, retentionDays)
+}
+
 func (RecoveryServicesVaultResource) basicWithClassicVmwareReplicateEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
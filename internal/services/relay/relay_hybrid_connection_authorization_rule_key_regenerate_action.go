@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package relay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type RelayHybridConnectionAuthorizationRuleKeyRegenerateAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &RelayHybridConnectionAuthorizationRuleKeyRegenerateAction{}
+
+func newRelayHybridConnectionAuthorizationRuleKeyRegenerateAction() action.Action {
+	return &RelayHybridConnectionAuthorizationRuleKeyRegenerateAction{}
+}
+
+type RelayHybridConnectionAuthorizationRuleKeyRegenerateActionModel struct {
+	AuthorizationRuleId types.String `tfsdk:"authorization_rule_id"`
+	KeyType             types.String `tfsdk:"key_type"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (a *RelayHybridConnectionAuthorizationRuleKeyRegenerateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_rule_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Relay Hybrid Connection Authorization Rule whose key should be regenerated.",
+				MarkdownDescription: "The ID of the Relay Hybrid Connection Authorization Rule whose key should be regenerated.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: hybridconnections.ValidateHybridConnectionAuthorizationRuleID,
+					},
+				},
+			},
+
+			"key_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The key to regenerate. Possible values are `PrimaryKey` and `SecondaryKey`.",
+				MarkdownDescription: "The key to regenerate. Possible values are `PrimaryKey` and `SecondaryKey`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(hybridconnections.PossibleValuesForKeyType()...),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (a *RelayHybridConnectionAuthorizationRuleKeyRegenerateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_relay_hybrid_connection_authorization_rule_key_regenerate"
+}
+
+func (a *RelayHybridConnectionAuthorizationRuleKeyRegenerateAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Relay.HybridConnectionsClient
+
+	model := RelayHybridConnectionAuthorizationRuleKeyRegenerateActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(model.AuthorizationRuleId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	payload := hybridconnections.RegenerateAccessKeyParameters{
+		KeyType: hybridconnections.KeyType(model.KeyType.ValueString()),
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("regenerating the %s for %s", payload.KeyType, id),
+	})
+
+	if _, err := client.RegenerateKeys(ctx, *id, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("regenerating the %s for %s", payload.KeyType, id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("regenerated the %s for %s", payload.KeyType, id),
+	})
+}
+
+func (a *RelayHybridConnectionAuthorizationRuleKeyRegenerateAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package relay_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type RelayHybridConnectionAuthorizationRuleKeyRegenerateAction struct{}
+
+func TestAccRelayHybridConnectionAuthorizationRuleKeyRegenerateAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_hybrid_connection_authorization_rule_key_regenerate", "test")
+	a := RelayHybridConnectionAuthorizationRuleKeyRegenerateAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func (a RelayHybridConnectionAuthorizationRuleKeyRegenerateAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_relay_hybrid_connection_authorization_rule.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_relay_hybrid_connection_authorization_rule_key_regenerate.test]
+    }
+  }
+}
+
+action "azurerm_relay_hybrid_connection_authorization_rule_key_regenerate" "test" {
+  config {
+    authorization_rule_id = azurerm_relay_hybrid_connection_authorization_rule.test.id
+    key_type              = "SecondaryKey"
+  }
+}
+`, RelayHybridConnectionAuthorizationRuleResource{}.basic(data))
+}
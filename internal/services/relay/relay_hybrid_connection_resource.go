@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -65,6 +67,16 @@ func resourceArmRelayHybridConnection() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"listener_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"sender_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -111,6 +123,7 @@ func resourceArmRelayHybridConnectionCreateUpdate(d *pluginsdk.ResourceData, met
 
 func resourceArmRelayHybridConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Relay.HybridConnectionsClient
+	namespacesClient := meta.(*clients.Client).Relay.NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -140,6 +153,30 @@ func resourceArmRelayHybridConnectionRead(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
+	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+	namespace, err := namespacesClient.Get(ctx, namespaceId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", namespaceId, err)
+	}
+
+	listenerUrl := ""
+	senderUrl := ""
+	if model := namespace.Model; model != nil {
+		if props := model.Properties; props != nil && props.ServiceBusEndpoint != nil {
+			endpoint, err := url.Parse(*props.ServiceBusEndpoint)
+			if err != nil {
+				return fmt.Errorf("parsing the Service Bus Endpoint for %s: %+v", namespaceId, err)
+			}
+
+			// listeners and senders connect over websockets to the `$hc` path on the Namespace host
+			hybridConnectionUrl := fmt.Sprintf("wss://%s/$hc/%s", endpoint.Hostname(), id.HybridConnectionName)
+			listenerUrl = hybridConnectionUrl + "?sb-hc-action=listen"
+			senderUrl = hybridConnectionUrl + "?sb-hc-action=connect"
+		}
+	}
+	d.Set("listener_url", listenerUrl)
+	d.Set("sender_url", senderUrl)
+
 	return nil
 }
 
@@ -28,6 +28,8 @@ func TestAccRelayHybridConnection_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("requires_client_authorization").Exists(),
+				check.That(data.ResourceName).Key("listener_url").Exists(),
+				check.That(data.ResourceName).Key("sender_url").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/keyrotation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -52,6 +53,10 @@ func resourceRelayNamespaceAuthorizationRule() *pluginsdk.Resource {
 			},
 
 			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"rotation_trigger": keyrotation.TriggerSchema(),
+
+			"regenerate_key": keyrotation.KeyTypeSchema(),
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
@@ -90,6 +95,18 @@ func resourceRelayNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceDa
 		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
+	if err := keyrotation.RegenerateIfTriggered(d, func(keyType string) error {
+		payload := namespaces.RegenerateAccessKeyParameters{
+			KeyType: namespaces.KeyType(keyType),
+		}
+		if _, err := client.RegenerateKeys(ctx, resourceId, payload); err != nil {
+			return fmt.Errorf("regenerating the %s for %s: %+v", keyType, resourceId, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceRelayNamespaceAuthorizationRuleRead(d, meta)
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ResourceProviderRegistrationsId struct {
+	SubscriptionId                   string
+	ResourceProviderRegistrationName string
+}
+
+func NewResourceProviderRegistrationsID(subscriptionId, resourceProviderRegistrationName string) ResourceProviderRegistrationsId {
+	return ResourceProviderRegistrationsId{
+		SubscriptionId:                   subscriptionId,
+		ResourceProviderRegistrationName: resourceProviderRegistrationName,
+	}
+}
+
+func (id ResourceProviderRegistrationsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Resource Provider Registration Name %q", id.ResourceProviderRegistrationName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Provider Registrations", segmentsStr)
+}
+
+func (id ResourceProviderRegistrationsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceProviderRegistrations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceProviderRegistrationName)
+}
+
+// ResourceProviderRegistrationsID parses a ResourceProviderRegistrations ID into an ResourceProviderRegistrationsId struct
+func ResourceProviderRegistrationsID(input string) (*ResourceProviderRegistrationsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ResourceProviderRegistrations ID: %+v", input, err)
+	}
+
+	resourceId := ResourceProviderRegistrationsId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceProviderRegistrationName, err = id.PopSegment("resourceProviderRegistrations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ResourceProviderRegistrationsId{}
+
+func TestResourceProviderRegistrationsIDFormatter(t *testing.T) {
+	actual := NewResourceProviderRegistrationsID("12345678-1234-9876-4563-123456789012", "registrations1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceProviderRegistrations/registrations1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestResourceProviderRegistrationsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ResourceProviderRegistrationsId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceProviderRegistrationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceProviderRegistrationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceProviderRegistrations/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceProviderRegistrations/registrations1",
+			Expected: &ResourceProviderRegistrationsId{
+				SubscriptionId:                   "12345678-1234-9876-4563-123456789012",
+				ResourceProviderRegistrationName: "registrations1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEPROVIDERREGISTRATIONS/REGISTRATIONS1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ResourceProviderRegistrationsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceProviderRegistrationName != v.Expected.ResourceProviderRegistrationName {
+			t.Fatalf("Expected %q but got %q for ResourceProviderRegistrationName", v.Expected.ResourceProviderRegistrationName, actual.ResourceProviderRegistrationName)
+		}
+	}
+}
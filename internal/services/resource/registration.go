@@ -14,6 +14,7 @@ var (
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
 	_ sdk.FrameworkServiceRegistration               = Registration{}
+	_ sdk.ServiceRegistrationWithSweepers            = Registration{}
 )
 
 type Registration struct{}
@@ -40,6 +41,7 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_resources":                            dataSourceResources(),
+		"azurerm_resource_graph_query":                 dataSourceResourceGraphQuery(),
 		"azurerm_resource_group":                       dataSourceResourceGroup(),
 		"azurerm_template_spec_version":                dataSourceTemplateSpecVersion(),
 		"azurerm_management_group_template_deployment": dataSourceManagementGroupTemplateDeployment(),
@@ -77,6 +79,14 @@ func (r Registration) Resources() []sdk.Resource {
 		ResourceManagementPrivateLinkResource{},
 		ResourceProviderFeatureRegistrationResource{},
 		ResourceProviderRegistrationResource{},
+		ResourceProviderRegistrationsResource{},
+	}
+}
+
+// Sweepers returns a list of Sweepers supported by this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		ResourceGroupSweeper{},
 	}
 }
 
@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	resourceGraph "github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2024-04-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	managementGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// resourceGraphQueryPageSize is the maximum number of rows the Resource Graph API returns in a single page
+const resourceGraphQueryPageSize = 1000
+
+func dataSourceResourceGraphQuery() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceResourceGraphQueryRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"query": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"subscription_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+				ConflictsWith: []string{"management_group_ids"},
+			},
+
+			"management_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: managementGroupValidate.ManagementGroupID,
+				},
+				ConflictsWith: []string{"subscription_ids"},
+			},
+
+			"authorization_scope_filter": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(resourceGraph.AuthorizationScopeFilterAtScopeAndBelow),
+				ValidateFunc: validation.StringInSlice(resourceGraph.PossibleValuesForAuthorizationScopeFilter(), false),
+			},
+
+			"allow_partial_scopes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"maximum_results": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"columns": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"rows": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeMap,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+
+			// NOTE: values of `rows` are flattened to strings, whereas `rows_json` retains the type of each value
+			// so that the rows can be used via `jsondecode` without converting each value
+			"rows_json": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"total_records": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourceGraphQueryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.ResourceGraphClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	query := d.Get("query").(string)
+	request := resourceGraph.QueryRequest{
+		Query: query,
+		Options: &resourceGraph.QueryRequestOptions{
+			AllowPartialScopes:       pointer.To(d.Get("allow_partial_scopes").(bool)),
+			AuthorizationScopeFilter: pointer.To(resourceGraph.AuthorizationScopeFilter(d.Get("authorization_scope_filter").(string))),
+			ResultFormat:             pointer.To(resourceGraph.ResultFormatTable),
+		},
+	}
+
+	scopes := make([]string, 0)
+	if v := d.Get("subscription_ids").([]interface{}); len(v) > 0 {
+		subscriptionIds := make([]string, 0)
+		for _, item := range v {
+			subscriptionIds = append(subscriptionIds, item.(string))
+		}
+		request.Subscriptions = &subscriptionIds
+		scopes = append(scopes, subscriptionIds...)
+	}
+
+	if v := d.Get("management_group_ids").([]interface{}); len(v) > 0 {
+		managementGroupNames := make([]string, 0)
+		for _, item := range v {
+			id, err := managementGroupParse.ManagementGroupID(item.(string))
+			if err != nil {
+				return err
+			}
+			managementGroupNames = append(managementGroupNames, id.Name)
+		}
+		request.ManagementGroups = &managementGroupNames
+		scopes = append(scopes, managementGroupNames...)
+	}
+
+	maximumResults := int64(d.Get("maximum_results").(int))
+
+	columns := make([]resourceGraphQueryColumn, 0)
+	rows := make([][]interface{}, 0)
+	var totalRecords int64
+	for {
+		pageSize := int64(resourceGraphQueryPageSize)
+		if maximumResults > 0 && maximumResults-int64(len(rows)) < pageSize {
+			pageSize = maximumResults - int64(len(rows))
+		}
+		request.Options.Top = pointer.To(pageSize)
+
+		resp, err := client.Resources(ctx, request)
+		if err != nil {
+			return fmt.Errorf("executing Resource Graph query: %+v", err)
+		}
+		if resp.Model == nil {
+			return fmt.Errorf("executing Resource Graph query: `model` was nil")
+		}
+
+		page, err := parseResourceGraphQueryTable(resp.Model.Data)
+		if err != nil {
+			return fmt.Errorf("parsing the results of the Resource Graph query: %+v", err)
+		}
+
+		columns = page.Columns
+		rows = append(rows, page.Rows...)
+		totalRecords = resp.Model.TotalRecords
+
+		if resp.Model.SkipToken == nil || *resp.Model.SkipToken == "" {
+			break
+		}
+		if maximumResults > 0 && int64(len(rows)) >= maximumResults {
+			break
+		}
+		request.Options.SkipToken = resp.Model.SkipToken
+	}
+
+	flattenedColumns := make([]interface{}, 0)
+	for _, column := range columns {
+		flattenedColumns = append(flattenedColumns, map[string]interface{}{
+			"name": column.Name,
+			"type": column.Type,
+		})
+	}
+
+	flattenedRows := make([]interface{}, 0)
+	jsonRows := make([]map[string]interface{}, 0)
+	for _, row := range rows {
+		flattenedRow := make(map[string]interface{})
+		jsonRow := make(map[string]interface{})
+		for i, column := range columns {
+			if i >= len(row) {
+				break
+			}
+
+			value, err := flattenResourceGraphQueryValue(row[i])
+			if err != nil {
+				return fmt.Errorf("flattening the value of column %q: %+v", column.Name, err)
+			}
+			flattenedRow[column.Name] = value
+			jsonRow[column.Name] = row[i]
+		}
+		flattenedRows = append(flattenedRows, flattenedRow)
+		jsonRows = append(jsonRows, jsonRow)
+	}
+
+	rowsJson, err := json.Marshal(jsonRows)
+	if err != nil {
+		return fmt.Errorf("serializing `rows_json`: %+v", err)
+	}
+
+	d.SetId(fmt.Sprintf("resourceGraphQuery-%x", sha256.Sum256([]byte(query+"|"+strings.Join(scopes, ",")))))
+
+	if err := d.Set("columns", flattenedColumns); err != nil {
+		return fmt.Errorf("setting `columns`: %+v", err)
+	}
+	if err := d.Set("rows", flattenedRows); err != nil {
+		return fmt.Errorf("setting `rows`: %+v", err)
+	}
+	d.Set("rows_json", string(rowsJson))
+	d.Set("total_records", totalRecords)
+
+	return nil
+}
+
+type resourceGraphQueryColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type resourceGraphQueryTable struct {
+	Columns []resourceGraphQueryColumn `json:"columns"`
+	Rows    [][]interface{}            `json:"rows"`
+}
+
+// parseResourceGraphQueryTable parses the `data` returned by the Resource Graph API when using the `table` result
+// format, which (unlike the `objectArray` format) includes the type of each column
+func parseResourceGraphQueryTable(input interface{}) (*resourceGraphQueryTable, error) {
+	table := resourceGraphQueryTable{
+		Columns: make([]resourceGraphQueryColumn, 0),
+		Rows:    make([][]interface{}, 0),
+	}
+	if input == nil {
+		return &table, nil
+	}
+
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, &table); err != nil {
+		return nil, err
+	}
+
+	return &table, nil
+}
+
+func flattenResourceGraphQueryValue(input interface{}) (string, error) {
+	switch v := input.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		result, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(result), nil
+	}
+}
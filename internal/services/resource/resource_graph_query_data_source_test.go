@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGraphQueryDataSource struct{}
+
+func TestAccDataSourceResourceGraphQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("columns.#").HasValue("2"),
+				check.That(data.ResourceName).Key("columns.0.name").HasValue("subscriptionId"),
+				check.That(data.ResourceName).Key("columns.0.type").HasValue("string"),
+				check.That(data.ResourceName).Key("rows.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rows_json").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_byResourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.byResourceGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rows.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rows.0.name").HasValue(fmt.Sprintf("acctestRG-rgq-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_maximumResults(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.maximumResults(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rows.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (ResourceGraphQueryDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "ResourceContainers | where type =~ 'microsoft.resources/subscriptions' | project subscriptionId, name"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`
+}
+
+func (ResourceGraphQueryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rgq-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGraphQueryDataSource) byResourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "ResourceContainers | where type =~ 'microsoft.resources/subscriptions/resourcegroups' and name =~ '${azurerm_resource_group.test.name}' | project id, name, location"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, r.template(data))
+}
+
+func (ResourceGraphQueryDataSource) maximumResults() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "ResourceContainers | project id"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+  maximum_results  = 1
+}
+`
+}
@@ -50,6 +50,11 @@ func resourceResourceGroup() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
+			"adopt_existing": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"tags": commonschema.Tags(),
 
 			"managed_by": {
@@ -80,7 +85,18 @@ func resourceResourceGroupCreate(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 
 	if !response.WasNotFound(existing.HttpResponse) {
-		return tf.ImportAsExistsError(resourceGroupResourceName, id.ID())
+		if !d.Get("adopt_existing").(bool) {
+			return tf.ImportAsExistsError(resourceGroupResourceName, id.ID())
+		}
+
+		// the location can't be changed, so the `managed_by` and `tags` from the configuration are applied as an update
+		if model := existing.Model; model != nil && location.Normalize(model.Location) != location.Normalize(d.Get("location").(string)) {
+			return tf.AdoptExistingMismatchError(resourceGroupResourceName, id.ID(), []string{"location"})
+		}
+
+		log.Printf("[DEBUG] `adopt_existing` is enabled - adopting the existing %s", id)
+		d.SetId(id.ID())
+		return resourceResourceGroupUpdateExisting(ctx, d, meta)
 	}
 
 	parameters := resourcegroups.ResourceGroup{
@@ -112,10 +128,17 @@ func resourceResourceGroupCreate(d *pluginsdk.ResourceData, meta interface{}) er
 }
 
 func resourceResourceGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.ResourceGroupsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	return resourceResourceGroupUpdateExisting(ctx, d, meta)
+}
+
+// resourceResourceGroupUpdateExisting applies the configuration to an existing Resource Group, which is used both when
+// updating and when adopting an existing Resource Group during creation
+func resourceResourceGroupUpdateExisting(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.ResourceGroupsClient
+
 	id, err := commonids.ParseResourceGroupIDInsensitively(d.Id())
 	if err != nil {
 		return err
@@ -41,6 +41,51 @@ func TestAccResourceGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		data.ApplyStep(testResource.basic, testResource),
+		{
+			// remove the Resource Group from the State, leaving it in Azure
+			Config: testResource.adoptExistingRemovedConfig(),
+		},
+		{
+			Config: testResource.adoptExistingConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(testResource),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.environment").HasValue("Production"),
+			),
+		},
+		// `adopt_existing` is only used during creation, so isn't set when importing
+		data.ImportStep("adopt_existing"),
+	})
+}
+
+func TestAccResourceGroup_adoptExistingDifferentLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		data.ApplyStep(testResource.basic, testResource),
+		{
+			// remove the Resource Group from the State, leaving it in Azure
+			Config: testResource.adoptExistingRemovedConfig(),
+		},
+		{
+			Config:      testResource.adoptExistingDifferentLocationConfig(data),
+			ExpectError: regexp.MustCompile("can't be adopted"),
+		},
+		{
+			Config: testResource.adoptExistingConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(testResource),
+			),
+		},
+		data.ImportStep("adopt_existing"),
+	})
+}
+
 func TestAccResourceGroup_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
 	testResource := ResourceGroupResource{}
@@ -214,6 +259,56 @@ resource "azurerm_resource_group" "import" {
 `, template)
 }
 
+func (r ResourceGroupResource) adoptExistingRemovedConfig() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+removed {
+  from = azurerm_resource_group.test
+
+  lifecycle {
+    destroy = false
+  }
+}
+`
+}
+
+func (r ResourceGroupResource) adoptExistingConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  adopt_existing = true
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupResource) adoptExistingDifferentLocationConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  adopt_existing = true
+}
+`, data.RandomInteger, data.Locations.Secondary)
+}
+
 func (r ResourceGroupResource) withFeatureFlag(data acceptance.TestData, featureFlagEnabled bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
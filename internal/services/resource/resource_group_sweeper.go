@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.Sweeper = ResourceGroupSweeper{}
+
+// ResourceGroupSweeper removes Resource Groups (and by extension the resources within them) which
+// have been leaked by the Acceptance Tests.
+type ResourceGroupSweeper struct{}
+
+func (ResourceGroupSweeper) Name() string {
+	return "Resource Groups"
+}
+
+func (ResourceGroupSweeper) Sweep(ctx context.Context, client *clients.Client, filter sdk.SweepFilter) ([]string, error) {
+	rgClient := client.Resource.ResourceGroupsClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+	resp, err := rgClient.ListComplete(ctx, subscriptionId, resourcegroups.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+	}
+
+	swept := make([]string, 0)
+	for _, group := range resp.Items {
+		name := pointer.From(group.Name)
+		if !filter.MatchesName(name) || !filter.MatchesTags(pointer.From(group.Tags)) {
+			continue
+		}
+
+		// Resource Groups which are already being deleted will be cleaned up by ARM
+		if props := group.Properties; props != nil && pointer.From(props.ProvisioningState) == "Deleting" {
+			continue
+		}
+
+		id := commonids.NewResourceGroupID(subscriptionId.SubscriptionId, name)
+		createdAt, err := resourceGroupCreatedTime(ctx, rgClient, id)
+		if err != nil {
+			return swept, err
+		}
+		if !filter.MatchesAge(createdAt) {
+			continue
+		}
+
+		swept = append(swept, id.ID())
+		if filter.DryRun {
+			log.Printf("[DEBUG] Dry Run: would delete %s", id)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting %s..", id)
+		if err := rgClient.DeleteThenPoll(ctx, id, resourcegroups.DefaultDeleteOperationOptions()); err != nil {
+			return swept, fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return swept, nil
+}
+
+// resourceGroupCreatedTime returns the creation time of the oldest resource within the Resource Group,
+// since ARM doesn't expose a creation time for the Resource Group itself.
+func resourceGroupCreatedTime(ctx context.Context, client *resourcegroups.ResourceGroupsClient, id commonids.ResourceGroupId) (*time.Time, error) {
+	options := resourcegroups.ResourcesListByResourceGroupOperationOptions{
+		Expand: pointer.To("createdTime"),
+	}
+	resp, err := client.ResourcesListByResourceGroupComplete(ctx, id, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing resources within %s: %+v", id, err)
+	}
+
+	return oldestResourceCreatedTime(resp.Items), nil
+}
+
+// oldestResourceCreatedTime returns the oldest known creation time of the resources. This is nil when the
+// creation time is unknown (e.g. for an empty Resource Group, or where ARM omits it) - which is deliberately
+// treated as too recent to sweep by `sdk.SweepFilter.MatchesAge` unless no minimum age is configured, since
+// the Resource Group may belong to an Acceptance Test which is still provisioning resources within it.
+func oldestResourceCreatedTime(items []resourcegroups.GenericResourceExpanded) *time.Time {
+	var oldest *time.Time
+	for _, item := range items {
+		createdAt, err := item.GetCreatedTimeAsTime()
+		if err != nil || createdAt == nil {
+			continue
+		}
+
+		if oldest == nil || createdAt.Before(*oldest) {
+			oldest = createdAt
+		}
+	}
+
+	return oldest
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func TestResourceGroupSweeper_UnknownCreatedTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	testData := []struct {
+		name              string
+		items             []resourcegroups.GenericResourceExpanded
+		expected          *time.Time
+		expectedOlderThan bool
+	}{
+		{
+			name:              "empty Resource Group",
+			items:             []resourcegroups.GenericResourceExpanded{},
+			expected:          nil,
+			expectedOlderThan: false,
+		},
+		{
+			name: "missing creation time",
+			items: []resourcegroups.GenericResourceExpanded{
+				{},
+			},
+			expected:          nil,
+			expectedOlderThan: false,
+		},
+		{
+			name: "invalid creation time",
+			items: []resourcegroups.GenericResourceExpanded{
+				{CreatedTime: pointer.To("not-a-time")},
+			},
+			expected:          nil,
+			expectedOlderThan: false,
+		},
+		{
+			name: "known and unknown creation times",
+			items: []resourcegroups.GenericResourceExpanded{
+				{},
+				{CreatedTime: pointer.To(recent.Format(time.RFC3339))},
+				{CreatedTime: pointer.To(old.Format(time.RFC3339))},
+			},
+			expected:          &old,
+			expectedOlderThan: true,
+		},
+		{
+			name: "recent",
+			items: []resourcegroups.GenericResourceExpanded{
+				{CreatedTime: pointer.To(recent.Format(time.RFC3339))},
+			},
+			expected:          &recent,
+			expectedOlderThan: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := oldestResourceCreatedTime(v.items)
+		if (actual == nil) != (v.expected == nil) || (actual != nil && !actual.Equal(*v.expected)) {
+			t.Fatalf("expected the creation time to be %v but got %v", v.expected, actual)
+		}
+
+		// a Resource Group of unknown age is only swept when no minimum age is configured
+		filter := sdk.SweepFilter{
+			OlderThan: 24 * time.Hour,
+			Now:       now,
+		}
+		if matches := filter.MatchesAge(actual); matches != v.expectedOlderThan {
+			t.Fatalf("expected MatchesAge with a minimum age to be %t but got %t", v.expectedOlderThan, matches)
+		}
+
+		filter.OlderThan = 0
+		if !filter.MatchesAge(actual) {
+			t.Fatalf("expected MatchesAge without a minimum age to be true")
+		}
+	}
+}
@@ -0,0 +1,445 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var (
+	_ sdk.ResourceWithUpdate         = ResourceProviderRegistrationsResource{}
+	_ sdk.ResourceWithCustomizeDiff  = ResourceProviderRegistrationsResource{}
+	_ sdk.ResourceWithCustomImporter = ResourceProviderRegistrationsResource{}
+)
+
+// ResourceProviderRegistrationsResource registers a set of Resource Providers, together with any Preview Features
+// within them, in a single resource. Features are registered before the Resource Providers, since the Resource
+// Provider must be (re-)registered for a newly registered Feature to be propagated.
+type ResourceProviderRegistrationsResource struct{}
+
+type ResourceProviderRegistrationsModel struct {
+	Name                string   `tfschema:"name"`
+	ResourceProviders   []string `tfschema:"resource_providers"`
+	Features            []string `tfschema:"features"`
+	UnregisterOnDestroy bool     `tfschema:"unregister_on_destroy"`
+}
+
+func (r ResourceProviderRegistrationsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_providers": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: resourceproviders.EnhancedValidate,
+			},
+		},
+
+		"features": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validateResourceProviderFeatureName,
+			},
+		},
+
+		"unregister_on_destroy": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ResourceProviderRegistrationsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ResourceProviderRegistrationsResource) ModelObject() interface{} {
+	return &ResourceProviderRegistrationsModel{}
+}
+
+func (r ResourceProviderRegistrationsResource) ResourceType() string {
+	return "azurerm_resource_provider_registrations"
+}
+
+func (r ResourceProviderRegistrationsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ResourceProviderRegistrationsID
+}
+
+func (r ResourceProviderRegistrationsResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff == nil {
+				return nil
+			}
+
+			var model ResourceProviderRegistrationsModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return err
+			}
+
+			// the Resource Provider for each Feature must be managed here, so that it's re-registered once the Feature is
+			for _, feature := range model.Features {
+				namespace, _, _ := strings.Cut(feature, "/")
+				found := false
+				for _, rp := range model.ResourceProviders {
+					if strings.EqualFold(rp, namespace) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return fmt.Errorf("the Resource Provider %q for the feature %q must be specified in `resource_providers`", namespace, feature)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// CustomImporter returns an error, since the ID only contains the name - as such the set of Resource Providers and
+// Features which make up this resource can't be determined when importing
+func (r ResourceProviderRegistrationsResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("`azurerm_resource_provider_registrations` can't be imported - the Resource Providers and Features can instead be registered again by creating this resource, which is a no-op for those already registered")
+	}
+}
+
+func (r ResourceProviderRegistrationsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ResourceProviderRegistrationsModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := parse.NewResourceProviderRegistrationsID(subscriptionId, model.Name)
+
+			if model.UnregisterOnDestroy {
+				for _, rp := range model.ResourceProviders {
+					if err := (ResourceProviderRegistrationResource{}).checkIfManagedByTerraform(rp, metadata.Client.Account); err != nil {
+						return err
+					}
+				}
+			}
+
+			if err := r.registerFeatures(ctx, metadata, subscriptionId, model.Features); err != nil {
+				return fmt.Errorf("registering features for %s: %+v", id, err)
+			}
+
+			if err := r.registerResourceProviders(ctx, metadata, subscriptionId, model.ResourceProviders); err != nil {
+				return fmt.Errorf("registering Resource Providers for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 120 * time.Minute,
+	}
+}
+
+func (r ResourceProviderRegistrationsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceProvidersClient
+			featuresClient := metadata.Client.Resource.FeaturesClient
+
+			id, err := parse.ResourceProviderRegistrationsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ResourceProviderRegistrationsModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			// only the Resource Providers and Features which are tracked in the state are checked, anything which is
+			// no longer registered is removed so that it's registered again during the next apply
+			providerStates, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, state.ResourceProviders, func(ctx context.Context, rp string) (bool, error) {
+				providerId := providers.NewSubscriptionProviderID(id.SubscriptionId, rp)
+				resp, err := client.Get(ctx, providerId, providers.DefaultGetOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return false, nil
+					}
+					return false, fmt.Errorf("retrieving %s: %+v", providerId, err)
+				}
+
+				if model := resp.Model; model != nil && model.RegistrationState != nil {
+					return strings.EqualFold(*model.RegistrationState, Registered) || strings.EqualFold(*model.RegistrationState, Registering), nil
+				}
+
+				return false, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			registeredProviders := make([]string, 0)
+			for i, rp := range state.ResourceProviders {
+				if providerStates[i] {
+					registeredProviders = append(registeredProviders, rp)
+				}
+			}
+
+			featureStates, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, state.Features, func(ctx context.Context, feature string) (bool, error) {
+				featureId := resourceProviderFeatureID(id.SubscriptionId, feature)
+				resp, err := featuresClient.Get(ctx, featureId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return false, nil
+					}
+					return false, fmt.Errorf("retrieving %s: %+v", featureId, err)
+				}
+
+				if model := resp.Model; model != nil && model.Properties != nil && model.Properties.State != nil {
+					return strings.EqualFold(*model.Properties.State, Registered) || strings.EqualFold(*model.Properties.State, Registering), nil
+				}
+
+				return false, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			registeredFeatures := make([]string, 0)
+			for i, feature := range state.Features {
+				if featureStates[i] {
+					registeredFeatures = append(registeredFeatures, feature)
+				}
+			}
+
+			return metadata.Encode(&ResourceProviderRegistrationsModel{
+				Name:                id.ResourceProviderRegistrationName,
+				ResourceProviders:   registeredProviders,
+				Features:            registeredFeatures,
+				UnregisterOnDestroy: state.UnregisterOnDestroy,
+			})
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ResourceProviderRegistrationsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ResourceProviderRegistrationsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceProviderRegistrationsModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			if model.UnregisterOnDestroy {
+				for _, rp := range model.ResourceProviders {
+					if err := (ResourceProviderRegistrationResource{}).checkIfManagedByTerraform(rp, metadata.Client.Account); err != nil {
+						return err
+					}
+				}
+			}
+
+			oldFeaturesRaw, _ := metadata.ResourceData.GetChange("features")
+			oldProvidersRaw, _ := metadata.ResourceData.GetChange("resource_providers")
+			oldFeatures := utils.ExpandStringSlice(oldFeaturesRaw.(*pluginsdk.Set).List())
+			oldProviders := utils.ExpandStringSlice(oldProvidersRaw.(*pluginsdk.Set).List())
+
+			// registering is idempotent, so everything is registered again to account for anything which has since been unregistered
+			if err := r.registerFeatures(ctx, metadata, id.SubscriptionId, model.Features); err != nil {
+				return fmt.Errorf("registering features for %s: %+v", id, err)
+			}
+			if err := r.registerResourceProviders(ctx, metadata, id.SubscriptionId, model.ResourceProviders); err != nil {
+				return fmt.Errorf("registering Resource Providers for %s: %+v", id, err)
+			}
+
+			if model.UnregisterOnDestroy {
+				if err := r.unregisterFeatures(ctx, metadata, id.SubscriptionId, stringsRemovedFrom(*oldFeatures, model.Features)); err != nil {
+					return fmt.Errorf("unregistering features for %s: %+v", id, err)
+				}
+				if err := r.unregisterResourceProviders(ctx, metadata, id.SubscriptionId, stringsRemovedFrom(*oldProviders, model.ResourceProviders)); err != nil {
+					return fmt.Errorf("unregistering Resource Providers for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 120 * time.Minute,
+	}
+}
+
+func (r ResourceProviderRegistrationsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ResourceProviderRegistrationsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceProviderRegistrationsModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			if !model.UnregisterOnDestroy {
+				log.Printf("[DEBUG] `unregister_on_destroy` is disabled - removing %s from the state without unregistering", id)
+				return nil
+			}
+
+			for _, rp := range model.ResourceProviders {
+				if err := (ResourceProviderRegistrationResource{}).checkIfManagedByTerraform(rp, metadata.Client.Account); err != nil {
+					return err
+				}
+			}
+
+			if err := r.unregisterFeatures(ctx, metadata, id.SubscriptionId, model.Features); err != nil {
+				return fmt.Errorf("unregistering features for %s: %+v", id, err)
+			}
+			if err := r.unregisterResourceProviders(ctx, metadata, id.SubscriptionId, model.ResourceProviders); err != nil {
+				return fmt.Errorf("unregistering Resource Providers for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+// registerFeatures registers the specified Features concurrently, waiting for each to be registered.
+func (r ResourceProviderRegistrationsResource) registerFeatures(ctx context.Context, metadata sdk.ResourceMetaData, subscriptionId string, featureNames []string) error {
+	_, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, featureNames, func(ctx context.Context, feature string) (struct{}, error) {
+		return struct{}{}, ResourceProviderRegistrationResource{}.registerFeature(ctx, metadata, resourceProviderFeatureID(subscriptionId, feature))
+	})
+	return err
+}
+
+// unregisterFeatures unregisters the specified Features concurrently, waiting for each to be unregistered.
+func (r ResourceProviderRegistrationsResource) unregisterFeatures(ctx context.Context, metadata sdk.ResourceMetaData, subscriptionId string, featureNames []string) error {
+	_, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, featureNames, func(ctx context.Context, feature string) (struct{}, error) {
+		return struct{}{}, ResourceProviderRegistrationResource{}.unregisterFeature(ctx, metadata, resourceProviderFeatureID(subscriptionId, feature))
+	})
+	return err
+}
+
+// registerResourceProviders registers the specified Resource Providers concurrently, waiting for each to be registered.
+func (r ResourceProviderRegistrationsResource) registerResourceProviders(ctx context.Context, metadata sdk.ResourceMetaData, subscriptionId string, names []string) error {
+	client := metadata.Client.Resource.ResourceProvidersClient
+
+	_, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, names, func(ctx context.Context, name string) (struct{}, error) {
+		id := providers.NewSubscriptionProviderID(subscriptionId, name)
+
+		log.Printf("[DEBUG] Registering %s..", id)
+		if _, err := client.Register(ctx, id, providers.ProviderRegistrationRequest{}); err != nil {
+			return struct{}{}, fmt.Errorf("registering %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for %s to finish registering..", id)
+		pollerType := custompollers.NewResourceProviderRegistrationPollerDefault(client, id, Registered)
+		poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			return struct{}{}, fmt.Errorf("waiting for %s to be registered: %+v", id, err)
+		}
+
+		return struct{}{}, nil
+	})
+	return err
+}
+
+// unregisterResourceProviders unregisters the specified Resource Providers concurrently, waiting for each to be unregistered.
+func (r ResourceProviderRegistrationsResource) unregisterResourceProviders(ctx context.Context, metadata sdk.ResourceMetaData, subscriptionId string, names []string) error {
+	client := metadata.Client.Resource.ResourceProvidersClient
+
+	_, err := utils.MapConcurrently(ctx, utils.DefaultConcurrencyLimit, names, func(ctx context.Context, name string) (struct{}, error) {
+		id := providers.NewSubscriptionProviderID(subscriptionId, name)
+
+		log.Printf("[DEBUG] Unregistering %s..", id)
+		if _, err := client.Unregister(ctx, id); err != nil {
+			return struct{}{}, fmt.Errorf("unregistering %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for %s to finish unregistering..", id)
+		pollerType := custompollers.NewResourceProviderRegistrationPollerDefault(client, id, Unregistered)
+		poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			return struct{}{}, fmt.Errorf("waiting for %s to be unregistered: %+v", id, err)
+		}
+
+		return struct{}{}, nil
+	})
+	return err
+}
+
+// stringsRemovedFrom returns the items from `old` which aren't present in `new`
+func stringsRemovedFrom(old []string, new []string) []string {
+	removed := make([]string, 0)
+	for _, o := range old {
+		found := false
+		for _, n := range new {
+			if strings.EqualFold(o, n) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, o)
+		}
+	}
+	return removed
+}
+
+// resourceProviderFeatureID returns the Feature ID for a feature in the format `{ResourceProviderNamespace}/{FeatureName}`
+func resourceProviderFeatureID(subscriptionId string, feature string) features.FeatureId {
+	namespace, name, _ := strings.Cut(feature, "/")
+	return features.NewFeatureID(subscriptionId, namespace, name)
+}
+
+var resourceProviderFeatureNameRegex = regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)+/[^/\s]+$`)
+
+func validateResourceProviderFeatureName(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !resourceProviderFeatureNameRegex.MatchString(v) {
+		errors = append(errors, fmt.Errorf("expected %q to be in the format `{ResourceProviderNamespace}/{FeatureName}`, for example `Microsoft.Compute/EncryptionAtHost`, got %q", key, v))
+	}
+
+	return
+}
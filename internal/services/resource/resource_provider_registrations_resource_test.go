@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceProviderRegistrationsResource struct{}
+
+func TestAccResourceProviderRegistrations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_provider_registrations", "test")
+	r := ResourceProviderRegistrationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			PreConfig: func() {
+				if err := (ResourceProviderRegistrationResource{}).unRegisterProviders(data.Subscriptions.Primary, "Microsoft.BlockchainTokens", "Microsoft.AppLink"); err != nil {
+					t.Fatalf("Failed to reset resource provider registration with error: %+v", err)
+				}
+			},
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_providers.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccResourceProviderRegistrations_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_provider_registrations", "test")
+	r := ResourceProviderRegistrationsResource{}
+
+	if data.Subscriptions.Secondary == "" {
+		t.Skip("Skipping test as secondary subscription ID was empty. To run this test, set `ARM_SUBSCRIPTION_ID_ALT")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			PreConfig: func() {
+				if err := (ResourceProviderRegistrationResource{}).unRegisterProviders(data.Subscriptions.Secondary, "Microsoft.DevCenter", "Microsoft.AppLink"); err != nil {
+					t.Fatalf("Failed to reset resource provider registration with error: %+v", err)
+				}
+			},
+			Config: r.withFeatures(data, `"Microsoft.DevCenter/AutoDeletePreview"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("features.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.withFeatures(data, `"Microsoft.DevCenter/AutoDeletePreview", "Microsoft.DevCenter/DevTunnelsPreview"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("features.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.withFeatures(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("features.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (ResourceProviderRegistrationsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ResourceProviderRegistrationsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count := 0
+	for k, v := range state.Attributes {
+		if !strings.HasPrefix(k, "resource_providers.") || k == "resource_providers.#" {
+			continue
+		}
+		count++
+
+		providerId := providers.NewSubscriptionProviderID(id.SubscriptionId, v)
+		resp, err := client.Resource.ResourceProvidersClient.Get(ctx, providerId, providers.DefaultGetOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", providerId, err)
+		}
+
+		if model := resp.Model; model == nil || model.RegistrationState == nil || !strings.EqualFold(*model.RegistrationState, "Registered") {
+			return pointer.To(false), nil
+		}
+	}
+
+	return pointer.To(count > 0), nil
+}
+
+func (ResourceProviderRegistrationsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+  resource_provider_registrations = "none"
+}
+
+resource "azurerm_resource_provider_registrations" "test" {
+  name = "acctest-%d"
+
+  resource_providers = [
+    "Microsoft.AppLink",
+    "Microsoft.BlockchainTokens",
+  ]
+
+  unregister_on_destroy = true
+}
+`, data.RandomInteger)
+}
+
+func (ResourceProviderRegistrationsResource) withFeatures(data acceptance.TestData, features string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  subscription_id = "%[1]s"
+
+  features {}
+  resource_provider_registrations = "none"
+}
+
+resource "azurerm_resource_provider_registrations" "test" {
+  name = "acctest-%[2]d"
+
+  resource_providers = [
+    "Microsoft.AppLink",
+    "Microsoft.DevCenter",
+  ]
+
+  features = [%[3]s]
+
+  unregister_on_destroy = true
+}
+`, data.Subscriptions.Secondary, data.RandomInteger, features)
+}
@@ -24,12 +24,15 @@ import (
 type StorageDefenderResource struct{}
 
 type StorageDefenderModel struct {
-	StorageAccountId                 string `tfschema:"storage_account_id"`
-	OverrideSubscriptionSettings     bool   `tfschema:"override_subscription_settings_enabled"`
-	MalwareScanningOnUploadEnabled   bool   `tfschema:"malware_scanning_on_upload_enabled"`
-	MalwareScanningOnUploadCapPerMon int64  `tfschema:"malware_scanning_on_upload_cap_gb_per_month"`
-	SensitiveDataDiscoveryEnabled    bool   `tfschema:"sensitive_data_discovery_enabled"`
-	ScanResultsEventGridTopicId      string `tfschema:"scan_results_event_grid_topic_id"`
+	StorageAccountId                        string   `tfschema:"storage_account_id"`
+	OverrideSubscriptionSettings            bool     `tfschema:"override_subscription_settings_enabled"`
+	MalwareScanningOnUploadEnabled          bool     `tfschema:"malware_scanning_on_upload_enabled"`
+	MalwareScanningOnUploadCapPerMon        int64    `tfschema:"malware_scanning_on_upload_cap_gb_per_month"`
+	MalwareScanningOnUploadExcludedPrefixes []string `tfschema:"malware_scanning_on_upload_excluded_blob_prefixes"`
+	MalwareScanningOnUploadExcludedSuffixes []string `tfschema:"malware_scanning_on_upload_excluded_blob_suffixes"`
+	MalwareScanningBlobIndexTagsResults     bool     `tfschema:"malware_scanning_blob_index_tags_results_enabled"`
+	SensitiveDataDiscoveryEnabled           bool     `tfschema:"sensitive_data_discovery_enabled"`
+	ScanResultsEventGridTopicId             string   `tfschema:"scan_results_event_grid_topic_id"`
 }
 
 var _ sdk.ResourceWithUpdate = StorageDefenderResource{}
@@ -77,6 +80,30 @@ func (s StorageDefenderResource) Arguments() map[string]*schema.Schema {
 			),
 		},
 
+		"malware_scanning_on_upload_excluded_blob_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"malware_scanning_on_upload_excluded_blob_suffixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"malware_scanning_blob_index_tags_results_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"sensitive_data_discovery_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -121,9 +148,11 @@ func (s StorageDefenderResource) Create() sdk.ResourceFunc {
 					IsEnabled:                         pointer.To(true),
 					OverrideSubscriptionLevelSettings: pointer.To(plan.OverrideSubscriptionSettings),
 					MalwareScanning: &defenderforstorage.MalwareScanningProperties{
+						BlobScanResultsOptions: expandStorageDefenderBlobScanResultsOptions(plan.MalwareScanningBlobIndexTagsResults),
 						OnUpload: &defenderforstorage.OnUploadProperties{
 							IsEnabled:     pointer.To(plan.MalwareScanningOnUploadEnabled),
 							CapGBPerMonth: pointer.To(plan.MalwareScanningOnUploadCapPerMon),
+							Filters:       expandStorageDefenderOnUploadFilters(plan.MalwareScanningOnUploadExcludedPrefixes, plan.MalwareScanningOnUploadExcludedSuffixes),
 						},
 					},
 					SensitiveDataDiscovery: &defenderforstorage.SensitiveDataDiscoveryProperties{
@@ -201,6 +230,14 @@ func (s StorageDefenderResource) Update() sdk.ResourceFunc {
 				prop.MalwareScanning.OnUpload.CapGBPerMonth = pointer.To(plan.MalwareScanningOnUploadCapPerMon)
 			}
 
+			if metadata.ResourceData.HasChanges("malware_scanning_on_upload_excluded_blob_prefixes", "malware_scanning_on_upload_excluded_blob_suffixes") {
+				prop.MalwareScanning.OnUpload.Filters = expandStorageDefenderOnUploadFilters(plan.MalwareScanningOnUploadExcludedPrefixes, plan.MalwareScanningOnUploadExcludedSuffixes)
+			}
+
+			if metadata.ResourceData.HasChange("malware_scanning_blob_index_tags_results_enabled") {
+				prop.MalwareScanning.BlobScanResultsOptions = expandStorageDefenderBlobScanResultsOptions(plan.MalwareScanningBlobIndexTagsResults)
+			}
+
 			if metadata.ResourceData.HasChange("scan_results_event_grid_topic_id") {
 				prop.MalwareScanning.ScanResultsEventGridTopicResourceId = pointer.To(plan.ScanResultsEventGridTopicId)
 			}
@@ -262,9 +299,17 @@ func (s StorageDefenderResource) Read() sdk.ResourceFunc {
 					state.OverrideSubscriptionSettings = pointer.From(prop.OverrideSubscriptionLevelSettings)
 
 					if ms := prop.MalwareScanning; ms != nil {
+						// results are written to the blob index tags unless this has been explicitly disabled
+						state.MalwareScanningBlobIndexTagsResults = pointer.From(ms.BlobScanResultsOptions) != defenderforstorage.BlobScanResultsOptionsNone
+
 						if onUpload := ms.OnUpload; onUpload != nil {
 							state.MalwareScanningOnUploadEnabled = pointer.From(onUpload.IsEnabled)
 							state.MalwareScanningOnUploadCapPerMon = pointer.From(onUpload.CapGBPerMonth)
+
+							if filters := onUpload.Filters; filters != nil {
+								state.MalwareScanningOnUploadExcludedPrefixes = pointer.From(filters.ExcludeBlobsWithPrefix)
+								state.MalwareScanningOnUploadExcludedSuffixes = pointer.From(filters.ExcludeBlobsWithSuffix)
+							}
 						}
 						if ms.ScanResultsEventGridTopicResourceId != nil {
 							topicId, err := topics.ParseTopicID(*ms.ScanResultsEventGridTopicResourceId)
@@ -315,3 +360,25 @@ func (s StorageDefenderResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func expandStorageDefenderBlobScanResultsOptions(input bool) *defenderforstorage.BlobScanResultsOptions {
+	if input {
+		return pointer.To(defenderforstorage.BlobScanResultsOptionsBlobIndexTags)
+	}
+	return pointer.To(defenderforstorage.BlobScanResultsOptionsNone)
+}
+
+func expandStorageDefenderOnUploadFilters(excludedPrefixes []string, excludedSuffixes []string) *defenderforstorage.OnUploadFilters {
+	// empty lists are sent rather than omitting the filters, so that any previously configured exclusions are removed
+	if excludedPrefixes == nil {
+		excludedPrefixes = make([]string, 0)
+	}
+	if excludedSuffixes == nil {
+		excludedSuffixes = make([]string, 0)
+	}
+
+	return &defenderforstorage.OnUploadFilters{
+		ExcludeBlobsWithPrefix: pointer.To(excludedPrefixes),
+		ExcludeBlobsWithSuffix: pointer.To(excludedSuffixes),
+	}
+}
@@ -191,11 +191,14 @@ func (r SecurityCenterStorageDefenderResource) complete(data acceptance.TestData
 %s
 
 resource "azurerm_security_center_storage_defender" "test" {
-  storage_account_id                          = azurerm_storage_account.test.id
-  override_subscription_settings_enabled      = true
-  malware_scanning_on_upload_enabled          = true
-  malware_scanning_on_upload_cap_gb_per_month = 4
-  sensitive_data_discovery_enabled            = true
+  storage_account_id                                = azurerm_storage_account.test.id
+  override_subscription_settings_enabled            = true
+  malware_scanning_on_upload_enabled                = true
+  malware_scanning_on_upload_cap_gb_per_month       = 4
+  malware_scanning_on_upload_excluded_blob_prefixes = ["logs/"]
+  malware_scanning_on_upload_excluded_blob_suffixes = [".log", ".tmp"]
+  malware_scanning_blob_index_tags_results_enabled  = false
+  sensitive_data_discovery_enabled                  = true
 }
 `, r.template(data))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespacesauthorizationrule"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/keyrotation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -65,6 +66,10 @@ func resourceServiceBusNamespaceAuthorizationRuleSchema() map[string]*pluginsdk.
 			ForceNew:     true,
 			ValidateFunc: namespaces.ValidateNamespaceID,
 		},
+
+		"rotation_trigger": keyrotation.TriggerSchema(),
+
+		"regenerate_key": keyrotation.KeyTypeSchema(),
 	})
 }
 
@@ -104,6 +109,18 @@ func resourceServiceBusNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.Resou
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	if err := keyrotation.RegenerateIfTriggered(d, func(keyType string) error {
+		payload := namespacesauthorizationrule.RegenerateAccessKeyParameters{
+			KeyType: namespacesauthorizationrule.KeyType(keyType),
+		}
+		if _, err := client.NamespacesRegenerateKeys(ctx, id, payload); err != nil {
+			return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	d.SetId(id.ID())
 
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
@@ -84,6 +84,37 @@ func TestAccServiceBusNamespaceAuthorizationRule_rightsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccServiceBusNamespaceAuthorizationRule_rotateKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_authorization_rule", "test")
+	r := ServiceBusNamespaceAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.base(data, true, false, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotateKey(data, "first", "SecondaryKey"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_trigger", "regenerate_key"),
+		{
+			Config: r.rotateKey(data, "second", "PrimaryKey"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+			),
+		},
+		data.ImportStep("rotation_trigger", "regenerate_key"),
+	})
+}
+
 func TestAccServiceBusNamespaceAuthorizationRule_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_authorization_rule", "test")
 	r := ServiceBusNamespaceAuthorizationRuleResource{}
@@ -148,6 +179,40 @@ resource "azurerm_servicebus_namespace_authorization_rule" "test" {
 `, data.RandomInteger, data.Locations.Primary, listen, send, manage)
 }
 
+func (ServiceBusNamespaceAuthorizationRuleResource) rotateKey(data acceptance.TestData, trigger, keyType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_namespace_authorization_rule" "test" {
+  name         = "acctest-%[1]d"
+  namespace_id = azurerm_servicebus_namespace.test.id
+
+  listen = true
+  send   = false
+  manage = false
+
+  rotation_trigger = {
+    rotated = "%[3]s"
+  }
+  regenerate_key = "%[4]s"
+}
+`, data.RandomInteger, data.Locations.Primary, trigger, keyType)
+}
+
 func (r ServiceBusNamespaceAuthorizationRuleResource) requiresImport(data acceptance.TestData, listen, send, manage bool) string {
 	return fmt.Sprintf(`
 %s
@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/disasterrecoveryconfigs"
@@ -74,6 +75,16 @@ func dataSourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"pending_replication_operations_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 
@@ -147,6 +158,8 @@ func dataSourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resour
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("partner_namespace_id", props.PartnerNamespace)
+			d.Set("role", string(pointer.From(props.Role)))
+			d.Set("pending_replication_operations_count", pointer.From(props.PendingReplicationOperationsCount))
 		}
 	}
 
@@ -91,6 +91,16 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"pending_replication_operations_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -204,6 +214,8 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigRead(d *pluginsdk.Resource
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("partner_namespace_id", props.PartnerNamespace)
+			d.Set("role", string(pointer.From(props.Role)))
+			d.Set("pending_replication_operations_count", pointer.From(props.PendingReplicationOperationsCount))
 		}
 	}
 
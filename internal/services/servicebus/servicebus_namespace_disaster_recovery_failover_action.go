@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/disasterrecoveryconfigs"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type ServiceBusNamespaceDisasterRecoveryFailoverAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ServiceBusNamespaceDisasterRecoveryFailoverAction{}
+
+func newServiceBusNamespaceDisasterRecoveryFailoverAction() action.Action {
+	return &ServiceBusNamespaceDisasterRecoveryFailoverAction{}
+}
+
+type ServiceBusNamespaceDisasterRecoveryFailoverActionModel struct {
+	DisasterRecoveryConfigId types.String `tfsdk:"disaster_recovery_config_id"`
+	SafeFailoverEnabled      types.Bool   `tfsdk:"safe_failover_enabled"`
+	NewPartnerNamespaceId    types.String `tfsdk:"new_partner_namespace_id"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (a *ServiceBusNamespaceDisasterRecoveryFailoverAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"disaster_recovery_config_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Service Bus Namespace Disaster Recovery Config to fail over. This can be the ID of the config on either the primary or the secondary Namespace.",
+				MarkdownDescription: "The ID of the Service Bus Namespace Disaster Recovery Config to fail over. This can be the ID of the config on either the primary or the secondary Namespace.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: disasterrecoveryconfigs.ValidateDisasterRecoveryConfigID,
+					},
+				},
+			},
+
+			"safe_failover_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the failover should wait for the pending replication to complete, so that it fails rather than losing data if the primary Namespace is unavailable. Defaults to `false`.",
+				MarkdownDescription: "Whether the failover should wait for the pending replication to complete, so that it fails rather than losing data if the primary Namespace is unavailable. Defaults to `false`.",
+			},
+
+			"new_partner_namespace_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of an empty Premium Service Bus Namespace which the new primary Namespace should be paired with once the failover has completed.",
+				MarkdownDescription: "The ID of an empty Premium Service Bus Namespace which the new primary Namespace should be paired with once the failover has completed.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: disasterrecoveryconfigs.ValidateNamespaceID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (a *ServiceBusNamespaceDisasterRecoveryFailoverAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_servicebus_namespace_disaster_recovery_failover"
+}
+
+func (a *ServiceBusNamespaceDisasterRecoveryFailoverAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.ServiceBus.DisasterRecoveryConfigsClient
+
+	model := ServiceBusNamespaceDisasterRecoveryFailoverActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(model.DisasterRecoveryConfigId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+
+	if existing.Model == nil || existing.Model.Properties == nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving %s", id), "`properties` was nil")
+		return
+	}
+	props := existing.Model.Properties
+
+	// a failover has to be initiated from the secondary Namespace, so when given the config on the primary Namespace
+	// the config with the same alias on the partner Namespace is used instead
+	secondaryId := *id
+	if pointer.From(props.Role) != disasterrecoveryconfigs.RoleDisasterRecoverySecondary {
+		if pointer.From(props.PartnerNamespace) == "" {
+			sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("failing over %s", id), fmt.Sprintf("the config has the role %q and isn't paired with another Namespace", pointer.From(props.Role)))
+			return
+		}
+
+		partnerId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(*props.PartnerNamespace)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing the partner Namespace ID", err)
+			return
+		}
+		secondaryId = disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerId.SubscriptionId, partnerId.ResourceGroupName, partnerId.NamespaceName, id.DisasterRecoveryConfigName)
+	}
+
+	locks.ByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(secondaryId.NamespaceName, serviceBusNamespaceResourceName)
+
+	payload := disasterrecoveryconfigs.FailoverProperties{
+		Properties: &disasterrecoveryconfigs.FailoverPropertiesProperties{
+			IsSafeFailover: pointer.To(model.SafeFailoverEnabled.ValueBool()),
+		},
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("failing over to %s", secondaryId),
+	})
+
+	if _, err := client.FailOver(ctx, secondaryId, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("failing over to %s", secondaryId), err)
+		return
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, secondaryId); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("waiting for the failover to %s to complete", secondaryId), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("failover to %s completed", secondaryId),
+	})
+
+	if v := model.NewPartnerNamespaceId; !v.IsNull() && v.ValueString() != "" {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("pairing %s with %s", secondaryId, v.ValueString()),
+		})
+
+		pairing := disasterrecoveryconfigs.ArmDisasterRecovery{
+			Properties: &disasterrecoveryconfigs.ArmDisasterRecoveryProperties{
+				PartnerNamespace: pointer.To(v.ValueString()),
+			},
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, secondaryId, pairing); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("pairing %s with %s", secondaryId, v.ValueString()), err)
+			return
+		}
+
+		if err := resourceServiceBusNamespaceDisasterRecoveryConfigWaitForState(ctx, client, secondaryId); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("waiting for %s to finish replicating", secondaryId), err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("%s is now paired with %s", secondaryId, v.ValueString()),
+		})
+	}
+}
+
+func (a *ServiceBusNamespaceDisasterRecoveryFailoverAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
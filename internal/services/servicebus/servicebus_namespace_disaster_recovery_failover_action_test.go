@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ServiceBusNamespaceDisasterRecoveryFailoverAction struct{}
+
+func TestAccServiceBusNamespaceDisasterRecoveryFailoverAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_failover", "test")
+	a := ServiceBusNamespaceDisasterRecoveryFailoverAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccServiceBusNamespaceDisasterRecoveryFailoverAction_repair(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_failover", "test")
+	a := ServiceBusNamespaceDisasterRecoveryFailoverAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.repair(data),
+			},
+		},
+	})
+}
+
+func (a ServiceBusNamespaceDisasterRecoveryFailoverAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_servicebus_namespace_disaster_recovery_failover" "test" {
+  config {
+    disaster_recovery_config_id = azurerm_servicebus_namespace_disaster_recovery_config.pairing_test.id
+  }
+}
+`, a.template(data))
+}
+
+func (a ServiceBusNamespaceDisasterRecoveryFailoverAction) repair(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace" "tertiary" {
+  name                         = "acctest3-%d"
+  location                     = azurerm_resource_group.primary.location
+  resource_group_name          = azurerm_resource_group.primary.name
+  sku                          = "Premium"
+  capacity                     = "1"
+  premium_messaging_partitions = 1
+}
+
+action "azurerm_servicebus_namespace_disaster_recovery_failover" "test" {
+  config {
+    disaster_recovery_config_id = azurerm_servicebus_namespace_disaster_recovery_config.pairing_test.id
+    safe_failover_enabled       = true
+    new_partner_namespace_id    = azurerm_servicebus_namespace.tertiary.id
+  }
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a ServiceBusNamespaceDisasterRecoveryFailoverAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_servicebus_namespace_disaster_recovery_config.pairing_test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_servicebus_namespace_disaster_recovery_failover.test]
+    }
+  }
+}
+`, ServiceBusNamespaceDisasterRecoveryConfigResource{}.basic(data))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/topicsauthorizationrule"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/keyrotation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -61,6 +62,10 @@ func resourceServiceBusTopicAuthorizationRuleSchema() map[string]*pluginsdk.Sche
 			ForceNew:     true,
 			ValidateFunc: topics.ValidateTopicID,
 		},
+
+		"rotation_trigger": keyrotation.TriggerSchema(),
+
+		"regenerate_key": keyrotation.KeyTypeSchema(),
 	}
 }
 
@@ -103,6 +108,18 @@ func resourceServiceBusTopicAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceD
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	if err := keyrotation.RegenerateIfTriggered(d, func(keyType string) error {
+		payload := topicsauthorizationrule.RegenerateAccessKeyParameters{
+			KeyType: topicsauthorizationrule.KeyType(keyType),
+		}
+		if _, err := client.TopicsRegenerateKeys(ctx, id, payload); err != nil {
+			return fmt.Errorf("regenerating the %s for %s: %+v", keyType, id, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
 	d.SetId(id.ID())
 
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
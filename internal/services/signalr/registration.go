@@ -28,6 +28,8 @@ func (r Registration) Resources() []sdk.Resource {
 		CustomDomainWebPubsubResource{},
 		CustomCertWebPubsubResource{},
 		CustomCertSignalrServiceResource{},
+		SignalRServiceReplicaResource{},
+		WebPubSubReplicaResource{},
 		WebPubSubSocketIOResource{},
 	}
 }
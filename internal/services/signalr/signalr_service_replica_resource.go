@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SignalRServiceReplicaResourceModel struct {
+	Name                  string                     `tfschema:"name"`
+	SignalRServiceId      string                     `tfschema:"signalr_service_id"`
+	Location              string                     `tfschema:"location"`
+	Sku                   []SignalRServiceReplicaSku `tfschema:"sku"`
+	RegionEndpointEnabled bool                       `tfschema:"region_endpoint_enabled"`
+	TrafficEnabled        bool                       `tfschema:"traffic_enabled"`
+	Tags                  map[string]string          `tfschema:"tags"`
+}
+
+type SignalRServiceReplicaSku struct {
+	Name     string `tfschema:"name"`
+	Capacity int64  `tfschema:"capacity"`
+}
+
+type SignalRServiceReplicaResource struct{}
+
+var _ sdk.ResourceWithUpdate = SignalRServiceReplicaResource{}
+
+func (r SignalRServiceReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"signalr_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: signalr.ValidateSignalRID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Premium_P1",
+							"Premium_P2",
+						}, false),
+					},
+
+					"capacity": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+						ValidateFunc: validation.IntInSlice([]int{
+							1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 200,
+							300, 400, 500, 600, 700, 800, 900, 1000,
+						}),
+					},
+				},
+			},
+		},
+
+		"region_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"traffic_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SignalRServiceReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SignalRServiceReplicaResource) ModelObject() interface{} {
+	return &SignalRServiceReplicaResourceModel{}
+}
+
+func (r SignalRServiceReplicaResource) ResourceType() string {
+	return "azurerm_signalr_service_replica"
+}
+
+func (r SignalRServiceReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			var model SignalRServiceReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			signalRServiceId, err := signalr.ParseSignalRID(model.SignalRServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := signalr.NewReplicaID(signalRServiceId.SubscriptionId, signalRServiceId.ResourceGroupName, signalRServiceId.SignalRName, model.Name)
+
+			locks.ByID(signalRServiceId.ID())
+			defer locks.UnlockByID(signalRServiceId.ID())
+
+			existing, err := client.ReplicasGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			replica := signalr.Replica{
+				Location: location.Normalize(model.Location),
+				Properties: &signalr.ReplicaProperties{
+					RegionEndpointEnabled: pointer.To(expandReplicaRegionEndpointEnabled(model.RegionEndpointEnabled)),
+					ResourceStopped:       pointer.To(strconv.FormatBool(!model.TrafficEnabled)),
+				},
+				Sku:  expandSignalRServiceReplicaSku(model.Sku),
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, replica); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SignalRServiceReplicaResourceModel{
+				Name:             id.ReplicaName,
+				SignalRServiceId: signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if sku := model.Sku; sku != nil {
+					state.Sku = []SignalRServiceReplicaSku{
+						{
+							Name:     sku.Name,
+							Capacity: pointer.From(sku.Capacity),
+						},
+					}
+				}
+
+				if props := model.Properties; props != nil {
+					state.RegionEndpointEnabled = !strings.EqualFold(pointer.From(props.RegionEndpointEnabled), "Disabled")
+					state.TrafficEnabled = !strings.EqualFold(pointer.From(props.ResourceStopped), "true")
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SignalRServiceReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			signalRServiceId := signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName)
+
+			locks.ByID(signalRServiceId.ID())
+			defer locks.UnlockByID(signalRServiceId.ID())
+
+			existing, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			replica := *existing.Model
+			if replica.Properties == nil {
+				replica.Properties = &signalr.ReplicaProperties{}
+			}
+			// `provisioningState` is read-only
+			replica.Properties.ProvisioningState = nil
+
+			if metadata.ResourceData.HasChange("sku") {
+				replica.Sku = expandSignalRServiceReplicaSku(model.Sku)
+			}
+
+			if metadata.ResourceData.HasChange("region_endpoint_enabled") {
+				replica.Properties.RegionEndpointEnabled = pointer.To(expandReplicaRegionEndpointEnabled(model.RegionEndpointEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("traffic_enabled") {
+				replica.Properties.ResourceStopped = pointer.To(strconv.FormatBool(!model.TrafficEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				replica.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.ReplicasUpdateThenPoll(ctx, *id, replica); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			signalRServiceId := signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName)
+
+			locks.ByID(signalRServiceId.ID())
+			defer locks.UnlockByID(signalRServiceId.ID())
+
+			if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return signalr.ValidateReplicaID
+}
+
+func expandSignalRServiceReplicaSku(input []SignalRServiceReplicaSku) *signalr.ResourceSku {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &signalr.ResourceSku{
+		Name:     input[0].Name,
+		Capacity: pointer.To(input[0].Capacity),
+	}
+}
+
+func expandReplicaRegionEndpointEnabled(input bool) string {
+	if input {
+		return "Enabled"
+	}
+	return "Disabled"
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SignalRServiceReplicaResource struct{}
+
+func TestAccSignalRServiceReplica_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_replica", "test")
+	r := SignalRServiceReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSignalRServiceReplica_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_replica", "test")
+	r := SignalRServiceReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSignalRServiceReplica_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_replica", "test")
+	r := SignalRServiceReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku.0.capacity").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SignalRServiceReplicaResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := signalr.ParseReplicaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SignalR.SignalRClient.ReplicasGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r SignalRServiceReplicaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_replica" "test" {
+  name               = "acctestreplica%d"
+  signalr_service_id = azurerm_signalr_service.test.id
+  location           = "%s"
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 1
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r SignalRServiceReplicaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_replica" "import" {
+  name               = azurerm_signalr_service_replica.test.name
+  signalr_service_id = azurerm_signalr_service_replica.test.signalr_service_id
+  location           = azurerm_signalr_service_replica.test.location
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 1
+  }
+}
+`, r.basic(data))
+}
+
+func (r SignalRServiceReplicaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_replica" "test" {
+  name                    = "acctestreplica%d"
+  signalr_service_id      = azurerm_signalr_service.test.id
+  location                = "%s"
+  region_endpoint_enabled = false
+  traffic_enabled         = false
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 2
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r SignalRServiceReplicaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-signalr-%d"
+  location = "%s"
+}
+
+resource "azurerm_signalr_service" "test" {
+  name                = "acctestSignalR-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,293 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WebPubSubReplicaResourceModel struct {
+	Name                  string            `tfschema:"name"`
+	WebPubSubId           string            `tfschema:"web_pubsub_id"`
+	Location              string            `tfschema:"location"`
+	Sku                   string            `tfschema:"sku"`
+	Capacity              int64             `tfschema:"capacity"`
+	RegionEndpointEnabled bool              `tfschema:"region_endpoint_enabled"`
+	TrafficEnabled        bool              `tfschema:"traffic_enabled"`
+	Tags                  map[string]string `tfschema:"tags"`
+}
+
+type WebPubSubReplicaResource struct{}
+
+var _ sdk.ResourceWithUpdate = WebPubSubReplicaResource{}
+
+func (r WebPubSubReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"web_pubsub_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: webpubsub.ValidateWebPubSubID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Premium_P1",
+				"Premium_P2",
+			}, false),
+		},
+
+		"capacity": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  1,
+			ValidateFunc: validation.IntInSlice([]int{
+				1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 200,
+				300, 400, 500, 600, 700, 800, 900, 1000,
+			}),
+		},
+
+		"region_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"traffic_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r WebPubSubReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebPubSubReplicaResource) ModelObject() interface{} {
+	return &WebPubSubReplicaResourceModel{}
+}
+
+func (r WebPubSubReplicaResource) ResourceType() string {
+	return "azurerm_web_pubsub_replica"
+}
+
+func (r WebPubSubReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			var model WebPubSubReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			webPubSubId, err := webpubsub.ParseWebPubSubID(model.WebPubSubId)
+			if err != nil {
+				return err
+			}
+
+			id := webpubsub.NewReplicaID(webPubSubId.SubscriptionId, webPubSubId.ResourceGroupName, webPubSubId.WebPubSubName, model.Name)
+
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			existing, err := client.ReplicasGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			replica := webpubsub.Replica{
+				Location: location.Normalize(model.Location),
+				Properties: &webpubsub.ReplicaProperties{
+					RegionEndpointEnabled: pointer.To(expandReplicaRegionEndpointEnabled(model.RegionEndpointEnabled)),
+					ResourceStopped:       pointer.To(strconv.FormatBool(!model.TrafficEnabled)),
+				},
+				Sku: &webpubsub.ResourceSku{
+					Name:     model.Sku,
+					Capacity: pointer.To(model.Capacity),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, replica); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WebPubSubReplicaResourceModel{
+				Name:        id.ReplicaName,
+				WebPubSubId: webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if sku := model.Sku; sku != nil {
+					state.Sku = sku.Name
+					state.Capacity = pointer.From(sku.Capacity)
+				}
+
+				if props := model.Properties; props != nil {
+					state.RegionEndpointEnabled = !strings.EqualFold(pointer.From(props.RegionEndpointEnabled), "Disabled")
+					state.TrafficEnabled = !strings.EqualFold(pointer.From(props.ResourceStopped), "true")
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WebPubSubReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			webPubSubId := webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName)
+
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			existing, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			replica := *existing.Model
+			if replica.Properties == nil {
+				replica.Properties = &webpubsub.ReplicaProperties{}
+			}
+			// `provisioningState` is read-only
+			replica.Properties.ProvisioningState = nil
+
+			if metadata.ResourceData.HasChanges("sku", "capacity") {
+				replica.Sku = &webpubsub.ResourceSku{
+					Name:     model.Sku,
+					Capacity: pointer.To(model.Capacity),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("region_endpoint_enabled") {
+				replica.Properties.RegionEndpointEnabled = pointer.To(expandReplicaRegionEndpointEnabled(model.RegionEndpointEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("traffic_enabled") {
+				replica.Properties.ResourceStopped = pointer.To(strconv.FormatBool(!model.TrafficEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				replica.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.ReplicasUpdateThenPoll(ctx, *id, replica); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.WebPubSubClient.WebPubSub
+
+			id, err := webpubsub.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			webPubSubId := webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName)
+
+			locks.ByID(webPubSubId.ID())
+			defer locks.UnlockByID(webPubSubId.ID())
+
+			if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebPubSubReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return webpubsub.ValidateReplicaID
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WebPubSubReplicaResource struct{}
+
+func TestAccWebPubSubReplica_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_replica", "test")
+	r := WebPubSubReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebPubSubReplica_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_replica", "test")
+	r := WebPubSubReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccWebPubSubReplica_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_replica", "test")
+	r := WebPubSubReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("capacity").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WebPubSubReplicaResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := webpubsub.ParseReplicaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SignalR.WebPubSubClient.WebPubSub.ReplicasGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r WebPubSubReplicaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_replica" "test" {
+  name               = "acctestreplica%d"
+  web_pubsub_id = azurerm_web_pubsub.test.id
+  location      = "%s"
+  sku           = "Premium_P1"
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r WebPubSubReplicaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_replica" "import" {
+  name          = azurerm_web_pubsub_replica.test.name
+  web_pubsub_id = azurerm_web_pubsub_replica.test.web_pubsub_id
+  location      = azurerm_web_pubsub_replica.test.location
+  sku           = azurerm_web_pubsub_replica.test.sku
+}
+`, r.basic(data))
+}
+
+func (r WebPubSubReplicaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_replica" "test" {
+  name                    = "acctestreplica%d"
+  web_pubsub_id           = azurerm_web_pubsub.test.id
+  location                = "%s"
+  sku                     = "Premium_P1"
+  capacity                = 2
+  region_endpoint_enabled = false
+  traffic_enabled         = false
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r WebPubSubReplicaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-wps-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_pubsub" "test" {
+  name                = "acctestWebPubSub-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium_P1"
+  capacity            = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
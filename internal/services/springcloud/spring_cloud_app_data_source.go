@@ -4,9 +4,11 @@
 package springcloud
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/jackofallops/kermit/sdk/appplatform/2023-05-01-preview/appplatform"
 )
 
 func dataSourceSpringCloudApp() *pluginsdk.Resource {
@@ -42,6 +45,91 @@ func dataSourceSpringCloudApp() *pluginsdk.Resource {
 				ValidateFunc: validate.SpringCloudServiceName,
 			},
 
+			"active_deployment": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"arguments": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"commands": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"environment_variables": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"image": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"instance_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"jvm_options": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"quota": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"cpu": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"memory": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"runtime_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"server": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"addon_json": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -92,6 +180,7 @@ func dataSourceSpringCloudApp() *pluginsdk.Resource {
 
 func dataSourceSpringCloudAppRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppPlatform.AppsClient
+	deploymentsClient := meta.(*clients.Client).AppPlatform.DeploymentsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -120,6 +209,7 @@ func dataSourceSpringCloudAppRead(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	if prop := resp.Properties; prop != nil {
+		d.Set("addon_json", flattenSpringCloudAppAddon(prop.AddonConfigs))
 		d.Set("fqdn", prop.Fqdn)
 		d.Set("https_only", prop.HTTPSOnly)
 		d.Set("is_public", prop.Public)
@@ -131,5 +221,81 @@ func dataSourceSpringCloudAppRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	activeDeployment, err := getSpringCloudAppActiveDeployment(ctx, deploymentsClient, id)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("active_deployment", flattenSpringCloudAppActiveDeployment(activeDeployment)); err != nil {
+		return fmt.Errorf("setting `active_deployment`: %s", err)
+	}
+
 	return nil
 }
+
+// getSpringCloudAppActiveDeployment returns the active deployment of the app, or nil when no deployment is active
+func getSpringCloudAppActiveDeployment(ctx context.Context, client *appplatform.DeploymentsClient, id parse.SpringCloudAppId) (*appplatform.DeploymentResource, error) {
+	it, err := client.ListComplete(ctx, id.ResourceGroup, id.SpringName, id.AppName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing deployments for %s: %+v", id, err)
+	}
+	for it.NotDone() {
+		value := it.Value()
+		if value.Properties != nil && value.Properties.Active != nil && *value.Properties.Active {
+			return &value, nil
+		}
+		if err := it.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing deployments for %s: %+v", id, err)
+		}
+	}
+	return nil, nil
+}
+
+func flattenSpringCloudAppActiveDeployment(input *appplatform.DeploymentResource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	instanceCount := 0
+	if input.Sku != nil && input.Sku.Capacity != nil {
+		instanceCount = int(*input.Sku.Capacity)
+	}
+
+	environmentVariables := make(map[string]interface{})
+	quota := make([]interface{}, 0)
+	arguments := make([]interface{}, 0)
+	commands := make([]interface{}, 0)
+	var image, jvmOptions, runtimeVersion, server string
+	if props := input.Properties; props != nil {
+		if settings := props.DeploymentSettings; settings != nil {
+			environmentVariables = flattenSpringCloudDeploymentEnvironmentVariables(settings.EnvironmentVariables)
+			quota = flattenSpringCloudDeploymentResourceRequests(settings.ResourceRequests)
+		}
+		if source, ok := props.Source.AsJarUploadedUserSourceInfo(); ok && source != nil {
+			jvmOptions = pointer.From(source.JvmOptions)
+			runtimeVersion = pointer.From(source.RuntimeVersion)
+		}
+		if source, ok := props.Source.AsCustomContainerUserSourceInfo(); ok && source != nil {
+			if container := source.CustomContainer; container != nil {
+				server = pointer.From(container.Server)
+				image = pointer.From(container.ContainerImage)
+				arguments = utils.FlattenStringSlice(container.Args)
+				commands = utils.FlattenStringSlice(container.Command)
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                  pointer.From(input.Name),
+			"arguments":             arguments,
+			"commands":              commands,
+			"environment_variables": environmentVariables,
+			"image":                 image,
+			"instance_count":        instanceCount,
+			"jvm_options":           jvmOptions,
+			"quota":                 quota,
+			"runtime_version":       runtimeVersion,
+			"server":                server,
+		},
+	}
+}
@@ -27,6 +27,23 @@ func TestAccDataSourceSpringCloudApp_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceSpringCloudApp_activeDeployment(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_spring_cloud_app", "test")
+	r := SpringCloudAppDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.activeDeployment(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("active_deployment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("active_deployment.0.name").Exists(),
+				check.That(data.ResourceName).Key("active_deployment.0.instance_count").Exists(),
+				check.That(data.ResourceName).Key("active_deployment.0.quota.#").HasValue("1"),
+			),
+		},
+	})
+}
+
 func (SpringCloudAppDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -38,3 +55,17 @@ data "azurerm_spring_cloud_app" "test" {
 }
 `, SpringCloudAppResource{}.complete(data))
 }
+
+func (SpringCloudAppDataSource) activeDeployment(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_spring_cloud_app" "test" {
+  name                = azurerm_spring_cloud_app.test.name
+  resource_group_name = azurerm_spring_cloud_app.test.resource_group_name
+  service_name        = azurerm_spring_cloud_app.test.service_name
+
+  depends_on = [azurerm_spring_cloud_active_deployment.test]
+}
+`, SpringCloudActiveDeploymentResource{}.basic(data))
+}
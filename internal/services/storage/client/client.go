@@ -31,7 +31,12 @@ type Client struct {
 	SyncServerEndpointsClient  *serverendpointresource.ServerEndpointResourceClient
 	SyncServiceClient          *storagesyncservicesresource.StorageSyncServicesResourceClient
 
-	authConfigForAzureAD *auth.Credentials
+	authConfig     *auth.Credentials
+	authorizerFunc common.ApiAuthorizerFunc
+
+	// useAzureADForDataPlane specifies whether Entra ID authentication should be preferred for Data Plane operations
+	// on all Storage Accounts, rather than only those where Shared Key access has been disabled
+	useAzureADForDataPlane bool
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -91,10 +96,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SyncGroupsClient:           syncGroupsClient,
 
 		StorageDomainSuffix: *storageSuffix,
+
+		authConfig:             o.AuthConfig,
+		useAzureADForDataPlane: o.StorageUseAzureAD,
 	}
 
-	if o.StorageUseAzureAD {
-		client.authConfigForAzureAD = o.AuthConfig
+	if o.Authorizers != nil {
+		client.authorizerFunc = o.Authorizers.AuthorizerFunc
 	}
 
 	return &client, nil
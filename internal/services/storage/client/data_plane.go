@@ -45,9 +45,12 @@ func (Client) DataPlaneOperationSupportingOnlySharedKeyAuth() DataPlaneOperation
 }
 
 func (c Client) configureDataPlane(ctx context.Context, clientName, resourceIdentifier string, baseClient client.BaseClient, account AccountDetails, operation DataPlaneOperation) error {
-	if operation.SupportsAadAuthentication && c.authConfigForAzureAD != nil {
-		api := c.authConfigForAzureAD.Environment.Storage.WithResourceIdentifier(resourceIdentifier)
-		storageAuth, err := auth.NewAuthorizerFromCredentials(ctx, *c.authConfigForAzureAD, api)
+	// Entra ID authentication is used when it's been requested in the Provider block, or when Shared Key access has
+	// been disabled on the Storage Account, since in that case retrieving (and using) the Account Key would fail
+	useAzureAD := c.useAzureADForDataPlane || !account.SharedKeyAccessEnabled
+	if operation.SupportsAadAuthentication && useAzureAD && c.authConfig != nil && c.authorizerFunc != nil {
+		api := c.authConfig.Environment.Storage.WithResourceIdentifier(resourceIdentifier)
+		storageAuth, err := c.authorizerFunc(api)
 		if err != nil {
 			return fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 		}
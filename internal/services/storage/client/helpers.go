@@ -37,6 +37,9 @@ type AccountDetails struct {
 	IsHnsEnabled     bool
 	StorageAccountId commonids.StorageAccountId
 
+	// SharedKeyAccessEnabled specifies whether requests to the Data Plane API can be authorized using the Account Key
+	SharedKeyAccessEnabled bool
+
 	accountKey *string
 
 	// primaryBlobEndpoint is the Primary Blob Endpoint for the Data Plane API for this Storage Account
@@ -227,6 +230,8 @@ func populateAccountDetails(accountId commonids.StorageAccountId, account storag
 
 	props := *account.Properties
 	out.IsHnsEnabled = pointer.From(props.IsHnsEnabled)
+	// the API omits this field when Shared Key access hasn't been explicitly configured, which defaults to enabled
+	out.SharedKeyAccessEnabled = props.AllowSharedKeyAccess == nil || *props.AllowSharedKeyAccess
 
 	endpoints := *props.PrimaryEndpoints
 	if endpoints.Blob != nil {
@@ -82,6 +82,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 		storageTableDataSource{},
 		storageTableEntitiesDataSource{},
 		storageContainersDataSource{},
+		StorageSyncRegisteredServerDataSource{},
 	}
 }
 
@@ -91,6 +92,7 @@ func (r Registration) Resources() []sdk.Resource {
 		AccountStaticWebsiteResource{},
 		LocalUserResource{},
 		StorageContainerImmutabilityPolicyResource{},
+		StorageSyncCloudEndpointChangeDetectionTriggerResource{},
 		SyncServerEndpointResource{},
 	}
 }
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// storageAccountFeatures contains the values which determine which combinations of features a Storage Account supports.
+// Fields are nil when the value isn't known at plan time, in which case the rules depending on them are skipped and the
+// API remains the final arbiter.
+type storageAccountFeatures struct {
+	AccountKind            *storageaccounts.Kind
+	AccountTier            *storageaccounts.SkuTier
+	ReplicationType        *string
+	AccessTier             *string
+	HnsEnabled             *bool
+	NfsV3Enabled           *bool
+	SftpEnabled            *bool
+	LocalUserEnabled       *bool
+	LargeFileShareEnabled  *bool
+	QueueEncryptionKeyType *storageaccounts.KeyType
+	TableEncryptionKeyType *storageaccounts.KeyType
+}
+
+func storageAccountFeaturesFromDiff(d *pluginsdk.ResourceDiff) storageAccountFeatures {
+	knownString := func(key string) *string {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		return pointer.To(d.Get(key).(string))
+	}
+	knownBool := func(key string) *bool {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		return pointer.To(d.Get(key).(bool))
+	}
+
+	features := storageAccountFeatures{
+		ReplicationType:       knownString("account_replication_type"),
+		AccessTier:            knownString("access_tier"),
+		HnsEnabled:            knownBool("is_hns_enabled"),
+		NfsV3Enabled:          knownBool("nfsv3_enabled"),
+		SftpEnabled:           knownBool("sftp_enabled"),
+		LocalUserEnabled:      knownBool("local_user_enabled"),
+		LargeFileShareEnabled: knownBool("large_file_share_enabled"),
+	}
+	if v := knownString("account_kind"); v != nil {
+		features.AccountKind = pointer.To(storageaccounts.Kind(*v))
+	}
+	if v := knownString("account_tier"); v != nil {
+		features.AccountTier = pointer.To(storageaccounts.SkuTier(*v))
+	}
+	if v := knownString("queue_encryption_key_type"); v != nil {
+		features.QueueEncryptionKeyType = pointer.To(storageaccounts.KeyType(*v))
+	}
+	if v := knownString("table_encryption_key_type"); v != nil {
+		features.TableEncryptionKeyType = pointer.To(storageaccounts.KeyType(*v))
+	}
+
+	return features
+}
+
+// Validate checks the combination of features against the matrix supported by the API, returning all of the conflicts
+// at once so that they can be fixed at plan time rather than one API error at a time part way through an apply.
+func (f storageAccountFeatures) Validate() error {
+	errs := make([]error, 0)
+
+	kind := f.AccountKind
+	tier := f.AccountTier
+
+	if kind != nil && tier != nil && *kind == storageaccounts.KindBlockBlobStorage && *tier != storageaccounts.SkuTierPremium {
+		errs = append(errs, fmt.Errorf("`account_tier` must be `Premium` when `account_kind` is `%s`", *kind))
+	}
+
+	if tier != nil && f.ReplicationType != nil && *tier == storageaccounts.SkuTierPremium {
+		if replication := strings.ToUpper(*f.ReplicationType); replication != "LRS" && replication != "ZRS" {
+			errs = append(errs, fmt.Errorf("`account_replication_type` must be `LRS` or `ZRS` when `account_tier` is `Premium`, got `%s`", *f.ReplicationType))
+		}
+	}
+
+	if kind != nil && f.ReplicationType != nil && *kind == storageaccounts.KindBlobStorage && strings.EqualFold(*f.ReplicationType, "ZRS") {
+		errs = append(errs, fmt.Errorf("`account_replication_type` of `ZRS` isn't supported when `account_kind` is `%s`", *kind))
+	}
+
+	if kind != nil && pointer.From(f.AccessTier) != "" {
+		if _, ok := storageKindsSupportsSkuTier[*kind]; !ok {
+			errs = append(errs, fmt.Errorf("`access_tier` is only available for accounts where `account_kind` is set to one of: %s", strings.Join(sortedKeysFromSlice(storageKindsSupportsSkuTier), " / ")))
+		}
+	}
+
+	if kind != nil && pointer.From(f.HnsEnabled) {
+		if _, ok := storageKindsSupportHns[*kind]; !ok {
+			errs = append(errs, fmt.Errorf("`is_hns_enabled` can only be used for accounts with `account_kind` set to one of: %s", strings.Join(sortedKeysFromSlice(storageKindsSupportHns), " / ")))
+		}
+	}
+
+	if kind != nil && pointer.From(f.LargeFileShareEnabled) {
+		if _, ok := storageKindsSupportLargeFileShares[*kind]; !ok {
+			errs = append(errs, fmt.Errorf("`large_file_share_enabled` can only be set to `true` with `account_kind` set to one of: %s", strings.Join(sortedKeysFromSlice(storageKindsSupportLargeFileShares), " / ")))
+		}
+	}
+
+	if pointer.From(f.SftpEnabled) {
+		if f.HnsEnabled != nil && !*f.HnsEnabled {
+			errs = append(errs, errors.New("`sftp_enabled` can only be used when `is_hns_enabled` is `true`"))
+		}
+
+		// SFTP clients authenticate exclusively as local users
+		if f.LocalUserEnabled != nil && !*f.LocalUserEnabled {
+			errs = append(errs, errors.New("`sftp_enabled` can only be used when `local_user_enabled` is `true`"))
+		}
+	}
+
+	// NFSv3 is supported for standard general-purpose v2 storage accounts and for premium block blob storage accounts.
+	// (https://learn.microsoft.com/azure/storage/blobs/network-file-system-protocol-support-how-to)
+	if pointer.From(f.NfsV3Enabled) {
+		if f.HnsEnabled != nil && !*f.HnsEnabled {
+			errs = append(errs, errors.New("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`"))
+		}
+
+		if kind != nil && tier != nil {
+			isPremiumTierAndBlockBlobStorageKind := *tier == storageaccounts.SkuTierPremium && *kind == storageaccounts.KindBlockBlobStorage
+			isStandardTierAndStorageV2Kind := *tier == storageaccounts.SkuTierStandard && *kind == storageaccounts.KindStorageVTwo
+			if !isPremiumTierAndBlockBlobStorageKind && !isStandardTierAndStorageV2Kind {
+				errs = append(errs, errors.New("`nfsv3_enabled` can only be used with `account_tier` `Standard` and `account_kind` `StorageV2`, or `account_tier` `Premium` and `account_kind` `BlockBlobStorage`"))
+			}
+		}
+	}
+
+	if kind != nil && *kind == storageaccounts.KindStorage {
+		if pointer.From(f.QueueEncryptionKeyType) == storageaccounts.KeyTypeAccount {
+			errs = append(errs, fmt.Errorf("`queue_encryption_key_type` cannot be `%s` when `account_kind` is `%s`", storageaccounts.KeyTypeAccount, storageaccounts.KindStorage))
+		}
+		if pointer.From(f.TableEncryptionKeyType) == storageaccounts.KeyTypeAccount {
+			errs = append(errs, fmt.Errorf("`table_encryption_key_type` cannot be `%s` when `account_kind` is `%s`", storageaccounts.KeyTypeAccount, storageaccounts.KindStorage))
+		}
+	}
+
+	return errors.Join(errs...)
+}
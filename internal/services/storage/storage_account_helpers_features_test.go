@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storageaccounts"
+)
+
+func TestStorageAccountFeaturesValidate(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Features storageAccountFeatures
+		Errors   []string
+	}{
+		{
+			Name:     "Unknown",
+			Features: storageAccountFeatures{},
+		},
+		{
+			Name: "Standard StorageV2 with NFSv3 and SFTP",
+			Features: storageAccountFeatures{
+				AccountKind:      pointer.To(storageaccounts.KindStorageVTwo),
+				AccountTier:      pointer.To(storageaccounts.SkuTierStandard),
+				ReplicationType:  pointer.To("LRS"),
+				HnsEnabled:       pointer.To(true),
+				NfsV3Enabled:     pointer.To(true),
+				SftpEnabled:      pointer.To(true),
+				LocalUserEnabled: pointer.To(true),
+			},
+		},
+		{
+			Name: "Premium BlockBlobStorage with NFSv3",
+			Features: storageAccountFeatures{
+				AccountKind:     pointer.To(storageaccounts.KindBlockBlobStorage),
+				AccountTier:     pointer.To(storageaccounts.SkuTierPremium),
+				ReplicationType: pointer.To("ZRS"),
+				HnsEnabled:      pointer.To(true),
+				NfsV3Enabled:    pointer.To(true),
+			},
+		},
+		{
+			Name: "Standard BlockBlobStorage",
+			Features: storageAccountFeatures{
+				AccountKind: pointer.To(storageaccounts.KindBlockBlobStorage),
+				AccountTier: pointer.To(storageaccounts.SkuTierStandard),
+			},
+			Errors: []string{"`account_tier` must be `Premium`"},
+		},
+		{
+			Name: "Premium GRS",
+			Features: storageAccountFeatures{
+				AccountTier:     pointer.To(storageaccounts.SkuTierPremium),
+				ReplicationType: pointer.To("GRS"),
+			},
+			Errors: []string{"`account_replication_type` must be `LRS` or `ZRS`"},
+		},
+		{
+			Name: "BlobStorage ZRS",
+			Features: storageAccountFeatures{
+				AccountKind:     pointer.To(storageaccounts.KindBlobStorage),
+				ReplicationType: pointer.To("ZRS"),
+			},
+			Errors: []string{"`account_replication_type` of `ZRS` isn't supported"},
+		},
+		{
+			Name: "Storage with Access Tier and HNS",
+			Features: storageAccountFeatures{
+				AccountKind: pointer.To(storageaccounts.KindStorage),
+				AccessTier:  pointer.To("Hot"),
+				HnsEnabled:  pointer.To(true),
+			},
+			Errors: []string{"`access_tier` is only available", "`is_hns_enabled` can only be used"},
+		},
+		{
+			Name: "BlockBlobStorage with Large File Shares",
+			Features: storageAccountFeatures{
+				AccountKind:           pointer.To(storageaccounts.KindBlockBlobStorage),
+				AccountTier:           pointer.To(storageaccounts.SkuTierPremium),
+				LargeFileShareEnabled: pointer.To(true),
+			},
+			Errors: []string{"`large_file_share_enabled` can only be set"},
+		},
+		{
+			Name: "SFTP without HNS or Local Users",
+			Features: storageAccountFeatures{
+				HnsEnabled:       pointer.To(false),
+				SftpEnabled:      pointer.To(true),
+				LocalUserEnabled: pointer.To(false),
+			},
+			Errors: []string{"`sftp_enabled` can only be used when `is_hns_enabled`", "`sftp_enabled` can only be used when `local_user_enabled`"},
+		},
+		{
+			Name: "SFTP with unknown HNS",
+			Features: storageAccountFeatures{
+				SftpEnabled: pointer.To(true),
+			},
+		},
+		{
+			Name: "NFSv3 on Premium StorageV2 without HNS",
+			Features: storageAccountFeatures{
+				AccountKind:  pointer.To(storageaccounts.KindStorageVTwo),
+				AccountTier:  pointer.To(storageaccounts.SkuTierPremium),
+				HnsEnabled:   pointer.To(false),
+				NfsV3Enabled: pointer.To(true),
+			},
+			Errors: []string{"`nfsv3_enabled` can only be used when `is_hns_enabled`", "`nfsv3_enabled` can only be used with `account_tier`"},
+		},
+		{
+			Name: "Storage with Account Encryption Key Types",
+			Features: storageAccountFeatures{
+				AccountKind:            pointer.To(storageaccounts.KindStorage),
+				QueueEncryptionKeyType: pointer.To(storageaccounts.KeyTypeAccount),
+				TableEncryptionKeyType: pointer.To(storageaccounts.KeyTypeAccount),
+			},
+			Errors: []string{"`queue_encryption_key_type` cannot be `Account`", "`table_encryption_key_type` cannot be `Account`"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Features.Validate()
+			if len(tc.Errors) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error but got: %+v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected %d errors but got none", len(tc.Errors))
+			}
+
+			if actual := len(strings.Split(err.Error(), "\n")); actual != len(tc.Errors) {
+				t.Fatalf("expected %d errors but got %d: %+v", len(tc.Errors), actual, err)
+			}
+
+			for _, expected := range tc.Errors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error containing %q but got: %+v", expected, err)
+				}
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/deletionprotection"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -79,7 +80,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 			3: migration.AccountV3ToV4{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.StorageAccountId{}),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&commonids.StorageAccountId{}, deletionprotection.Import),
 
 		Identity: &schema.ResourceIdentity{
 			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.StorageAccountId{}),
@@ -1088,6 +1089,13 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"adopt_existing": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"deletion_protection_enabled": deletionprotection.Schema(),
+
 			"tags": {
 				// TODO: introduce/refactor this to use a `commonschema.TagsOptionalWith(a, b, c)` to enable us to handle this in one place
 				Type:         pluginsdk.TypeMap,
@@ -1120,14 +1128,6 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
-				if d.Get("access_tier") != "" {
-					accountKind := storageaccounts.Kind(d.Get("account_kind").(string))
-					if _, ok := storageKindsSupportsSkuTier[accountKind]; !ok {
-						keys := sortedKeysFromSlice(storageKindsSupportsSkuTier)
-						return fmt.Errorf("`access_tier` is only available for accounts where `kind` is set to one of: %+v", strings.Join(keys, " / "))
-					}
-				}
-
 				if !features.FivePointOh() && !v.(*clients.Client).Features.Storage.DataPlaneAvailable {
 					rawQueueProperties, diags := d.GetRawConfigAt(sdk.ConstructCtyPath("queue_properties"))
 					if diags.HasError() {
@@ -1172,12 +1172,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
-				isHnsEnabled := d.Get("is_hns_enabled").(bool)
-				accountKind := storageaccounts.Kind(d.Get("account_kind").(string))
-				if _, supportsHns := storageKindsSupportHns[accountKind]; !supportsHns && isHnsEnabled {
-					keys := sortedKeysFromSlice(storageKindsSupportHns)
-					return fmt.Errorf("`is_hns_enabled` can only be used for accounts with `account_kind` set to one of: %+v", strings.Join(keys, " / "))
+				if err := storageAccountFeaturesFromDiff(d).Validate(); err != nil {
+					return fmt.Errorf("validating the features of the Storage Account: %+v", err)
 				}
+
 				return nil
 			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
@@ -1389,8 +1387,6 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	defer cancel()
 
 	id := commonids.NewStorageAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	locks.ByName(id.StorageAccountName, storageAccountResourceName)
-	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
 	existing, err := client.GetProperties(ctx, id, storageaccounts.DefaultGetPropertiesOperationOptions())
 	if err != nil {
@@ -1399,9 +1395,26 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 	if !response.WasNotFound(existing.HttpResponse) {
-		return tf.ImportAsExistsError("azurerm_storage_account", id.ID())
+		if !d.Get("adopt_existing").(bool) {
+			return tf.ImportAsExistsError("azurerm_storage_account", id.ID())
+		}
+
+		// the adopted Storage Account is brought in line with the configuration as an update, which isn't possible when
+		// any of the arguments which can't be updated differ
+		if model := existing.Model; model != nil {
+			if mismatched := storageAccountAdoptExistingMismatches(d, *model); len(mismatched) > 0 {
+				return tf.AdoptExistingMismatchError("azurerm_storage_account", id.ID(), mismatched)
+			}
+		}
+
+		log.Printf("[DEBUG] `adopt_existing` is enabled - adopting the existing %s", id)
+		d.SetId(id.ID())
+		return resourceStorageAccountUpdateExisting(ctx, d, meta)
 	}
 
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
 	accountKind := storageaccounts.Kind(d.Get("account_kind").(string))
 	accountTier := storageaccounts.SkuTier(d.Get("account_tier").(string))
 	provisionedBillingModelVersion := d.Get("provisioned_billing_model_version").(string)
@@ -1712,16 +1725,86 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.Get("deletion_protection_enabled").(bool) {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceStorageAccountRead(d, meta)
 }
 
+// storageAccountAdoptExistingMismatches returns the arguments which can't be updated, where the existing Storage
+// Account differs from the configuration
+func storageAccountAdoptExistingMismatches(d *pluginsdk.ResourceData, account storageaccounts.StorageAccount) []string {
+	existing := map[string]interface{}{
+		"location":                          location.Normalize(account.Location),
+		"edge_zone":                         flattenEdgeZone(account.ExtendedLocation),
+		"account_tier":                      "",
+		"provisioned_billing_model_version": "",
+		"is_hns_enabled":                    false,
+		"nfsv3_enabled":                     false,
+		"dns_endpoint_type":                 string(storageaccounts.DnsEndpointTypeStandard),
+		"infrastructure_encryption_enabled": false,
+		"queue_encryption_key_type":         string(storageaccounts.KeyTypeService),
+		"table_encryption_key_type":         string(storageaccounts.KeyTypeService),
+	}
+
+	if sku := account.Sku; sku != nil && sku.Tier != nil {
+		existing["account_tier"] = string(*sku.Tier)
+		existing["provisioned_billing_model_version"] = strings.TrimPrefix(strings.Split(string(sku.Name), "_")[0], string(*sku.Tier))
+	}
+
+	if props := account.Properties; props != nil {
+		existing["is_hns_enabled"] = pointer.From(props.IsHnsEnabled)
+		existing["nfsv3_enabled"] = pointer.From(props.IsNfsV3Enabled)
+		if props.DnsEndpointType != nil {
+			existing["dns_endpoint_type"] = string(*props.DnsEndpointType)
+		}
+		if encryption := props.Encryption; encryption != nil {
+			existing["infrastructure_encryption_enabled"] = pointer.From(encryption.RequireInfrastructureEncryption)
+			if encryption.Services != nil {
+				if encryption.Services.Queue != nil && encryption.Services.Queue.KeyType != nil {
+					existing["queue_encryption_key_type"] = string(*encryption.Services.Queue.KeyType)
+				}
+				if encryption.Services.Table != nil && encryption.Services.Table.KeyType != nil {
+					existing["table_encryption_key_type"] = string(*encryption.Services.Table.KeyType)
+				}
+			}
+		}
+	}
+
+	mismatched := make([]string, 0)
+	for _, key := range []string{"location", "edge_zone", "account_tier", "provisioned_billing_model_version", "is_hns_enabled", "nfsv3_enabled", "dns_endpoint_type", "infrastructure_encryption_enabled", "queue_encryption_key_type", "table_encryption_key_type"} {
+		configured := d.Get(key)
+		switch key {
+		case "location":
+			configured = location.Normalize(configured.(string))
+		case "edge_zone":
+			configured = edgezones.Normalize(configured.(string))
+		}
+		if !strings.EqualFold(fmt.Sprint(configured), fmt.Sprint(existing[key])) {
+			mismatched = append(mismatched, key)
+		}
+	}
+
+	return mismatched
+}
+
 func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	return resourceStorageAccountUpdateExisting(ctx, d, meta)
+}
+
+// resourceStorageAccountUpdateExisting applies the configuration to an existing Storage Account, which is used both
+// when updating and when adopting an existing Storage Account during creation
+func resourceStorageAccountUpdateExisting(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) error {
 	tenantId := meta.(*clients.Client).Account.TenantId
 	storageClient := meta.(*clients.Client).Storage.ResourceManager
 	client := storageClient.StorageAccounts
 	keyVaultClient := meta.(*clients.Client).KeyVault
-	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
-	defer cancel()
 
 	id, err := commonids.ParseStorageAccountID(d.Id())
 	if err != nil {
@@ -1731,6 +1814,16 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.ByName(id.StorageAccountName, storageAccountResourceName)
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
+	if pluginsdk.HasOnlyTagsChange(d) {
+		updated, err := pluginsdk.UpdateTagsOnly(ctx, meta.(*clients.Client).Resource.TagsClient, id.ID(), d)
+		if err != nil {
+			return err
+		}
+		if updated {
+			return resourceStorageAccountRead(d, meta)
+		}
+	}
+
 	accountTier := storageaccounts.SkuTier(d.Get("account_tier").(string))
 	provisionedBillingModelVersion := d.Get("provisioned_billing_model_version").(string)
 	replicationType := d.Get("account_replication_type").(string)
@@ -2083,6 +2176,12 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("deletion_protection_enabled") {
+		if err := deletionprotection.Set(ctx, meta.(*clients.Client).Resource.LocksClient, id.ID(), d.Get("deletion_protection_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	return resourceStorageAccountRead(d, meta)
 }
 
@@ -2134,6 +2233,9 @@ func resourceStorageAccountFlatten(ctx context.Context, d *pluginsdk.ResourceDat
 		return fmt.Errorf("unable to locate %q", id)
 	}
 
+	// only the ID is available when importing, so this must be checked prior to setting any values
+	hasPriorState := d.Get("name").(string) != ""
+
 	d.Set("name", id.StorageAccountName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
@@ -2307,6 +2409,10 @@ func resourceStorageAccountFlatten(ctx context.Context, d *pluginsdk.ResourceDat
 		return err
 	}
 
+	if err := deletionprotection.FlattenAndSet(ctx, d, meta.(*clients.Client).Resource.LocksClient, id.ID()); err != nil {
+		return fmt.Errorf("setting `deletion_protection_enabled`: %+v", err)
+	}
+
 	endpoints := flattenAccountEndpoints(primaryEndpoints, secondaryEndpoints, routingPreference)
 	endpoints.set(d)
 
@@ -2380,8 +2486,13 @@ func resourceStorageAccountFlatten(ctx context.Context, d *pluginsdk.ResourceDat
 			return fmt.Errorf("setting `queue_properties`: %+v", err)
 		}
 
+		// the `static_website` block is superseded by the `azurerm_storage_account_static_website` resource, as such the
+		// Blob endpoint is only probed when the block is present in the state - or when there's no prior state to go on
+		// (e.g. when importing, where only the ID has been set) - to avoid requiring Data Plane access when it isn't used
+		probeStaticWebsite := supportLevel.supportStaticWebsite && (!hasPriorState || len(d.Get("static_website").([]interface{})) > 0)
+
 		staticWebsiteProperties := make([]interface{}, 0)
-		if supportLevel.supportStaticWebsite {
+		if probeStaticWebsite {
 			accountsClient, err := dataPlaneClient.AccountsDataPlaneClient(ctx, *details, dataPlaneClient.DataPlaneOperationSupportingAnyAuthMethod())
 			if err != nil {
 				return fmt.Errorf("building Accounts Data Plane Client: %s", err)
@@ -2416,6 +2527,10 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if err := deletionprotection.EnsureDeletable(d, id.ID()); err != nil {
+		return err
+	}
+
 	locks.ByName(id.StorageAccountName, storageAccountResourceName)
 	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
 
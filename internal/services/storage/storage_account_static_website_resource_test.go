@@ -99,6 +99,21 @@ func TestStorageAccountStaticWebsiteResource_withIndex(t *testing.T) {
 	})
 }
 
+func TestStorageAccountStaticWebsiteResource_sharedAccessKeyDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sharedAccessKeyDisabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageAccountStaticWebsiteResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := commonids.ParseStorageAccountID(state.ID)
 	if err != nil {
@@ -164,6 +179,35 @@ resource "azurerm_storage_account_static_website" "test" {
 `, r.template(data))
 }
 
+func (r StorageAccountStaticWebsiteResource) sharedAccessKeyDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                  = azurerm_resource_group.test.location
+  account_tier              = "Standard"
+  account_replication_type  = "LRS"
+  shared_access_key_enabled = false
+}
+
+resource "azurerm_storage_account_static_website" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  error_404_document = "sadpanda.html"
+  index_document     = "index.html"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StorageAccountStaticWebsiteResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -126,6 +126,12 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 										},
 									},
 
+									"creation_time_last_n_days": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 36500),
+									},
+
 									"include_blob_versions": {
 										Type:     pluginsdk.TypeBool,
 										Optional: true,
@@ -317,6 +323,12 @@ func expandBlobInventoryPolicyFilter(input []interface{}, objectType string) (*b
 		IncludeSnapshots:    pointer.To(v["include_snapshots"].(bool)),
 	}
 
+	if lastNDays := v["creation_time_last_n_days"].(int); lastNDays != 0 {
+		policyFilter.CreationTime = &blobinventorypolicies.BlobInventoryCreationTime{
+			LastNDays: pointer.To(int64(lastNDays)),
+		}
+	}
+
 	// If the objectType is Container, the following values must be nil when passed to the API
 	if objectType == string(blobinventorypolicies.ObjectTypeContainer) {
 		if len(*policyFilter.BlobTypes) > 0 || *policyFilter.IncludeBlobVersions || *policyFilter.IncludeSnapshots {
@@ -371,14 +383,19 @@ func flattenBlobInventoryPolicyFilter(input *blobinventorypolicies.BlobInventory
 	if input.IncludeSnapshots != nil {
 		includeSnapshots = *input.IncludeSnapshots
 	}
+	var creationTimeLastNDays int64
+	if input.CreationTime != nil {
+		creationTimeLastNDays = pointer.From(input.CreationTime.LastNDays)
+	}
 	return []interface{}{
 		map[string]interface{}{
-			"blob_types":            utils.FlattenStringSlice(input.BlobTypes),
-			"include_blob_versions": includeBlobVersions,
-			"include_deleted":       includeDeleted,
-			"include_snapshots":     includeSnapshots,
-			"prefix_match":          utils.FlattenStringSlice(input.PrefixMatch),
-			"exclude_prefixes":      utils.FlattenStringSlice(input.ExcludePrefix),
+			"blob_types":                utils.FlattenStringSlice(input.BlobTypes),
+			"creation_time_last_n_days": creationTimeLastNDays,
+			"include_blob_versions":     includeBlobVersions,
+			"include_deleted":           includeDeleted,
+			"include_snapshots":         includeSnapshots,
+			"prefix_match":              utils.FlattenStringSlice(input.PrefixMatch),
+			"exclude_prefixes":          utils.FlattenStringSlice(input.ExcludePrefix),
 		},
 	}
 }
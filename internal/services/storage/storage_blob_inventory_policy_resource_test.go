@@ -235,12 +235,13 @@ resource "azurerm_storage_blob_inventory_policy" "test" {
       "RemainingRetentionDays",
     ]
     filter {
-      blob_types            = ["blockBlob", "pageBlob"]
-      include_blob_versions = true
-      include_deleted       = true
-      include_snapshots     = true
-      prefix_match          = ["*/test"]
-      exclude_prefixes      = ["syslog.log"]
+      blob_types                = ["blockBlob", "pageBlob"]
+      creation_time_last_n_days = 30
+      include_blob_versions     = true
+      include_deleted           = true
+      include_snapshots         = true
+      prefix_match              = ["*/test"]
+      exclude_prefixes          = ["syslog.log"]
     }
   }
 }
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/cloudendpointresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageSyncCloudEndpointChangeDetectionTriggerResource struct{}
+
+var _ sdk.Resource = StorageSyncCloudEndpointChangeDetectionTriggerResource{}
+
+type StorageSyncCloudEndpointChangeDetectionTriggerResourceModel struct {
+	StorageSyncCloudEndpointId string            `tfschema:"storage_sync_cloud_endpoint_id"`
+	ChangeDetectionMode        string            `tfschema:"change_detection_mode"`
+	DirectoryPath              string            `tfschema:"directory_path"`
+	Paths                      []string          `tfschema:"paths"`
+	Triggers                   map[string]string `tfschema:"triggers"`
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) ResourceType() string {
+	return "azurerm_storage_sync_cloud_endpoint_change_detection_trigger"
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) ModelObject() interface{} {
+	return &StorageSyncCloudEndpointChangeDetectionTriggerResourceModel{}
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return cloudendpointresource.ValidateCloudEndpointID
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_sync_cloud_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cloudendpointresource.ValidateCloudEndpointID,
+		},
+
+		"change_detection_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(cloudendpointresource.ChangeDetectionModeDefault),
+			ValidateFunc: validation.StringInSlice(cloudendpointresource.PossibleValuesForChangeDetectionMode(), false),
+		},
+
+		"directory_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"paths": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.SyncCloudEndpointsClient
+
+			var model StorageSyncCloudEndpointChangeDetectionTriggerResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := cloudendpointresource.ParseCloudEndpointID(model.StorageSyncCloudEndpointId)
+			if err != nil {
+				return err
+			}
+
+			payload := cloudendpointresource.TriggerChangeDetectionParameters{
+				ChangeDetectionMode: pointer.To(cloudendpointresource.ChangeDetectionMode(model.ChangeDetectionMode)),
+			}
+
+			if model.DirectoryPath != "" {
+				payload.DirectoryPath = pointer.To(model.DirectoryPath)
+			}
+
+			if len(model.Paths) > 0 {
+				payload.Paths = pointer.To(model.Paths)
+			}
+
+			if err := client.CloudEndpointsTriggerChangeDetectionThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("triggering change detection for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.SyncCloudEndpointsClient
+
+			id, err := cloudendpointresource.ParseCloudEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.CloudEndpointsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// a change detection run isn't exposed by the API, so everything other than the Cloud Endpoint ID is retained from the state
+			var state StorageSyncCloudEndpointChangeDetectionTriggerResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.StorageSyncCloudEndpointId = id.ID()
+			if state.ChangeDetectionMode == "" {
+				state.ChangeDetectionMode = string(cloudendpointresource.ChangeDetectionModeDefault)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// change detection can't be undone, so this is only removed from the state
+			return nil
+		},
+	}
+}
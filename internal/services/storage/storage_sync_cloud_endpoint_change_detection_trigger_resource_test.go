@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/cloudendpointresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageSyncCloudEndpointChangeDetectionTriggerResource struct{}
+
+func TestAccStorageSyncCloudEndpointChangeDetectionTrigger_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_sync_cloud_endpoint_change_detection_trigger", "test")
+	r := StorageSyncCloudEndpointChangeDetectionTriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("change_detection_mode", "directory_path", "paths", "triggers"),
+		{
+			Config: r.basic(data, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccStorageSyncCloudEndpointChangeDetectionTrigger_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_sync_cloud_endpoint_change_detection_trigger", "test")
+	r := StorageSyncCloudEndpointChangeDetectionTriggerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cloudendpointresource.ParseCloudEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.SyncCloudEndpointsClient.CloudEndpointsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) basic(data acceptance.TestData, wave string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_cloud_endpoint_change_detection_trigger" "test" {
+  storage_sync_cloud_endpoint_id = azurerm_storage_sync_cloud_endpoint.test.id
+
+  triggers = {
+    wave = "%s"
+  }
+}
+`, StorageSyncCloudEndpointResource{}.basic(data), wave)
+}
+
+func (r StorageSyncCloudEndpointChangeDetectionTriggerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_sync_cloud_endpoint_change_detection_trigger" "test" {
+  storage_sync_cloud_endpoint_id = azurerm_storage_sync_cloud_endpoint.test.id
+  change_detection_mode          = "Recursive"
+  directory_path                 = "folder"
+}
+`, StorageSyncCloudEndpointResource{}.basic(data))
+}
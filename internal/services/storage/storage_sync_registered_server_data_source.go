@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/registeredserverresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/storagesyncservicesresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageSyncRegisteredServerDataSource struct{}
+
+var _ sdk.DataSource = StorageSyncRegisteredServerDataSource{}
+
+type StorageSyncRegisteredServerDataSourceModel struct {
+	ServerId      string `tfschema:"server_id"`
+	StorageSyncId string `tfschema:"storage_sync_id"`
+
+	AgentVersion       string `tfschema:"agent_version"`
+	AgentVersionStatus string `tfschema:"agent_version_status"`
+	ClusterId          string `tfschema:"cluster_id"`
+	ClusterName        string `tfschema:"cluster_name"`
+	FriendlyName       string `tfschema:"friendly_name"`
+	LastHeartBeat      string `tfschema:"last_heart_beat"`
+	ServerOSVersion    string `tfschema:"server_os_version"`
+	ServerRole         string `tfschema:"server_role"`
+	ServiceLocation    string `tfschema:"service_location"`
+}
+
+func (d StorageSyncRegisteredServerDataSource) ResourceType() string {
+	return "azurerm_storage_sync_registered_server"
+}
+
+func (d StorageSyncRegisteredServerDataSource) ModelObject() interface{} {
+	return &StorageSyncRegisteredServerDataSourceModel{}
+}
+
+func (d StorageSyncRegisteredServerDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"storage_sync_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: storagesyncservicesresource.ValidateStorageSyncServiceID,
+		},
+	}
+}
+
+func (d StorageSyncRegisteredServerDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"agent_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"agent_version_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"cluster_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"cluster_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"friendly_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_heart_beat": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"server_os_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"server_role": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"service_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d StorageSyncRegisteredServerDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.SyncRegisteredServerClient
+
+			var state StorageSyncRegisteredServerDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serviceId, err := registeredserverresource.ParseStorageSyncServiceID(state.StorageSyncId)
+			if err != nil {
+				return err
+			}
+
+			id := registeredserverresource.NewRegisteredServerID(serviceId.SubscriptionId, serviceId.ResourceGroupName, serviceId.StorageSyncServiceName, state.ServerId)
+
+			resp, err := client.RegisteredServersGet(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AgentVersion = pointer.From(props.AgentVersion)
+					state.AgentVersionStatus = pointer.FromEnum(props.AgentVersionStatus)
+					state.ClusterId = pointer.From(props.ClusterId)
+					state.ClusterName = pointer.From(props.ClusterName)
+					state.FriendlyName = pointer.From(props.FriendlyName)
+					state.LastHeartBeat = pointer.From(props.LastHeartBeat)
+					state.ServerOSVersion = pointer.From(props.ServerOSVersion)
+					state.ServerRole = pointer.From(props.ServerRole)
+					state.ServiceLocation = pointer.From(props.ServiceLocation)
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageSyncRegisteredServerDataSource struct{}
+
+func TestAccDataSourceStorageSyncRegisteredServer_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_STORAGE_SYNC_ID") == "" || os.Getenv("ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STORAGE_SYNC_ID and/or ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID are not specified - servers must be registered manually")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_sync_registered_server", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageSyncRegisteredServerDataSource{}.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("agent_version").IsNotEmpty(),
+				check.That(data.ResourceName).Key("friendly_name").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (d StorageSyncRegisteredServerDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_storage_sync_registered_server" "test" {
+  server_id       = "%s"
+  storage_sync_id = "%s"
+}
+`, os.Getenv("ARM_TEST_STORAGE_SYNC_REGISTERED_SERVER_ID"), os.Getenv("ARM_TEST_STORAGE_SYNC_ID"))
+}
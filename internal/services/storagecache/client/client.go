@@ -8,6 +8,7 @@ import (
 
 	storagecache_2023_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01"
 	storagecache_2024_07_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01"
+	storagecache_2025_07_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2025-07-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -33,3 +34,14 @@ func NewClient_2023_05_01(o *common.ClientOptions) (*storagecache_2023_05_01.Cli
 
 	return client, nil
 }
+
+func NewClient_2025_07_01(o *common.ClientOptions) (*storagecache_2025_07_01.Client, error) {
+	client, err := storagecache_2025_07_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building Azure Managed Lustre File System client: %+v", err)
+	}
+
+	return client, nil
+}
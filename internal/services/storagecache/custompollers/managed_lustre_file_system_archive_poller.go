@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = &ManagedLustreFileSystemArchivePoller{}
+
+type ManagedLustreFileSystemArchivePoller struct {
+	client         *amlfilesystems.AmlFilesystemsClient
+	id             amlfilesystems.AmlFilesystemId
+	filesystemPath string
+}
+
+func NewManagedLustreFileSystemArchivePoller(client *amlfilesystems.AmlFilesystemsClient, id amlfilesystems.AmlFilesystemId, filesystemPath string) *ManagedLustreFileSystemArchivePoller {
+	return &ManagedLustreFileSystemArchivePoller{
+		client:         client,
+		id:             id,
+		filesystemPath: filesystemPath,
+	}
+}
+
+func (p ManagedLustreFileSystemArchivePoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.Get(ctx, p.id)
+	if err != nil {
+		return &pollingFailed, fmt.Errorf("polling %s: %w", p.id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Hsm == nil {
+		return &pollingInProgress, nil
+	}
+
+	for _, archive := range pointer.From(resp.Model.Properties.Hsm.ArchiveStatus) {
+		if pointer.From(archive.FilesystemPath) != p.filesystemPath || archive.Status == nil {
+			continue
+		}
+
+		switch state := pointer.From(archive.Status.State); state {
+		case amlfilesystems.ArchiveStatusTypeCompleted:
+			return &pollingSuccess, nil
+		case amlfilesystems.ArchiveStatusTypeCanceled, amlfilesystems.ArchiveStatusTypeFailed:
+			return &pollingFailed, pollers.PollingFailedError{
+				Message: fmt.Sprintf("archive of %q on %s finished in state %q: %s", p.filesystemPath, p.id, state, pointer.From(archive.Status.ErrorMessage)),
+			}
+		}
+	}
+
+	return &pollingInProgress, nil
+}
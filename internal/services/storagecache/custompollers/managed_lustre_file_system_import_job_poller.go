@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/importjobs"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var (
+	_ pollers.PollerType = &ManagedLustreFileSystemImportJobPoller{}
+
+	pollingSuccess = pollers.PollResult{
+		Status: pollers.PollingStatusSucceeded,
+	}
+
+	pollingFailed = pollers.PollResult{
+		Status: pollers.PollingStatusFailed,
+	}
+
+	pollingInProgress = pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 30 * time.Second,
+	}
+
+	importStatusToResult = map[importjobs.ImportStatusType]pollers.PollResult{
+		importjobs.ImportStatusTypeCompleted:        pollingSuccess,
+		importjobs.ImportStatusTypeCompletedPartial: pollingSuccess,
+
+		importjobs.ImportStatusTypeInProgress: pollingInProgress,
+		importjobs.ImportStatusTypeCancelling: pollingInProgress,
+
+		importjobs.ImportStatusTypeCanceled: pollingFailed,
+		importjobs.ImportStatusTypeFailed:   pollingFailed,
+	}
+)
+
+type ManagedLustreFileSystemImportJobPoller struct {
+	client *importjobs.ImportJobsClient
+	id     importjobs.ImportJobId
+}
+
+func NewManagedLustreFileSystemImportJobPoller(client *importjobs.ImportJobsClient, id importjobs.ImportJobId) *ManagedLustreFileSystemImportJobPoller {
+	return &ManagedLustreFileSystemImportJobPoller{
+		client: client,
+		id:     id,
+	}
+}
+
+func (p ManagedLustreFileSystemImportJobPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.Get(ctx, p.id)
+	if err != nil {
+		return &pollingFailed, fmt.Errorf("polling %s: %w", p.id, err)
+	}
+
+	if resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.Status != nil {
+		status := resp.Model.Properties.Status
+		if result, ok := importStatusToResult[pointer.From(status.State)]; ok {
+			if result.Status == pollers.PollingStatusFailed {
+				return &pollingFailed, pollers.PollingFailedError{
+					Message: fmt.Sprintf("%s finished in state %q: %s", p.id, pointer.From(status.State), pointer.From(status.StatusMessage)),
+				}
+			}
+			return &result, nil
+		}
+	}
+
+	// the status isn't populated until the job has been picked up
+	return &pollingInProgress, nil
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/custompollers"
+)
+
+type ManagedLustreFileSystemArchiveAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ManagedLustreFileSystemArchiveAction{}
+
+func newManagedLustreFileSystemArchiveAction() action.Action {
+	return &ManagedLustreFileSystemArchiveAction{}
+}
+
+type ManagedLustreFileSystemArchiveActionModel struct {
+	ManagedLustreFileSystemId types.String `tfsdk:"managed_lustre_file_system_id"`
+	FileSystemPath            types.String `tfsdk:"file_system_path"`
+	WaitForCompletion         types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout                   types.String `tfsdk:"timeout"`
+}
+
+func (a *ManagedLustreFileSystemArchiveAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"managed_lustre_file_system_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Managed Lustre File System whose files should be exported to the HSM container.",
+				MarkdownDescription: "The ID of the Managed Lustre File System whose files should be exported to the HSM container.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: amlfilesystems.ValidateAmlFilesystemID,
+					},
+				},
+			},
+
+			"file_system_path": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path within the File System which should be exported. Defaults to `/`.",
+				MarkdownDescription: "The path within the File System which should be exported. Defaults to `/`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to poll the export until it has finished. Defaults to `false`.",
+				MarkdownDescription: "Whether to poll the export until it has finished. Defaults to `false`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (a *ManagedLustreFileSystemArchiveAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_managed_lustre_file_system_archive"
+}
+
+func (a *ManagedLustreFileSystemArchiveAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.StorageCache.AmlFilesystems
+
+	model := ManagedLustreFileSystemArchiveActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := amlfilesystems.ParseAmlFilesystemID(model.ManagedLustreFileSystemId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	fileSystemPath := "/"
+	if v := model.FileSystemPath; !v.IsNull() {
+		fileSystemPath = v.ValueString()
+	}
+
+	payload := amlfilesystems.AmlFilesystemArchiveInfo{
+		FilesystemPath: pointer.To(fileSystemPath),
+	}
+
+	if _, err := client.Archive(ctx, *id, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("archiving %q on %s", fileSystemPath, id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("started archiving %q on %s", fileSystemPath, id),
+	})
+
+	if model.WaitForCompletion.ValueBool() {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("waiting for the archive of %q on %s to complete", fileSystemPath, id),
+		})
+
+		poller := pollers.NewPoller(custompollers.NewManagedLustreFileSystemArchivePoller(client, *id, fileSystemPath), 30*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "waiting for completion", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("archive of %q on %s completed", fileSystemPath, id),
+		})
+	}
+}
+
+func (a *ManagedLustreFileSystemArchiveAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
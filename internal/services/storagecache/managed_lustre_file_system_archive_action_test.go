@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ManagedLustreFileSystemArchiveAction struct{}
+
+func TestAccManagedLustreFileSystemArchiveAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_archive", "test")
+	a := ManagedLustreFileSystemArchiveAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccManagedLustreFileSystemArchiveAction_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_archive", "test")
+	a := ManagedLustreFileSystemArchiveAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.complete(data),
+			},
+		},
+	})
+}
+
+func (a ManagedLustreFileSystemArchiveAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_managed_lustre_file_system_archive" "test" {
+  config {
+    managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  }
+}
+`, a.template(data))
+}
+
+func (a ManagedLustreFileSystemArchiveAction) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_managed_lustre_file_system_archive" "test" {
+  config {
+    managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+    file_system_path              = "/"
+    wait_for_completion           = true
+  }
+}
+`, a.template(data))
+}
+
+func (a ManagedLustreFileSystemArchiveAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_managed_lustre_file_system.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_managed_lustre_file_system_archive.test]
+    }
+  }
+}
+`, ManagedLustreFileSystemResource{}.complete(data))
+}
@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2025-07-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2025-07-01/autoimportjobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagedLustreFileSystemAutoImportJobModel struct {
+	Name                      string            `tfschema:"name"`
+	ManagedLustreFileSystemId string            `tfschema:"managed_lustre_file_system_id"`
+	AutoImportPrefixes        []string          `tfschema:"auto_import_prefixes"`
+	ConflictResolutionMode    string            `tfschema:"conflict_resolution_mode"`
+	DeletionsEnabled          bool              `tfschema:"deletions_enabled"`
+	Enabled                   bool              `tfschema:"enabled"`
+	MaximumErrors             int64             `tfschema:"maximum_errors"`
+	State                     string            `tfschema:"state"`
+	Tags                      map[string]string `tfschema:"tags"`
+}
+
+type ManagedLustreFileSystemAutoImportJobResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagedLustreFileSystemAutoImportJobResource{}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) ResourceType() string {
+	return "azurerm_managed_lustre_file_system_auto_import_job"
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) ModelObject() interface{} {
+	return &ManagedLustreFileSystemAutoImportJobModel{}
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return autoimportjobs.ValidateAutoImportJobID
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 80),
+		},
+
+		"managed_lustre_file_system_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: autoimportjobs.ValidateAmlFilesystemID,
+		},
+
+		"auto_import_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 100,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ImportPrefix,
+			},
+		},
+
+		"conflict_resolution_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(autoimportjobs.ConflictResolutionModeSkip),
+			ValidateFunc: validation.StringInSlice(autoimportjobs.PossibleValuesForConflictResolutionMode(), false),
+		},
+
+		"deletions_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"maximum_errors": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache_2025_07_01.AutoImportJobs
+			fileSystemsClient := metadata.Client.StorageCache_2025_07_01.AmlFilesystems
+
+			var model ManagedLustreFileSystemAutoImportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fileSystemId, err := amlfilesystems.ParseAmlFilesystemID(model.ManagedLustreFileSystemId)
+			if err != nil {
+				return err
+			}
+
+			id := autoimportjobs.NewAutoImportJobID(fileSystemId.SubscriptionId, fileSystemId.ResourceGroupName, fileSystemId.AmlFilesystemName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// Auto Import Jobs must be created in the same location as the File System
+			fileSystem, err := fileSystemsClient.Get(ctx, *fileSystemId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", fileSystemId, err)
+			}
+			if fileSystem.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", fileSystemId)
+			}
+
+			payload := autoimportjobs.AutoImportJob{
+				Location: fileSystem.Model.Location,
+				Properties: &autoimportjobs.AutoImportJobProperties{
+					AdminStatus:            pointer.To(expandManagedLustreFileSystemAutoImportJobAdminStatus(model.Enabled)),
+					ConflictResolutionMode: pointer.To(autoimportjobs.ConflictResolutionMode(model.ConflictResolutionMode)),
+					EnableDeletions:        pointer.To(model.DeletionsEnabled),
+					MaximumErrors:          pointer.To(model.MaximumErrors),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if len(model.AutoImportPrefixes) > 0 {
+				payload.Properties.AutoImportPrefixes = pointer.To(model.AutoImportPrefixes)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache_2025_07_01.AutoImportJobs
+
+			id, err := autoimportjobs.ParseAutoImportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagedLustreFileSystemAutoImportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := autoimportjobs.AutoImportJobUpdate{
+				Properties: &autoimportjobs.AutoImportJobUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.AdminStatus = pointer.To(expandManagedLustreFileSystemAutoImportJobAdminStatus(model.Enabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache_2025_07_01.AutoImportJobs
+
+			id, err := autoimportjobs.ParseAutoImportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagedLustreFileSystemAutoImportJobModel{
+				Name:                      id.AutoImportJobName,
+				ManagedLustreFileSystemId: autoimportjobs.NewAmlFilesystemID(id.SubscriptionId, id.ResourceGroupName, id.AmlFilesystemName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if properties := model.Properties; properties != nil {
+					state.AutoImportPrefixes = pointer.From(properties.AutoImportPrefixes)
+					state.ConflictResolutionMode = pointer.FromEnum(properties.ConflictResolutionMode)
+					state.DeletionsEnabled = pointer.From(properties.EnableDeletions)
+					state.Enabled = pointer.From(properties.AdminStatus) == autoimportjobs.AdminStatusEnable
+					state.MaximumErrors = pointer.From(properties.MaximumErrors)
+
+					if status := properties.Status; status != nil {
+						state.State = pointer.FromEnum(status.State)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoImportJobResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache_2025_07_01.AutoImportJobs
+
+			id, err := autoimportjobs.ParseAutoImportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagedLustreFileSystemAutoImportJobAdminStatus(enabled bool) autoimportjobs.AdminStatus {
+	if enabled {
+		return autoimportjobs.AdminStatusEnable
+	}
+
+	return autoimportjobs.AdminStatusDisable
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/importjobs"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/custompollers"
+)
+
+type ManagedLustreFileSystemImportAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ManagedLustreFileSystemImportAction{}
+
+func newManagedLustreFileSystemImportAction() action.Action {
+	return &ManagedLustreFileSystemImportAction{}
+}
+
+type ManagedLustreFileSystemImportActionModel struct {
+	ManagedLustreFileSystemId types.String   `tfsdk:"managed_lustre_file_system_id"`
+	Name                      types.String   `tfsdk:"name"`
+	ImportPrefixes            []types.String `tfsdk:"import_prefixes"`
+	ConflictResolutionMode    types.String   `tfsdk:"conflict_resolution_mode"`
+	MaximumErrors             types.Int64    `tfsdk:"maximum_errors"`
+	WaitForCompletion         types.Bool     `tfsdk:"wait_for_completion"`
+	Timeout                   types.String   `tfsdk:"timeout"`
+}
+
+func (a *ManagedLustreFileSystemImportAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"managed_lustre_file_system_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Managed Lustre File System into which the blobs should be imported.",
+				MarkdownDescription: "The ID of the Managed Lustre File System into which the blobs should be imported.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: amlfilesystems.ValidateAmlFilesystemID,
+					},
+				},
+			},
+
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Import Job which should be created.",
+				MarkdownDescription: "The name of the Import Job which should be created.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 80),
+				},
+			},
+
+			"import_prefixes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "A list of blob prefixes which should be imported from the HSM container. Defaults to `[\"/\"]`.",
+				MarkdownDescription: "A list of blob prefixes which should be imported from the HSM container. Defaults to `[\"/\"]`.",
+			},
+
+			"conflict_resolution_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How conflicts between blobs and files which already exist in the File System should be handled. Possible values are `Fail`, `OverwriteAlways`, `OverwriteIfDirty` and `Skip`. Defaults to `Fail`.",
+				MarkdownDescription: "How conflicts between blobs and files which already exist in the File System should be handled. Possible values are `Fail`, `OverwriteAlways`, `OverwriteIfDirty` and `Skip`. Defaults to `Fail`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(importjobs.PossibleValuesForConflictResolutionMode()...),
+				},
+			},
+
+			"maximum_errors": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of errors after which the Import Job is cancelled. `-1` allows an unlimited number of errors. Defaults to `0`.",
+				MarkdownDescription: "The number of errors after which the Import Job is cancelled. `-1` allows an unlimited number of errors. Defaults to `0`.",
+			},
+
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to poll the Import Job until it has finished. Defaults to `false`.",
+				MarkdownDescription: "Whether to poll the Import Job until it has finished. Defaults to `false`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (a *ManagedLustreFileSystemImportAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_managed_lustre_file_system_import"
+}
+
+func (a *ManagedLustreFileSystemImportAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.StorageCache.ImportJobs
+	fileSystemsClient := a.Client.StorageCache.AmlFilesystems
+
+	model := ManagedLustreFileSystemImportActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	fileSystemId, err := amlfilesystems.ParseAmlFilesystemID(model.ManagedLustreFileSystemId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	// Import Jobs must be created in the same location as the File System
+	fileSystem, err := fileSystemsClient.Get(ctx, *fileSystemId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving %s", fileSystemId), err)
+		return
+	}
+	if fileSystem.Model == nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("retrieving %s", fileSystemId), "model was nil")
+		return
+	}
+
+	id := importjobs.NewImportJobID(fileSystemId.SubscriptionId, fileSystemId.ResourceGroupName, fileSystemId.AmlFilesystemName, model.Name.ValueString())
+
+	payload := importjobs.ImportJob{
+		Location:   fileSystem.Model.Location,
+		Properties: &importjobs.ImportJobProperties{},
+	}
+
+	if len(model.ImportPrefixes) > 0 {
+		importPrefixes := make([]string, 0)
+		for _, prefix := range model.ImportPrefixes {
+			importPrefixes = append(importPrefixes, prefix.ValueString())
+		}
+		payload.Properties.ImportPrefixes = pointer.To(importPrefixes)
+	}
+
+	if v := model.ConflictResolutionMode; !v.IsNull() {
+		payload.Properties.ConflictResolutionMode = pointer.To(importjobs.ConflictResolutionMode(v.ValueString()))
+	}
+
+	if v := model.MaximumErrors; !v.IsNull() {
+		payload.Properties.MaximumErrors = pointer.To(v.ValueInt64())
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("creating %s", id), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("started %s", id),
+	})
+
+	if model.WaitForCompletion.ValueBool() {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("waiting for completion of %s", id),
+		})
+
+		poller := pollers.NewPoller(custompollers.NewManagedLustreFileSystemImportJobPoller(client, id), 30*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "waiting for completion", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("%s completed", id),
+		})
+	}
+}
+
+func (a *ManagedLustreFileSystemImportAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
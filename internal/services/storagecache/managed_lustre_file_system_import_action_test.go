@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ManagedLustreFileSystemImportAction struct{}
+
+func TestAccManagedLustreFileSystemImportAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_import", "test")
+	a := ManagedLustreFileSystemImportAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccManagedLustreFileSystemImportAction_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_import", "test")
+	a := ManagedLustreFileSystemImportAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.complete(data),
+			},
+		},
+	})
+}
+
+func (a ManagedLustreFileSystemImportAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_managed_lustre_file_system_import" "test" {
+  config {
+    managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+    name                          = "acctest-import-%d"
+  }
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a ManagedLustreFileSystemImportAction) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_managed_lustre_file_system_import" "test" {
+  config {
+    managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+    name                          = "acctest-import-%d"
+    import_prefixes               = ["/"]
+    conflict_resolution_mode      = "OverwriteIfDirty"
+    maximum_errors                = -1
+    wait_for_completion           = true
+  }
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a ManagedLustreFileSystemImportAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_managed_lustre_file_system.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_managed_lustre_file_system_import.test]
+    }
+  }
+}
+`, ManagedLustreFileSystemResource{}.complete(data))
+}
@@ -61,11 +61,15 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ManagedLustreFileSystemResource{},
+		ManagedLustreFileSystemAutoImportJobResource{},
 	}
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newManagedLustreFileSystemArchiveAction,
+		newManagedLustreFileSystemImportAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/agents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/endpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/jobdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/jobruns"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/projects"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -20,6 +21,7 @@ type Client struct {
 	EndpointsClient      *endpoints.EndpointsClient
 	ProjectsClient       *projects.ProjectsClient
 	JobDefinitionsClient *jobdefinitions.JobDefinitionsClient
+	JobRunsClient        *jobruns.JobRunsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -53,11 +55,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(jobDefinitionsClient.Client, o.Authorizers.ResourceManager)
 
+	jobRunsClient, err := jobruns.NewJobRunsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Job Runs client: %+v", err)
+	}
+	o.Configure(jobRunsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		StorageMoversClient:  storageMoversClient,
 		AgentsClient:         agentsClient,
 		EndpointsClient:      endpointsClient,
 		ProjectsClient:       projectsClient,
 		JobDefinitionsClient: jobDefinitionsClient,
+		JobRunsClient:        jobRunsClient,
 	}, nil
 }
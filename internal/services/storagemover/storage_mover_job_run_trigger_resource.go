@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/jobdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/jobruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageMoverJobRunTriggerResourceModel struct {
+	StorageMoverJobDefinitionId string            `tfschema:"storage_mover_job_definition_id"`
+	Triggers                    map[string]string `tfschema:"triggers"`
+
+	AgentName          string `tfschema:"agent_name"`
+	BytesTransferred   int64  `tfschema:"bytes_transferred"`
+	ExecutionEndTime   string `tfschema:"execution_end_time"`
+	ExecutionStartTime string `tfschema:"execution_start_time"`
+	ItemsFailed        int64  `tfschema:"items_failed"`
+	ItemsTransferred   int64  `tfschema:"items_transferred"`
+	JobRunName         string `tfschema:"job_run_name"`
+	ScanStatus         string `tfschema:"scan_status"`
+	Status             string `tfschema:"status"`
+}
+
+type StorageMoverJobRunTriggerResource struct{}
+
+var _ sdk.Resource = StorageMoverJobRunTriggerResource{}
+
+func (r StorageMoverJobRunTriggerResource) ResourceType() string {
+	return "azurerm_storage_mover_job_run_trigger"
+}
+
+func (r StorageMoverJobRunTriggerResource) ModelObject() interface{} {
+	return &StorageMoverJobRunTriggerResourceModel{}
+}
+
+func (r StorageMoverJobRunTriggerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobruns.ValidateJobRunID
+}
+
+func (r StorageMoverJobRunTriggerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_mover_job_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: jobdefinitions.ValidateJobDefinitionID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageMoverJobRunTriggerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"agent_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"bytes_transferred": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"execution_end_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"execution_start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"items_failed": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"items_transferred": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"job_run_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"scan_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageMoverJobRunTriggerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			var model StorageMoverJobRunTriggerResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			jobDefinitionId, err := jobdefinitions.ParseJobDefinitionID(model.StorageMoverJobDefinitionId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.StartJob(ctx, *jobDefinitionId)
+			if err != nil {
+				return fmt.Errorf("starting a job run for %s: %+v", jobDefinitionId, err)
+			}
+
+			if resp.Model == nil || resp.Model.JobRunResourceId == nil {
+				return fmt.Errorf("starting a job run for %s: `jobRunResourceId` was nil", jobDefinitionId)
+			}
+
+			id, err := jobruns.ParseJobRunIDInsensitively(*resp.Model.JobRunResourceId)
+			if err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobRunTriggerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobRunsClient
+
+			id, err := jobruns.ParseJobRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state StorageMoverJobRunTriggerResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.StorageMoverJobDefinitionId = jobdefinitions.NewJobDefinitionID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName, id.ProjectName, id.JobDefinitionName).ID()
+			state.JobRunName = id.JobRunName
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AgentName = pointer.From(props.AgentName)
+					state.BytesTransferred = pointer.From(props.BytesTransferred)
+					state.ExecutionEndTime = pointer.From(props.ExecutionEndTime)
+					state.ExecutionStartTime = pointer.From(props.ExecutionStartTime)
+					state.ItemsFailed = pointer.From(props.ItemsFailed)
+					state.ItemsTransferred = pointer.From(props.ItemsTransferred)
+					state.ScanStatus = pointer.FromEnum(props.ScanStatus)
+					state.Status = pointer.FromEnum(props.Status)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverJobRunTriggerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobRunsClient
+
+			id, err := jobruns.ParseJobRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			status := ""
+			if model := resp.Model; model != nil && model.Properties != nil {
+				status = pointer.FromEnum(model.Properties.Status)
+			}
+
+			// job runs can't be deleted, so a run which is still in progress is stopped and anything else is only removed from the state
+			switch jobruns.JobRunStatus(status) {
+			case jobruns.JobRunStatusQueued, jobruns.JobRunStatusStarted, jobruns.JobRunStatusRunning, jobruns.JobRunStatusPausedByBandwidthManagement:
+				jobDefinitionId := jobdefinitions.NewJobDefinitionID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName, id.ProjectName, id.JobDefinitionName)
+				if _, err := metadata.Client.StorageMover.JobDefinitionsClient.StopJob(ctx, jobDefinitionId); err != nil {
+					return fmt.Errorf("stopping %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2025-07-01/jobruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageMoverJobRunTriggerTestResource struct{}
+
+func TestAccStorageMoverJobRunTrigger_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_run_trigger", "test")
+	r := StorageMoverJobRunTriggerTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (r StorageMoverJobRunTriggerTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobruns.ParseJobRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.StorageMover.JobRunsClient
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StorageMoverJobRunTriggerTestResource) basic(data acceptance.TestData, wave string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_run_trigger" "test" {
+  storage_mover_job_definition_id = azurerm_storage_mover_job_definition.test.id
+
+  triggers = {
+    wave = "%s"
+  }
+}
+`, StorageMoverJobDefinitionTestResource{}.basic(data), wave)
+}
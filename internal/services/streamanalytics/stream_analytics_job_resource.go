@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/streamingjobs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -28,6 +29,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+const (
+	streamAnalyticsJobDesiredStateRunning = "Running"
+	streamAnalyticsJobDesiredStateStopped = "Stopped"
+)
+
 func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsJobCreate,
@@ -193,6 +199,32 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
 
+			"desired_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					streamAnalyticsJobDesiredStateRunning,
+					streamAnalyticsJobDesiredStateStopped,
+				}, false),
+			},
+
+			"output_start_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(streamingjobs.OutputStartModeJobStartTime),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamingjobs.OutputStartModeCustomTime),
+					string(streamingjobs.OutputStartModeJobStartTime),
+					string(streamingjobs.OutputStartModeLastOutputEventTime),
+				}, false),
+			},
+
+			"output_start_time": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ISO8601DateTime,
+			},
+
 			"job_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -215,6 +247,20 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 			if d.Get("job_storage_account.0.authentication_mode") == string(streamingjobs.AuthenticationModeMsi) && d.Get("job_storage_account.0.account_key") != "" {
 				return fmt.Errorf("`job_storage_account.0.account_key` cannot be set when `job_storage_account.0.authentication_mode` is `Msi`")
 			}
+
+			outputStartMode := d.Get("output_start_mode").(string)
+			outputStartTime := d.Get("output_start_time").(string)
+			if outputStartMode == string(streamingjobs.OutputStartModeCustomTime) && outputStartTime == "" {
+				return fmt.Errorf("`output_start_time` must be specified when `output_start_mode` is `CustomTime`")
+			}
+			if outputStartMode != string(streamingjobs.OutputStartModeCustomTime) && outputStartTime != "" {
+				return fmt.Errorf("`output_start_time` can only be specified when `output_start_mode` is `CustomTime`")
+			}
+
+			// a new job has no output events yet, so it can't be started from the last output event time
+			if d.Id() == "" && d.Get("desired_state").(string) == streamAnalyticsJobDesiredStateRunning && outputStartMode == string(streamingjobs.OutputStartModeLastOutputEventTime) {
+				return fmt.Errorf("`output_start_mode` cannot be `LastOutputEventTime` when creating a job with `desired_state` set to `%s`", streamAnalyticsJobDesiredStateRunning)
+			}
 			return nil
 		},
 	}
@@ -338,6 +384,12 @@ func resourceStreamAnalyticsJobCreate(d *pluginsdk.ResourceData, meta interface{
 
 	d.SetId(id.ID())
 
+	if d.Get("desired_state").(string) == streamAnalyticsJobDesiredStateRunning {
+		if err := client.StartThenPoll(ctx, id, expandStreamAnalyticsJobStartParameters(d)); err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+	}
+
 	return resourceStreamAnalyticsJobRead(d, meta)
 }
 
@@ -407,6 +459,16 @@ func resourceStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("job_id", pointer.From(props.JobId))
 			d.Set("job_storage_account", flattenJobStorageAccount(d, props.JobStorageAccount))
 
+			// `desired_state` is only tracked once it has been specified, so that jobs started and stopped
+			// outside of this resource (e.g. via `azurerm_stream_analytics_job_schedule`) don't show a diff
+			if d.Get("desired_state").(string) != "" {
+				desiredState := streamAnalyticsJobDesiredStateStopped
+				if streamAnalyticsJobIsRunning(props.JobState) {
+					desiredState = streamAnalyticsJobDesiredStateRunning
+				}
+				d.Set("desired_state", desiredState)
+			}
+
 			if transformation := props.Transformation; transformation != nil {
 				if transformProps := transformation.Properties; transformProps != nil {
 					d.Set("streaming_units", pointer.From(transformProps.StreamingUnits))
@@ -452,6 +514,19 @@ func resourceStreamAnalyticsJobUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	payload := existing.Model
 
+	desiredState := d.Get("desired_state").(string)
+	isRunning := streamAnalyticsJobIsRunning(payload.Properties.JobState)
+
+	// a running job has to be stopped before its configuration can be changed, it's then started again below
+	// using `output_start_mode` so that deployments behave the same regardless of the job's current state
+	requiresStop := desiredState == streamAnalyticsJobDesiredStateStopped || d.HasChangesExcept("desired_state", "output_start_mode", "output_start_time", "tags")
+	if desiredState != "" && isRunning && requiresStop {
+		if err := client.StopThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", id, err)
+		}
+		isRunning = false
+	}
+
 	if d.HasChange("stream_analytics_cluster_id") {
 		clusterId := d.Get("stream_analytics_cluster_id").(string)
 		if d.Get("type").(string) == string(streamingjobs.JobTypeEdge) {
@@ -551,6 +626,12 @@ func resourceStreamAnalyticsJobUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	if desiredState == streamAnalyticsJobDesiredStateRunning && !isRunning {
+		if err := client.StartThenPoll(ctx, *id, expandStreamAnalyticsJobStartParameters(d)); err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+	}
+
 	return resourceStreamAnalyticsJobRead(d, meta)
 }
 
@@ -571,6 +652,29 @@ func resourceStreamAnalyticsJobDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
+func expandStreamAnalyticsJobStartParameters(d *pluginsdk.ResourceData) streamingjobs.StartStreamingJobParameters {
+	outputStartMode := streamingjobs.OutputStartMode(d.Get("output_start_mode").(string))
+
+	params := streamingjobs.StartStreamingJobParameters{
+		OutputStartMode: pointer.To(outputStartMode),
+	}
+
+	if outputStartMode == streamingjobs.OutputStartModeCustomTime {
+		params.OutputStartTime = pointer.To(d.Get("output_start_time").(string))
+	}
+
+	return params
+}
+
+func streamAnalyticsJobIsRunning(input *string) bool {
+	switch pointer.From(input) {
+	case "Running", "Starting", "Restarting", "Scaling":
+		return true
+	}
+
+	return false
+}
+
 func expandJobStorageAccount(input []interface{}) *streamingjobs.JobStorageAccount {
 	if len(input) == 0 {
 		return nil
@@ -6,6 +6,7 @@ package streamanalytics_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -211,6 +212,58 @@ func TestAccStreamAnalyticsJob_Msi(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsJob_desiredState(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job", "test")
+	r := StreamAnalyticsJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.desiredState(data, "Stopped", 6),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("desired_state", "output_start_mode"),
+		{
+			Config: r.desiredState(data, "Running", 6),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("desired_state").HasValue("Running"),
+			),
+		},
+		data.ImportStep("desired_state", "output_start_mode"),
+		{
+			// changing the job whilst it's running requires it to be stopped and started again
+			Config: r.desiredState(data, "Running", 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("desired_state").HasValue("Running"),
+			),
+		},
+		data.ImportStep("desired_state", "output_start_mode"),
+		{
+			Config: r.desiredState(data, "Stopped", 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("desired_state").HasValue("Stopped"),
+			),
+		},
+		data.ImportStep("desired_state", "output_start_mode"),
+	})
+}
+
+func TestAccStreamAnalyticsJob_desiredStateRunningLastOutputEventTime(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job", "test")
+	r := StreamAnalyticsJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.desiredStateRunningLastOutputEventTime(data),
+			ExpectError: regexp.MustCompile("`output_start_mode` cannot be `LastOutputEventTime` when creating a job"),
+		},
+	})
+}
+
 func (r StreamAnalyticsJobResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := streamingjobs.ParseStreamingJobID(state.ID)
 	if err != nil {
@@ -597,3 +650,107 @@ QUERY
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (r StreamAnalyticsJobResource) desiredStateRunningLastOutputEventTime(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                = "acctestjob-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  streaming_units     = 3
+  desired_state       = "Running"
+  output_start_mode   = "LastOutputEventTime"
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [YourOutputAlias]
+    FROM [YourInputAlias]
+QUERY
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StreamAnalyticsJobResource) desiredState(data acceptance.TestData, desiredState string, streamingUnits int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "chonks"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                = "acctestjob-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  streaming_units     = %[5]d
+  desired_state       = "%[4]s"
+  output_start_mode   = "JobStartTime"
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [acctestoutputchonk]
+    FROM [acctestinputchonk]
+QUERY
+}
+
+resource "azurerm_stream_analytics_stream_input_blob" "test" {
+  name                      = "acctestinputchonk"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  storage_account_name      = azurerm_storage_account.test.name
+  storage_account_key       = azurerm_storage_account.test.primary_access_key
+  storage_container_name    = azurerm_storage_container.test.name
+  path_pattern              = ""
+  date_format               = "yyyy/MM/dd"
+  time_format               = "HH"
+
+  serialization {
+    type            = "Csv"
+    encoding        = "UTF8"
+    field_delimiter = ","
+  }
+}
+
+resource "azurerm_stream_analytics_output_blob" "test" {
+  name                      = "acctestoutputchonk"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  storage_account_name      = azurerm_storage_account.test.name
+  storage_account_key       = azurerm_storage_account.test.primary_access_key
+  storage_container_name    = azurerm_storage_container.test.name
+  path_pattern              = "avro-chonks-{date}-{time}"
+  date_format               = "yyyy-MM-dd"
+  time_format               = "HH"
+
+  serialization {
+    type = "Avro"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, desiredState, streamingUnits)
+}
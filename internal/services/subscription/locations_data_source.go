@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	resourcesSubscription "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = LocationsDataSource{}
+
+type LocationsDataSource struct{}
+
+type LocationsDataSourceModel struct {
+	GeographyGroup string                   `tfschema:"geography_group"`
+	RegionCategory string                   `tfschema:"region_category"`
+	ZonesSupported bool                     `tfschema:"zones_supported"`
+	Locations      []LocationsLocationModel `tfschema:"locations"`
+}
+
+type LocationsLocationModel struct {
+	Name                string                `tfschema:"name"`
+	DisplayName         string                `tfschema:"display_name"`
+	RegionalDisplayName string                `tfschema:"regional_display_name"`
+	Geography           string                `tfschema:"geography"`
+	GeographyGroup      string                `tfschema:"geography_group"`
+	RegionCategory      string                `tfschema:"region_category"`
+	PairedRegions       []string              `tfschema:"paired_regions"`
+	Latitude            string                `tfschema:"latitude"`
+	Longitude           string                `tfschema:"longitude"`
+	ZoneMappings        []LocationZoneMapping `tfschema:"zone_mappings"`
+}
+
+func (r LocationsDataSource) ResourceType() string {
+	return "azurerm_locations"
+}
+
+func (r LocationsDataSource) ModelObject() interface{} {
+	return &LocationsDataSourceModel{}
+}
+
+func (r LocationsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"geography_group": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"region_category": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(resourcesSubscription.PossibleValuesForRegionCategory(), false),
+		},
+
+		"zones_supported": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func (r LocationsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"locations": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"regional_display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"geography": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"geography_group": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"region_category": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"paired_regions": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+					"latitude": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"longitude": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"zone_mappings": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"logical_zone": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+								"physical_zone": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r LocationsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Subscription.SubscriptionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state LocationsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewSubscriptionID(subscriptionId)
+			resp, err := client.ListLocations(ctx, id, resourcesSubscription.DefaultListLocationsOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving Locations for %s: %+v", id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving Locations for %s: model was nil", id)
+			}
+
+			locations := make([]LocationsLocationModel, 0)
+			for _, item := range *resp.Model {
+				// logical regions (e.g. `europe`) can't be deployed into, so only physical regions are returned
+				if item.Metadata == nil || !strings.EqualFold(string(pointer.From(item.Metadata.RegionType)), string(resourcesSubscription.RegionTypePhysical)) {
+					continue
+				}
+
+				location := flattenLocationsLocation(item)
+
+				if state.GeographyGroup != "" && !strings.EqualFold(location.GeographyGroup, state.GeographyGroup) {
+					continue
+				}
+
+				if state.RegionCategory != "" && location.RegionCategory != state.RegionCategory {
+					continue
+				}
+
+				if state.ZonesSupported && len(location.ZoneMappings) == 0 {
+					continue
+				}
+
+				locations = append(locations, location)
+			}
+
+			sort.Slice(locations, func(i, j int) bool {
+				return locations[i].Name < locations[j].Name
+			})
+			state.Locations = locations
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/locations", id.ID()))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenLocationsLocation(input resourcesSubscription.Location) LocationsLocationModel {
+	output := LocationsLocationModel{
+		Name:                pointer.From(input.Name),
+		DisplayName:         pointer.From(input.DisplayName),
+		RegionalDisplayName: pointer.From(input.RegionalDisplayName),
+		PairedRegions:       make([]string, 0),
+		ZoneMappings:        flattenZonesMapping(&input),
+	}
+
+	if metadata := input.Metadata; metadata != nil {
+		output.Geography = pointer.From(metadata.Geography)
+		output.GeographyGroup = pointer.From(metadata.GeographyGroup)
+		output.RegionCategory = string(pointer.From(metadata.RegionCategory))
+		output.Latitude = pointer.From(metadata.Latitude)
+		output.Longitude = pointer.From(metadata.Longitude)
+
+		for _, paired := range pointer.From(metadata.PairedRegion) {
+			if paired.Name != nil {
+				output.PairedRegions = append(output.PairedRegions, *paired.Name)
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package subscription_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LocationsListDataSource struct{}
+
+func TestAccLocationsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_locations", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: LocationsListDataSource{}.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("locations.0.name").Exists(),
+				check.That(data.ResourceName).Key("locations.0.display_name").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccLocationsDataSource_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_locations", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: LocationsListDataSource{}.filtered(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("locations.0.geography_group").HasValue("US"),
+				check.That(data.ResourceName).Key("locations.0.region_category").HasValue("Recommended"),
+				check.That(data.ResourceName).Key("locations.0.zone_mappings.0.physical_zone").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (d LocationsListDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_locations" "test" {}
+`
+}
+
+func (d LocationsListDataSource) filtered() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_locations" "test" {
+  geography_group = "US"
+  region_category = "Recommended"
+  zones_supported = true
+}
+`
+}
@@ -53,6 +53,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		LocationDataSource{},
+		LocationsDataSource{},
 	}
 }
 
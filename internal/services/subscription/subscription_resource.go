@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,13 +16,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/management/2020-05-01/managementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 	tagsSdk "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
 	subscriptionAlias "github.com/hashicorp/go-azure-sdk/resource-manager/subscription/2021-10-01/subscriptions"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
+	managementGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -110,6 +115,23 @@ func resourceSubscription() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Description:  "The ID of the Management Group the Subscription should be placed in.",
+				ValidateFunc: managementGroupValidate.ManagementGroupID,
+			},
+
+			"resource_providers_to_register": {
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Description: "A list of Resource Provider namespaces which should be registered in the Subscription before the Subscription is considered ready.",
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			"tenant_id": {
 				Type:        pluginsdk.TypeString,
 				Description: "The Tenant ID to which the subscription belongs",
@@ -210,6 +232,16 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		// If we're not assuming control of an existing Subscription, we need to know where to create it.
 		req.Properties.DisplayName = pointer.To(d.Get("subscription_name").(string))
 		req.Properties.BillingScope = pointer.To(d.Get("billing_scope_id").(string))
+
+		// placing the Subscription in the Management Group and tagging it as part of the creation ensures
+		// that the Subscription is never subject to the policies of the default Management Group
+		additionalProperties := subscriptionAlias.PutAliasRequestAdditionalProperties{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if v := d.Get("management_group_id").(string); v != "" {
+			additionalProperties.ManagementGroupId = pointer.To(v)
+		}
+		req.Properties.AdditionalProperties = &additionalProperties
 	}
 
 	if err := aliasClient.AliasCreateThenPoll(ctx, id, req); err != nil {
@@ -232,6 +264,9 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("failed waiting for Subscription %q (Alias %q) to enter %q state: %+v", *alias.Model.Properties.SubscriptionId, id.AliasName, "Active", err)
 	}
 
+	// the Subscription now exists, so it's tracked in the state before the remaining steps which can fail
+	d.SetId(id.ID())
+
 	if d.HasChange("tags") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClient
 		t := tags.Expand(d.Get("tags").(map[string]interface{}))
@@ -246,7 +281,16 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	d.SetId(id.ID())
+	if v := d.Get("management_group_id").(string); v != "" {
+		if err := placeSubscriptionInManagementGroup(ctx, meta.(*clients.Client).ManagementGroups.GroupsClient, subscriptionResourceId, v); err != nil {
+			return err
+		}
+	}
+
+	providersClient := meta.(*clients.Client).Resource.ResourceProvidersClient
+	if err := resourceproviders.RegisterForSubscription(ctx, providersClient, subscriptionResourceId, expandSubscriptionResourceProvidersToRegister(d.Get("resource_providers_to_register").(*pluginsdk.Set))); err != nil {
+		return fmt.Errorf("registering Resource Providers for %s: %+v", subscriptionResourceId, err)
+	}
 
 	return resourceSubscriptionRead(d, meta)
 }
@@ -296,6 +340,35 @@ func resourceSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("management_group_id") {
+		groupsClient := meta.(*clients.Client).ManagementGroups.GroupsClient
+		oldManagementGroupId, newManagementGroupId := d.GetChange("management_group_id")
+
+		if v := newManagementGroupId.(string); v != "" {
+			if err := placeSubscriptionInManagementGroup(ctx, groupsClient, subscriptionId, v); err != nil {
+				return err
+			}
+		} else if v := oldManagementGroupId.(string); v != "" {
+			// removing the Subscription from the Management Group places it back in the Tenant Root Group
+			managementGroupId, err := managementGroupParse.ManagementGroupID(v)
+			if err != nil {
+				return err
+			}
+
+			associationId := managementgroups.NewSubscriptionID(managementGroupId.Name, subscriptionId.SubscriptionId)
+			if resp, err := groupsClient.SubscriptionsDelete(ctx, associationId, managementgroups.SubscriptionsDeleteOperationOptions{}); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("removing %s from Management Group %q: %+v", subscriptionId, managementGroupId.Name, err)
+			}
+		}
+	}
+
+	if d.HasChange("resource_providers_to_register") {
+		providersClient := meta.(*clients.Client).Resource.ResourceProvidersClient
+		if err := resourceproviders.RegisterForSubscription(ctx, providersClient, subscriptionId, expandSubscriptionResourceProvidersToRegister(d.Get("resource_providers_to_register").(*pluginsdk.Set))); err != nil {
+			return fmt.Errorf("registering Resource Providers for %s: %+v", subscriptionId, err)
+		}
+	}
+
 	return nil
 }
 
@@ -325,6 +398,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 	subscriptionId := ""
 	subscriptionName := ""
 	tenantId := ""
+	managementGroupId := d.Get("management_group_id").(string)
 	var t *map[string]string
 	if props := alias.Model.Properties; props != nil && props.SubscriptionId != nil {
 		subscriptionId = *props.SubscriptionId
@@ -342,6 +416,15 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 			tenantId = pointer.From(model.TenantId)
 			t = model.Tags
 		}
+
+		// the Management Group a Subscription is in isn't returned by the Subscriptions API, so only the
+		// Management Group we've placed the Subscription in is checked, to detect the Subscription being moved
+		if managementGroupId != "" {
+			managementGroupId, err = flattenSubscriptionManagementGroupId(ctx, meta.(*clients.Client).ManagementGroups.GroupsClient, subscriptionResourceId, managementGroupId)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// (@jackofallops) A subscription's billing scope is not exposed in any way in the API/SDK so we cannot read it back here
@@ -349,6 +432,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("subscription_id", subscriptionId)
 	d.Set("subscription_name", subscriptionName)
 	d.Set("tenant_id", tenantId)
+	d.Set("management_group_id", managementGroupId)
 	if err := tags.FlattenAndSet(d, t); err != nil {
 		return err
 	}
@@ -511,3 +595,57 @@ func checkExistingAliases(ctx context.Context, client subscriptionAlias.Subscrip
 
 	return nil, len(aliasList.Items), nil
 }
+
+func placeSubscriptionInManagementGroup(ctx context.Context, client *managementgroups.ManagementGroupsClient, subscriptionId commonids.SubscriptionId, input string) error {
+	managementGroupId, err := managementGroupParse.ManagementGroupID(input)
+	if err != nil {
+		return err
+	}
+
+	associationId := managementgroups.NewSubscriptionID(managementGroupId.Name, subscriptionId.SubscriptionId)
+	if _, err := client.SubscriptionsCreate(ctx, associationId, managementgroups.SubscriptionsCreateOperationOptions{}); err != nil {
+		return fmt.Errorf("placing %s in Management Group %q: %+v", subscriptionId, managementGroupId.Name, err)
+	}
+
+	return nil
+}
+
+func flattenSubscriptionManagementGroupId(ctx context.Context, client *managementgroups.ManagementGroupsClient, subscriptionId commonids.SubscriptionId, input string) (string, error) {
+	managementGroupId, err := managementGroupParse.ManagementGroupID(input)
+	if err != nil {
+		return "", err
+	}
+
+	cacheControl := "no-cache"
+	associationId := managementgroups.NewSubscriptionID(managementGroupId.Name, subscriptionId.SubscriptionId)
+	resp, err := client.SubscriptionsGetSubscription(ctx, associationId, managementgroups.SubscriptionsGetSubscriptionOperationOptions{
+		CacheControl: &cacheControl,
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found in Management Group %q", subscriptionId, managementGroupId.Name)
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieving %s from Management Group %q: %+v", subscriptionId, managementGroupId.Name, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Parent != nil && model.Properties.Parent.Id != nil {
+		parentId, err := managementGroupParse.ManagementGroupID(*model.Properties.Parent.Id)
+		if err != nil {
+			return "", err
+		}
+		return parentId.ID(), nil
+	}
+
+	return managementGroupId.ID(), nil
+}
+
+func expandSubscriptionResourceProvidersToRegister(input *pluginsdk.Set) []string {
+	output := make([]string, 0)
+	for _, v := range input.List() {
+		output = append(output, v.(string))
+	}
+	sort.Strings(output)
+
+	return output
+}
@@ -79,6 +79,33 @@ func TestAccSubscriptionResource_update(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionResource_managementGroupAndResourceProviders(t *testing.T) {
+	if os.Getenv("ARM_BILLING_ACCOUNT") == "" {
+		t.Skip("skipping tests - no billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_subscription", "test")
+	r := SubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managementGroupAndResourceProviders(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("management_group_id", "resource_providers_to_register"),
+		{
+			Config: r.basicEnrollmentAccount(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSubscriptionResource_devTest(t *testing.T) {
 	if os.Getenv("ARM_BILLING_ACCOUNT") == "" {
 		t.Skip("skipping tests - no billing account data provided")
@@ -137,6 +164,43 @@ resource "azurerm_subscription" "test" {
 `, billingAccount, billingProfile, invoiceSection, data.RandomInteger)
 }
 
+func (SubscriptionResource) managementGroupAndResourceProviders(data acceptance.TestData) string {
+	billingAccount := os.Getenv("ARM_BILLING_ACCOUNT")
+	billingProfile := os.Getenv("ARM_BILLING_PROFILE")
+	invoiceSection := os.Getenv("ARM_INVOICE_SECTION")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_billing_mca_account_scope" "test" {
+  billing_account_name = "%[1]s"
+  billing_profile_name = "%[2]s"
+  invoice_section_name = "%[3]s"
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%[4]d"
+}
+
+resource "azurerm_subscription" "test" {
+  alias               = "testAcc-%[4]d"
+  subscription_name   = "testAccSubscription %[4]d"
+  billing_scope_id    = data.azurerm_billing_mca_account_scope.test.id
+  management_group_id = azurerm_management_group.test.id
+
+  resource_providers_to_register = [
+    "Microsoft.Network",
+    "Microsoft.Storage",
+  ]
+
+  tags = {
+    environment = "test"
+  }
+}
+`, billingAccount, billingProfile, invoiceSection, data.RandomInteger)
+}
+
 func (SubscriptionResource) basicEnrollmentAccountUpdate(data acceptance.TestData) string {
 	billingAccount := os.Getenv("ARM_BILLING_ACCOUNT")
 	enrollmentAccount := os.Getenv("ARM_BILLING_ENROLLMENT_ACCOUNT")
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ManagedPrivateEndpointApprovalId{}
+
+// ManagedPrivateEndpointApprovalId identifies the approval of the connections from Synapse Managed Private Endpoints
+// to a target resource. The ID of the target resource is suffixed, since it's already used by the target resource itself.
+type ManagedPrivateEndpointApprovalId struct {
+	TargetResourceId string
+}
+
+func (id ManagedPrivateEndpointApprovalId) ID() string {
+	return fmt.Sprintf("%s/managedPrivateEndpointApproval", id.TargetResourceId)
+}
+
+func (id ManagedPrivateEndpointApprovalId) String() string {
+	components := []string{
+		fmt.Sprintf("TargetResourceId %s", id.TargetResourceId),
+	}
+	return fmt.Sprintf("Managed Private Endpoint Approval: %s", strings.Join(components, " / "))
+}
+
+func NewManagedPrivateEndpointApprovalID(targetResourceId string) ManagedPrivateEndpointApprovalId {
+	return ManagedPrivateEndpointApprovalId{
+		TargetResourceId: targetResourceId,
+	}
+}
+
+// ManagedPrivateEndpointApprovalID parses a Managed Private Endpoint Approval ID, the type of the target resource is
+// validated separately using network.ParsePrivateEndpointConnectionTargetID
+func ManagedPrivateEndpointApprovalID(input string) (*ManagedPrivateEndpointApprovalId, error) {
+	targetResourceId, ok := strings.CutSuffix(input, "/managedPrivateEndpointApproval")
+	if !ok {
+		return nil, fmt.Errorf("expected ID to be in the format {TargetResourceId}/managedPrivateEndpointApproval but got %q", input)
+	}
+
+	if _, err := resourceids.ParseAzureResourceID(targetResourceId); err != nil {
+		return nil, fmt.Errorf("parsing %q as a Managed Private Endpoint Approval ID: %+v", input, err)
+	}
+
+	return &ManagedPrivateEndpointApprovalId{
+		TargetResourceId: targetResourceId,
+	}, nil
+}
@@ -48,6 +48,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_synapse_integration_runtime_self_hosted":            resourceSynapseIntegrationRuntimeSelfHosted(),
 		"azurerm_synapse_linked_service":                             resourceSynapseLinkedService(),
 		"azurerm_synapse_managed_private_endpoint":                   resourceSynapseManagedPrivateEndpoint(),
+		"azurerm_synapse_managed_private_endpoint_approval":          resourceSynapseManagedPrivateEndpointApproval(),
 		"azurerm_synapse_private_link_hub":                           resourceSynapsePrivateLinkHub(),
 		"azurerm_synapse_role_assignment":                            resourceSynapseRoleAssignment(),
 		"azurerm_synapse_spark_pool":                                 resourceSynapseSparkPool(),
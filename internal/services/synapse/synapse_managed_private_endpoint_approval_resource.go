@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceSynapseManagedPrivateEndpointApproval() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseManagedPrivateEndpointApprovalCreateUpdate,
+		Read:   resourceSynapseManagedPrivateEndpointApprovalRead,
+		Update: resourceSynapseManagedPrivateEndpointApprovalCreateUpdate,
+		Delete: resourceSynapseManagedPrivateEndpointApprovalDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(input string) error {
+			id, err := parse.ManagedPrivateEndpointApprovalID(input)
+			if err != nil {
+				return err
+			}
+			_, err = network.ParsePrivateEndpointConnectionTargetID(id.TargetResourceId)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: network.ValidatePrivateEndpointConnectionTargetID,
+			},
+
+			"request_descriptions": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"approval_description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "Approved by Terraform",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"approved_connection_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSynapseManagedPrivateEndpointApprovalCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	targetId := d.Get("target_resource_id").(string)
+	if _, err := network.ParsePrivateEndpointConnectionTargetID(targetId); err != nil {
+		return err
+	}
+
+	requestDescriptions := make(map[string]bool)
+	for _, v := range d.Get("request_descriptions").(*pluginsdk.Set).List() {
+		requestDescriptions[v.(string)] = true
+	}
+	approvalDescription := d.Get("approval_description").(string)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	// Managed Private Endpoints are provisioned asynchronously from within the Managed Virtual Network, as such the
+	// connection request can take some time to appear on the target resource - so we poll until at least one matching
+	// connection exists and all matching connections have been approved
+	stateChangeConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Approved"},
+		Refresh: func() (result interface{}, state string, err error) {
+			connections, err := network.ListTargetPrivateEndpointConnections(ctx, meta.(*clients.Client), targetId)
+			if err != nil {
+				return nil, "Error", err
+			}
+			if connections == nil {
+				return nil, "Error", fmt.Errorf("%q was not found", targetId)
+			}
+
+			matched := false
+			for _, connection := range *connections {
+				if !requestDescriptions[connection.Description] {
+					continue
+				}
+				matched = true
+
+				if connection.Status != network.TargetPrivateEndpointConnectionStatusPending {
+					continue
+				}
+
+				log.Printf("[DEBUG] approving Private Endpoint Connection %q on %q", connection.Name, targetId)
+				if err := network.UpdateTargetPrivateEndpointConnectionStatus(ctx, meta.(*clients.Client), targetId, connection.Name, network.TargetPrivateEndpointConnectionStatusApproved, approvalDescription); err != nil {
+					return nil, "Error", err
+				}
+			}
+
+			if !matched {
+				return connections, "Pending", nil
+			}
+			return connections, "Approved", nil
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateChangeConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Private Endpoint Connections matching `request_descriptions` on %q to be approved: %+v", targetId, err)
+	}
+
+	d.SetId(parse.NewManagedPrivateEndpointApprovalID(targetId).ID())
+
+	return resourceSynapseManagedPrivateEndpointApprovalRead(d, meta)
+}
+
+func resourceSynapseManagedPrivateEndpointApprovalRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedPrivateEndpointApprovalID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	targetId := id.TargetResourceId
+	connections, err := network.ListTargetPrivateEndpointConnections(ctx, meta.(*clients.Client), targetId)
+	if err != nil {
+		return err
+	}
+	if connections == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	requestDescriptions := make(map[string]bool)
+	for _, v := range d.Get("request_descriptions").(*pluginsdk.Set).List() {
+		requestDescriptions[v.(string)] = true
+	}
+
+	approvedConnectionNames := make([]string, 0)
+	for _, connection := range *connections {
+		if requestDescriptions[connection.Description] && connection.Status == network.TargetPrivateEndpointConnectionStatusApproved {
+			approvedConnectionNames = append(approvedConnectionNames, connection.Name)
+		}
+	}
+
+	d.Set("target_resource_id", targetId)
+	d.Set("approved_connection_names", approvedConnectionNames)
+
+	return nil
+}
+
+func resourceSynapseManagedPrivateEndpointApprovalDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	// approved connections are intentionally left in place, since rejecting them would break the Managed Private
+	// Endpoint - they're removed when the Managed Private Endpoint itself is deleted
+	log.Printf("[DEBUG] removing approval of Private Endpoint Connections on %q from state", d.Id())
+	return nil
+}
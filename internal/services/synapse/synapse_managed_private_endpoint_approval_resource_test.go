@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SynapseManagedPrivateEndpointApprovalResource struct{}
+
+func TestAccSynapseManagedPrivateEndpointApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_managed_private_endpoint_approval", "test")
+	r := SynapseManagedPrivateEndpointApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("approved_connection_names.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("request_descriptions", "approval_description", "approved_connection_names"),
+	})
+}
+
+func (r SynapseManagedPrivateEndpointApprovalResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	approvalId, err := parse.ManagedPrivateEndpointApprovalID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := commonids.ParseStorageAccountID(approvalId.TargetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ResourceManager.PrivateEndpointConnections.List(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", *id, err)
+	}
+
+	if resp.Model != nil {
+		for _, v := range *resp.Model {
+			if props := v.Properties; props != nil && pointer.From(props.PrivateLinkServiceConnectionState.Status) == "Approved" {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r SynapseManagedPrivateEndpointApprovalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-synapse-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.5.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.1.0/24"]
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+# a manual Private Endpoint connection behaves in the same way as one requested from a Managed Virtual Network
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-pe-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctest-psc-%[1]d"
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+    is_manual_connection           = true
+    request_message                = "acctest-%[1]d"
+  }
+}
+
+resource "azurerm_synapse_managed_private_endpoint_approval" "test" {
+  target_resource_id   = azurerm_storage_account.test.id
+  request_descriptions = ["acctest-%[1]d"]
+
+  depends_on = [azurerm_private_endpoint.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -36,6 +36,7 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_traffic_manager_endpoint_status":       dataSourceArmTrafficManagerEndpointStatus(),
 		"azurerm_traffic_manager_geographical_location": dataSourceArmTrafficManagerGeographicalLocation(),
 		"azurerm_traffic_manager_profile":               dataSourceArmTrafficManagerProfile(),
 	}
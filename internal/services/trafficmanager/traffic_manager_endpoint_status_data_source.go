@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package trafficmanager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2022-04-01/profiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceArmTrafficManagerEndpointStatus() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmTrafficManagerEndpointStatusRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: profiles.ValidateTrafficManagerProfileID,
+			},
+
+			"profile_monitor_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"endpoint": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"always_serve_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"monitor_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"target": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"target_resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"weight": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmTrafficManagerEndpointStatusRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).TrafficManager.ProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseTrafficManagerProfileID(d.Get("profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	profileMonitorStatus := ""
+	endpoints := make([]interface{}, 0)
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if monitorConfig := model.Properties.MonitorConfig; monitorConfig != nil {
+			profileMonitorStatus = string(pointer.From(monitorConfig.ProfileMonitorStatus))
+		}
+		endpoints = flattenTrafficManagerEndpointStatus(model.Properties.Endpoints)
+	}
+
+	d.Set("profile_monitor_status", profileMonitorStatus)
+	if err := d.Set("endpoint", endpoints); err != nil {
+		return fmt.Errorf("setting `endpoint`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenTrafficManagerEndpointStatus(input *[]profiles.Endpoint) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, endpoint := range *input {
+		// the type is returned as `Microsoft.Network/trafficManagerProfiles/{endpointType}`
+		endpointType := pointer.From(endpoint.Type)
+		if v := strings.Split(endpointType, "/"); len(v) > 0 {
+			endpointType = v[len(v)-1]
+		}
+
+		block := map[string]interface{}{
+			"id":                   pointer.From(endpoint.Id),
+			"name":                 pointer.From(endpoint.Name),
+			"type":                 endpointType,
+			"enabled":              true,
+			"always_serve_enabled": false,
+			"monitor_status":       "",
+			"target":               "",
+			"target_resource_id":   "",
+			"priority":             0,
+			"weight":               0,
+		}
+
+		if props := endpoint.Properties; props != nil {
+			block["enabled"] = pointer.From(props.EndpointStatus) != profiles.EndpointStatusDisabled
+			block["always_serve_enabled"] = pointer.From(props.AlwaysServe) == profiles.AlwaysServeEnabled
+			block["monitor_status"] = string(pointer.From(props.EndpointMonitorStatus))
+			block["target"] = pointer.From(props.Target)
+			block["target_resource_id"] = pointer.From(props.TargetResourceId)
+			block["priority"] = int(pointer.From(props.Priority))
+			block["weight"] = int(pointer.From(props.Weight))
+		}
+
+		output = append(output, block)
+	}
+
+	return output
+}
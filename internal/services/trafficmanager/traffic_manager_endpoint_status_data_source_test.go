@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package trafficmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type TrafficManagerEndpointStatusDataSource struct{}
+
+func TestAccAzureRMDataSourceTrafficManagerEndpointStatus(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_endpoint_status", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: TrafficManagerEndpointStatusDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("profile_monitor_status").Exists(),
+				check.That(data.ResourceName).Key("endpoint.#").HasValue("1"),
+				check.That(data.ResourceName).Key("endpoint.0.type").HasValue("NestedEndpoints"),
+				check.That(data.ResourceName).Key("endpoint.0.always_serve_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("endpoint.0.monitor_status").Exists(),
+			),
+		},
+	})
+}
+
+func (d TrafficManagerEndpointStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_traffic_manager_endpoint_status" "test" {
+  profile_id = azurerm_traffic_manager_profile.parent.id
+
+  depends_on = [azurerm_traffic_manager_nested_endpoint.test]
+}
+`, NestedEndpointResource{}.complete(data))
+}
@@ -82,6 +82,12 @@ func resourceNestedEndpoint() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"always_serve_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"minimum_child_endpoints": {
 				Type:         pluginsdk.TypeInt,
 				Required:     true,
@@ -201,6 +207,7 @@ func resourceNestedEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Name: pointer.To(id.EndpointName),
 		Type: pointer.To(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", trafficmanagers.EndpointTypeNestedEndpoints)),
 		Properties: &trafficmanagers.EndpointProperties{
+			AlwaysServe:       pointer.To(trafficmanagers.AlwaysServeDisabled),
 			CustomHeaders:     expandEndpointCustomHeaderConfig(d.Get("custom_header").([]interface{})),
 			EndpointStatus:    &status,
 			MinChildEndpoints: pointer.To(int64(d.Get("minimum_child_endpoints").(int))),
@@ -209,6 +216,10 @@ func resourceNestedEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		},
 	}
 
+	if alwaysServe := d.Get("always_serve_enabled").(bool); alwaysServe {
+		params.Properties.AlwaysServe = pointer.To(trafficmanagers.AlwaysServeEnabled)
+	}
+
 	if weight := d.Get("weight").(int); weight != 0 {
 		params.Properties.Weight = pointer.To(int64(weight))
 	}
@@ -277,6 +288,7 @@ func resourceNestedEndpointRead(d *pluginsdk.ResourceData, meta interface{}) err
 				enabled = false
 			}
 			d.Set("enabled", enabled)
+			d.Set("always_serve_enabled", pointer.From(props.AlwaysServe) == trafficmanagers.AlwaysServeEnabled)
 			d.Set("target_resource_id", props.TargetResourceId)
 			d.Set("weight", props.Weight)
 			d.Set("minimum_child_endpoints", props.MinChildEndpoints)
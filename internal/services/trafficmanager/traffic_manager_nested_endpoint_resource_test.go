@@ -242,6 +242,7 @@ resource "azurerm_traffic_manager_nested_endpoint" "test" {
   minimum_required_child_endpoints_ipv4 = 2
   minimum_required_child_endpoints_ipv6 = 2
   endpoint_location                     = azurerm_resource_group.test.location
+  always_serve_enabled                  = true
 
   geo_mappings = ["WORLD"]
 }
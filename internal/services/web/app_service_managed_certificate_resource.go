@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -49,6 +50,17 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 				ValidateFunc: validate.AppServiceCustomHostnameBindingID,
 			},
 
+			"domain_validation_method": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"cname-delegation",
+					"http-token",
+				}, false),
+			},
+
 			"canonical_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -158,6 +170,11 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 		Tags:     tags.Expand(t),
 	}
 
+	// apex domains can't be validated using a CNAME record, so these use an HTTP token served by the App Service instead
+	if v, ok := d.GetOk("domain_validation_method"); ok {
+		certificate.CertificateProperties.DomainValidationMethod = pointer.To(v.(string))
+	}
+
 	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CertificateName, certificate); err != nil {
 		// API returns 202 where 200 is expected - https://github.com/Azure/azure-sdk-for-go/issues/13665
 		if !utils.ResponseWasStatusCode(resp.Response, 202) {
@@ -235,6 +252,10 @@ func resourceAppServiceManagedCertificateRead(d *pluginsdk.ResourceData, meta in
 		}
 		d.Set("expiration_date", expirationDate)
 		d.Set("thumbprint", props.Thumbprint)
+
+		if props.DomainValidationMethod != nil {
+			d.Set("domain_validation_method", props.DomainValidationMethod)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
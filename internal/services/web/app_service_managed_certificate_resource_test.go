@@ -6,10 +6,13 @@ package web_test
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -78,6 +81,56 @@ func TestAccAppServiceManagedCertificate_basicWindows(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceManagedCertificate_httpTokenValidation(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_managed_certificate", "test")
+	r := AppServiceManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.httpTokenValidation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domain_validation_method").HasValue("http-token"),
+				check.That(data.ResourceName).Key("thumbprint").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccAppServiceManagedCertificate_httpTokenValidationApex(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_managed_certificate", "test")
+	r := AppServiceManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the apex of the DNS Zone is pointed at the App Service using an A record, which needs the inbound IP
+			// address of the App Service - since this isn't exposed it's resolved from the default hostname
+			Config: r.apexTemplate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(r.createApexARecord(data), "azurerm_dns_zone.apex"),
+			),
+		},
+		{
+			Config: r.httpTokenValidationApex(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domain_validation_method").HasValue("http-token"),
+				check.That(data.ResourceName).Key("thumbprint").IsNotEmpty(),
+			),
+		},
+	})
+}
+
 func (t AppServiceManagedCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ManagedCertificateID(state.ID)
 	if err != nil {
@@ -106,6 +159,81 @@ resource "azurerm_app_service_managed_certificate" "test" {
 `, template)
 }
 
+func (t AppServiceManagedCertificateResource) httpTokenValidation(data acceptance.TestData) string {
+	template := t.linuxTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_managed_certificate" "test" {
+  custom_hostname_binding_id = azurerm_app_service_custom_hostname_binding.test.id
+  domain_validation_method   = "http-token"
+}
+`, template)
+}
+
+func (AppServiceManagedCertificateResource) createApexARecord(data acceptance.TestData) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		zoneId, err := zones.ParseDnsZoneIDInsensitively(state.ID)
+		if err != nil {
+			return err
+		}
+
+		appName := fmt.Sprintf("acctest%s", data.RandomString)
+		app, err := clients.Web.AppServicesClient.Get(ctx, zoneId.ResourceGroupName, appName)
+		if err != nil {
+			return fmt.Errorf("retrieving App Service %q (Resource Group %q): %+v", appName, zoneId.ResourceGroupName, err)
+		}
+		if app.SiteProperties == nil || pointer.From(app.SiteProperties.DefaultHostName) == "" {
+			return fmt.Errorf("retrieving App Service %q (Resource Group %q): `defaultHostName` was empty", appName, zoneId.ResourceGroupName)
+		}
+
+		addresses, err := net.DefaultResolver.LookupIP(ctx, "ip4", *app.SiteProperties.DefaultHostName)
+		if err != nil {
+			return fmt.Errorf("resolving the inbound IP Address of App Service %q: %+v", appName, err)
+		}
+
+		records := make([]recordsets.ARecord, 0)
+		for _, address := range addresses {
+			records = append(records, recordsets.ARecord{
+				IPv4Address: pointer.To(address.String()),
+			})
+		}
+
+		recordId := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName, recordsets.RecordTypeA, "@")
+		payload := recordsets.RecordSet{
+			Properties: &recordsets.RecordSetProperties{
+				TTL:      pointer.To(int64(300)),
+				ARecords: &records,
+			},
+		}
+		if _, err := clients.Dns.RecordSets.CreateOrUpdate(ctx, recordId, payload, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("creating %s: %+v", recordId, err)
+		}
+
+		return nil
+	}
+}
+
+func (t AppServiceManagedCertificateResource) httpTokenValidationApex(data acceptance.TestData) string {
+	template := t.apexTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_custom_hostname_binding" "test" {
+  hostname            = azurerm_dns_zone.apex.name
+  app_service_name    = azurerm_app_service.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_dns_txt_record.apex]
+}
+
+resource "azurerm_app_service_managed_certificate" "test" {
+  custom_hostname_binding_id = azurerm_app_service_custom_hostname_binding.test.id
+  domain_validation_method   = "http-token"
+}
+`, template)
+}
+
 func (t AppServiceManagedCertificateResource) requiresImport(data acceptance.TestData) string {
 	template := t.basicLinux(data)
 	return fmt.Sprintf(`
@@ -183,6 +311,75 @@ resource "azurerm_app_service_custom_hostname_binding" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString, dnsZone, dataResourceGroup, data.RandomString, data.RandomString)
 }
 
+// apexTemplate creates a DNS Zone delegated from the shared test DNS Zone, so that the apex of this DNS Zone
+// can be bound to the App Service without affecting other tests
+func (AppServiceManagedCertificateResource) apexTemplate(data acceptance.TestData) string {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-asmc-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "Linux"
+
+  sku {
+    tier = "Basic"
+    size = "B1"
+  }
+
+  reserved = true
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctest%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  app_service_plan_id = azurerm_app_service_plan.test.id
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[4]s"
+  resource_group_name = "%[5]s"
+}
+
+resource "azurerm_dns_zone" "apex" {
+  name                = "acctest%[3]s.${data.azurerm_dns_zone.test.name}"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_ns_record" "apex" {
+  name                = "acctest%[3]s"
+  zone_name           = data.azurerm_dns_zone.test.name
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  ttl                 = 300
+  records             = azurerm_dns_zone.apex.name_servers
+}
+
+resource "azurerm_dns_txt_record" "apex" {
+  name                = "asuid"
+  zone_name           = azurerm_dns_zone.apex.name
+  resource_group_name = azurerm_dns_zone.apex.resource_group_name
+  ttl                 = 300
+
+  record {
+    value = azurerm_app_service.test.custom_domain_verification_id
+  }
+
+  depends_on = [azurerm_dns_ns_record.apex]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, dnsZone, dataResourceGroup)
+}
+
 func (t AppServiceManagedCertificateResource) basicWindows(data acceptance.TestData) string {
 	template := t.windowsTemplate(data)
 	return fmt.Sprintf(`
@@ -4,17 +4,42 @@
 package client
 
 import (
-	sapvirtualinstances_v2024_09_01 "github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2024-09-01"
+	"fmt"
+
+	workloadsV20230401 "github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/monitors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/providerinstances"
+	workloadsV20240901 "github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2024-09-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
-func NewClient(o *common.ClientOptions) (*sapvirtualinstances_v2024_09_01.Client, error) {
-	client, err := sapvirtualinstances_v2024_09_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+type Client struct {
+	*workloadsV20240901.Client
+
+	// NOTE: Monitors aren't available in newer API Versions, as such these use `2023-04-01`
+	Monitors          *monitors.MonitorsClient
+	ProviderInstances *providerinstances.ProviderInstancesClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	v20240901Client, err := workloadsV20240901.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
+		o.Configure(c, o.Authorizers.ResourceManager)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building client for workloads V20240901: %+v", err)
+	}
+
+	v20230401Client, err := workloadsV20230401.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("building client for workloads V20230401: %+v", err)
 	}
-	return client, nil
+
+	return &Client{
+		Client:            v20240901Client,
+		Monitors:          v20230401Client.Monitors,
+		ProviderInstances: v20230401Client.ProviderInstances,
+	}, nil
 }
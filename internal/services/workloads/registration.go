@@ -41,6 +41,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		WorkloadsSAPDiscoveryVirtualInstanceResource{},
+		WorkloadsSAPMonitorResource{},
+		WorkloadsSAPMonitorProviderInstanceResource{},
 		WorkloadsSAPSingleNodeVirtualInstanceResource{},
 		WorkloadsSAPThreeTierVirtualInstanceResource{},
 	}
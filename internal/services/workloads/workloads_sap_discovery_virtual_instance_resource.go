@@ -31,6 +31,7 @@ type WorkloadsSAPDiscoveryVirtualInstanceModel struct {
 	ResourceGroupName                 string                       `tfschema:"resource_group_name"`
 	Location                          string                       `tfschema:"location"`
 	CentralServerVmId                 string                       `tfschema:"central_server_virtual_machine_id"`
+	DesiredState                      string                       `tfschema:"desired_state"`
 	Environment                       string                       `tfschema:"environment"`
 	Identity                          []identity.ModelUserAssigned `tfschema:"identity"`
 	ManagedResourceGroupName          string                       `tfschema:"managed_resource_group_name"`
@@ -118,6 +119,8 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Arguments() map[string]*pl
 			ValidateFunc: storageValidate.StorageAccountName,
 		},
 
+		"desired_state": sapVirtualInstanceDesiredStateSchema(),
+
 		"identity": commonschema.UserAssignedIdentityOptional(),
 
 		"tags": commonschema.Tags(),
@@ -190,6 +193,13 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Create() sdk.ResourceFunc
 			if err = pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
 				return fmt.Errorf("setting resource identity data: %+v", err)
 			}
+
+			if model.DesiredState != "" {
+				if err := applySAPVirtualInstanceDesiredState(ctx, client, id, model.DesiredState); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -235,6 +245,12 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if metadata.ResourceData.HasChange("desired_state") {
+				if err := applySAPVirtualInstanceDesiredState(ctx, client, *id, model.DesiredState); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -281,6 +297,7 @@ func (WorkloadsSAPDiscoveryVirtualInstanceResource) flatten(metadata sdk.Resourc
 		state.Identity = pointer.From(identity)
 
 		if props := model.Properties; props != nil {
+			state.DesiredState = flattenSAPVirtualInstanceDesiredState(props.Status, metadata.ResourceData)
 			state.Environment = string(props.Environment)
 			state.ManagedResourcesNetworkAccessType = string(pointer.From(props.ManagedResourcesNetworkAccessType))
 			state.SapProduct = string(props.SapProduct)
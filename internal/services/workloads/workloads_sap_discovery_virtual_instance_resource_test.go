@@ -32,6 +32,7 @@ func TestAccWorkloadsSAPDiscoveryVirtualInstanceSequential(t *testing.T) {
 			"requiresImport":   testAccWorkloadsSAPDiscoveryVirtualInstance_requiresImport,
 			"complete":         testAccWorkloadsSAPDiscoveryVirtualInstance_complete,
 			"update":           testAccWorkloadsSAPDiscoveryVirtualInstance_update,
+			"desiredState":     testAccWorkloadsSAPDiscoveryVirtualInstance_desiredState,
 			"resourceIdentity": testAccWorkloadsSapDiscoveryVirtualInstance_resourceIdentity,
 			"list_basic":       testAccWorkloadsSAPDiscoveryVirtualInstance_list_basic,
 		},
@@ -112,6 +113,30 @@ func testAccWorkloadsSAPDiscoveryVirtualInstance_update(t *testing.T) {
 	})
 }
 
+func testAccWorkloadsSAPDiscoveryVirtualInstance_desiredState(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_discovery_virtual_instance", "test")
+	r := WorkloadsSapDiscoveryVirtualInstanceResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.desiredState(data, "Stopped"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("desired_state").HasValue("Stopped"),
+			),
+		},
+		data.ImportStep("desired_state"),
+		{
+			Config: r.desiredState(data, "Running"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("desired_state").HasValue("Running"),
+			),
+		},
+		data.ImportStep("desired_state"),
+	})
+}
+
 func (r WorkloadsSapDiscoveryVirtualInstanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := sapvirtualinstances.ParseSapVirtualInstanceID(state.ID)
 	if err != nil {
@@ -253,3 +278,39 @@ resource "azurerm_workloads_sap_discovery_virtual_instance" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_TEST_SAP_VIRTUAL_INSTANCE_NAME"), data.RandomInteger, os.Getenv("ARM_TEST_CENTRAL_SERVER_VM_ID"), data.RandomString)
 }
+
+func (r WorkloadsSapDiscoveryVirtualInstanceResource) desiredState(data acceptance.TestData, desiredState string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sapvis-%d"
+  location = "%s"
+}
+
+resource "azurerm_workloads_sap_discovery_virtual_instance" "test" {
+  name                              = "%s"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  environment                       = "NonProd"
+  sap_product                       = "S4HANA"
+  central_server_virtual_machine_id = "%s"
+  managed_storage_account_name      = "acctestmanagedsa%s"
+  desired_state                     = "%s"
+
+  identity {
+    type = "UserAssigned"
+
+    identity_ids = [
+      "%s",
+    ]
+  }
+
+  lifecycle {
+    ignore_changes = [managed_resource_group_name]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_TEST_SAP_VIRTUAL_INSTANCE_NAME"), os.Getenv("ARM_TEST_CENTRAL_SERVER_VM_ID"), data.RandomString, desiredState, os.Getenv("ARM_TEST_IDENTITY_ID"))
+}
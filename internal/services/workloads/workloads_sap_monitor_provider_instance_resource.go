@@ -0,0 +1,596 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package workloads
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name workloads_sap_monitor_provider_instance -service-package-name workloads -properties "name" -compare-values "subscription_id:monitor_id,resource_group_name:monitor_id,monitor_name:monitor_id" -test-sequential
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/providerinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkloadsSAPMonitorProviderInstanceModel struct {
+	Name         string                      `tfschema:"name"`
+	MonitorId    string                      `tfschema:"monitor_id"`
+	PrometheusOS []PrometheusOSProviderModel `tfschema:"prometheus_os"`
+	SapHana      []SapHanaProviderModel      `tfschema:"sap_hana"`
+	SapNetWeaver []SapNetWeaverProviderModel `tfschema:"sap_netweaver"`
+}
+
+type PrometheusOSProviderModel struct {
+	PrometheusUrl     string `tfschema:"prometheus_url"`
+	SapSid            string `tfschema:"sap_sid"`
+	SslCertificateUri string `tfschema:"ssl_certificate_uri"`
+	SslPreference     string `tfschema:"ssl_preference"`
+}
+
+type SapHanaProviderModel struct {
+	DatabaseName                     string `tfschema:"database_name"`
+	DatabasePassword                 string `tfschema:"database_password"`
+	DatabasePasswordKeyVaultSecretId string `tfschema:"database_password_key_vault_secret_id"`
+	DatabaseUsername                 string `tfschema:"database_username"`
+	Hostname                         string `tfschema:"hostname"`
+	InstanceNumber                   string `tfschema:"instance_number"`
+	SapSid                           string `tfschema:"sap_sid"`
+	SqlPort                          string `tfschema:"sql_port"`
+	SslCertificateUri                string `tfschema:"ssl_certificate_uri"`
+	SslHostNameInCertificate         string `tfschema:"ssl_host_name_in_certificate"`
+	SslPreference                    string `tfschema:"ssl_preference"`
+}
+
+type SapNetWeaverProviderModel struct {
+	ClientId                 string   `tfschema:"client_id"`
+	HostFileEntries          []string `tfschema:"host_file_entries"`
+	Hostname                 string   `tfschema:"hostname"`
+	InstanceNumber           string   `tfschema:"instance_number"`
+	Password                 string   `tfschema:"password"`
+	PasswordKeyVaultSecretId string   `tfschema:"password_key_vault_secret_id"`
+	PortNumber               string   `tfschema:"port_number"`
+	SapSid                   string   `tfschema:"sap_sid"`
+	SslCertificateUri        string   `tfschema:"ssl_certificate_uri"`
+	SslPreference            string   `tfschema:"ssl_preference"`
+	Username                 string   `tfschema:"username"`
+}
+
+type WorkloadsSAPMonitorProviderInstanceResource struct{}
+
+var (
+	_ sdk.Resource             = WorkloadsSAPMonitorProviderInstanceResource{}
+	_ sdk.ResourceWithIdentity = WorkloadsSAPMonitorProviderInstanceResource{}
+)
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) Identity() resourceids.ResourceId {
+	return &providerinstances.ProviderInstanceId{}
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) ResourceType() string {
+	return "azurerm_workloads_sap_monitor_provider_instance"
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) ModelObject() interface{} {
+	return &WorkloadsSAPMonitorProviderInstanceModel{}
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return providerinstances.ValidateProviderInstanceID
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	providerTypes := []string{"prometheus_os", "sap_hana", "sap_netweaver"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"monitor_id": commonschema.ResourceIDReferenceRequiredForceNew(&providerinstances.MonitorId{}),
+
+		"prometheus_os": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: providerTypes,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"prometheus_url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"sap_sid": sapMonitorProviderSapSidSchema(false),
+
+					"ssl_certificate_uri": sapMonitorProviderSslCertificateUriSchema(),
+
+					"ssl_preference": sapMonitorProviderSslPreferenceSchema(),
+				},
+			},
+		},
+
+		"sap_hana": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: providerTypes,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"hostname": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"instance_number": sapMonitorProviderInstanceNumberSchema(),
+
+					"database_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"database_username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"database_password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"sap_hana.0.database_password", "sap_hana.0.database_password_key_vault_secret_id"},
+					},
+
+					"database_password_key_vault_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"sap_hana.0.database_password", "sap_hana.0.database_password_key_vault_secret_id"},
+					},
+
+					"sql_port": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{1,5}$`), "must be a port number"),
+					},
+
+					"sap_sid": sapMonitorProviderSapSidSchema(false),
+
+					"ssl_certificate_uri": sapMonitorProviderSslCertificateUriSchema(),
+
+					"ssl_host_name_in_certificate": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"ssl_preference": sapMonitorProviderSslPreferenceSchema(),
+				},
+			},
+		},
+
+		"sap_netweaver": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: providerTypes,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"hostname": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"instance_number": sapMonitorProviderInstanceNumberSchema(),
+
+					"sap_sid": sapMonitorProviderSapSidSchema(true),
+
+					"client_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"host_file_entries": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ForceNew:      true,
+						Sensitive:     true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						ConflictsWith: []string{"sap_netweaver.0.password_key_vault_secret_id"},
+					},
+
+					"password_key_vault_secret_id": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ForceNew:      true,
+						ValidateFunc:  validation.IsURLWithHTTPS,
+						ConflictsWith: []string{"sap_netweaver.0.password"},
+					},
+
+					"port_number": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{1,5}$`), "must be a port number"),
+					},
+
+					"ssl_certificate_uri": sapMonitorProviderSslCertificateUriSchema(),
+
+					"ssl_preference": sapMonitorProviderSslPreferenceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model WorkloadsSAPMonitorProviderInstanceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Workloads.ProviderInstances
+
+			monitorId, err := providerinstances.ParseMonitorID(model.MonitorId)
+			if err != nil {
+				return err
+			}
+
+			id := providerinstances.NewProviderInstanceID(monitorId.SubscriptionId, monitorId.ResourceGroupName, monitorId.MonitorName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := providerinstances.ProviderInstance{
+				Properties: &providerinstances.ProviderInstanceProperties{
+					ProviderSettings: expandSAPMonitorProviderSettings(model),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return fmt.Errorf("setting resource identity data: %+v", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Workloads.ProviderInstances
+
+			id, err := providerinstances.ParseProviderInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the passwords aren't returned by the API, so these are retained from the existing state
+			var existing WorkloadsSAPMonitorProviderInstanceModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WorkloadsSAPMonitorProviderInstanceModel{
+				Name:      id.ProviderInstanceName,
+				MonitorId: providerinstances.NewMonitorID(id.SubscriptionId, id.ResourceGroupName, id.MonitorName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					switch settings := props.ProviderSettings.(type) {
+					case providerinstances.PrometheusOSProviderInstanceProperties:
+						state.PrometheusOS = flattenSAPMonitorPrometheusOSProvider(settings)
+					case providerinstances.HanaDbProviderInstanceProperties:
+						state.SapHana = flattenSAPMonitorSapHanaProvider(settings, existing.SapHana)
+					case providerinstances.SapNetWeaverProviderInstanceProperties:
+						state.SapNetWeaver = flattenSAPMonitorSapNetWeaverProvider(settings, existing.SapNetWeaver)
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkloadsSAPMonitorProviderInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Workloads.ProviderInstances
+
+			id, err := providerinstances.ParseProviderInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func sapMonitorProviderInstanceNumberSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{2}$`), "must be a two digit number"),
+	}
+}
+
+func sapMonitorProviderSapSidSchema(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     required,
+		Optional:     !required,
+		ForceNew:     true,
+		ValidateFunc: validate.SAPVirtualInstanceName,
+	}
+}
+
+func sapMonitorProviderSslCertificateUriSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsURLWithHTTPS,
+	}
+}
+
+func sapMonitorProviderSslPreferenceSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      string(providerinstances.SslPreferenceDisabled),
+		ValidateFunc: validation.StringInSlice(providerinstances.PossibleValuesForSslPreference(), false),
+	}
+}
+
+func expandSAPMonitorProviderSettings(input WorkloadsSAPMonitorProviderInstanceModel) providerinstances.ProviderSpecificProperties {
+	if len(input.PrometheusOS) > 0 {
+		return expandSAPMonitorPrometheusOSProvider(input.PrometheusOS[0])
+	}
+
+	if len(input.SapHana) > 0 {
+		return expandSAPMonitorSapHanaProvider(input.SapHana[0])
+	}
+
+	if len(input.SapNetWeaver) > 0 {
+		return expandSAPMonitorSapNetWeaverProvider(input.SapNetWeaver[0])
+	}
+
+	return nil
+}
+
+func expandSAPMonitorPrometheusOSProvider(input PrometheusOSProviderModel) providerinstances.PrometheusOSProviderInstanceProperties {
+	output := providerinstances.PrometheusOSProviderInstanceProperties{
+		PrometheusURL: pointer.To(input.PrometheusUrl),
+		SslPreference: pointer.To(providerinstances.SslPreference(input.SslPreference)),
+	}
+
+	if input.SapSid != "" {
+		output.SapSid = pointer.To(input.SapSid)
+	}
+
+	if input.SslCertificateUri != "" {
+		output.SslCertificateUri = pointer.To(input.SslCertificateUri)
+	}
+
+	return output
+}
+
+func expandSAPMonitorSapHanaProvider(input SapHanaProviderModel) providerinstances.HanaDbProviderInstanceProperties {
+	output := providerinstances.HanaDbProviderInstanceProperties{
+		DbName:         pointer.To(input.DatabaseName),
+		DbUsername:     pointer.To(input.DatabaseUsername),
+		Hostname:       pointer.To(input.Hostname),
+		InstanceNumber: pointer.To(input.InstanceNumber),
+		SslPreference:  pointer.To(providerinstances.SslPreference(input.SslPreference)),
+	}
+
+	if input.DatabasePassword != "" {
+		output.DbPassword = pointer.To(input.DatabasePassword)
+	}
+
+	if input.DatabasePasswordKeyVaultSecretId != "" {
+		output.DbPasswordUri = pointer.To(input.DatabasePasswordKeyVaultSecretId)
+	}
+
+	if input.SapSid != "" {
+		output.SapSid = pointer.To(input.SapSid)
+	}
+
+	if input.SqlPort != "" {
+		output.SqlPort = pointer.To(input.SqlPort)
+	}
+
+	if input.SslCertificateUri != "" {
+		output.SslCertificateUri = pointer.To(input.SslCertificateUri)
+	}
+
+	if input.SslHostNameInCertificate != "" {
+		output.SslHostNameInCertificate = pointer.To(input.SslHostNameInCertificate)
+	}
+
+	return output
+}
+
+func expandSAPMonitorSapNetWeaverProvider(input SapNetWeaverProviderModel) providerinstances.SapNetWeaverProviderInstanceProperties {
+	output := providerinstances.SapNetWeaverProviderInstanceProperties{
+		SapHostname:   pointer.To(input.Hostname),
+		SapInstanceNr: pointer.To(input.InstanceNumber),
+		SapSid:        pointer.To(input.SapSid),
+		SslPreference: pointer.To(providerinstances.SslPreference(input.SslPreference)),
+	}
+
+	if input.ClientId != "" {
+		output.SapClientId = pointer.To(input.ClientId)
+	}
+
+	if len(input.HostFileEntries) > 0 {
+		output.SapHostFileEntries = pointer.To(input.HostFileEntries)
+	}
+
+	if input.Password != "" {
+		output.SapPassword = pointer.To(input.Password)
+	}
+
+	if input.PasswordKeyVaultSecretId != "" {
+		output.SapPasswordUri = pointer.To(input.PasswordKeyVaultSecretId)
+	}
+
+	if input.PortNumber != "" {
+		output.SapPortNumber = pointer.To(input.PortNumber)
+	}
+
+	if input.SslCertificateUri != "" {
+		output.SslCertificateUri = pointer.To(input.SslCertificateUri)
+	}
+
+	if input.Username != "" {
+		output.SapUsername = pointer.To(input.Username)
+	}
+
+	return output
+}
+
+func flattenSAPMonitorPrometheusOSProvider(input providerinstances.PrometheusOSProviderInstanceProperties) []PrometheusOSProviderModel {
+	return []PrometheusOSProviderModel{
+		{
+			PrometheusUrl:     pointer.From(input.PrometheusURL),
+			SapSid:            pointer.From(input.SapSid),
+			SslCertificateUri: pointer.From(input.SslCertificateUri),
+			SslPreference:     string(pointer.From(input.SslPreference)),
+		},
+	}
+}
+
+func flattenSAPMonitorSapHanaProvider(input providerinstances.HanaDbProviderInstanceProperties, existing []SapHanaProviderModel) []SapHanaProviderModel {
+	output := SapHanaProviderModel{
+		DatabaseName:                     pointer.From(input.DbName),
+		DatabasePasswordKeyVaultSecretId: pointer.From(input.DbPasswordUri),
+		DatabaseUsername:                 pointer.From(input.DbUsername),
+		Hostname:                         pointer.From(input.Hostname),
+		InstanceNumber:                   pointer.From(input.InstanceNumber),
+		SapSid:                           pointer.From(input.SapSid),
+		SqlPort:                          pointer.From(input.SqlPort),
+		SslCertificateUri:                pointer.From(input.SslCertificateUri),
+		SslHostNameInCertificate:         pointer.From(input.SslHostNameInCertificate),
+		SslPreference:                    string(pointer.From(input.SslPreference)),
+	}
+
+	if len(existing) > 0 {
+		output.DatabasePassword = existing[0].DatabasePassword
+	}
+
+	return []SapHanaProviderModel{output}
+}
+
+func flattenSAPMonitorSapNetWeaverProvider(input providerinstances.SapNetWeaverProviderInstanceProperties, existing []SapNetWeaverProviderModel) []SapNetWeaverProviderModel {
+	output := SapNetWeaverProviderModel{
+		ClientId:                 pointer.From(input.SapClientId),
+		HostFileEntries:          pointer.From(input.SapHostFileEntries),
+		Hostname:                 pointer.From(input.SapHostname),
+		InstanceNumber:           pointer.From(input.SapInstanceNr),
+		PasswordKeyVaultSecretId: pointer.From(input.SapPasswordUri),
+		PortNumber:               pointer.From(input.SapPortNumber),
+		SapSid:                   pointer.From(input.SapSid),
+		SslCertificateUri:        pointer.From(input.SslCertificateUri),
+		SslPreference:            string(pointer.From(input.SslPreference)),
+		Username:                 pointer.From(input.SapUsername),
+	}
+
+	if len(existing) > 0 {
+		output.Password = existing[0].Password
+	}
+
+	return []SapNetWeaverProviderModel{output}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package workloads_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func testAccWorkloadsSapMonitorProviderInstance_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor_provider_instance", "test")
+	r := WorkloadsSapMonitorProviderInstanceResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"monitor_name":        {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_workloads_sap_monitor_provider_instance.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_workloads_sap_monitor_provider_instance.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_workloads_sap_monitor_provider_instance.test", tfjsonpath.New("monitor_name"), tfjsonpath.New("monitor_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_workloads_sap_monitor_provider_instance.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("monitor_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_workloads_sap_monitor_provider_instance.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("monitor_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, true)
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package workloads_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/providerinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WorkloadsSapMonitorProviderInstanceResource struct{}
+
+func TestAccWorkloadsSAPMonitorProviderInstanceSequential(t *testing.T) {
+	// The Provider Instances connect to the monitored SAP system when they're created, so these have to be tested against systems provided by the service team.
+	if os.Getenv("ARM_TEST_SAP_PROMETHEUS_URL") == "" || os.Getenv("ARM_TEST_SAP_HOSTNAME") == "" || os.Getenv("ARM_TEST_SAP_HANA_PASSWORD") == "" {
+		t.Skip("Skipping as `ARM_TEST_SAP_PROMETHEUS_URL`, `ARM_TEST_SAP_HOSTNAME` and `ARM_TEST_SAP_HANA_PASSWORD` are not specified")
+	}
+
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"providerInstance": {
+			"basic":            testAccWorkloadsSAPMonitorProviderInstance_basic,
+			"requiresImport":   testAccWorkloadsSAPMonitorProviderInstance_requiresImport,
+			"sapHana":          testAccWorkloadsSAPMonitorProviderInstance_sapHana,
+			"sapNetWeaver":     testAccWorkloadsSAPMonitorProviderInstance_sapNetWeaver,
+			"resourceIdentity": testAccWorkloadsSapMonitorProviderInstance_resourceIdentity,
+		},
+	})
+}
+
+func testAccWorkloadsSAPMonitorProviderInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor_provider_instance", "test")
+	r := WorkloadsSapMonitorProviderInstanceResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccWorkloadsSAPMonitorProviderInstance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor_provider_instance", "test")
+	r := WorkloadsSapMonitorProviderInstanceResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccWorkloadsSAPMonitorProviderInstance_sapHana(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor_provider_instance", "test")
+	r := WorkloadsSapMonitorProviderInstanceResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sapHana(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sap_hana.0.database_password"),
+	})
+}
+
+func testAccWorkloadsSAPMonitorProviderInstance_sapNetWeaver(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor_provider_instance", "test")
+	r := WorkloadsSapMonitorProviderInstanceResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sapNetWeaver(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WorkloadsSapMonitorProviderInstanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := providerinstances.ParseProviderInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Workloads.ProviderInstances.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r WorkloadsSapMonitorProviderInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_workloads_sap_monitor_provider_instance" "test" {
+  name       = "acctestpi-%d"
+  monitor_id = azurerm_workloads_sap_monitor.test.id
+
+  prometheus_os {
+    prometheus_url = "%s"
+  }
+}
+`, WorkloadsSapMonitorResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_SAP_PROMETHEUS_URL"))
+}
+
+func (r WorkloadsSapMonitorProviderInstanceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_workloads_sap_monitor_provider_instance" "import" {
+  name       = azurerm_workloads_sap_monitor_provider_instance.test.name
+  monitor_id = azurerm_workloads_sap_monitor_provider_instance.test.monitor_id
+
+  prometheus_os {
+    prometheus_url = "%s"
+  }
+}
+`, r.basic(data), os.Getenv("ARM_TEST_SAP_PROMETHEUS_URL"))
+}
+
+func (r WorkloadsSapMonitorProviderInstanceResource) sapHana(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_workloads_sap_monitor_provider_instance" "test" {
+  name       = "acctestpi-%d"
+  monitor_id = azurerm_workloads_sap_monitor.test.id
+
+  sap_hana {
+    hostname          = "%s"
+    instance_number   = "00"
+    database_name     = "SYSTEMDB"
+    database_username = "SYSTEM"
+    database_password = "%s"
+    sql_port          = "30013"
+    sap_sid           = "X00"
+  }
+}
+`, WorkloadsSapMonitorResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_SAP_HOSTNAME"), os.Getenv("ARM_TEST_SAP_HANA_PASSWORD"))
+}
+
+func (r WorkloadsSapMonitorProviderInstanceResource) sapNetWeaver(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_workloads_sap_monitor_provider_instance" "test" {
+  name       = "acctestpi-%d"
+  monitor_id = azurerm_workloads_sap_monitor.test.id
+
+  sap_netweaver {
+    hostname        = "%s"
+    instance_number = "00"
+    sap_sid         = "X00"
+    client_id       = "000"
+    port_number     = "8000"
+  }
+}
+`, WorkloadsSapMonitorResource{}.basic(data), data.RandomInteger, os.Getenv("ARM_TEST_SAP_HOSTNAME"))
+}
@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package workloads
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name workloads_sap_monitor -service-package-name workloads -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/monitors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkloadsSAPMonitorModel struct {
+	Name                     string                       `tfschema:"name"`
+	ResourceGroupName        string                       `tfschema:"resource_group_name"`
+	Location                 string                       `tfschema:"location"`
+	AppLocation              string                       `tfschema:"app_location"`
+	Identity                 []identity.ModelUserAssigned `tfschema:"identity"`
+	LogAnalyticsWorkspaceId  string                       `tfschema:"log_analytics_workspace_id"`
+	ManagedResourceGroupName string                       `tfschema:"managed_resource_group_name"`
+	RoutingPreference        string                       `tfschema:"routing_preference"`
+	SubnetId                 string                       `tfschema:"subnet_id"`
+	ZoneRedundancyPreference string                       `tfschema:"zone_redundancy_preference"`
+	Tags                     map[string]string            `tfschema:"tags"`
+	StorageAccountId         string                       `tfschema:"storage_account_id"`
+}
+
+type WorkloadsSAPMonitorResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate   = WorkloadsSAPMonitorResource{}
+	_ sdk.ResourceWithIdentity = WorkloadsSAPMonitorResource{}
+)
+
+func (r WorkloadsSAPMonitorResource) Identity() resourceids.ResourceId {
+	return &monitors.MonitorId{}
+}
+
+func (r WorkloadsSAPMonitorResource) ResourceType() string {
+	return "azurerm_workloads_sap_monitor"
+}
+
+func (r WorkloadsSAPMonitorResource) ModelObject() interface{} {
+	return &WorkloadsSAPMonitorModel{}
+}
+
+func (r WorkloadsSAPMonitorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return monitors.ValidateMonitorID
+}
+
+func (r WorkloadsSAPMonitorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"app_location": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+
+		"subnet_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.SubnetId{}),
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"managed_resource_group_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: resourcegroups.ValidateName,
+		},
+
+		"routing_preference": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(monitors.RoutingPreferenceDefault),
+			ValidateFunc: validation.StringInSlice(monitors.PossibleValuesForRoutingPreference(), false),
+		},
+
+		"zone_redundancy_preference": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"identity": commonschema.UserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r WorkloadsSAPMonitorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WorkloadsSAPMonitorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model WorkloadsSAPMonitorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Workloads.Monitors
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := monitors.NewMonitorID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identity, err := identity.ExpandUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			parameters := monitors.Monitor{
+				Identity: identity,
+				Location: location.Normalize(model.Location),
+				Properties: &monitors.MonitorProperties{
+					AppLocation:       pointer.To(location.Normalize(model.AppLocation)),
+					MonitorSubnet:     pointer.To(model.SubnetId),
+					RoutingPreference: pointer.To(monitors.RoutingPreference(model.RoutingPreference)),
+				},
+				Tags: &model.Tags,
+			}
+
+			if v := model.LogAnalyticsWorkspaceId; v != "" {
+				parameters.Properties.LogAnalyticsWorkspaceArmId = pointer.To(v)
+			}
+
+			if v := model.ManagedResourceGroupName; v != "" {
+				parameters.Properties.ManagedResourceGroupConfiguration = &monitors.ManagedRGConfiguration{
+					Name: pointer.To(v),
+				}
+			}
+
+			if v := model.ZoneRedundancyPreference; v != "" {
+				parameters.Properties.ZoneRedundancyPreference = pointer.To(v)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return fmt.Errorf("setting resource identity data: %+v", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkloadsSAPMonitorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Workloads.Monitors
+
+			id, err := monitors.ParseMonitorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkloadsSAPMonitorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := monitors.UpdateMonitorRequest{}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				parameters.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkloadsSAPMonitorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Workloads.Monitors
+
+			id, err := monitors.ParseMonitorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WorkloadsSAPMonitorModel{
+				Name:              id.MonitorName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				identity, err := identity.FlattenUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(identity)
+
+				if props := model.Properties; props != nil {
+					state.AppLocation = location.NormalizeNilable(props.AppLocation)
+					state.RoutingPreference = string(pointer.From(props.RoutingPreference))
+					state.StorageAccountId = pointer.From(props.StorageAccountArmId)
+					state.ZoneRedundancyPreference = pointer.From(props.ZoneRedundancyPreference)
+
+					if v := pointer.From(props.LogAnalyticsWorkspaceArmId); v != "" {
+						workspaceId, err := workspaces.ParseWorkspaceIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.LogAnalyticsWorkspaceId = workspaceId.ID()
+					}
+
+					if v := pointer.From(props.MonitorSubnet); v != "" {
+						subnetId, err := commonids.ParseSubnetIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.SubnetId = subnetId.ID()
+					}
+
+					if v := props.ManagedResourceGroupConfiguration; v != nil {
+						state.ManagedResourceGroupName = pointer.From(v.Name)
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkloadsSAPMonitorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Workloads.Monitors
+
+			id, err := monitors.ParseMonitorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package workloads_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/monitors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WorkloadsSapMonitorResource struct{}
+
+func TestAccWorkloadsSAPMonitor_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor", "test")
+	r := WorkloadsSapMonitorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkloadsSAPMonitor_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor", "test")
+	r := WorkloadsSapMonitorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccWorkloadsSAPMonitor_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor", "test")
+	r := WorkloadsSapMonitorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkloadsSAPMonitor_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_monitor", "test")
+	r := WorkloadsSapMonitorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WorkloadsSapMonitorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := monitors.ParseMonitorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Workloads.Monitors.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r WorkloadsSapMonitorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sapmon-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      name    = "Microsoft.Web/serverFarms"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r WorkloadsSapMonitorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_workloads_sap_monitor" "test" {
+  name                = "acctestsapmon-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  app_location        = azurerm_resource_group.test.location
+  subnet_id           = azurerm_subnet.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WorkloadsSapMonitorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_workloads_sap_monitor" "import" {
+  name                = azurerm_workloads_sap_monitor.test.name
+  resource_group_name = azurerm_workloads_sap_monitor.test.resource_group_name
+  location            = azurerm_workloads_sap_monitor.test.location
+  app_location        = azurerm_workloads_sap_monitor.test.app_location
+  subnet_id           = azurerm_workloads_sap_monitor.test.subnet_id
+}
+`, r.basic(data))
+}
+
+func (r WorkloadsSapMonitorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_workloads_sap_monitor" "test" {
+  name                        = "acctestsapmon-%[2]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  app_location                = azurerm_resource_group.test.location
+  subnet_id                   = azurerm_subnet.test.id
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  managed_resource_group_name = "acctestRG-sapmon-managed-%[2]d"
+  routing_preference          = "RouteAll"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WorkloadsSapMonitorResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_user_assigned_identity" "other" {
+  name                = "acctestuai2-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_workloads_sap_monitor" "test" {
+  name                        = "acctestsapmon-%[2]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  app_location                = azurerm_resource_group.test.location
+  subnet_id                   = azurerm_subnet.test.id
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  managed_resource_group_name = "acctestRG-sapmon-managed-%[2]d"
+  routing_preference          = "RouteAll"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.other.id]
+  }
+
+  tags = {
+    env = "Test2"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
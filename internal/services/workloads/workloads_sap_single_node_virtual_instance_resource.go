@@ -32,10 +32,12 @@ type WorkloadsSAPSingleNodeVirtualInstanceModel struct {
 	ResourceGroupName                 string                       `tfschema:"resource_group_name"`
 	Location                          string                       `tfschema:"location"`
 	AppLocation                       string                       `tfschema:"app_location"`
+	DesiredState                      string                       `tfschema:"desired_state"`
 	Environment                       string                       `tfschema:"environment"`
 	SapFqdn                           string                       `tfschema:"sap_fqdn"`
 	SapProduct                        string                       `tfschema:"sap_product"`
 	SingleServerConfiguration         []SingleServerConfiguration  `tfschema:"single_server_configuration"`
+	SoftwareConfiguration             []SAPSoftwareConfiguration   `tfschema:"software_configuration"`
 	Identity                          []identity.ModelUserAssigned `tfschema:"identity"`
 	ManagedResourceGroupName          string                       `tfschema:"managed_resource_group_name"`
 	ManagedResourcesNetworkAccessType string                       `tfschema:"managed_resources_network_access_type"`
@@ -390,6 +392,8 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Arguments() map[string]*p
 			ValidateFunc: validation.StringInSlice(sapvirtualinstances.PossibleValuesForSAPProductType(), false),
 		},
 
+		"desired_state": sapVirtualInstanceDesiredStateSchema(),
+
 		"identity": commonschema.UserAssignedIdentityOptional(),
 
 		"managed_resource_group_name": {
@@ -406,6 +410,8 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Arguments() map[string]*p
 			ValidateFunc: validation.StringInSlice(sapvirtualinstances.PossibleValuesForManagedResourcesNetworkAccessType(), false),
 		},
 
+		"software_configuration": sapVirtualInstanceSoftwareConfigurationSchema(false),
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -427,7 +433,7 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) CustomizeDiff() sdk.Resou
 				}
 			}
 
-			return nil
+			return validateSAPVirtualInstanceSoftwareConfigurationChange(rd)
 		},
 	}
 }
@@ -479,13 +485,7 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Create() sdk.ResourceFunc
 				Identity: identity,
 				Location: location.Normalize(model.Location),
 				Properties: &sapvirtualinstances.SAPVirtualInstanceProperties{
-					Configuration: sapvirtualinstances.DeploymentWithOSConfiguration{
-						AppLocation:                 pointer.To(location.Normalize(model.AppLocation)),
-						InfrastructureConfiguration: expandSingleServerConfiguration(model.SingleServerConfiguration),
-						OsSapConfiguration: &sapvirtualinstances.OsSapConfiguration{
-							SapFqdn: pointer.To(model.SapFqdn),
-						},
-					},
+					Configuration:                     expandSAPSingleNodeVirtualInstanceDeploymentWithOSConfiguration(model),
 					Environment:                       sapvirtualinstances.SAPEnvironmentType(model.Environment),
 					ManagedResourcesNetworkAccessType: pointer.To(sapvirtualinstances.ManagedResourcesNetworkAccessType(model.ManagedResourcesNetworkAccessType)),
 					SapProduct:                        sapvirtualinstances.SAPProductType(model.SapProduct),
@@ -508,6 +508,13 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Create() sdk.ResourceFunc
 				return err
 			}
 
+			// the SAP system is running once it's been deployed, so it only needs to be stopped
+			if model.DesiredState == sapVirtualInstanceDesiredStateStopped {
+				if err := applySAPVirtualInstanceDesiredState(ctx, client, id, model.DesiredState); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -529,6 +536,28 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			// installing the SAP software on an infrastructure-only deployment requires the full configuration to be sent,
+			// changes to an existing installation are rejected by the CustomizeDiff, other than to the sensitive values
+			// which are only used during the installation
+			if metadata.ResourceData.HasChange("software_configuration") {
+				if old, _ := metadata.ResourceData.GetChange("software_configuration"); len(old.([]interface{})) == 0 {
+					existing, err := client.Get(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("retrieving %s: %+v", *id, err)
+					}
+					if existing.Model == nil || existing.Model.Properties == nil {
+						return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+					}
+
+					payload := *existing.Model
+					payload.Properties.Configuration = expandSAPSingleNodeVirtualInstanceDeploymentWithOSConfiguration(model)
+
+					if err := client.CreateThenPoll(ctx, *id, payload); err != nil {
+						return fmt.Errorf("installing the SAP software for %s: %+v", *id, err)
+					}
+				}
+			}
+
 			parameters := &sapvirtualinstances.UpdateSAPVirtualInstanceRequest{
 				Properties: &sapvirtualinstances.UpdateSAPVirtualInstanceProperties{},
 			}
@@ -553,6 +582,12 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if metadata.ResourceData.HasChange("desired_state") {
+				if err := applySAPVirtualInstanceDesiredState(ctx, client, *id, model.DesiredState); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -603,6 +638,7 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) flatten(metadata sdk.Reso
 		state.Identity = pointer.From(identity)
 
 		if props := model.Properties; props != nil {
+			state.DesiredState = flattenSAPVirtualInstanceDesiredState(props.Status, metadata.ResourceData)
 			state.Environment = string(props.Environment)
 			state.ManagedResourcesNetworkAccessType = string(pointer.From(props.ManagedResourcesNetworkAccessType))
 			state.SapProduct = string(props.SapProduct)
@@ -627,6 +663,8 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) flatten(metadata sdk.Reso
 							state.SingleServerConfiguration = flattenSingleServerConfiguration(singleServerConfiguration, metadata.ResourceData)
 						}
 					}
+
+					state.SoftwareConfiguration = flattenSAPSoftwareConfiguration(v.SoftwareConfiguration, metadata.ResourceData)
 				}
 			}
 
@@ -663,6 +701,17 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Delete() sdk.ResourceFunc
 	}
 }
 
+func expandSAPSingleNodeVirtualInstanceDeploymentWithOSConfiguration(input WorkloadsSAPSingleNodeVirtualInstanceModel) sapvirtualinstances.DeploymentWithOSConfiguration {
+	return sapvirtualinstances.DeploymentWithOSConfiguration{
+		AppLocation:                 pointer.To(location.Normalize(input.AppLocation)),
+		InfrastructureConfiguration: expandSingleServerConfiguration(input.SingleServerConfiguration),
+		OsSapConfiguration: &sapvirtualinstances.OsSapConfiguration{
+			SapFqdn: pointer.To(input.SapFqdn),
+		},
+		SoftwareConfiguration: expandSAPSoftwareConfiguration(input.SoftwareConfiguration, input.SapFqdn),
+	}
+}
+
 func expandSAPSingleNodeVirtualInstanceVirtualMachineConfiguration(input []SingleServerVirtualMachineConfiguration) *sapvirtualinstances.VirtualMachineConfiguration {
 	if len(input) == 0 {
 		return nil
@@ -30,18 +30,20 @@ import (
 )
 
 type WorkloadsSAPThreeTierVirtualInstanceModel struct {
-	Name                              string                       `tfschema:"name"`
-	ResourceGroupName                 string                       `tfschema:"resource_group_name"`
-	Location                          string                       `tfschema:"location"`
-	AppLocation                       string                       `tfschema:"app_location"`
-	Environment                       string                       `tfschema:"environment"`
-	Identity                          []identity.ModelUserAssigned `tfschema:"identity"`
-	ManagedResourceGroupName          string                       `tfschema:"managed_resource_group_name"`
-	ManagedResourcesNetworkAccessType string                       `tfschema:"managed_resources_network_access_type"`
-	SapFqdn                           string                       `tfschema:"sap_fqdn"`
-	SapProduct                        string                       `tfschema:"sap_product"`
-	ThreeTierConfiguration            []ThreeTierConfiguration     `tfschema:"three_tier_configuration"`
-	Tags                              map[string]string            `tfschema:"tags"`
+	Name                              string                                     `tfschema:"name"`
+	ResourceGroupName                 string                                     `tfschema:"resource_group_name"`
+	Location                          string                                     `tfschema:"location"`
+	AppLocation                       string                                     `tfschema:"app_location"`
+	DesiredState                      string                                     `tfschema:"desired_state"`
+	Environment                       string                                     `tfschema:"environment"`
+	Identity                          []identity.ModelUserAssigned               `tfschema:"identity"`
+	ManagedResourceGroupName          string                                     `tfschema:"managed_resource_group_name"`
+	ManagedResourcesNetworkAccessType string                                     `tfschema:"managed_resources_network_access_type"`
+	SapFqdn                           string                                     `tfschema:"sap_fqdn"`
+	SapProduct                        string                                     `tfschema:"sap_product"`
+	SoftwareConfiguration             []SAPHighAvailabilitySoftwareConfiguration `tfschema:"software_configuration"`
+	ThreeTierConfiguration            []ThreeTierConfiguration                   `tfschema:"three_tier_configuration"`
+	Tags                              map[string]string                          `tfschema:"tags"`
 }
 
 type DiskVolumeConfiguration struct {
@@ -200,6 +202,8 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) Arguments() map[string]*pl
 			ValidateFunc: validation.StringInSlice(sapvirtualinstances.PossibleValuesForSAPEnvironmentType(), false),
 		},
 
+		"desired_state": sapVirtualInstanceDesiredStateSchema(),
+
 		"identity": commonschema.UserAssignedIdentityOptional(),
 
 		"sap_fqdn": {
@@ -1058,6 +1062,8 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) Arguments() map[string]*pl
 			ValidateFunc: validation.StringInSlice(sapvirtualinstances.PossibleValuesForManagedResourcesNetworkAccessType(), false),
 		},
 
+		"software_configuration": sapVirtualInstanceSoftwareConfigurationSchema(true),
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -1079,11 +1085,27 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) CustomizeDiff() sdk.Resour
 				}
 			}
 
-			return nil
+			return validateSAPVirtualInstanceSoftwareConfigurationChange(rd)
 		},
 	}
 }
 
+func expandSAPThreeTierVirtualInstanceDeploymentWithOSConfiguration(input WorkloadsSAPThreeTierVirtualInstanceModel) (*sapvirtualinstances.DeploymentWithOSConfiguration, error) {
+	threeTierConfiguration, err := expandThreeTierConfiguration(input.ThreeTierConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sapvirtualinstances.DeploymentWithOSConfiguration{
+		AppLocation:                 pointer.To(location.Normalize(input.AppLocation)),
+		InfrastructureConfiguration: threeTierConfiguration,
+		OsSapConfiguration: &sapvirtualinstances.OsSapConfiguration{
+			SapFqdn: pointer.To(input.SapFqdn),
+		},
+		SoftwareConfiguration: expandSAPHighAvailabilitySoftwareConfiguration(input.SoftwareConfiguration, input.SapFqdn),
+	}, nil
+}
+
 func hasDuplicateVolumeName(input []interface{}) bool {
 	seen := make(map[string]bool)
 
@@ -1138,19 +1160,10 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) Create() sdk.ResourceFunc
 				Tags: &model.Tags,
 			}
 
-			deploymentWithOSConfiguration := &sapvirtualinstances.DeploymentWithOSConfiguration{
-				AppLocation: pointer.To(location.Normalize(model.AppLocation)),
-				OsSapConfiguration: &sapvirtualinstances.OsSapConfiguration{
-					SapFqdn: pointer.To(model.SapFqdn),
-				},
-			}
-
-			threeTierConfiguration, err := expandThreeTierConfiguration(model.ThreeTierConfiguration)
+			deploymentWithOSConfiguration, err := expandSAPThreeTierVirtualInstanceDeploymentWithOSConfiguration(model)
 			if err != nil {
 				return err
 			}
-			deploymentWithOSConfiguration.InfrastructureConfiguration = threeTierConfiguration
-
 			parameters.Properties.Configuration = deploymentWithOSConfiguration
 
 			if v := model.ManagedResourceGroupName; v != "" {
@@ -1168,6 +1181,13 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) Create() sdk.ResourceFunc
 				return err
 			}
 
+			// the SAP system is running once it's been deployed, so it only needs to be stopped
+			if model.DesiredState == sapVirtualInstanceDesiredStateStopped {
+				if err := applySAPVirtualInstanceDesiredState(ctx, client, id, model.DesiredState); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -1189,6 +1209,33 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			// installing the SAP software on an infrastructure-only deployment requires the full configuration to be sent,
+			// changes to an existing installation are rejected by the CustomizeDiff, other than to the sensitive values
+			// which are only used during the installation
+			if metadata.ResourceData.HasChange("software_configuration") {
+				if old, _ := metadata.ResourceData.GetChange("software_configuration"); len(old.([]interface{})) == 0 {
+					existing, err := client.Get(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("retrieving %s: %+v", *id, err)
+					}
+					if existing.Model == nil || existing.Model.Properties == nil {
+						return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+					}
+
+					deploymentWithOSConfiguration, err := expandSAPThreeTierVirtualInstanceDeploymentWithOSConfiguration(model)
+					if err != nil {
+						return err
+					}
+
+					payload := *existing.Model
+					payload.Properties.Configuration = deploymentWithOSConfiguration
+
+					if err := client.CreateThenPoll(ctx, *id, payload); err != nil {
+						return fmt.Errorf("installing the SAP software for %s: %+v", *id, err)
+					}
+				}
+			}
+
 			parameters := &sapvirtualinstances.UpdateSAPVirtualInstanceRequest{
 				Properties: &sapvirtualinstances.UpdateSAPVirtualInstanceProperties{},
 			}
@@ -1213,6 +1260,12 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) Update() sdk.ResourceFunc
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if metadata.ResourceData.HasChange("desired_state") {
+				if err := applySAPVirtualInstanceDesiredState(ctx, client, *id, model.DesiredState); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -1261,6 +1314,7 @@ func (WorkloadsSAPThreeTierVirtualInstanceResource) flatten(metadata sdk.Resourc
 		state.Identity = pointer.From(identity)
 
 		if props := model.Properties; props != nil {
+			state.DesiredState = flattenSAPVirtualInstanceDesiredState(props.Status, metadata.ResourceData)
 			state.Environment = string(props.Environment)
 			state.ManagedResourcesNetworkAccessType = string(pointer.From(props.ManagedResourcesNetworkAccessType))
 			state.SapProduct = string(props.SapProduct)
@@ -1283,6 +1337,8 @@ func (WorkloadsSAPThreeTierVirtualInstanceResource) flatten(metadata sdk.Resourc
 							state.ThreeTierConfiguration = threeTierConfig
 						}
 					}
+
+					state.SoftwareConfiguration = flattenSAPHighAvailabilitySoftwareConfiguration(v.SoftwareConfiguration, metadata.ResourceData)
 				}
 			}
 
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package workloads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2024-09-01/sapvirtualinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	sapVirtualInstanceDesiredStateRunning = "Running"
+	sapVirtualInstanceDesiredStateStopped = "Stopped"
+)
+
+type SAPSoftwareConfiguration struct {
+	BomUrl                  string `tfschema:"bom_url"`
+	SapBitsStorageAccountId string `tfschema:"sap_bits_storage_account_id"`
+	SoftwareVersion         string `tfschema:"software_version"`
+	SshPrivateKey           string `tfschema:"ssh_private_key"`
+}
+
+type SAPHighAvailabilitySoftwareConfiguration struct {
+	BomUrl                  string `tfschema:"bom_url"`
+	FencingClientId         string `tfschema:"fencing_client_id"`
+	FencingClientPassword   string `tfschema:"fencing_client_password"`
+	SapBitsStorageAccountId string `tfschema:"sap_bits_storage_account_id"`
+	SoftwareVersion         string `tfschema:"software_version"`
+	SshPrivateKey           string `tfschema:"ssh_private_key"`
+}
+
+func sapVirtualInstanceDesiredStateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			sapVirtualInstanceDesiredStateRunning,
+			sapVirtualInstanceDesiredStateStopped,
+		}, false),
+	}
+}
+
+// sapVirtualInstanceSoftwareConfigurationSchema returns the schema used to install the SAP software on the infrastructure
+// deployed by the SAP Virtual Instance, the fencing agent is only configured for highly available deployments
+func sapVirtualInstanceSoftwareConfigurationSchema(highAvailability bool) *pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"bom_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"sap_bits_storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"software_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ssh_private_key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+
+	if highAvailability {
+		s["fencing_client_id"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		}
+
+		s["fencing_client_password"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
+
+// validateSAPVirtualInstanceSoftwareConfigurationChange ensures `software_configuration` is only ever added to an existing
+// infrastructure-only deployment - once the SAP software is installed the installation can't be changed or removed, and
+// since re-creating the SAP system is rarely what's intended this is surfaced as an error rather than forcing a new resource
+func validateSAPVirtualInstanceSoftwareConfigurationChange(rd *pluginsdk.ResourceDiff) error {
+	if rd.Id() == "" || !rd.HasChange("software_configuration") {
+		return nil
+	}
+
+	o, n := rd.GetChange("software_configuration")
+	oldConfig := o.([]interface{})
+	newConfig := n.([]interface{})
+	if len(oldConfig) == 0 || oldConfig[0] == nil {
+		return nil
+	}
+
+	if len(newConfig) == 0 || newConfig[0] == nil {
+		return fmt.Errorf("`software_configuration` cannot be removed once the SAP software has been installed")
+	}
+
+	oldValues := oldConfig[0].(map[string]interface{})
+	newValues := newConfig[0].(map[string]interface{})
+	for _, key := range []string{"bom_url", "sap_bits_storage_account_id", "software_version", "fencing_client_id"} {
+		if oldValues[key] != newValues[key] {
+			return fmt.Errorf("`software_configuration.0.%s` cannot be changed once the SAP software has been installed", key)
+		}
+	}
+
+	return nil
+}
+
+func expandSAPSoftwareConfiguration(input []SAPSoftwareConfiguration, sapFqdn string) sapvirtualinstances.SoftwareConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return sapvirtualinstances.ServiceInitiatedSoftwareConfiguration{
+		BomURL:                  v.BomUrl,
+		SapBitsStorageAccountId: v.SapBitsStorageAccountId,
+		SapFqdn:                 sapFqdn,
+		SoftwareVersion:         v.SoftwareVersion,
+		SshPrivateKey:           v.SshPrivateKey,
+	}
+}
+
+func expandSAPHighAvailabilitySoftwareConfiguration(input []SAPHighAvailabilitySoftwareConfiguration, sapFqdn string) sapvirtualinstances.SoftwareConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := sapvirtualinstances.ServiceInitiatedSoftwareConfiguration{
+		BomURL:                  v.BomUrl,
+		SapBitsStorageAccountId: v.SapBitsStorageAccountId,
+		SapFqdn:                 sapFqdn,
+		SoftwareVersion:         v.SoftwareVersion,
+		SshPrivateKey:           v.SshPrivateKey,
+	}
+
+	if v.FencingClientId != "" {
+		result.HighAvailabilitySoftwareConfiguration = &sapvirtualinstances.HighAvailabilitySoftwareConfiguration{
+			FencingClientId:       v.FencingClientId,
+			FencingClientPassword: v.FencingClientPassword,
+		}
+	}
+
+	return result
+}
+
+func flattenSAPSoftwareConfiguration(input sapvirtualinstances.SoftwareConfiguration, d *pluginsdk.ResourceData) []SAPSoftwareConfiguration {
+	v, ok := input.(sapvirtualinstances.ServiceInitiatedSoftwareConfiguration)
+	if !ok {
+		return []SAPSoftwareConfiguration{}
+	}
+
+	return []SAPSoftwareConfiguration{
+		{
+			BomUrl:                  v.BomURL,
+			SapBitsStorageAccountId: v.SapBitsStorageAccountId,
+			SoftwareVersion:         v.SoftwareVersion,
+			// the API doesn't return the SSH Private Key so we look this up from the config
+			SshPrivateKey: d.Get("software_configuration.0.ssh_private_key").(string),
+		},
+	}
+}
+
+func flattenSAPHighAvailabilitySoftwareConfiguration(input sapvirtualinstances.SoftwareConfiguration, d *pluginsdk.ResourceData) []SAPHighAvailabilitySoftwareConfiguration {
+	v, ok := input.(sapvirtualinstances.ServiceInitiatedSoftwareConfiguration)
+	if !ok {
+		return []SAPHighAvailabilitySoftwareConfiguration{}
+	}
+
+	result := SAPHighAvailabilitySoftwareConfiguration{
+		BomUrl:                  v.BomURL,
+		SapBitsStorageAccountId: v.SapBitsStorageAccountId,
+		SoftwareVersion:         v.SoftwareVersion,
+		// the API doesn't return the SSH Private Key or the Fencing Client Password so we look these up from the config
+		SshPrivateKey:         d.Get("software_configuration.0.ssh_private_key").(string),
+		FencingClientPassword: d.Get("software_configuration.0.fencing_client_password").(string),
+	}
+
+	if ha := v.HighAvailabilitySoftwareConfiguration; ha != nil {
+		result.FencingClientId = ha.FencingClientId
+	}
+
+	return []SAPHighAvailabilitySoftwareConfiguration{result}
+}
+
+// flattenSAPVirtualInstanceDesiredState maps the status of the SAP system onto `desired_state`, `desired_state` is only
+// tracked once it has been specified so that systems started and stopped outside of Terraform don't show a diff
+func flattenSAPVirtualInstanceDesiredState(status *sapvirtualinstances.SAPVirtualInstanceStatus, d *pluginsdk.ResourceData) string {
+	current := d.Get("desired_state").(string)
+	if current == "" || status == nil {
+		return current
+	}
+
+	switch *status {
+	case sapvirtualinstances.SAPVirtualInstanceStatusRunning, sapvirtualinstances.SAPVirtualInstanceStatusStarting:
+		return sapVirtualInstanceDesiredStateRunning
+	case sapvirtualinstances.SAPVirtualInstanceStatusOffline, sapvirtualinstances.SAPVirtualInstanceStatusSoftShutdown, sapvirtualinstances.SAPVirtualInstanceStatusStopping:
+		return sapVirtualInstanceDesiredStateStopped
+	case sapvirtualinstances.SAPVirtualInstanceStatusPartiallyRunning:
+		// surfaced as-is so that the diff shows the system needs to be started or stopped to match `desired_state`
+		return string(*status)
+	}
+
+	// the status is `Unavailable` when it can't be determined, in which case the configured value is retained
+	return current
+}
+
+func applySAPVirtualInstanceDesiredState(ctx context.Context, client *sapvirtualinstances.SAPVirtualInstancesClient, id sapvirtualinstances.SapVirtualInstanceId, desiredState string) error {
+	switch desiredState {
+	case sapVirtualInstanceDesiredStateRunning:
+		if err := client.StartThenPoll(ctx, id, sapvirtualinstances.StartRequest{}); err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+	case sapVirtualInstanceDesiredStateStopped:
+		if err := client.StopThenPoll(ctx, id, sapvirtualinstances.StopRequest{}); err != nil {
+			return fmt.Errorf("stopping %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+)
+
+// HasOnlyTagsChange returns whether `tags` is the only field which has changed - in which case the tags can be
+// updated using UpdateTagsOnly, rather than sending a full PUT for the resource (which can be a long-running
+// operation with side effects, such as restarting the resource)
+func HasOnlyTagsChange(d *ResourceData) bool {
+	return d.HasChange("tags") && !d.HasChangeExcept("tags")
+}
+
+// UpdateTagsOnly replaces the tags for the resource with the specified ID with those in `tags`, using the Tags API
+// (`Microsoft.Resources/tags`) rather than the API for the resource itself.
+//
+// The Tags API requires the `Microsoft.Resources/tags/write` permission, which isn't granted by roles scoped to the
+// resource provider (e.g. `Network Contributor`) - as such when this returns a 403 `false` is returned, and the caller
+// should fall back to updating the resource using its own API.
+func UpdateTagsOnly(ctx context.Context, client *tags.TagsClient, resourceId string, d *ResourceData) (bool, error) {
+	scope := commonids.NewScopeID(resourceId)
+
+	input := make(map[string]string)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		input[k] = v.(string)
+	}
+
+	// removing all of the tags is done by deleting the tags resource for this scope
+	if len(input) == 0 {
+		result, err := client.DeleteAtScope(ctx, scope)
+		if err != nil {
+			if response.WasForbidden(result.HttpResponse) {
+				log.Printf("[DEBUG] Removing the tags for %s using the Tags API was forbidden - falling back to updating the resource", resourceId)
+				return false, nil
+			}
+			return false, fmt.Errorf("removing the tags for %s: %+v", resourceId, err)
+		}
+		if err := result.Poller.PollUntilDone(ctx); err != nil {
+			return false, fmt.Errorf("polling after removing the tags for %s: %+v", resourceId, err)
+		}
+
+		return true, nil
+	}
+
+	payload := tags.TagsPatchResource{
+		Operation: pointer.To(tags.TagsPatchOperationReplace),
+		Properties: &tags.Tags{
+			Tags: pointer.To(input),
+		},
+	}
+	result, err := client.UpdateAtScope(ctx, scope, payload)
+	if err != nil {
+		if response.WasForbidden(result.HttpResponse) {
+			log.Printf("[DEBUG] Updating the tags for %s using the Tags API was forbidden - falling back to updating the resource", resourceId)
+			return false, nil
+		}
+		return false, fmt.Errorf("updating the tags for %s: %+v", resourceId, err)
+	}
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return false, fmt.Errorf("polling after updating the tags for %s: %+v", resourceId, err)
+	}
+
+	return true, nil
+}
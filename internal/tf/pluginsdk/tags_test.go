@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"golang.org/x/oauth2"
+)
+
+const testTagsResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1"
+
+func testTagsSchema() map[string]*Schema {
+	return map[string]*Schema{
+		"sku": {
+			Type:     TypeString,
+			Optional: true,
+		},
+
+		"tags": {
+			Type:     TypeMap,
+			Optional: true,
+			Elem: &Schema{
+				Type: TypeString,
+			},
+		},
+	}
+}
+
+// testTagsResourceData returns the ResourceData for a resource with the specified state being updated to the
+// specified configuration
+func testTagsResourceData(t *testing.T, state map[string]string, config map[string]interface{}) *ResourceData {
+	s := schema.InternalMap(testTagsSchema())
+	instanceState := &terraform.InstanceState{
+		ID:         testTagsResourceId,
+		Attributes: state,
+	}
+
+	diff, err := s.Diff(context.Background(), instanceState, terraform.NewResourceConfigRaw(config), nil, nil, false)
+	if err != nil {
+		t.Fatalf("building the diff: %+v", err)
+	}
+
+	d, err := s.Data(instanceState, diff)
+	if err != nil {
+		t.Fatalf("building the resource data: %+v", err)
+	}
+
+	return d
+}
+
+func TestHasOnlyTagsChange(t *testing.T) {
+	state := map[string]string{
+		"sku":         "Basic",
+		"tags.%":      "1",
+		"tags.source": "terraform",
+	}
+
+	testData := []struct {
+		name     string
+		config   map[string]interface{}
+		expected bool
+	}{
+		{
+			name: "no changes",
+			config: map[string]interface{}{
+				"sku":  "Basic",
+				"tags": map[string]interface{}{"source": "terraform"},
+			},
+			expected: false,
+		},
+		{
+			name: "tag value changed",
+			config: map[string]interface{}{
+				"sku":  "Basic",
+				"tags": map[string]interface{}{"source": "updated"},
+			},
+			expected: true,
+		},
+		{
+			name: "tag added",
+			config: map[string]interface{}{
+				"sku":  "Basic",
+				"tags": map[string]interface{}{"source": "terraform", "environment": "test"},
+			},
+			expected: true,
+		},
+		{
+			name: "tags removed",
+			config: map[string]interface{}{
+				"sku": "Basic",
+			},
+			expected: true,
+		},
+		{
+			name: "other field changed",
+			config: map[string]interface{}{
+				"sku":  "Standard",
+				"tags": map[string]interface{}{"source": "terraform"},
+			},
+			expected: false,
+		},
+		{
+			name: "tags and other field changed",
+			config: map[string]interface{}{
+				"sku":  "Standard",
+				"tags": map[string]interface{}{"source": "updated"},
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			d := testTagsResourceData(t, state, v.config)
+			if actual := HasOnlyTagsChange(d); actual != v.expected {
+				t.Fatalf("expected %t but got %t", v.expected, actual)
+			}
+		})
+	}
+}
+
+type testTagsAuthorizer struct{}
+
+func (testTagsAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: "token", TokenType: "Bearer"}, nil
+}
+
+func (testTagsAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+type testTagsRequest struct {
+	method string
+	path   string
+	body   map[string]interface{}
+}
+
+// testTagsClient returns a Tags client which sends requests to a test server, and the requests to update or remove
+// the tags which have been received
+func testTagsClient(t *testing.T) (*tags.TagsClient, *[]testTagsRequest) {
+	requests := make([]testTagsRequest, 0)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			// polling the long-running operation for an update
+			if r.URL.Path == "/operations/1" {
+				w.Write([]byte(`{"status": "Succeeded"}`))
+				return
+			}
+
+			// polling until the tags have been removed
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		request := testTagsRequest{
+			method: r.Method,
+			path:   r.URL.Path,
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading the request body: %+v", err)
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &request.body); err != nil {
+				t.Errorf("unmarshaling the request body: %+v", err)
+			}
+		}
+		requests = append(requests, request)
+
+		if r.Method == http.MethodPatch {
+			w.Header().Set("Azure-AsyncOperation", server.URL+"/operations/1")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := tags.NewTagsClientWithBaseURI(environments.NewApiEndpoint("ResourceManager", server.URL, nil))
+	if err != nil {
+		t.Fatalf("building the client: %+v", err)
+	}
+	client.Client.SetAuthorizer(testTagsAuthorizer{})
+
+	return client, &requests
+}
+
+func TestUpdateTagsOnly(t *testing.T) {
+	client, requests := testTagsClient(t)
+	d := testTagsResourceData(t, map[string]string{"tags.%": "1", "tags.source": "terraform"}, map[string]interface{}{
+		"tags": map[string]interface{}{"source": "updated", "environment": "test"},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	updated, err := UpdateTagsOnly(ctx, client, testTagsResourceId, d)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !updated {
+		t.Fatalf("expected the tags to have been updated")
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("expected 1 request but got %d", len(*requests))
+	}
+
+	request := (*requests)[0]
+	if request.method != http.MethodPatch {
+		t.Fatalf("expected the method to be %q but got %q", http.MethodPatch, request.method)
+	}
+	if expected := testTagsResourceId + "/providers/Microsoft.Resources/tags/default"; request.path != expected {
+		t.Fatalf("expected the path to be %q but got %q", expected, request.path)
+	}
+
+	expected := map[string]interface{}{
+		"operation": "Replace",
+		"properties": map[string]interface{}{
+			"tags": map[string]interface{}{
+				"source":      "updated",
+				"environment": "test",
+			},
+		},
+	}
+	if !reflect.DeepEqual(request.body, expected) {
+		t.Fatalf("expected the request body to be %+v but got %+v", expected, request.body)
+	}
+}
+
+func TestUpdateTagsOnly_RemoveAllTags(t *testing.T) {
+	client, requests := testTagsClient(t)
+	d := testTagsResourceData(t, map[string]string{"tags.%": "1", "tags.source": "terraform"}, map[string]interface{}{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	updated, err := UpdateTagsOnly(ctx, client, testTagsResourceId, d)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !updated {
+		t.Fatalf("expected the tags to have been updated")
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("expected 1 request but got %d", len(*requests))
+	}
+
+	request := (*requests)[0]
+	if request.method != http.MethodDelete {
+		t.Fatalf("expected the method to be %q but got %q", http.MethodDelete, request.method)
+	}
+	if expected := testTagsResourceId + "/providers/Microsoft.Resources/tags/default"; request.path != expected {
+		t.Fatalf("expected the path to be %q but got %q", expected, request.path)
+	}
+}
+
+func TestUpdateTagsOnly_Forbidden(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": {"code": "AuthorizationFailed", "message": "does not have authorization to perform action 'Microsoft.Resources/tags/write'"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := tags.NewTagsClientWithBaseURI(environments.NewApiEndpoint("ResourceManager", server.URL, nil))
+	if err != nil {
+		t.Fatalf("building the client: %+v", err)
+	}
+	client.Client.SetAuthorizer(testTagsAuthorizer{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	testData := []struct {
+		name   string
+		config map[string]interface{}
+	}{
+		{
+			name: "update",
+			config: map[string]interface{}{
+				"tags": map[string]interface{}{"source": "updated"},
+			},
+		},
+		{
+			name:   "remove all",
+			config: map[string]interface{}{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			requests = 0
+			d := testTagsResourceData(t, map[string]string{"tags.%": "1", "tags.source": "terraform"}, v.config)
+
+			updated, err := UpdateTagsOnly(ctx, client, testTagsResourceId, d)
+			if err != nil {
+				t.Fatalf("expected a 403 to fall back rather than error but got: %+v", err)
+			}
+			if updated {
+				t.Fatalf("expected the tags not to have been updated")
+			}
+			if requests != 1 {
+				t.Fatalf("expected 1 request but got %d", requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// DefaultTimeouts are the overrides for the default timeouts of resources which are configured in the
+// `default_timeouts` blocks within the Provider block. A `timeouts` block within a resource takes precedence.
+type DefaultTimeouts struct {
+	// AllResources are the overrides which apply to every resource
+	AllResources Overrides
+
+	// ResourceTypes are the overrides for specific resource types, which take precedence over AllResources
+	ResourceTypes map[string]Overrides
+}
+
+type Overrides struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// DefaultTimeoutsConfig is a single `default_timeouts` block from the Provider block
+type DefaultTimeoutsConfig struct {
+	ResourceTypes []string
+	Create        string
+	Read          string
+	Update        string
+	Delete        string
+}
+
+// ExpandDefaultTimeouts validates and combines the `default_timeouts` blocks from the Provider block
+func ExpandDefaultTimeouts(input []DefaultTimeoutsConfig) (*DefaultTimeouts, error) {
+	output := DefaultTimeouts{
+		ResourceTypes: make(map[string]Overrides),
+	}
+
+	allResourcesConfigured := false
+	for _, block := range input {
+		overrides, err := expandOverrides(block)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(block.ResourceTypes) == 0 {
+			if allResourcesConfigured {
+				return nil, fmt.Errorf("only one `default_timeouts` block can omit `resource_types`")
+			}
+			allResourcesConfigured = true
+			output.AllResources = *overrides
+			continue
+		}
+
+		for _, resourceType := range block.ResourceTypes {
+			resourceType = strings.ToLower(resourceType)
+			if _, ok := output.ResourceTypes[resourceType]; ok {
+				return nil, fmt.Errorf("the resource type %q is specified in more than one `default_timeouts` block", resourceType)
+			}
+			output.ResourceTypes[resourceType] = *overrides
+		}
+	}
+
+	return &output, nil
+}
+
+func expandOverrides(input DefaultTimeoutsConfig) (*Overrides, error) {
+	output := Overrides{}
+
+	values := []struct {
+		key    string
+		value  string
+		target **time.Duration
+	}{
+		{"create", input.Create, &output.Create},
+		{"read", input.Read, &output.Read},
+		{"update", input.Update, &output.Update},
+		{"delete", input.Delete, &output.Delete},
+	}
+	for _, v := range values {
+		if v.value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(v.value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `%s` within the `default_timeouts` block: %+v", v.key, err)
+		}
+		if duration <= 0 {
+			return nil, fmt.Errorf("`%s` within the `default_timeouts` block must be greater than zero, got %q", v.key, v.value)
+		}
+		*v.target = &duration
+	}
+
+	return &output, nil
+}
+
+// For returns the overrides for the specified resource type
+func (d DefaultTimeouts) For(resourceType string) Overrides {
+	output := d.AllResources
+
+	if v, ok := d.ResourceTypes[strings.ToLower(resourceType)]; ok {
+		if v.Create != nil {
+			output.Create = v.Create
+		}
+		if v.Read != nil {
+			output.Read = v.Read
+		}
+		if v.Update != nil {
+			output.Update = v.Update
+		}
+		if v.Delete != nil {
+			output.Delete = v.Delete
+		}
+	}
+
+	return output
+}
+
+type defaultsContextKey struct{}
+
+type resourceDefaults struct {
+	overrides Overrides
+	timeouts  pluginsdk.ResourceTimeout
+}
+
+// WithDefaults returns a copy of the context which carries the overrides for a resource alongside the default timeouts
+// defined by that resource, which ForCreate, ForRead, ForUpdate and ForDelete use when the resource has no `timeouts` block
+func WithDefaults(ctx context.Context, overrides Overrides, resourceTimeouts pluginsdk.ResourceTimeout) context.Context {
+	return context.WithValue(ctx, defaultsContextKey{}, resourceDefaults{
+		overrides: overrides,
+		timeouts:  resourceTimeouts,
+	})
+}
+
+// HasDefaults returns whether the context carries overrides added by WithDefaults
+func HasDefaults(ctx context.Context) bool {
+	_, ok := ctx.Value(defaultsContextKey{}).(resourceDefaults)
+	return ok
+}
+
+// determine returns the timeout for the specified operation, using the override carried by the context when the
+// resource has no timeout configured for it within a `timeouts` block. Only the operations which the resource defines
+// a timeout for can be overridden, since the others aren't supported by the resource.
+func determine(ctx context.Context, d *pluginsdk.ResourceData, operation string) time.Duration {
+	timeout := d.Timeout(operation)
+
+	v, ok := ctx.Value(defaultsContextKey{}).(resourceDefaults)
+	if !ok {
+		return timeout
+	}
+
+	var override, resourceDefault *time.Duration
+	switch operation {
+	case pluginsdk.TimeoutCreate:
+		override, resourceDefault = v.overrides.Create, v.timeouts.Create
+	case pluginsdk.TimeoutRead:
+		override, resourceDefault = v.overrides.Read, v.timeouts.Read
+	case pluginsdk.TimeoutUpdate:
+		override, resourceDefault = v.overrides.Update, v.timeouts.Update
+	case pluginsdk.TimeoutDelete:
+		override, resourceDefault = v.overrides.Delete, v.timeouts.Delete
+	}
+
+	if override == nil || resourceDefault == nil || isConfigured(d, operation) {
+		return timeout
+	}
+
+	return *override
+}
+
+// isConfigured returns whether a timeout for the specified operation is set within the `timeouts` block of the
+// resource. The configuration is only available when planning and applying, however the Plugin SDK copies the
+// `timeouts` block into the state - which is used when reading and deleting.
+func isConfigured(d *pluginsdk.ResourceData, operation string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		raw = d.GetRawState()
+	}
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(pluginsdk.TimeoutsConfigKey) {
+		return false
+	}
+
+	block := raw.GetAttr(pluginsdk.TimeoutsConfigKey)
+	if block.IsNull() || !block.IsKnown() || !block.Type().IsObjectType() {
+		return false
+	}
+
+	for _, key := range []string{operation, pluginsdk.TimeoutDefault} {
+		if block.Type().HasAttribute(key) && !block.GetAttr(key).IsNull() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func main() {
+	f := flag.NewFlagSet("sweeper", flag.ExitOnError)
+
+	prefixes := f.String("prefixes", "acctest", "a comma-separated list of name prefixes which resources must match to be removed")
+	tag := f.String("tag", "", "an optional `key` or `key=value` Tag which resources must have to be removed")
+	olderThan := f.Duration("older-than", 24*time.Hour, "the minimum age of a resource before it's removed, `0` removes resources of any age")
+	services := f.String("services", "", "an optional comma-separated list of Service names to limit the sweep to")
+	dryRun := f.Bool("dry-run", true, "log the resources which would be removed rather than removing them")
+	includeTenantResources := f.Bool("include-tenant-resources", false, "also remove resources scoped to the Tenant rather than the Subscription, such as Management Groups")
+	timeout := f.Duration("timeout", 3*time.Hour, "the maximum duration of the sweep")
+
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing arguments: %+v", err)
+	}
+
+	filter := sdk.SweepFilter{
+		Prefixes:  splitAndTrim(*prefixes),
+		OlderThan: *olderThan,
+		DryRun:    *dryRun,
+		Now:       time.Now(),
+
+		IncludeTenantResources: *includeTenantResources,
+	}
+	if len(filter.Prefixes) == 0 {
+		log.Fatalf("at least one value must be specified for `-prefixes`")
+	}
+	if *tag != "" {
+		key, value, _ := strings.Cut(*tag, "=")
+		filter.TagKey = key
+		filter.TagValue = value
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, filter, splitAndTrim(*services)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, filter sdk.SweepFilter, serviceNames []string) error {
+	client, err := testclient.BuildWithTestName("")
+	if err != nil {
+		return err
+	}
+
+	failed := false
+	for _, sweeper := range registeredSweepers(serviceNames) {
+		log.Printf("[INFO] Sweeping %s in Subscription %q..", sweeper.Name(), client.Account.SubscriptionId)
+		swept, err := sweeper.Sweep(ctx, client, filter)
+		for _, id := range swept {
+			log.Printf("[INFO]   %s", id)
+		}
+		if err != nil {
+			log.Printf("[ERROR] sweeping %s: %+v", sweeper.Name(), err)
+			failed = true
+		}
+	}
+
+	if failed {
+		return fmt.Errorf("one or more Sweepers failed, see the log output above for more information")
+	}
+
+	return nil
+}
+
+// registeredSweepers returns the Sweepers exposed by each Service Registration, optionally limited to
+// the specified Service names. Service Registrations can be both Typed and Untyped, so these are
+// de-duplicated by name.
+func registeredSweepers(serviceNames []string) []sdk.Sweeper {
+	registrations := make(map[string]interface{})
+	for _, service := range provider.SupportedTypedServices() {
+		registrations[service.Name()] = service
+	}
+	for _, service := range provider.SupportedUntypedServices() {
+		registrations[service.Name()] = service
+	}
+
+	names := make([]string, 0, len(registrations))
+	for name := range registrations {
+		if len(serviceNames) > 0 && !containsFold(serviceNames, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sweepers := make([]sdk.Sweeper, 0)
+	for _, name := range names {
+		if v, ok := registrations[name].(sdk.ServiceRegistrationWithSweepers); ok {
+			sweepers = append(sweepers, v.Sweepers()...)
+		}
+	}
+
+	return sweepers
+}
+
+func containsFold(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitAndTrim(input string) []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultConcurrencyLimit is the number of concurrent requests used when retrieving child resources, which is kept
+// low enough to avoid being throttled by the Resource Manager API
+const DefaultConcurrencyLimit = 8
+
+// MapConcurrently calls `fn` for each of the `items` using at most `limit` goroutines at once, returning the results in
+// the same order as `items`. When any call fails the context passed to the remaining calls is cancelled and the first
+// error is returned.
+func MapConcurrently[T any, R any](ctx context.Context, limit int, items []T, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	if limit < 1 {
+		limit = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]R, len(items))
+	semaphore := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for i, item := range items {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			result, err := fn(ctx, item)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+
+			results[i] = result
+		}(i, item)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
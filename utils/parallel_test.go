@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapConcurrently_PreservesOrder(t *testing.T) {
+	items := []int{5, 4, 3, 2, 1}
+
+	results, err := MapConcurrently(context.TODO(), 3, items, func(ctx context.Context, item int) (string, error) {
+		time.Sleep(time.Duration(item) * time.Millisecond)
+		return fmt.Sprintf("item-%d", item), nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	for i, item := range items {
+		if expected := fmt.Sprintf("item-%d", item); results[i] != expected {
+			t.Fatalf("expected result %d to be %q but got %q", i, expected, results[i])
+		}
+	}
+}
+
+func TestMapConcurrently_LimitsConcurrency(t *testing.T) {
+	var current, highest int32
+	items := make([]int, 20)
+
+	_, err := MapConcurrently(context.TODO(), 4, items, func(ctx context.Context, item int) (int, error) {
+		v := atomic.AddInt32(&current, 1)
+		for {
+			h := atomic.LoadInt32(&highest)
+			if v <= h || atomic.CompareAndSwapInt32(&highest, h, v) {
+				break
+			}
+		}
+
+		time.Sleep(2 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+		return item, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if highest > 4 {
+		t.Fatalf("expected at most 4 concurrent calls but got %d", highest)
+	}
+}
+
+func TestMapConcurrently_ReturnsError(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6}
+
+	_, err := MapConcurrently(context.TODO(), 2, items, func(ctx context.Context, item int) (int, error) {
+		if item == 3 {
+			return 0, fmt.Errorf("retrieving item %d", item)
+		}
+		return item, nil
+	})
+	if err == nil || err.Error() != "retrieving item 3" {
+		t.Fatalf("expected the error for item 3 but got: %+v", err)
+	}
+}